@@ -6,7 +6,9 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
@@ -36,7 +38,6 @@ var IntegrationKind_name = map[int32]string{
 	0: "HTTP",
 	1: "INFLUXDB",
 }
-
 var IntegrationKind_value = map[string]int32{
 	"HTTP":     0,
 	"INFLUXDB": 1,
@@ -45,11 +46,35 @@ var IntegrationKind_value = map[string]int32{
 func (x IntegrationKind) String() string {
 	return proto.EnumName(IntegrationKind_name, int32(x))
 }
-
 func (IntegrationKind) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fc846aced8fe6ea6, []int{0}
 }
 
+type PayloadCodecOperation int32
+
+const (
+	// Decode the given bytes into an object.
+	PayloadCodecOperation_DECODE PayloadCodecOperation = 0
+	// Encode the given object into bytes.
+	PayloadCodecOperation_ENCODE PayloadCodecOperation = 1
+)
+
+var PayloadCodecOperation_name = map[int32]string{
+	0: "DECODE",
+	1: "ENCODE",
+}
+var PayloadCodecOperation_value = map[string]int32{
+	"DECODE": 0,
+	"ENCODE": 1,
+}
+
+func (x PayloadCodecOperation) String() string {
+	return proto.EnumName(PayloadCodecOperation_name, int32(x))
+}
+func (PayloadCodecOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{1}
+}
+
 type InfluxDBPrecision int32
 
 const (
@@ -69,7 +94,6 @@ var InfluxDBPrecision_name = map[int32]string{
 	4: "M",
 	5: "H",
 }
-
 var InfluxDBPrecision_value = map[string]int32{
 	"NS": 0,
 	"U":  1,
@@ -82,9 +106,8 @@ var InfluxDBPrecision_value = map[string]int32{
 func (x InfluxDBPrecision) String() string {
 	return proto.EnumName(InfluxDBPrecision_name, int32(x))
 }
-
 func (InfluxDBPrecision) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{1}
+	return fileDescriptor_fc846aced8fe6ea6, []int{2}
 }
 
 type Application struct {
@@ -104,7 +127,20 @@ type Application struct {
 	// Payload encoder script.
 	PayloadEncoderScript string `protobuf:"bytes,7,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
 	// Payload decoder script.
-	PayloadDecoderScript string   `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	PayloadDecoderScript string `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// ID of the shared codec (see CodecService) to use.
+	// When set, this codec is used instead of the payload codec and scripts
+	// defined above. The codec must belong to the same organization.
+	CodecId int64 `protobuf:"varint,9,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Publish the decoded object as SenML records (RFC 8428).
+	// When set, the object of the uplink payload is converted into a list
+	// of SenML records, using the DevEUI as base name and the time of
+	// reception as base time.
+	SenmlOutput bool `protobuf:"varint,10,opt,name=senml_output,json=senMLOutput,proto3" json:"senml_output,omitempty"`
+	// Version of the shared codec to use.
+	// When set, this version of the codec is used, instead of the latest
+	// version. This can only be set in combination with the codec ID.
+	CodecVersion         int64    `protobuf:"varint,11,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -190,6 +226,27 @@ func (m *Application) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *Application) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *Application) GetSenmlOutput() bool {
+	if m != nil {
+		return m.SenmlOutput
+	}
+	return false
+}
+
+func (m *Application) GetCodecVersion() int64 {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return 0
+}
+
 type ApplicationListItem struct {
 	// Application ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1381,216 +1438,1123 @@ func (m *DeleteInfluxDBIntegrationRequest) GetApplicationId() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Application)(nil), "api.Application")
-	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
-	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
-	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
-	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
-	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
-	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
-	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
-	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
-	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
-	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
-	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
-	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
-	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
-	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
-	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
-	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
-	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
-	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
-	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
-	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
-	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
-	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
-	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
-	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
-	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
-	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
-	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+type TestPayloadCodecRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Codec operation to test.
+	Operation PayloadCodecOperation `protobuf:"varint,2,opt,name=operation,proto3,enum=api.PayloadCodecOperation" json:"operation,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,3,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,4,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,5,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// FPort used for the payload.
+	FPort uint32 `protobuf:"varint,6,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Bytes to decode (base64 encoded in JSON).
+	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// Bytes to decode (HEX encoded).
+	// When set, this takes precedence over data.
+	DataHex string `protobuf:"bytes,8,opt,name=data_hex,json=dataHEX,proto3" json:"data_hex,omitempty"`
+	// JSON object to encode.
+	JsonObject string `protobuf:"bytes,9,opt,name=json_object,json=jsonObject,proto3" json:"json_object,omitempty"`
+	// Device EUI (HEX encoded, optional).
+	// When set, the DevEUI, name and variables of this device are exposed
+	// to the decode function. The device must belong to the application.
+	DevEui string `protobuf:"bytes,10,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Device variables exposed to the decode function.
+	// These take precedence over the variables of the device.
+	Variables            map[string]string `protobuf:"bytes,11,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *TestPayloadCodecRequest) Reset()         { *m = TestPayloadCodecRequest{} }
+func (m *TestPayloadCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*TestPayloadCodecRequest) ProtoMessage()    {}
+func (*TestPayloadCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{26}
+}
+func (m *TestPayloadCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayloadCodecRequest.Unmarshal(m, b)
+}
+func (m *TestPayloadCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayloadCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *TestPayloadCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadCodecRequest.Merge(dst, src)
+}
+func (m *TestPayloadCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_TestPayloadCodecRequest.Size(m)
+}
+func (m *TestPayloadCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadCodecRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TestPayloadCodecRequest proto.InternalMessageInfo
 
-// ApplicationServiceClient is the client API for ApplicationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationServiceClient interface {
-	// Create creates the given application.
-	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
-	// Get returns the requested application.
-	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
-	// Update updates the given application.
-	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Delete deletes the given application.
-	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// List lists the available applications.
-	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
-	// CreateHTTPIntegration creates a HTTP application-integration.
-	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetHTTPIntegration returns the HTTP application-integration.
-	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
-	// UpdateHTTPIntegration updates the HTTP application-integration.
-	UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// DeleteIntegration deletes the HTTP application-integration.
-	DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// CreateInfluxDBIntegration create an InfluxDB application-integration.
-	CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetInfluxDBIntegration returns the InfluxDB application-integration.
-	GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error)
-	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
-	UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
-	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// ListIntegrations lists all configured integrations.
-	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+func (m *TestPayloadCodecRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
 }
 
-type applicationServiceClient struct {
-	cc *grpc.ClientConn
+func (m *TestPayloadCodecRequest) GetOperation() PayloadCodecOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return PayloadCodecOperation_DECODE
 }
 
-func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
-	return &applicationServiceClient{cc}
+func (m *TestPayloadCodecRequest) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
 }
 
-func (c *applicationServiceClient) Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error) {
-	out := new(CreateApplicationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error) {
-	out := new(GetApplicationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationServiceClient) Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error) {
-	out := new(ListApplicationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetDataHex() string {
+	if m != nil {
+		return m.DataHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error) {
-	out := new(GetHTTPIntegrationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecRequest) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+type TestPayloadCodecResponse struct {
+	// Decoded object (JSON encoded).
+	JsonObject string `protobuf:"bytes,1,opt,name=json_object,json=jsonObject,proto3" json:"json_object,omitempty"`
+	// Encoded bytes (base64 encoded in JSON).
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Encoded bytes (HEX encoded).
+	DataHex string `protobuf:"bytes,3,opt,name=data_hex,json=dataHEX,proto3" json:"data_hex,omitempty"`
+	// Codec error.
+	// This is empty when the codec was executed successfully.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Codec execution time.
+	ExecutionTime *duration.Duration `protobuf:"bytes,5,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Captured console output (console.log).
+	Console              []string `protobuf:"bytes,6,rep,name=console,proto3" json:"console,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestPayloadCodecResponse) Reset()         { *m = TestPayloadCodecResponse{} }
+func (m *TestPayloadCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*TestPayloadCodecResponse) ProtoMessage()    {}
+func (*TestPayloadCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{27}
+}
+func (m *TestPayloadCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestPayloadCodecResponse.Unmarshal(m, b)
+}
+func (m *TestPayloadCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestPayloadCodecResponse.Marshal(b, m, deterministic)
+}
+func (dst *TestPayloadCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestPayloadCodecResponse.Merge(dst, src)
+}
+func (m *TestPayloadCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_TestPayloadCodecResponse.Size(m)
+}
+func (m *TestPayloadCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestPayloadCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestPayloadCodecResponse proto.InternalMessageInfo
+
+func (m *TestPayloadCodecResponse) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateInfluxDBIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error) {
-	out := new(GetInfluxDBIntegrationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetInfluxDBIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecResponse) GetDataHex() string {
+	if m != nil {
+		return m.DataHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateInfluxDBIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteInfluxDBIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecResponse) GetExecutionTime() *duration.Duration {
+	if m != nil {
+		return m.ExecutionTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
-	out := new(ListIntegrationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListIntegrations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestPayloadCodecResponse) GetConsole() []string {
+	if m != nil {
+		return m.Console
 	}
-	return out, nil
+	return nil
 }
 
-// ApplicationServiceServer is the server API for ApplicationService service.
-type ApplicationServiceServer interface {
-	// Create creates the given application.
+type PayloadCodecRevision struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Revision number.
+	Revision uint32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Username of the user who created the revision.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,5,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,6,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,7,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// ID of the shared codec.
+	CodecId int64 `protobuf:"varint,8,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version of the shared codec (0 = latest version).
+	CodecVersion         int64    `protobuf:"varint,9,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayloadCodecRevision) Reset()         { *m = PayloadCodecRevision{} }
+func (m *PayloadCodecRevision) String() string { return proto.CompactTextString(m) }
+func (*PayloadCodecRevision) ProtoMessage()    {}
+func (*PayloadCodecRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{28}
+}
+func (m *PayloadCodecRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayloadCodecRevision.Unmarshal(m, b)
+}
+func (m *PayloadCodecRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayloadCodecRevision.Marshal(b, m, deterministic)
+}
+func (dst *PayloadCodecRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadCodecRevision.Merge(dst, src)
+}
+func (m *PayloadCodecRevision) XXX_Size() int {
+	return xxx_messageInfo_PayloadCodecRevision.Size(m)
+}
+func (m *PayloadCodecRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadCodecRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadCodecRevision proto.InternalMessageInfo
+
+func (m *PayloadCodecRevision) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *PayloadCodecRevision) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *PayloadCodecRevision) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PayloadCodecRevision) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *PayloadCodecRevision) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *PayloadCodecRevision) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *PayloadCodecRevision) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *PayloadCodecRevision) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *PayloadCodecRevision) GetCodecVersion() int64 {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return 0
+}
+
+type PayloadCodecRevisionListItem struct {
+	// Revision number.
+	Revision uint32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Username of the user who created the revision.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,4,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// ID of the shared codec.
+	CodecId int64 `protobuf:"varint,5,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version of the shared codec (0 = latest version).
+	CodecVersion         int64    `protobuf:"varint,6,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayloadCodecRevisionListItem) Reset()         { *m = PayloadCodecRevisionListItem{} }
+func (m *PayloadCodecRevisionListItem) String() string { return proto.CompactTextString(m) }
+func (*PayloadCodecRevisionListItem) ProtoMessage()    {}
+func (*PayloadCodecRevisionListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{29}
+}
+func (m *PayloadCodecRevisionListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayloadCodecRevisionListItem.Unmarshal(m, b)
+}
+func (m *PayloadCodecRevisionListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayloadCodecRevisionListItem.Marshal(b, m, deterministic)
+}
+func (dst *PayloadCodecRevisionListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadCodecRevisionListItem.Merge(dst, src)
+}
+func (m *PayloadCodecRevisionListItem) XXX_Size() int {
+	return xxx_messageInfo_PayloadCodecRevisionListItem.Size(m)
+}
+func (m *PayloadCodecRevisionListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadCodecRevisionListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadCodecRevisionListItem proto.InternalMessageInfo
+
+func (m *PayloadCodecRevisionListItem) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *PayloadCodecRevisionListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PayloadCodecRevisionListItem) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *PayloadCodecRevisionListItem) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *PayloadCodecRevisionListItem) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *PayloadCodecRevisionListItem) GetCodecVersion() int64 {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return 0
+}
+
+type ListPayloadCodecRevisionsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Max number of revisions to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPayloadCodecRevisionsRequest) Reset()         { *m = ListPayloadCodecRevisionsRequest{} }
+func (m *ListPayloadCodecRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPayloadCodecRevisionsRequest) ProtoMessage()    {}
+func (*ListPayloadCodecRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{30}
+}
+func (m *ListPayloadCodecRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPayloadCodecRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListPayloadCodecRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPayloadCodecRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListPayloadCodecRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPayloadCodecRevisionsRequest.Merge(dst, src)
+}
+func (m *ListPayloadCodecRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPayloadCodecRevisionsRequest.Size(m)
+}
+func (m *ListPayloadCodecRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPayloadCodecRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPayloadCodecRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListPayloadCodecRevisionsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListPayloadCodecRevisionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListPayloadCodecRevisionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListPayloadCodecRevisionsResponse struct {
+	// Total number of revisions.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Revisions within this result-set.
+	Result               []*PayloadCodecRevisionListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ListPayloadCodecRevisionsResponse) Reset()         { *m = ListPayloadCodecRevisionsResponse{} }
+func (m *ListPayloadCodecRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPayloadCodecRevisionsResponse) ProtoMessage()    {}
+func (*ListPayloadCodecRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{31}
+}
+func (m *ListPayloadCodecRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPayloadCodecRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListPayloadCodecRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPayloadCodecRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListPayloadCodecRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPayloadCodecRevisionsResponse.Merge(dst, src)
+}
+func (m *ListPayloadCodecRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPayloadCodecRevisionsResponse.Size(m)
+}
+func (m *ListPayloadCodecRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPayloadCodecRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPayloadCodecRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListPayloadCodecRevisionsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListPayloadCodecRevisionsResponse) GetResult() []*PayloadCodecRevisionListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetPayloadCodecRevisionRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Revision number.
+	Revision             uint32   `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPayloadCodecRevisionRequest) Reset()         { *m = GetPayloadCodecRevisionRequest{} }
+func (m *GetPayloadCodecRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPayloadCodecRevisionRequest) ProtoMessage()    {}
+func (*GetPayloadCodecRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{32}
+}
+func (m *GetPayloadCodecRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayloadCodecRevisionRequest.Unmarshal(m, b)
+}
+func (m *GetPayloadCodecRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayloadCodecRevisionRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPayloadCodecRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayloadCodecRevisionRequest.Merge(dst, src)
+}
+func (m *GetPayloadCodecRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPayloadCodecRevisionRequest.Size(m)
+}
+func (m *GetPayloadCodecRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayloadCodecRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayloadCodecRevisionRequest proto.InternalMessageInfo
+
+func (m *GetPayloadCodecRevisionRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *GetPayloadCodecRevisionRequest) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type GetPayloadCodecRevisionResponse struct {
+	// Payload codec revision.
+	Revision             *PayloadCodecRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPayloadCodecRevisionResponse) Reset()         { *m = GetPayloadCodecRevisionResponse{} }
+func (m *GetPayloadCodecRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPayloadCodecRevisionResponse) ProtoMessage()    {}
+func (*GetPayloadCodecRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{33}
+}
+func (m *GetPayloadCodecRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPayloadCodecRevisionResponse.Unmarshal(m, b)
+}
+func (m *GetPayloadCodecRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPayloadCodecRevisionResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPayloadCodecRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPayloadCodecRevisionResponse.Merge(dst, src)
+}
+func (m *GetPayloadCodecRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPayloadCodecRevisionResponse.Size(m)
+}
+func (m *GetPayloadCodecRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPayloadCodecRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPayloadCodecRevisionResponse proto.InternalMessageInfo
+
+func (m *GetPayloadCodecRevisionResponse) GetRevision() *PayloadCodecRevision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type DiffPayloadCodecRevisionsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Revision number.
+	Revision uint32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Revision number to compare against.
+	// When not set, the previous revision is used.
+	FromRevision         uint32   `protobuf:"varint,3,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffPayloadCodecRevisionsRequest) Reset()         { *m = DiffPayloadCodecRevisionsRequest{} }
+func (m *DiffPayloadCodecRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffPayloadCodecRevisionsRequest) ProtoMessage()    {}
+func (*DiffPayloadCodecRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{34}
+}
+func (m *DiffPayloadCodecRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffPayloadCodecRevisionsRequest.Unmarshal(m, b)
+}
+func (m *DiffPayloadCodecRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffPayloadCodecRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DiffPayloadCodecRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffPayloadCodecRevisionsRequest.Merge(dst, src)
+}
+func (m *DiffPayloadCodecRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffPayloadCodecRevisionsRequest.Size(m)
+}
+func (m *DiffPayloadCodecRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffPayloadCodecRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffPayloadCodecRevisionsRequest proto.InternalMessageInfo
+
+func (m *DiffPayloadCodecRevisionsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DiffPayloadCodecRevisionsRequest) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DiffPayloadCodecRevisionsRequest) GetFromRevision() uint32 {
+	if m != nil {
+		return m.FromRevision
+	}
+	return 0
+}
+
+type DiffPayloadCodecRevisionsResponse struct {
+	// Revision number compared against.
+	FromRevision uint32 `protobuf:"varint,1,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// Revision number.
+	Revision uint32 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Payload codec of the revision compared against.
+	FromPayloadCodec string `protobuf:"bytes,3,opt,name=from_payload_codec,json=fromPayloadCodec,proto3" json:"from_payload_codec,omitempty"`
+	// Payload codec of the revision.
+	PayloadCodec string `protobuf:"bytes,4,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Diff of the payload encoder script (unified format).
+	PayloadEncoderScriptDiff string `protobuf:"bytes,5,opt,name=payload_encoder_script_diff,json=payloadEncoderScriptDiff,proto3" json:"payload_encoder_script_diff,omitempty"`
+	// Diff of the payload decoder script (unified format).
+	PayloadDecoderScriptDiff string   `protobuf:"bytes,6,opt,name=payload_decoder_script_diff,json=payloadDecoderScriptDiff,proto3" json:"payload_decoder_script_diff,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *DiffPayloadCodecRevisionsResponse) Reset()         { *m = DiffPayloadCodecRevisionsResponse{} }
+func (m *DiffPayloadCodecRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffPayloadCodecRevisionsResponse) ProtoMessage()    {}
+func (*DiffPayloadCodecRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{35}
+}
+func (m *DiffPayloadCodecRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffPayloadCodecRevisionsResponse.Unmarshal(m, b)
+}
+func (m *DiffPayloadCodecRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffPayloadCodecRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *DiffPayloadCodecRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffPayloadCodecRevisionsResponse.Merge(dst, src)
+}
+func (m *DiffPayloadCodecRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffPayloadCodecRevisionsResponse.Size(m)
+}
+func (m *DiffPayloadCodecRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffPayloadCodecRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffPayloadCodecRevisionsResponse proto.InternalMessageInfo
+
+func (m *DiffPayloadCodecRevisionsResponse) GetFromRevision() uint32 {
+	if m != nil {
+		return m.FromRevision
+	}
+	return 0
+}
+
+func (m *DiffPayloadCodecRevisionsResponse) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *DiffPayloadCodecRevisionsResponse) GetFromPayloadCodec() string {
+	if m != nil {
+		return m.FromPayloadCodec
+	}
+	return ""
+}
+
+func (m *DiffPayloadCodecRevisionsResponse) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *DiffPayloadCodecRevisionsResponse) GetPayloadEncoderScriptDiff() string {
+	if m != nil {
+		return m.PayloadEncoderScriptDiff
+	}
+	return ""
+}
+
+func (m *DiffPayloadCodecRevisionsResponse) GetPayloadDecoderScriptDiff() string {
+	if m != nil {
+		return m.PayloadDecoderScriptDiff
+	}
+	return ""
+}
+
+type RollbackPayloadCodecRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Revision number to restore.
+	Revision             uint32   `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackPayloadCodecRequest) Reset()         { *m = RollbackPayloadCodecRequest{} }
+func (m *RollbackPayloadCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackPayloadCodecRequest) ProtoMessage()    {}
+func (*RollbackPayloadCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{36}
+}
+func (m *RollbackPayloadCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackPayloadCodecRequest.Unmarshal(m, b)
+}
+func (m *RollbackPayloadCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackPayloadCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *RollbackPayloadCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPayloadCodecRequest.Merge(dst, src)
+}
+func (m *RollbackPayloadCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackPayloadCodecRequest.Size(m)
+}
+func (m *RollbackPayloadCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPayloadCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPayloadCodecRequest proto.InternalMessageInfo
+
+func (m *RollbackPayloadCodecRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *RollbackPayloadCodecRequest) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type RollbackPayloadCodecResponse struct {
+	// Revision number of the created revision.
+	Revision             uint32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackPayloadCodecResponse) Reset()         { *m = RollbackPayloadCodecResponse{} }
+func (m *RollbackPayloadCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackPayloadCodecResponse) ProtoMessage()    {}
+func (*RollbackPayloadCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{37}
+}
+func (m *RollbackPayloadCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackPayloadCodecResponse.Unmarshal(m, b)
+}
+func (m *RollbackPayloadCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackPayloadCodecResponse.Marshal(b, m, deterministic)
+}
+func (dst *RollbackPayloadCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPayloadCodecResponse.Merge(dst, src)
+}
+func (m *RollbackPayloadCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackPayloadCodecResponse.Size(m)
+}
+func (m *RollbackPayloadCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPayloadCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPayloadCodecResponse proto.InternalMessageInfo
+
+func (m *RollbackPayloadCodecResponse) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Application)(nil), "api.Application")
+	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
+	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
+	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
+	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
+	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
+	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
+	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
+	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
+	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
+	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
+	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
+	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
+	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
+	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
+	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
+	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
+	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
+	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
+	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
+	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
+	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
+	proto.RegisterType((*TestPayloadCodecRequest)(nil), "api.TestPayloadCodecRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.TestPayloadCodecRequest.VariablesEntry")
+	proto.RegisterType((*TestPayloadCodecResponse)(nil), "api.TestPayloadCodecResponse")
+	proto.RegisterType((*PayloadCodecRevision)(nil), "api.PayloadCodecRevision")
+	proto.RegisterType((*PayloadCodecRevisionListItem)(nil), "api.PayloadCodecRevisionListItem")
+	proto.RegisterType((*ListPayloadCodecRevisionsRequest)(nil), "api.ListPayloadCodecRevisionsRequest")
+	proto.RegisterType((*ListPayloadCodecRevisionsResponse)(nil), "api.ListPayloadCodecRevisionsResponse")
+	proto.RegisterType((*GetPayloadCodecRevisionRequest)(nil), "api.GetPayloadCodecRevisionRequest")
+	proto.RegisterType((*GetPayloadCodecRevisionResponse)(nil), "api.GetPayloadCodecRevisionResponse")
+	proto.RegisterType((*DiffPayloadCodecRevisionsRequest)(nil), "api.DiffPayloadCodecRevisionsRequest")
+	proto.RegisterType((*DiffPayloadCodecRevisionsResponse)(nil), "api.DiffPayloadCodecRevisionsResponse")
+	proto.RegisterType((*RollbackPayloadCodecRequest)(nil), "api.RollbackPayloadCodecRequest")
+	proto.RegisterType((*RollbackPayloadCodecResponse)(nil), "api.RollbackPayloadCodecResponse")
+	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
+	proto.RegisterEnum("api.PayloadCodecOperation", PayloadCodecOperation_name, PayloadCodecOperation_value)
+	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationServiceClient is the client API for ApplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationServiceClient interface {
+	// Create creates the given application.
+	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
+	// Get returns the requested application.
+	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
+	// Update updates the given application.
+	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the given application.
+	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the available applications.
+	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
+	// CreateHTTPIntegration creates a HTTP application-integration.
+	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP application-integration.
+	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP application-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteIntegration deletes the HTTP application-integration.
+	DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateInfluxDBIntegration create an InfluxDB application-integration.
+	CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB application-integration.
+	GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
+	UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
+	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListIntegrations lists all configured integrations.
+	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// TestPayloadCodec runs the given payload codec against the given
+	// payload, without storing the codec configuration.
+	TestPayloadCodec(ctx context.Context, in *TestPayloadCodecRequest, opts ...grpc.CallOption) (*TestPayloadCodecResponse, error)
+	// ListPayloadCodecRevisions lists the payload codec revisions of the
+	// application (newest first).
+	ListPayloadCodecRevisions(ctx context.Context, in *ListPayloadCodecRevisionsRequest, opts ...grpc.CallOption) (*ListPayloadCodecRevisionsResponse, error)
+	// GetPayloadCodecRevision returns the given payload codec revision.
+	GetPayloadCodecRevision(ctx context.Context, in *GetPayloadCodecRevisionRequest, opts ...grpc.CallOption) (*GetPayloadCodecRevisionResponse, error)
+	// DiffPayloadCodecRevisions returns the differences between two
+	// payload codec revisions.
+	DiffPayloadCodecRevisions(ctx context.Context, in *DiffPayloadCodecRevisionsRequest, opts ...grpc.CallOption) (*DiffPayloadCodecRevisionsResponse, error)
+	// RollbackPayloadCodec restores the payload codec of the given revision.
+	// This creates a new revision.
+	RollbackPayloadCodec(ctx context.Context, in *RollbackPayloadCodecRequest, opts ...grpc.CallOption) (*RollbackPayloadCodecResponse, error)
+}
+
+type applicationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
+	return &applicationServiceClient{cc}
+}
+
+func (c *applicationServiceClient) Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error) {
+	out := new(CreateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error) {
+	out := new(GetApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error) {
+	out := new(ListApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error) {
+	out := new(GetHTTPIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error) {
+	out := new(GetInfluxDBIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
+	out := new(ListIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListIntegrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) TestPayloadCodec(ctx context.Context, in *TestPayloadCodecRequest, opts ...grpc.CallOption) (*TestPayloadCodecResponse, error) {
+	out := new(TestPayloadCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/TestPayloadCodec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListPayloadCodecRevisions(ctx context.Context, in *ListPayloadCodecRevisionsRequest, opts ...grpc.CallOption) (*ListPayloadCodecRevisionsResponse, error) {
+	out := new(ListPayloadCodecRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListPayloadCodecRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetPayloadCodecRevision(ctx context.Context, in *GetPayloadCodecRevisionRequest, opts ...grpc.CallOption) (*GetPayloadCodecRevisionResponse, error) {
+	out := new(GetPayloadCodecRevisionResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetPayloadCodecRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DiffPayloadCodecRevisions(ctx context.Context, in *DiffPayloadCodecRevisionsRequest, opts ...grpc.CallOption) (*DiffPayloadCodecRevisionsResponse, error) {
+	out := new(DiffPayloadCodecRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DiffPayloadCodecRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RollbackPayloadCodec(ctx context.Context, in *RollbackPayloadCodecRequest, opts ...grpc.CallOption) (*RollbackPayloadCodecResponse, error) {
+	out := new(RollbackPayloadCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/RollbackPayloadCodec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationServiceServer is the server API for ApplicationService service.
+type ApplicationServiceServer interface {
+	// Create creates the given application.
 	Create(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error)
 	// Get returns the requested application.
 	Get(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
@@ -1618,6 +2582,20 @@ type ApplicationServiceServer interface {
 	DeleteInfluxDBIntegration(context.Context, *DeleteInfluxDBIntegrationRequest) (*empty.Empty, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	// TestPayloadCodec runs the given payload codec against the given
+	// payload, without storing the codec configuration.
+	TestPayloadCodec(context.Context, *TestPayloadCodecRequest) (*TestPayloadCodecResponse, error)
+	// ListPayloadCodecRevisions lists the payload codec revisions of the
+	// application (newest first).
+	ListPayloadCodecRevisions(context.Context, *ListPayloadCodecRevisionsRequest) (*ListPayloadCodecRevisionsResponse, error)
+	// GetPayloadCodecRevision returns the given payload codec revision.
+	GetPayloadCodecRevision(context.Context, *GetPayloadCodecRevisionRequest) (*GetPayloadCodecRevisionResponse, error)
+	// DiffPayloadCodecRevisions returns the differences between two
+	// payload codec revisions.
+	DiffPayloadCodecRevisions(context.Context, *DiffPayloadCodecRevisionsRequest) (*DiffPayloadCodecRevisionsResponse, error)
+	// RollbackPayloadCodec restores the payload codec of the given revision.
+	// This creates a new revision.
+	RollbackPayloadCodec(context.Context, *RollbackPayloadCodecRequest) (*RollbackPayloadCodecResponse, error)
 }
 
 func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
@@ -1876,6 +2854,96 @@ func _ApplicationService_ListIntegrations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_TestPayloadCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).TestPayloadCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/TestPayloadCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).TestPayloadCodec(ctx, req.(*TestPayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListPayloadCodecRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayloadCodecRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListPayloadCodecRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/ListPayloadCodecRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListPayloadCodecRevisions(ctx, req.(*ListPayloadCodecRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetPayloadCodecRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayloadCodecRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetPayloadCodecRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetPayloadCodecRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetPayloadCodecRevision(ctx, req.(*GetPayloadCodecRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DiffPayloadCodecRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPayloadCodecRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DiffPayloadCodecRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/DiffPayloadCodecRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DiffPayloadCodecRevisions(ctx, req.(*DiffPayloadCodecRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RollbackPayloadCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RollbackPayloadCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/RollbackPayloadCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RollbackPayloadCodec(ctx, req.(*RollbackPayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.ApplicationService",
 	HandlerType: (*ApplicationServiceServer)(nil),
@@ -1936,6 +3004,26 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListIntegrations",
 			Handler:    _ApplicationService_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "TestPayloadCodec",
+			Handler:    _ApplicationService_TestPayloadCodec_Handler,
+		},
+		{
+			MethodName: "ListPayloadCodecRevisions",
+			Handler:    _ApplicationService_ListPayloadCodecRevisions_Handler,
+		},
+		{
+			MethodName: "GetPayloadCodecRevision",
+			Handler:    _ApplicationService_GetPayloadCodecRevision_Handler,
+		},
+		{
+			MethodName: "DiffPayloadCodecRevisions",
+			Handler:    _ApplicationService_DiffPayloadCodecRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackPayloadCodec",
+			Handler:    _ApplicationService_RollbackPayloadCodec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
@@ -1944,92 +3032,143 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("application.proto", fileDescriptor_fc846aced8fe6ea6) }
 
 var fileDescriptor_fc846aced8fe6ea6 = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xee, 0xda, 0x89, 0x9b, 0x1c, 0xb7, 0x89, 0x3b, 0x49, 0xdc, 0xcd, 0xd6, 0x4d, 0xf3, 0xdb,
-	0xea, 0x47, 0x83, 0x01, 0xbb, 0x84, 0xa8, 0xa0, 0x08, 0xa9, 0xa5, 0x75, 0x9a, 0x5a, 0x4d, 0x43,
-	0xb4, 0x69, 0x2a, 0x2e, 0xaa, 0x5a, 0x13, 0xef, 0x24, 0x1d, 0xb2, 0xd9, 0x5d, 0x76, 0xc7, 0x85,
-	0x80, 0x7a, 0xc3, 0x05, 0x48, 0x5c, 0x21, 0xf5, 0x16, 0x09, 0x21, 0x24, 0x6e, 0x78, 0x04, 0x1e,
-	0x83, 0x57, 0xe8, 0x83, 0xa0, 0xf9, 0xb3, 0xce, 0x66, 0x3d, 0x9b, 0xb4, 0x4e, 0x90, 0xb8, 0xb2,
-	0x67, 0xce, 0x37, 0x67, 0xbe, 0xf3, 0xcd, 0x99, 0x73, 0x66, 0xe1, 0x12, 0x0e, 0x43, 0x8f, 0x76,
-	0x31, 0xa3, 0x81, 0xdf, 0x08, 0xa3, 0x80, 0x05, 0xa8, 0x88, 0x43, 0x6a, 0xd5, 0x76, 0x83, 0x60,
-	0xd7, 0x23, 0x4d, 0x1c, 0xd2, 0x26, 0xf6, 0xfd, 0x80, 0x09, 0x44, 0x2c, 0x21, 0xd6, 0x15, 0x65,
-	0x15, 0xa3, 0xed, 0xde, 0x4e, 0x93, 0xec, 0x87, 0xec, 0x40, 0x1a, 0xed, 0xbf, 0x0a, 0x50, 0xfe,
-	0xec, 0xd0, 0x2b, 0x9a, 0x80, 0x02, 0x75, 0x4d, 0x63, 0xde, 0x58, 0x28, 0x3a, 0x05, 0xea, 0x22,
-	0x04, 0x23, 0x3e, 0xde, 0x27, 0x66, 0x61, 0xde, 0x58, 0x18, 0x77, 0xc4, 0x7f, 0x34, 0x0f, 0x65,
-	0x97, 0xc4, 0xdd, 0x88, 0x86, 0x7c, 0x89, 0x59, 0x14, 0xa6, 0xf4, 0x14, 0xba, 0x01, 0x93, 0x41,
-	0xb4, 0x8b, 0x7d, 0xfa, 0xad, 0xf0, 0xda, 0xa1, 0xae, 0x39, 0x22, 0x5c, 0x4e, 0xa4, 0xa7, 0xdb,
-	0x2d, 0xf4, 0x3e, 0xa0, 0x98, 0x44, 0x2f, 0x68, 0x97, 0x74, 0xc2, 0x28, 0xd8, 0xa1, 0x1e, 0xe1,
-	0xd8, 0x51, 0xe1, 0xb1, 0xa2, 0x2c, 0x1b, 0xd2, 0xd0, 0x6e, 0xa1, 0xeb, 0x70, 0x31, 0xc4, 0x07,
-	0x5e, 0x80, 0xdd, 0x4e, 0x37, 0x70, 0x49, 0xd7, 0x2c, 0x09, 0xe0, 0x05, 0x35, 0x79, 0x8f, 0xcf,
-	0xa1, 0x25, 0xa8, 0x26, 0x20, 0xe2, 0x73, 0x58, 0xd4, 0x91, 0xc4, 0xcc, 0xf3, 0x02, 0x3d, 0xad,
-	0xac, 0x2b, 0xd2, 0xb8, 0x29, 0x6c, 0xe9, 0x55, 0x2e, 0x39, 0xb2, 0x6a, 0xec, 0xc8, 0xaa, 0x16,
-	0x49, 0xad, 0xb2, 0x5f, 0x1b, 0x30, 0x95, 0x52, 0x6f, 0x8d, 0xc6, 0xac, 0xcd, 0xc8, 0xfe, 0x7f,
-	0x5b, 0xc5, 0x9b, 0x30, 0x9d, 0x45, 0x0b, 0x72, 0x52, 0x4c, 0x74, 0x14, 0xbf, 0x8e, 0xf7, 0x89,
-	0xbd, 0x0e, 0xe6, 0xbd, 0x88, 0x60, 0x46, 0x52, 0xb1, 0x3a, 0xe4, 0xab, 0x1e, 0x89, 0x19, 0x5a,
-	0x84, 0x72, 0x2a, 0x2b, 0x45, 0xcc, 0xe5, 0xc5, 0x4a, 0x03, 0x87, 0xb4, 0x91, 0x46, 0xa7, 0x41,
-	0xf6, 0x7b, 0x30, 0xab, 0xf1, 0x17, 0x87, 0x81, 0x1f, 0x93, 0xac, 0x76, 0xf6, 0x0d, 0x98, 0x59,
-	0x25, 0x4c, 0xb3, 0x73, 0x16, 0xb8, 0x06, 0xd5, 0x2c, 0x50, 0xb9, 0x1c, 0x86, 0xe3, 0x3a, 0x98,
-	0x5b, 0xa1, 0x7b, 0x76, 0x31, 0xd7, 0xc1, 0x6c, 0x11, 0x8f, 0x68, 0xfd, 0x65, 0x23, 0xf9, 0xd1,
-	0x80, 0x2a, 0xcf, 0x25, 0x0d, 0x74, 0x1a, 0x46, 0x3d, 0xba, 0x4f, 0x99, 0x42, 0xcb, 0x01, 0xaa,
-	0x42, 0x29, 0xd8, 0xd9, 0x89, 0x09, 0x13, 0x19, 0x56, 0x74, 0xd4, 0x48, 0x97, 0x41, 0x45, 0x6d,
-	0x06, 0x55, 0xa1, 0x14, 0x13, 0x1c, 0x75, 0x9f, 0x8b, 0x0c, 0x1b, 0x77, 0xd4, 0xc8, 0xf6, 0xe0,
-	0xf2, 0x00, 0x11, 0x25, 0xea, 0x35, 0x28, 0xb3, 0x80, 0x61, 0xaf, 0xd3, 0x0d, 0x7a, 0x7e, 0xc2,
-	0x07, 0xc4, 0xd4, 0x3d, 0x3e, 0x83, 0x6e, 0x42, 0x29, 0x22, 0x71, 0xcf, 0xe3, 0xa4, 0x8a, 0x0b,
-	0xe5, 0x45, 0x33, 0x2b, 0x50, 0x72, 0x5d, 0x1c, 0x85, 0xb3, 0x6f, 0xc3, 0xcc, 0x83, 0xc7, 0x8f,
-	0x37, 0xda, 0x3e, 0x23, 0xbb, 0x91, 0x80, 0x3c, 0x20, 0xd8, 0x25, 0x11, 0xaa, 0x40, 0x71, 0x8f,
-	0x1c, 0x88, 0x3d, 0xc6, 0x1d, 0xfe, 0x97, 0xeb, 0xf0, 0x02, 0x7b, 0xbd, 0xe4, 0x4a, 0xc9, 0x81,
-	0xfd, 0x47, 0x11, 0x26, 0x33, 0x1e, 0xd0, 0xff, 0x61, 0x22, 0x75, 0x0e, 0x9d, 0xbe, 0xd0, 0x17,
-	0x53, 0xb3, 0xed, 0x16, 0x5a, 0x82, 0xf3, 0xcf, 0xc5, 0x66, 0xb1, 0xa2, 0x6b, 0x09, 0xba, 0x5a,
-	0x3e, 0x4e, 0x02, 0x45, 0xef, 0xc0, 0x64, 0x2f, 0xf4, 0xa8, 0xbf, 0xd7, 0x71, 0x31, 0xc3, 0x9d,
-	0x5e, 0xe4, 0xa9, 0x8b, 0x7c, 0x51, 0x4e, 0xb7, 0x30, 0xc3, 0x5b, 0xce, 0x1a, 0x5a, 0x84, 0x99,
-	0x2f, 0x03, 0xea, 0x77, 0xfc, 0x80, 0xd1, 0x9d, 0x84, 0x0a, 0x47, 0x4b, 0xb9, 0xa7, 0xb8, 0x71,
-	0x3d, 0x65, 0xe3, 0x6b, 0x6e, 0xc2, 0x34, 0xee, 0xee, 0x0d, 0x2e, 0x91, 0xf7, 0x1a, 0xe1, 0xee,
-	0x5e, 0x76, 0xc5, 0x12, 0x54, 0x49, 0x14, 0x05, 0xd1, 0xe0, 0x1a, 0x79, 0xb7, 0xa7, 0x85, 0x35,
-	0xbb, 0xea, 0x16, 0x5c, 0x8e, 0x19, 0x66, 0xbd, 0x78, 0x70, 0x99, 0xac, 0x98, 0x33, 0xd2, 0x9c,
-	0x5d, 0xb7, 0x0c, 0xb3, 0x5e, 0xa0, 0xc0, 0x03, 0x2b, 0x65, 0xd5, 0xbc, 0x9c, 0x00, 0x32, 0x6b,
-	0xed, 0x27, 0x50, 0x93, 0x15, 0x20, 0xa3, 0x6f, 0x92, 0xe6, 0xb7, 0xa0, 0x4c, 0x0f, 0x67, 0xd5,
-	0x0d, 0x9b, 0xd6, 0x9d, 0x88, 0x93, 0x06, 0xda, 0x77, 0x61, 0x76, 0x95, 0xb0, 0x1c, 0xa7, 0x6f,
-	0x96, 0x09, 0xf6, 0x63, 0xb0, 0x74, 0x3e, 0x54, 0xda, 0x0f, 0xcb, 0xec, 0x09, 0xd4, 0x64, 0x3d,
-	0x39, 0xe3, 0x88, 0x57, 0xa0, 0x26, 0xeb, 0xca, 0xe9, 0x82, 0xbe, 0x2d, 0x2b, 0xce, 0x69, 0x1c,
-	0x4c, 0xa5, 0x16, 0xf7, 0x3b, 0xe1, 0x02, 0x8c, 0xec, 0x51, 0x5f, 0xae, 0x99, 0x50, 0xf1, 0xa4,
-	0x70, 0x0f, 0xa9, 0xef, 0x3a, 0x02, 0x91, 0x94, 0x1a, 0x9d, 0xe6, 0x43, 0x96, 0x1a, 0x0d, 0x9f,
-	0x7e, 0xa9, 0xf9, 0xa9, 0xc0, 0xf9, 0xee, 0x78, 0xbd, 0x6f, 0x5a, 0x77, 0x87, 0xa8, 0x16, 0x16,
-	0x8c, 0x11, 0xdf, 0x0d, 0x03, 0xea, 0x33, 0x55, 0x81, 0xfa, 0x63, 0x5e, 0xcd, 0xdd, 0x6d, 0x55,
-	0x06, 0x0a, 0xee, 0x36, 0xc7, 0xf6, 0x62, 0x12, 0x89, 0x1e, 0x2b, 0xaf, 0x7b, 0x7f, 0xcc, 0x6d,
-	0x21, 0x8e, 0xe3, 0xaf, 0x83, 0x28, 0xe9, 0xd7, 0xfd, 0x31, 0xaf, 0x19, 0x11, 0x61, 0xc4, 0x17,
-	0x44, 0xc2, 0xc0, 0xa3, 0xdd, 0x83, 0x74, 0xa3, 0x9e, 0xea, 0x1b, 0x37, 0x84, 0x8d, 0x77, 0x6a,
-	0xb4, 0x04, 0xe3, 0x61, 0x44, 0xba, 0x34, 0xe6, 0x39, 0x74, 0x5e, 0x68, 0x5e, 0x55, 0x5a, 0xc8,
-	0x58, 0x37, 0x12, 0xab, 0x73, 0x08, 0xb4, 0x9f, 0xc1, 0xbc, 0xbc, 0x8d, 0x1a, 0x45, 0x92, 0x34,
-	0x58, 0xd6, 0xe5, 0xa7, 0x79, 0xc4, 0x77, 0x6e, 0x8e, 0xde, 0x87, 0xab, 0xab, 0x84, 0x1d, 0xe3,
-	0xfc, 0x0d, 0x73, 0xec, 0x29, 0xcc, 0xe5, 0xf9, 0x51, 0x99, 0x72, 0x1a, 0x96, 0xcf, 0x60, 0x5e,
-	0xde, 0xd0, 0x7f, 0x49, 0x85, 0x36, 0xcc, 0xcb, 0x9b, 0x7a, 0x6a, 0x21, 0xea, 0xef, 0xc2, 0x64,
-	0xe6, 0x12, 0xa1, 0x31, 0x18, 0xe1, 0x15, 0xa0, 0x72, 0x0e, 0x5d, 0x80, 0xb1, 0xf6, 0xfa, 0xfd,
-	0xb5, 0xad, 0x2f, 0x5a, 0x77, 0x2b, 0x46, 0xfd, 0x36, 0x5c, 0x1a, 0x38, 0x7b, 0x54, 0x82, 0xc2,
-	0xfa, 0x66, 0xe5, 0x1c, 0x1a, 0x05, 0x63, 0xab, 0x62, 0xf0, 0xe1, 0xa3, 0xcd, 0x4a, 0x81, 0x0f,
-	0x37, 0x2b, 0x45, 0xfe, 0xf3, 0xa8, 0x32, 0xc2, 0x7f, 0x1e, 0x54, 0x46, 0x17, 0x7f, 0x9b, 0x04,
-	0x94, 0x6a, 0xda, 0x9b, 0xf2, 0x79, 0x88, 0x08, 0x94, 0x64, 0xce, 0xa0, 0xab, 0x22, 0xfc, 0xbc,
-	0x07, 0xa2, 0x35, 0x97, 0x67, 0x96, 0x47, 0x66, 0xd7, 0xbe, 0xff, 0xfb, 0xf5, 0xab, 0x42, 0xd5,
-	0xbe, 0x24, 0x3f, 0x5f, 0x0e, 0x11, 0xf1, 0xb2, 0x51, 0x47, 0xcf, 0xa0, 0xb8, 0x4a, 0x18, 0x92,
-	0xcd, 0x58, 0xfb, 0x0e, 0xb4, 0xae, 0x68, 0x6d, 0xca, 0xfb, 0x9c, 0xf0, 0x6e, 0xa2, 0xea, 0x80,
-	0xf7, 0xe6, 0x77, 0xd4, 0x7d, 0x89, 0x7c, 0x28, 0xc9, 0x43, 0x57, 0x61, 0xe4, 0xbd, 0xf9, 0xac,
-	0x6a, 0x43, 0x7e, 0x46, 0x35, 0x92, 0xcf, 0xa8, 0xc6, 0x0a, 0xff, 0x8c, 0xb2, 0x3f, 0x10, 0x1b,
-	0xdc, 0xb0, 0x6c, 0xcd, 0x06, 0xe9, 0xcf, 0x35, 0xea, 0xbe, 0xe4, 0xf1, 0x74, 0xa0, 0x24, 0x93,
-	0x40, 0xed, 0x97, 0xf7, 0x26, 0xcc, 0xdd, 0x4f, 0x05, 0x54, 0xcf, 0x0b, 0xe8, 0x29, 0x8c, 0xf0,
-	0x62, 0x87, 0xa4, 0x2a, 0xfa, 0x57, 0xa4, 0x55, 0xd3, 0x1b, 0x95, 0x66, 0xb3, 0x62, 0x8b, 0x29,
-	0x34, 0x78, 0x22, 0xe8, 0x57, 0x03, 0x66, 0xb4, 0x8d, 0x1b, 0xfd, 0x2f, 0x75, 0xcc, 0xfa, 0x56,
-	0x94, 0x1b, 0xd2, 0x43, 0xb1, 0xdf, 0x8a, 0x7d, 0x47, 0x17, 0xd2, 0xa1, 0x9b, 0xc6, 0xd1, 0x9b,
-	0xf1, 0xb2, 0x99, 0xb2, 0xc5, 0xcd, 0xe7, 0x8c, 0x85, 0x5c, 0xe0, 0x57, 0x06, 0xa0, 0xc1, 0xf6,
-	0x8d, 0xe6, 0x92, 0x24, 0xc9, 0xe1, 0x76, 0x2d, 0xd7, 0xae, 0x44, 0xf9, 0x54, 0x90, 0xbc, 0x85,
-	0x96, 0x8e, 0x3f, 0x67, 0x3d, 0x31, 0xa1, 0x9b, 0xb6, 0xfd, 0x2b, 0xdd, 0x8e, 0x7b, 0x1a, 0x9c,
-	0xa4, 0x9b, 0x75, 0x26, 0xba, 0xfd, 0x6c, 0xc0, 0x8c, 0xf6, 0x21, 0xa1, 0x18, 0x1e, 0xf7, 0xc8,
-	0xc8, 0x65, 0xa8, 0x44, 0xab, 0x0f, 0x27, 0xda, 0x9f, 0x46, 0xf2, 0x9d, 0xa8, 0xed, 0xd4, 0xa9,
-	0x84, 0xcb, 0xaf, 0xa8, 0xb9, 0xd4, 0x3e, 0x17, 0xd4, 0xda, 0x76, 0xeb, 0x34, 0xe2, 0x51, 0xb1,
-	0xaf, 0xbb, 0xcd, 0x05, 0xfc, 0xdd, 0x10, 0xdf, 0x9f, 0x3a, 0xaa, 0x76, 0x92, 0x5c, 0xc7, 0xf0,
-	0xbc, 0x7e, 0x2c, 0x46, 0x25, 0xe1, 0x1d, 0x41, 0x7a, 0x19, 0x7d, 0xf2, 0xb6, 0x7a, 0x26, 0x44,
-	0x85, 0xa6, 0xb9, 0x5d, 0x4e, 0x69, 0x7a, 0x52, 0x17, 0x3c, 0x49, 0x53, 0xeb, 0xcc, 0x34, 0xfd,
-	0xc5, 0x80, 0xd9, 0xdc, 0x9e, 0xa9, 0xd8, 0x9e, 0xd4, 0x53, 0x73, 0xd9, 0x2a, 0x31, 0xeb, 0xc3,
-	0x8b, 0xf9, 0x83, 0x01, 0x95, 0xcc, 0x9b, 0x35, 0x4e, 0x15, 0x5e, 0x0d, 0x97, 0x9a, 0xde, 0xa8,
-	0x8e, 0xf7, 0x63, 0xc1, 0xe8, 0x43, 0xd4, 0x7c, 0x4b, 0x46, 0xdb, 0x25, 0x11, 0xda, 0x47, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x15, 0x7f, 0x76, 0x21, 0x14, 0x00, 0x00,
+	// 2198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x5f, 0x4a, 0xb6, 0x6c, 0x3d, 0xd9, 0x8e, 0x32, 0xb1, 0x65, 0x59, 0x51, 0x1c, 0x99, 0x69,
+	0x13, 0xd7, 0xbb, 0x6b, 0xa5, 0xae, 0x37, 0xcd, 0x1a, 0x29, 0x92, 0x4d, 0xa4, 0x75, 0xd4, 0x75,
+	0x6c, 0x83, 0xb6, 0x83, 0x14, 0x58, 0x44, 0xa5, 0xc5, 0x51, 0xc2, 0x98, 0x22, 0x59, 0x72, 0xe4,
+	0x8d, 0xbb, 0x08, 0x8a, 0xf6, 0xd0, 0x02, 0x7b, 0x6a, 0xb1, 0x45, 0x4f, 0x45, 0x7b, 0x28, 0xd0,
+	0x4b, 0x81, 0x5e, 0x8a, 0xf6, 0xd8, 0x2f, 0xd1, 0x6b, 0x8f, 0xfb, 0x19, 0x7a, 0x2e, 0xe6, 0x0f,
+	0x65, 0x8a, 0x1a, 0x52, 0xb6, 0xec, 0x02, 0x3d, 0x59, 0x33, 0xef, 0xcf, 0xfc, 0xde, 0x9b, 0x79,
+	0x8f, 0xef, 0x3d, 0xc3, 0x55, 0xdd, 0x75, 0x2d, 0xb3, 0xa5, 0x13, 0xd3, 0xb1, 0x57, 0x5d, 0xcf,
+	0x21, 0x0e, 0x4a, 0xeb, 0xae, 0x59, 0x2a, 0xbf, 0x72, 0x9c, 0x57, 0x16, 0xae, 0xea, 0xae, 0x59,
+	0xd5, 0x6d, 0xdb, 0x21, 0x8c, 0xc3, 0xe7, 0x2c, 0xa5, 0xeb, 0x82, 0xca, 0x56, 0x87, 0xdd, 0x76,
+	0x15, 0x77, 0x5c, 0x72, 0x22, 0x88, 0x8b, 0x51, 0xa2, 0xd1, 0xf5, 0x42, 0xfa, 0x4b, 0x37, 0xa3,
+	0x74, 0x62, 0x76, 0xb0, 0x4f, 0xf4, 0x8e, 0xcb, 0x19, 0xd4, 0x3f, 0xa4, 0x21, 0xf7, 0xc9, 0x29,
+	0x2c, 0x34, 0x03, 0x29, 0xd3, 0x28, 0x2a, 0x15, 0x65, 0x39, 0xad, 0xa5, 0x4c, 0x03, 0x21, 0x18,
+	0xb3, 0xf5, 0x0e, 0x2e, 0xa6, 0x2a, 0xca, 0x72, 0x56, 0x63, 0xbf, 0x51, 0x05, 0x72, 0x06, 0xf6,
+	0x5b, 0x9e, 0xe9, 0x52, 0x91, 0x62, 0x9a, 0x91, 0xc2, 0x5b, 0xe8, 0x0e, 0x5c, 0x71, 0xbc, 0x57,
+	0xba, 0x6d, 0xfe, 0x94, 0x69, 0x6d, 0x9a, 0x46, 0x71, 0x8c, 0xa9, 0x9c, 0x09, 0x6f, 0x37, 0x6a,
+	0xe8, 0x03, 0x40, 0x3e, 0xf6, 0x8e, 0xcd, 0x16, 0x6e, 0xba, 0x9e, 0xd3, 0x36, 0x2d, 0x4c, 0x79,
+	0xc7, 0x99, 0xc6, 0xbc, 0xa0, 0xec, 0x72, 0x42, 0xa3, 0x86, 0x6e, 0xc1, 0xb4, 0xab, 0x9f, 0x58,
+	0x8e, 0x6e, 0x34, 0x5b, 0x8e, 0x81, 0x5b, 0xc5, 0x0c, 0x63, 0x9c, 0x12, 0x9b, 0x4f, 0xe8, 0x1e,
+	0x5a, 0x87, 0x42, 0xc0, 0x84, 0x6d, 0xca, 0xe6, 0x35, 0x39, 0xb0, 0xe2, 0x04, 0xe3, 0x9e, 0x15,
+	0xd4, 0x3a, 0x27, 0xee, 0x31, 0x5a, 0x58, 0xca, 0xc0, 0x7d, 0x52, 0x93, 0x7d, 0x52, 0x35, 0x1c,
+	0x96, 0x5a, 0x80, 0x49, 0x06, 0x84, 0x82, 0xce, 0x32, 0x03, 0x27, 0xd8, 0xba, 0x51, 0x43, 0x4b,
+	0x30, 0xe5, 0x63, 0xbb, 0x63, 0x35, 0x9d, 0x2e, 0x71, 0xbb, 0xa4, 0x08, 0x15, 0x65, 0x79, 0x52,
+	0xcb, 0xf9, 0xd8, 0x7e, 0xb6, 0xb5, 0xc3, 0xb6, 0xa8, 0x39, 0x5c, 0xfa, 0x18, 0x7b, 0x3e, 0xf5,
+	0x64, 0x8e, 0xa9, 0x98, 0x62, 0x9b, 0xcf, 0xf9, 0x9e, 0xfa, 0x8d, 0x02, 0xd7, 0x42, 0x17, 0xb4,
+	0x65, 0xfa, 0xa4, 0x41, 0x70, 0xe7, 0xff, 0xfb, 0xa2, 0xee, 0xc2, 0x6c, 0x94, 0x9b, 0x81, 0xe3,
+	0xf7, 0x85, 0xfa, 0xf9, 0xb7, 0xf5, 0x0e, 0x56, 0xb7, 0xa1, 0xf8, 0xc4, 0xc3, 0x3a, 0xc1, 0x21,
+	0x5b, 0x35, 0xfc, 0x93, 0x2e, 0xf6, 0x09, 0x5a, 0x83, 0x5c, 0x28, 0x72, 0x98, 0xcd, 0xb9, 0xb5,
+	0xfc, 0xaa, 0xee, 0x9a, 0xab, 0x61, 0xee, 0x30, 0x93, 0xfa, 0x3e, 0x2c, 0x48, 0xf4, 0xf9, 0xae,
+	0x63, 0xfb, 0x38, 0xea, 0x3b, 0xf5, 0x0e, 0xcc, 0x6d, 0x62, 0x22, 0x39, 0x39, 0xca, 0xb8, 0x05,
+	0x85, 0x28, 0xa3, 0x50, 0x39, 0x0a, 0xc6, 0x6d, 0x28, 0x1e, 0xb8, 0xc6, 0xe5, 0xd9, 0xbc, 0x02,
+	0xc5, 0x1a, 0xb6, 0x30, 0xc1, 0x67, 0xb0, 0xe4, 0x57, 0x0a, 0x14, 0xe8, 0x5b, 0x92, 0xb0, 0xce,
+	0xc2, 0xb8, 0x65, 0x76, 0x4c, 0x22, 0xb8, 0xf9, 0x02, 0x15, 0x20, 0xe3, 0xb4, 0xdb, 0x3e, 0x26,
+	0xec, 0x85, 0xa5, 0x35, 0xb1, 0x92, 0xbd, 0xa0, 0xb4, 0xf4, 0x05, 0x15, 0x20, 0xe3, 0x63, 0xdd,
+	0x6b, 0xbd, 0x66, 0x2f, 0x2c, 0xab, 0x89, 0x95, 0x6a, 0xc1, 0xfc, 0x00, 0x10, 0xe1, 0xd4, 0x9b,
+	0x90, 0x23, 0x0e, 0xd1, 0xad, 0x66, 0xcb, 0xe9, 0xda, 0x01, 0x1e, 0x60, 0x5b, 0x4f, 0xe8, 0x0e,
+	0xba, 0x0b, 0x19, 0x0f, 0xfb, 0x5d, 0x8b, 0x82, 0x4a, 0x2f, 0xe7, 0xd6, 0x8a, 0x51, 0x07, 0x05,
+	0xe1, 0xa2, 0x09, 0x3e, 0xf5, 0x21, 0xcc, 0x3d, 0xdd, 0xdf, 0xdf, 0x6d, 0xd8, 0x04, 0xbf, 0xe2,
+	0x99, 0xf2, 0x29, 0xd6, 0x0d, 0xec, 0xa1, 0x3c, 0xa4, 0x8f, 0xf0, 0x09, 0x3b, 0x23, 0xab, 0xd1,
+	0x9f, 0xd4, 0x0f, 0xc7, 0xba, 0xd5, 0x0d, 0x42, 0x8a, 0x2f, 0xd4, 0x3f, 0xa7, 0xe1, 0x4a, 0x44,
+	0x03, 0xfa, 0x36, 0xcc, 0x84, 0xee, 0xa1, 0xd9, 0x73, 0xf4, 0x74, 0x68, 0xb7, 0x51, 0x43, 0xeb,
+	0x30, 0xf1, 0x9a, 0x1d, 0xe6, 0x0b, 0xb8, 0x25, 0x06, 0x57, 0x8a, 0x47, 0x0b, 0x58, 0xd1, 0x6d,
+	0xb8, 0xd2, 0x75, 0x2d, 0xd3, 0x3e, 0x6a, 0x1a, 0x3a, 0xd1, 0x9b, 0x5d, 0xcf, 0x12, 0x81, 0x3c,
+	0xcd, 0xb7, 0x6b, 0x3a, 0xd1, 0x0f, 0xb4, 0x2d, 0xb4, 0x06, 0x73, 0x6f, 0x1c, 0xd3, 0x6e, 0xda,
+	0x0e, 0x31, 0xdb, 0x01, 0x14, 0xca, 0xcd, 0xdd, 0x7d, 0x8d, 0x12, 0xb7, 0x43, 0x34, 0x2a, 0x73,
+	0x17, 0x66, 0xf5, 0xd6, 0xd1, 0xa0, 0x08, 0x8f, 0x6b, 0xa4, 0xb7, 0x8e, 0xa2, 0x12, 0xeb, 0x50,
+	0xc0, 0x9e, 0xe7, 0x78, 0x83, 0x32, 0x3c, 0xb6, 0x67, 0x19, 0x35, 0x2a, 0x75, 0x0f, 0xe6, 0x7d,
+	0xa2, 0x93, 0xae, 0x3f, 0x28, 0xc6, 0x93, 0xf2, 0x1c, 0x27, 0x47, 0xe5, 0x36, 0x60, 0xc1, 0x72,
+	0x04, 0xf3, 0x80, 0x24, 0x4f, 0xcc, 0xf3, 0x01, 0x43, 0x44, 0x56, 0x7d, 0x0e, 0x65, 0x9e, 0x01,
+	0x22, 0xfe, 0x0d, 0x9e, 0xf9, 0x3d, 0xc8, 0x99, 0xa7, 0xbb, 0x22, 0xc2, 0x66, 0x65, 0x37, 0xa2,
+	0x85, 0x19, 0xd5, 0xc7, 0xb0, 0xb0, 0x89, 0x49, 0x8c, 0xd2, 0xb3, 0xbd, 0x04, 0x75, 0x1f, 0x4a,
+	0x32, 0x1d, 0xe2, 0xd9, 0x8f, 0x8a, 0xec, 0x39, 0x94, 0x79, 0x3e, 0xb9, 0x64, 0x8b, 0xeb, 0x50,
+	0xe6, 0x79, 0xe5, 0x62, 0x46, 0x3f, 0xe4, 0x19, 0xe7, 0x22, 0x0a, 0xae, 0x85, 0x84, 0x7b, 0x5f,
+	0xc2, 0x65, 0x18, 0x3b, 0x32, 0x6d, 0x2e, 0x33, 0x23, 0xec, 0x09, 0xf1, 0x7d, 0x66, 0xda, 0x86,
+	0xc6, 0x38, 0x82, 0x54, 0x23, 0xf3, 0xf9, 0x88, 0xa9, 0x46, 0x82, 0xa7, 0x97, 0x6a, 0xbe, 0x4a,
+	0x51, 0xbc, 0x6d, 0xab, 0xfb, 0xb6, 0xf6, 0x78, 0x84, 0x6c, 0x51, 0x82, 0x49, 0x6c, 0x1b, 0xae,
+	0x63, 0xda, 0x44, 0x64, 0xa0, 0xde, 0x9a, 0x66, 0x73, 0xe3, 0x50, 0xa4, 0x81, 0x94, 0x71, 0x48,
+	0x79, 0xbb, 0x3e, 0xf6, 0xd8, 0x37, 0x96, 0x87, 0x7b, 0x6f, 0x4d, 0x69, 0xae, 0xee, 0xfb, 0x5f,
+	0x38, 0x5e, 0xf0, 0xbd, 0xee, 0xad, 0x69, 0xce, 0xf0, 0x30, 0xc1, 0x36, 0x03, 0xe2, 0x3a, 0x96,
+	0xd9, 0x3a, 0x09, 0x7f, 0xa8, 0xaf, 0xf5, 0x88, 0xbb, 0x8c, 0x46, 0xbf, 0xd4, 0x68, 0x1d, 0xb2,
+	0xae, 0x87, 0x5b, 0x26, 0xab, 0x58, 0x26, 0x98, 0xcf, 0x0b, 0xc2, 0x17, 0xdc, 0xd6, 0xdd, 0x80,
+	0xaa, 0x9d, 0x32, 0xaa, 0x2f, 0xa1, 0xc2, 0xa3, 0x51, 0xe2, 0x91, 0xe0, 0x19, 0x6c, 0xc8, 0xde,
+	0x67, 0xb1, 0x4f, 0x77, 0xec, 0x1b, 0xfd, 0x14, 0x6e, 0x6c, 0x62, 0x92, 0xa0, 0xfc, 0x8c, 0x6f,
+	0xec, 0x73, 0x58, 0x8c, 0xd3, 0x23, 0x5e, 0xca, 0x45, 0x50, 0xbe, 0x84, 0x0a, 0x8f, 0xd0, 0xff,
+	0x91, 0x17, 0x1a, 0x50, 0xe1, 0x91, 0x7a, 0x71, 0x47, 0xfc, 0x76, 0x0c, 0xe6, 0xf7, 0xb1, 0x4f,
+	0x76, 0x43, 0xb5, 0xf5, 0xf9, 0x54, 0xa0, 0xfb, 0x90, 0x75, 0x5c, 0x2c, 0xec, 0x48, 0xb1, 0x97,
+	0xc2, 0xbf, 0x78, 0x61, 0x9d, 0x3b, 0x01, 0x87, 0x76, 0xca, 0x3c, 0x58, 0xe8, 0xa7, 0xcf, 0x55,
+	0xe8, 0x8f, 0x8d, 0x54, 0xe8, 0x8f, 0x27, 0x14, 0xfa, 0x73, 0x90, 0x69, 0x37, 0x5d, 0xc7, 0x23,
+	0x2c, 0x32, 0xa6, 0xb5, 0xf1, 0xf6, 0xae, 0xe3, 0x11, 0x5a, 0x74, 0xd3, 0x8f, 0x32, 0x0b, 0x83,
+	0x29, 0x8d, 0xfd, 0xa6, 0x3d, 0x01, 0xfd, 0xdb, 0x7c, 0x8d, 0xdf, 0x8a, 0x4f, 0xd4, 0x04, 0x5d,
+	0x3f, 0xad, 0xbf, 0xa0, 0x49, 0xe6, 0x8d, 0xef, 0xd8, 0x4d, 0xe7, 0xf0, 0x0d, 0x6e, 0x11, 0xd6,
+	0x31, 0x64, 0x35, 0xa0, 0x5b, 0x3b, 0x6c, 0x07, 0xcd, 0xc3, 0x84, 0x81, 0x8f, 0x9b, 0xb8, 0x6b,
+	0xb2, 0x7e, 0x21, 0xab, 0x65, 0x0c, 0x7c, 0x5c, 0x3f, 0x68, 0xa0, 0x06, 0x64, 0x8f, 0x75, 0xcf,
+	0xd4, 0x0f, 0x2d, 0xec, 0x17, 0x73, 0x2c, 0x01, 0xbd, 0xcf, 0x5c, 0x19, 0x73, 0x45, 0xab, 0xcf,
+	0x03, 0xee, 0xba, 0x4d, 0xbc, 0x13, 0xed, 0x54, 0xba, 0xf4, 0x00, 0x66, 0xfa, 0x89, 0x67, 0x2d,
+	0x7d, 0x36, 0x52, 0xf7, 0x15, 0xf5, 0xdf, 0x0a, 0x14, 0x07, 0xcf, 0x3c, 0x4d, 0xa2, 0x61, 0xfb,
+	0x94, 0x01, 0xfb, 0x02, 0x7f, 0xa5, 0x62, 0xfc, 0x95, 0xee, 0xf7, 0xd7, 0x2c, 0x8c, 0xb3, 0x72,
+	0x42, 0x5c, 0x28, 0x5f, 0xa0, 0x47, 0x30, 0x83, 0xdf, 0xe2, 0x56, 0x97, 0xbd, 0x3d, 0xda, 0xcf,
+	0xb2, 0x9b, 0xcb, 0xad, 0x2d, 0xac, 0xf2, 0x66, 0x77, 0x35, 0x68, 0x76, 0x57, 0x6b, 0xa2, 0x19,
+	0xd6, 0xa6, 0x7b, 0x02, 0xfb, 0x66, 0x07, 0xa3, 0x22, 0x4c, 0xb4, 0x1c, 0xdb, 0x77, 0x2c, 0x9a,
+	0xe8, 0xd2, 0xf4, 0x44, 0xb1, 0x54, 0x7f, 0x9e, 0x86, 0xd9, 0x7e, 0xd3, 0x8e, 0x59, 0xfe, 0x3a,
+	0x47, 0xd2, 0xf6, 0x84, 0x08, 0x33, 0x72, 0x5a, 0xeb, 0xad, 0xd1, 0xc7, 0x00, 0x2d, 0x96, 0x02,
+	0x8d, 0xa6, 0x4e, 0x98, 0xa9, 0xb4, 0x02, 0x8c, 0x62, 0xde, 0x0f, 0x1a, 0x74, 0x2d, 0x2b, 0xb8,
+	0x3f, 0x21, 0x89, 0xf9, 0x7d, 0x20, 0x56, 0xc6, 0xcf, 0x15, 0x2b, 0x99, 0x91, 0x62, 0x65, 0xe2,
+	0x8c, 0x4d, 0xf1, 0x64, 0x7f, 0x53, 0x3c, 0xd0, 0xf1, 0x66, 0x25, 0x1d, 0xef, 0x7f, 0x14, 0x28,
+	0xcb, 0xee, 0xa0, 0xf7, 0xc1, 0x0f, 0x3b, 0x59, 0x49, 0x74, 0x72, 0x6a, 0x54, 0x27, 0xa7, 0x87,
+	0x39, 0x79, 0x4c, 0xe2, 0xe4, 0xb0, 0xe1, 0xe3, 0x43, 0x0c, 0xcf, 0x48, 0x0c, 0xff, 0x02, 0x2a,
+	0xd4, 0x46, 0x99, 0xed, 0xfe, 0x39, 0x53, 0x6f, 0xaf, 0x87, 0x4b, 0xc9, 0x7b, 0xb8, 0x74, 0xb8,
+	0x87, 0x53, 0x7f, 0x06, 0x4b, 0x09, 0x07, 0x9f, 0xb5, 0x42, 0xfa, 0x38, 0x52, 0x21, 0x2d, 0x0d,
+	0xe4, 0xfa, 0xe8, 0x4d, 0xf6, 0x4a, 0xa5, 0x16, 0xfb, 0xea, 0xca, 0x58, 0xcf, 0x69, 0x77, 0x42,
+	0xfc, 0xa9, 0x2f, 0xe0, 0x66, 0xec, 0x21, 0xc2, 0xc6, 0x8f, 0x22, 0x2f, 0x8b, 0x26, 0x95, 0x38,
+	0x23, 0x42, 0x9a, 0xbf, 0x52, 0xa0, 0x52, 0x33, 0xdb, 0xed, 0xcb, 0xb8, 0xb9, 0xa4, 0x0c, 0x72,
+	0x0b, 0xa6, 0xdb, 0x9e, 0xd3, 0x69, 0xf6, 0x18, 0xd2, 0x8c, 0x61, 0x8a, 0x6e, 0x06, 0xe7, 0xa9,
+	0x7f, 0x4f, 0xc1, 0x52, 0x02, 0x18, 0x61, 0xe9, 0x80, 0x2a, 0x65, 0x50, 0x55, 0x22, 0x96, 0x0f,
+	0x00, 0x31, 0x05, 0xb2, 0xef, 0x74, 0x9e, 0x52, 0xc2, 0xe7, 0x9f, 0x2d, 0x7e, 0x7e, 0x00, 0xd7,
+	0xe5, 0x49, 0xaa, 0x69, 0x98, 0xed, 0xb6, 0xc8, 0x6b, 0x45, 0x59, 0xa6, 0xa2, 0xf6, 0x86, 0xc5,
+	0x0d, 0x3c, 0x28, 0x9e, 0xe9, 0x13, 0xaf, 0xe1, 0x88, 0xb8, 0xfa, 0x63, 0xb8, 0xae, 0x39, 0x96,
+	0x75, 0xa8, 0xb7, 0x8e, 0x2e, 0x50, 0xf3, 0x24, 0x3d, 0xc0, 0x0d, 0x28, 0xcb, 0x4f, 0x10, 0x77,
+	0x92, 0x90, 0xd7, 0x56, 0xbe, 0x03, 0x57, 0x22, 0x3d, 0x0d, 0x9a, 0x84, 0x31, 0xda, 0x90, 0xe5,
+	0xdf, 0x43, 0x53, 0x30, 0xd9, 0xd8, 0xfe, 0x74, 0xeb, 0xe0, 0x45, 0xed, 0x71, 0x5e, 0x59, 0xa9,
+	0xc2, 0x9c, 0xb4, 0xc0, 0x42, 0x00, 0x99, 0x5a, 0xfd, 0xc9, 0x4e, 0xad, 0x9e, 0x7f, 0x8f, 0xfe,
+	0xae, 0x6f, 0xb3, 0xdf, 0xca, 0xca, 0x43, 0xb8, 0x3a, 0x50, 0xbb, 0xa3, 0x0c, 0xa4, 0xb6, 0xf7,
+	0xf2, 0xef, 0xa1, 0x71, 0x50, 0x0e, 0xf2, 0x0a, 0x5d, 0x3e, 0xdb, 0xcb, 0xa7, 0xe8, 0x72, 0x2f,
+	0x9f, 0xa6, 0x7f, 0x9e, 0xe5, 0xc7, 0xe8, 0x9f, 0xa7, 0xf9, 0xf1, 0xb5, 0xdf, 0x15, 0x00, 0x85,
+	0x86, 0x2e, 0x7b, 0x7c, 0xbc, 0x87, 0x30, 0x64, 0x78, 0xcd, 0x8f, 0x6e, 0xb0, 0x28, 0x8a, 0x1b,
+	0xf0, 0x95, 0x16, 0xe3, 0xc8, 0xdc, 0x31, 0x6a, 0xf9, 0x17, 0xff, 0xfa, 0xe6, 0xeb, 0x54, 0x41,
+	0xbd, 0xca, 0x47, 0xe4, 0xa7, 0x1c, 0xfe, 0x86, 0xb2, 0x82, 0x5e, 0x42, 0x7a, 0x13, 0x13, 0xc4,
+	0x4b, 0x4b, 0xe9, 0x1c, 0xaf, 0x74, 0x5d, 0x4a, 0x13, 0xda, 0x17, 0x99, 0xf6, 0x22, 0x2a, 0x0c,
+	0x68, 0xaf, 0x7e, 0x69, 0x1a, 0xef, 0x90, 0x0d, 0x19, 0x5e, 0xb4, 0x0b, 0x33, 0xe2, 0x66, 0x76,
+	0xa5, 0xc2, 0xc0, 0x77, 0xa6, 0x4e, 0x47, 0xf5, 0xea, 0x87, 0xec, 0x80, 0x3b, 0x25, 0x55, 0x72,
+	0x40, 0x68, 0xb5, 0x6a, 0x1a, 0xef, 0xa8, 0x3d, 0x4d, 0xc8, 0xf0, 0x22, 0x5e, 0x9c, 0x17, 0x37,
+	0xd3, 0x8b, 0x3d, 0x4f, 0x18, 0xb4, 0x12, 0x67, 0xd0, 0xe7, 0x30, 0x46, 0x33, 0x30, 0xe2, 0x5e,
+	0x91, 0x4f, 0x01, 0x4b, 0x65, 0x39, 0x51, 0xf8, 0x6c, 0x81, 0x1d, 0x71, 0x0d, 0x0d, 0xde, 0x08,
+	0xfa, 0xa3, 0x02, 0x73, 0xd2, 0xc1, 0x0b, 0x5a, 0x0a, 0x5d, 0xb3, 0x7c, 0x94, 0x10, 0x6b, 0xd2,
+	0x67, 0xec, 0xbc, 0xba, 0xfa, 0x48, 0x66, 0xd2, 0xa9, 0x9a, 0xd5, 0xfe, 0x10, 0x7d, 0x57, 0x0d,
+	0xd1, 0xfc, 0xea, 0x6b, 0x42, 0x5c, 0xea, 0xe0, 0xaf, 0x15, 0x40, 0x83, 0xe3, 0x17, 0xb4, 0x18,
+	0x3c, 0x92, 0x18, 0x6c, 0x37, 0x63, 0xe9, 0xc2, 0x29, 0x0f, 0x18, 0xc8, 0x7b, 0x68, 0x3d, 0xf9,
+	0x9e, 0xe5, 0xc0, 0x98, 0xdf, 0xa4, 0xe3, 0x1b, 0xe1, 0xb7, 0xa4, 0xd1, 0xce, 0x30, 0xbf, 0x95,
+	0x2e, 0xc5, 0x6f, 0xbf, 0x56, 0x60, 0x4e, 0x3a, 0x08, 0x12, 0x08, 0x93, 0x86, 0x44, 0xb1, 0x08,
+	0x85, 0xd3, 0x56, 0x46, 0x73, 0xda, 0x5f, 0x94, 0x60, 0xce, 0x2f, 0x9d, 0xb4, 0x84, 0x1e, 0x5c,
+	0x7c, 0x47, 0x1c, 0x0b, 0x6d, 0x87, 0x41, 0x6b, 0xa8, 0xb5, 0x8b, 0x38, 0xcf, 0x64, 0xe7, 0x1a,
+	0x87, 0xd4, 0x81, 0x7f, 0x52, 0xd8, 0xff, 0x0f, 0x64, 0x50, 0xd5, 0xe0, 0x71, 0x25, 0xe0, 0xbc,
+	0x95, 0xc8, 0x23, 0x1e, 0xe1, 0x23, 0x06, 0x7a, 0x03, 0xdd, 0x3f, 0xaf, 0x3f, 0x03, 0xa0, 0xcc,
+	0xa7, 0xb1, 0x53, 0x0a, 0xe1, 0xd3, 0x61, 0x53, 0x8c, 0x61, 0x3e, 0x2d, 0x5d, 0x9a, 0x4f, 0x7f,
+	0xaf, 0xc0, 0x42, 0xec, 0xcc, 0x43, 0xa0, 0x1d, 0x36, 0x13, 0x89, 0x45, 0x2b, 0x9c, 0xb9, 0x32,
+	0xba, 0x33, 0x7f, 0xa9, 0x40, 0x3e, 0x32, 0x73, 0xf4, 0x43, 0x89, 0x57, 0x82, 0xa5, 0x2c, 0x27,
+	0x8a, 0xeb, 0xfd, 0x3e, 0x43, 0xf4, 0x5d, 0x54, 0x3d, 0x27, 0x22, 0xf4, 0x1b, 0x05, 0xf2, 0xd1,
+	0xc6, 0x1d, 0x95, 0x93, 0x66, 0x08, 0xa5, 0x1b, 0x31, 0xd4, 0xfe, 0x97, 0xa6, 0x7e, 0x74, 0x06,
+	0x28, 0xa2, 0xea, 0xfa, 0x90, 0x15, 0x7f, 0x55, 0x82, 0x7d, 0x42, 0xef, 0xee, 0xaf, 0x0a, 0x2c,
+	0xc4, 0x36, 0x1e, 0xe2, 0xee, 0x86, 0x75, 0x44, 0xa5, 0xdb, 0xc3, 0xd8, 0x04, 0xdc, 0xc7, 0x0c,
+	0xee, 0x03, 0xb4, 0x71, 0x6e, 0xb8, 0x5e, 0x0f, 0xd2, 0xdf, 0x14, 0x98, 0x8f, 0xe9, 0x21, 0x50,
+	0x2f, 0x3a, 0x13, 0xda, 0x98, 0xd2, 0xb7, 0x92, 0x99, 0x04, 0xd4, 0x67, 0x0c, 0xea, 0x26, 0xaa,
+	0x8f, 0x0e, 0xb5, 0xfa, 0x65, 0xf0, 0xf3, 0x1d, 0xfa, 0x27, 0x0d, 0x91, 0xb8, 0x8e, 0x20, 0x08,
+	0x91, 0x21, 0xed, 0x4b, 0xe9, 0xf6, 0x30, 0x36, 0x81, 0x5d, 0x63, 0xd8, 0xb7, 0xd0, 0x0f, 0x2f,
+	0x05, 0x7b, 0x95, 0x96, 0xee, 0xe8, 0x1f, 0x0a, 0xcc, 0xca, 0x2a, 0x67, 0x54, 0x61, 0xa0, 0x12,
+	0xca, 0xf6, 0xd2, 0x52, 0x02, 0x87, 0x40, 0xfc, 0x23, 0x86, 0x78, 0x4f, 0xdd, 0xbe, 0x1c, 0xc4,
+	0x9e, 0x38, 0x6b, 0x43, 0x59, 0x39, 0xcc, 0xb0, 0x7c, 0xf2, 0xbd, 0xff, 0x0e, 0x00, 0xd3, 0xbd,
+	0x0d, 0x08, 0xfa, 0x21, 0x00, 0x00,
 }
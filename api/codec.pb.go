@@ -0,0 +1,1284 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: codec.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Codec struct {
+	// Codec ID.
+	// This will be generated automatically on create.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	// After creation, this can not be updated.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Name of the codec.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the codec.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Version of the codec.
+	// This is set to 1 on create and incremented on every update.
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,6,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,7,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string   `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Codec) Reset()         { *m = Codec{} }
+func (m *Codec) String() string { return proto.CompactTextString(m) }
+func (*Codec) ProtoMessage()    {}
+func (*Codec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{0}
+}
+func (m *Codec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Codec.Unmarshal(m, b)
+}
+func (m *Codec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Codec.Marshal(b, m, deterministic)
+}
+func (dst *Codec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Codec.Merge(dst, src)
+}
+func (m *Codec) XXX_Size() int {
+	return xxx_messageInfo_Codec.Size(m)
+}
+func (m *Codec) XXX_DiscardUnknown() {
+	xxx_messageInfo_Codec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Codec proto.InternalMessageInfo
+
+func (m *Codec) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Codec) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *Codec) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Codec) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Codec) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Codec) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *Codec) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *Codec) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+type CodecListItem struct {
+	// Codec ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Name of the codec.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the codec.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Version of the codec.
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,6,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Number of applications using this codec.
+	ApplicationCount     int64    `protobuf:"varint,7,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecListItem) Reset()         { *m = CodecListItem{} }
+func (m *CodecListItem) String() string { return proto.CompactTextString(m) }
+func (*CodecListItem) ProtoMessage()    {}
+func (*CodecListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{1}
+}
+func (m *CodecListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecListItem.Unmarshal(m, b)
+}
+func (m *CodecListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecListItem.Marshal(b, m, deterministic)
+}
+func (dst *CodecListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecListItem.Merge(dst, src)
+}
+func (m *CodecListItem) XXX_Size() int {
+	return xxx_messageInfo_CodecListItem.Size(m)
+}
+func (m *CodecListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecListItem proto.InternalMessageInfo
+
+func (m *CodecListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CodecListItem) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *CodecListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CodecListItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CodecListItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CodecListItem) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *CodecListItem) GetApplicationCount() int64 {
+	if m != nil {
+		return m.ApplicationCount
+	}
+	return 0
+}
+
+type CreateCodecRequest struct {
+	// Codec object to create.
+	Codec                *Codec   `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCodecRequest) Reset()         { *m = CreateCodecRequest{} }
+func (m *CreateCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCodecRequest) ProtoMessage()    {}
+func (*CreateCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{2}
+}
+func (m *CreateCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCodecRequest.Unmarshal(m, b)
+}
+func (m *CreateCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCodecRequest.Merge(dst, src)
+}
+func (m *CreateCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCodecRequest.Size(m)
+}
+func (m *CreateCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCodecRequest proto.InternalMessageInfo
+
+func (m *CreateCodecRequest) GetCodec() *Codec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+type CreateCodecResponse struct {
+	// ID of the created codec.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCodecResponse) Reset()         { *m = CreateCodecResponse{} }
+func (m *CreateCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCodecResponse) ProtoMessage()    {}
+func (*CreateCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{3}
+}
+func (m *CreateCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCodecResponse.Unmarshal(m, b)
+}
+func (m *CreateCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCodecResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCodecResponse.Merge(dst, src)
+}
+func (m *CreateCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCodecResponse.Size(m)
+}
+func (m *CreateCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCodecResponse proto.InternalMessageInfo
+
+func (m *CreateCodecResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetCodecRequest struct {
+	// Codec ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodecRequest) Reset()         { *m = GetCodecRequest{} }
+func (m *GetCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCodecRequest) ProtoMessage()    {}
+func (*GetCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{4}
+}
+func (m *GetCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecRequest.Unmarshal(m, b)
+}
+func (m *GetCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecRequest.Merge(dst, src)
+}
+func (m *GetCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCodecRequest.Size(m)
+}
+func (m *GetCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecRequest proto.InternalMessageInfo
+
+func (m *GetCodecRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetCodecResponse struct {
+	// Codec object.
+	Codec *Codec `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetCodecResponse) Reset()         { *m = GetCodecResponse{} }
+func (m *GetCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCodecResponse) ProtoMessage()    {}
+func (*GetCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{5}
+}
+func (m *GetCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecResponse.Unmarshal(m, b)
+}
+func (m *GetCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecResponse.Merge(dst, src)
+}
+func (m *GetCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCodecResponse.Size(m)
+}
+func (m *GetCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecResponse proto.InternalMessageInfo
+
+func (m *GetCodecResponse) GetCodec() *Codec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+func (m *GetCodecResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetCodecResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateCodecRequest struct {
+	// Codec object to update.
+	Codec                *Codec   `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCodecRequest) Reset()         { *m = UpdateCodecRequest{} }
+func (m *UpdateCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCodecRequest) ProtoMessage()    {}
+func (*UpdateCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{6}
+}
+func (m *UpdateCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCodecRequest.Unmarshal(m, b)
+}
+func (m *UpdateCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCodecRequest.Merge(dst, src)
+}
+func (m *UpdateCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCodecRequest.Size(m)
+}
+func (m *UpdateCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCodecRequest proto.InternalMessageInfo
+
+func (m *UpdateCodecRequest) GetCodec() *Codec {
+	if m != nil {
+		return m.Codec
+	}
+	return nil
+}
+
+type DeleteCodecRequest struct {
+	// Codec ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCodecRequest) Reset()         { *m = DeleteCodecRequest{} }
+func (m *DeleteCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCodecRequest) ProtoMessage()    {}
+func (*DeleteCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{7}
+}
+func (m *DeleteCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCodecRequest.Unmarshal(m, b)
+}
+func (m *DeleteCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCodecRequest.Merge(dst, src)
+}
+func (m *DeleteCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCodecRequest.Size(m)
+}
+func (m *DeleteCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCodecRequest proto.InternalMessageInfo
+
+func (m *DeleteCodecRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListCodecRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Organization ID to filter on.
+	OrganizationId       int64    `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCodecRequest) Reset()         { *m = ListCodecRequest{} }
+func (m *ListCodecRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCodecRequest) ProtoMessage()    {}
+func (*ListCodecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{8}
+}
+func (m *ListCodecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecRequest.Unmarshal(m, b)
+}
+func (m *ListCodecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListCodecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecRequest.Merge(dst, src)
+}
+func (m *ListCodecRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCodecRequest.Size(m)
+}
+func (m *ListCodecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecRequest proto.InternalMessageInfo
+
+func (m *ListCodecRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCodecRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListCodecRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type ListCodecResponse struct {
+	// Total number of codecs.
+	TotalCount           int64            `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*CodecListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListCodecResponse) Reset()         { *m = ListCodecResponse{} }
+func (m *ListCodecResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCodecResponse) ProtoMessage()    {}
+func (*ListCodecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{9}
+}
+func (m *ListCodecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecResponse.Unmarshal(m, b)
+}
+func (m *ListCodecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListCodecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecResponse.Merge(dst, src)
+}
+func (m *ListCodecResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCodecResponse.Size(m)
+}
+func (m *ListCodecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecResponse proto.InternalMessageInfo
+
+func (m *ListCodecResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListCodecResponse) GetResult() []*CodecListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CodecVersion struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version of the codec.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,4,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,5,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string   `protobuf:"bytes,6,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecVersion) Reset()         { *m = CodecVersion{} }
+func (m *CodecVersion) String() string { return proto.CompactTextString(m) }
+func (*CodecVersion) ProtoMessage()    {}
+func (*CodecVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{10}
+}
+func (m *CodecVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecVersion.Unmarshal(m, b)
+}
+func (m *CodecVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecVersion.Marshal(b, m, deterministic)
+}
+func (dst *CodecVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecVersion.Merge(dst, src)
+}
+func (m *CodecVersion) XXX_Size() int {
+	return xxx_messageInfo_CodecVersion.Size(m)
+}
+func (m *CodecVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecVersion proto.InternalMessageInfo
+
+func (m *CodecVersion) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *CodecVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CodecVersion) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CodecVersion) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *CodecVersion) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *CodecVersion) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+type CodecVersionListItem struct {
+	// Version of the codec.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Payload codec.
+	PayloadCodec         string   `protobuf:"bytes,3,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecVersionListItem) Reset()         { *m = CodecVersionListItem{} }
+func (m *CodecVersionListItem) String() string { return proto.CompactTextString(m) }
+func (*CodecVersionListItem) ProtoMessage()    {}
+func (*CodecVersionListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{11}
+}
+func (m *CodecVersionListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecVersionListItem.Unmarshal(m, b)
+}
+func (m *CodecVersionListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecVersionListItem.Marshal(b, m, deterministic)
+}
+func (dst *CodecVersionListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecVersionListItem.Merge(dst, src)
+}
+func (m *CodecVersionListItem) XXX_Size() int {
+	return xxx_messageInfo_CodecVersionListItem.Size(m)
+}
+func (m *CodecVersionListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecVersionListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecVersionListItem proto.InternalMessageInfo
+
+func (m *CodecVersionListItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CodecVersionListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CodecVersionListItem) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+type GetCodecVersionRequest struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Version of the codec.
+	Version              int64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCodecVersionRequest) Reset()         { *m = GetCodecVersionRequest{} }
+func (m *GetCodecVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCodecVersionRequest) ProtoMessage()    {}
+func (*GetCodecVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{12}
+}
+func (m *GetCodecVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecVersionRequest.Unmarshal(m, b)
+}
+func (m *GetCodecVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCodecVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecVersionRequest.Merge(dst, src)
+}
+func (m *GetCodecVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCodecVersionRequest.Size(m)
+}
+func (m *GetCodecVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecVersionRequest proto.InternalMessageInfo
+
+func (m *GetCodecVersionRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *GetCodecVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetCodecVersionResponse struct {
+	// Codec version object.
+	CodecVersion         *CodecVersion `protobuf:"bytes,1,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetCodecVersionResponse) Reset()         { *m = GetCodecVersionResponse{} }
+func (m *GetCodecVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCodecVersionResponse) ProtoMessage()    {}
+func (*GetCodecVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{13}
+}
+func (m *GetCodecVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCodecVersionResponse.Unmarshal(m, b)
+}
+func (m *GetCodecVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCodecVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCodecVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCodecVersionResponse.Merge(dst, src)
+}
+func (m *GetCodecVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCodecVersionResponse.Size(m)
+}
+func (m *GetCodecVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCodecVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCodecVersionResponse proto.InternalMessageInfo
+
+func (m *GetCodecVersionResponse) GetCodecVersion() *CodecVersion {
+	if m != nil {
+		return m.CodecVersion
+	}
+	return nil
+}
+
+type ListCodecVersionsRequest struct {
+	// Codec ID.
+	CodecId int64 `protobuf:"varint,1,opt,name=codec_id,json=codecID,proto3" json:"codec_id,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCodecVersionsRequest) Reset()         { *m = ListCodecVersionsRequest{} }
+func (m *ListCodecVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCodecVersionsRequest) ProtoMessage()    {}
+func (*ListCodecVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{14}
+}
+func (m *ListCodecVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecVersionsRequest.Unmarshal(m, b)
+}
+func (m *ListCodecVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecVersionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListCodecVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecVersionsRequest.Merge(dst, src)
+}
+func (m *ListCodecVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCodecVersionsRequest.Size(m)
+}
+func (m *ListCodecVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecVersionsRequest proto.InternalMessageInfo
+
+func (m *ListCodecVersionsRequest) GetCodecId() int64 {
+	if m != nil {
+		return m.CodecId
+	}
+	return 0
+}
+
+func (m *ListCodecVersionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCodecVersionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListCodecVersionsResponse struct {
+	// Total number of versions.
+	TotalCount           int64                   `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*CodecVersionListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListCodecVersionsResponse) Reset()         { *m = ListCodecVersionsResponse{} }
+func (m *ListCodecVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCodecVersionsResponse) ProtoMessage()    {}
+func (*ListCodecVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610d574777ab505, []int{15}
+}
+func (m *ListCodecVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCodecVersionsResponse.Unmarshal(m, b)
+}
+func (m *ListCodecVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCodecVersionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListCodecVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCodecVersionsResponse.Merge(dst, src)
+}
+func (m *ListCodecVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCodecVersionsResponse.Size(m)
+}
+func (m *ListCodecVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCodecVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCodecVersionsResponse proto.InternalMessageInfo
+
+func (m *ListCodecVersionsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListCodecVersionsResponse) GetResult() []*CodecVersionListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Codec)(nil), "api.Codec")
+	proto.RegisterType((*CodecListItem)(nil), "api.CodecListItem")
+	proto.RegisterType((*CreateCodecRequest)(nil), "api.CreateCodecRequest")
+	proto.RegisterType((*CreateCodecResponse)(nil), "api.CreateCodecResponse")
+	proto.RegisterType((*GetCodecRequest)(nil), "api.GetCodecRequest")
+	proto.RegisterType((*GetCodecResponse)(nil), "api.GetCodecResponse")
+	proto.RegisterType((*UpdateCodecRequest)(nil), "api.UpdateCodecRequest")
+	proto.RegisterType((*DeleteCodecRequest)(nil), "api.DeleteCodecRequest")
+	proto.RegisterType((*ListCodecRequest)(nil), "api.ListCodecRequest")
+	proto.RegisterType((*ListCodecResponse)(nil), "api.ListCodecResponse")
+	proto.RegisterType((*CodecVersion)(nil), "api.CodecVersion")
+	proto.RegisterType((*CodecVersionListItem)(nil), "api.CodecVersionListItem")
+	proto.RegisterType((*GetCodecVersionRequest)(nil), "api.GetCodecVersionRequest")
+	proto.RegisterType((*GetCodecVersionResponse)(nil), "api.GetCodecVersionResponse")
+	proto.RegisterType((*ListCodecVersionsRequest)(nil), "api.ListCodecVersionsRequest")
+	proto.RegisterType((*ListCodecVersionsResponse)(nil), "api.ListCodecVersionsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CodecServiceClient is the client API for CodecService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CodecServiceClient interface {
+	// Create creates the given codec.
+	Create(ctx context.Context, in *CreateCodecRequest, opts ...grpc.CallOption) (*CreateCodecResponse, error)
+	// Get returns the codec matching the given ID.
+	Get(ctx context.Context, in *GetCodecRequest, opts ...grpc.CallOption) (*GetCodecResponse, error)
+	// Update updates the given codec.
+	// This increments the version of the codec and stores the updated codec
+	// as new version. All applications referencing the codec (without a
+	// pinned version) will use the updated codec.
+	Update(ctx context.Context, in *UpdateCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the codec matching the given ID.
+	// A codec which is referenced by applications can not be deleted.
+	Delete(ctx context.Context, in *DeleteCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the codecs of the given organization.
+	List(ctx context.Context, in *ListCodecRequest, opts ...grpc.CallOption) (*ListCodecResponse, error)
+	// GetVersion returns the given version of the codec.
+	GetVersion(ctx context.Context, in *GetCodecVersionRequest, opts ...grpc.CallOption) (*GetCodecVersionResponse, error)
+	// ListVersions lists the versions of the given codec.
+	ListVersions(ctx context.Context, in *ListCodecVersionsRequest, opts ...grpc.CallOption) (*ListCodecVersionsResponse, error)
+}
+
+type codecServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCodecServiceClient(cc *grpc.ClientConn) CodecServiceClient {
+	return &codecServiceClient{cc}
+}
+
+func (c *codecServiceClient) Create(ctx context.Context, in *CreateCodecRequest, opts ...grpc.CallOption) (*CreateCodecResponse, error) {
+	out := new(CreateCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) Get(ctx context.Context, in *GetCodecRequest, opts ...grpc.CallOption) (*GetCodecResponse, error) {
+	out := new(GetCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) Update(ctx context.Context, in *UpdateCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) Delete(ctx context.Context, in *DeleteCodecRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.CodecService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) List(ctx context.Context, in *ListCodecRequest, opts ...grpc.CallOption) (*ListCodecResponse, error) {
+	out := new(ListCodecResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) GetVersion(ctx context.Context, in *GetCodecVersionRequest, opts ...grpc.CallOption) (*GetCodecVersionResponse, error) {
+	out := new(GetCodecVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codecServiceClient) ListVersions(ctx context.Context, in *ListCodecVersionsRequest, opts ...grpc.CallOption) (*ListCodecVersionsResponse, error) {
+	out := new(ListCodecVersionsResponse)
+	err := c.cc.Invoke(ctx, "/api.CodecService/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CodecServiceServer is the server API for CodecService service.
+type CodecServiceServer interface {
+	// Create creates the given codec.
+	Create(context.Context, *CreateCodecRequest) (*CreateCodecResponse, error)
+	// Get returns the codec matching the given ID.
+	Get(context.Context, *GetCodecRequest) (*GetCodecResponse, error)
+	// Update updates the given codec.
+	// This increments the version of the codec and stores the updated codec
+	// as new version. All applications referencing the codec (without a
+	// pinned version) will use the updated codec.
+	Update(context.Context, *UpdateCodecRequest) (*empty.Empty, error)
+	// Delete deletes the codec matching the given ID.
+	// A codec which is referenced by applications can not be deleted.
+	Delete(context.Context, *DeleteCodecRequest) (*empty.Empty, error)
+	// List lists the codecs of the given organization.
+	List(context.Context, *ListCodecRequest) (*ListCodecResponse, error)
+	// GetVersion returns the given version of the codec.
+	GetVersion(context.Context, *GetCodecVersionRequest) (*GetCodecVersionResponse, error)
+	// ListVersions lists the versions of the given codec.
+	ListVersions(context.Context, *ListCodecVersionsRequest) (*ListCodecVersionsResponse, error)
+}
+
+func RegisterCodecServiceServer(s *grpc.Server, srv CodecServiceServer) {
+	s.RegisterService(&_CodecService_serviceDesc, srv)
+}
+
+func _CodecService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Create(ctx, req.(*CreateCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Get(ctx, req.(*GetCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Update(ctx, req.(*UpdateCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Delete(ctx, req.(*DeleteCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).List(ctx, req.(*ListCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodecVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).GetVersion(ctx, req.(*GetCodecVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CodecService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCodecVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CodecService/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).ListVersions(ctx, req.(*ListCodecVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CodecService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.CodecService",
+	HandlerType: (*CodecServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _CodecService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CodecService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CodecService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CodecService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _CodecService_List_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _CodecService_GetVersion_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _CodecService_ListVersions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "codec.proto",
+}
+
+func init() { proto.RegisterFile("codec.proto", fileDescriptor_9610d574777ab505) }
+
+var fileDescriptor_9610d574777ab505 = []byte{
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x96, 0xed, 0xc4, 0xc0, 0x71, 0x02, 0x61, 0xc8, 0x0d, 0xc6, 0x70, 0x2f, 0xc1, 0xf7, 0x5e,
+	0x41, 0x41, 0x4a, 0x44, 0x5a, 0x21, 0xb5, 0x3b, 0x44, 0x10, 0xa2, 0xa2, 0x8b, 0x26, 0x6d, 0x57,
+	0x95, 0x52, 0x63, 0x0f, 0x68, 0xa4, 0xc4, 0x76, 0xed, 0x09, 0x12, 0xa0, 0x6c, 0xfa, 0x02, 0xad,
+	0xd4, 0x27, 0xa8, 0xd4, 0x55, 0x5f, 0xa7, 0xaf, 0xd0, 0x55, 0x9f, 0xa2, 0xf2, 0xcc, 0x38, 0xf8,
+	0x27, 0xfc, 0x76, 0xd5, 0x15, 0xcc, 0x9c, 0x73, 0xbe, 0xf9, 0xce, 0xe7, 0xef, 0x1c, 0x05, 0x34,
+	0xdb, 0x73, 0xb0, 0xdd, 0xf0, 0x03, 0x8f, 0x7a, 0x48, 0xb1, 0x7c, 0x62, 0xac, 0x9c, 0x7a, 0xde,
+	0x69, 0x1f, 0x37, 0x2d, 0x9f, 0x34, 0x2d, 0xd7, 0xf5, 0xa8, 0x45, 0x89, 0xe7, 0x86, 0x3c, 0xc5,
+	0x58, 0x15, 0x51, 0x76, 0x3a, 0x1e, 0x9e, 0x34, 0x29, 0x19, 0xe0, 0x90, 0x5a, 0x03, 0x5f, 0x24,
+	0x2c, 0x67, 0x13, 0xf0, 0xc0, 0xa7, 0xe7, 0x3c, 0x68, 0x7e, 0x91, 0xa1, 0xb8, 0x17, 0x3d, 0x88,
+	0x66, 0x41, 0x26, 0x8e, 0x2e, 0xd5, 0xa5, 0x0d, 0xa5, 0x23, 0x13, 0x07, 0xad, 0xc3, 0x9c, 0x17,
+	0x9c, 0x5a, 0x2e, 0xb9, 0x60, 0xcf, 0xf5, 0x88, 0xa3, 0xcb, 0x2c, 0x38, 0x9b, 0xbc, 0x3e, 0x6c,
+	0x23, 0x04, 0x05, 0xd7, 0x1a, 0x60, 0x5d, 0xa9, 0x4b, 0x1b, 0x33, 0x1d, 0xf6, 0x3f, 0xaa, 0x83,
+	0xe6, 0xe0, 0xd0, 0x0e, 0x88, 0x1f, 0x25, 0xe9, 0x05, 0x16, 0x4a, 0x5e, 0x21, 0x1d, 0xa6, 0xce,
+	0x70, 0x10, 0x46, 0xd1, 0x22, 0x83, 0x8d, 0x8f, 0xe8, 0x5f, 0x28, 0xfb, 0xd6, 0x79, 0xdf, 0xb3,
+	0x9c, 0x1e, 0x93, 0x42, 0x57, 0x59, 0x75, 0x49, 0x5c, 0x72, 0xb6, 0x4f, 0xa0, 0x16, 0x27, 0x61,
+	0x37, 0x4a, 0x0b, 0x7a, 0x1c, 0x5b, 0x9f, 0x62, 0xd9, 0x55, 0x11, 0xdd, 0xe7, 0xc1, 0x2e, 0x8b,
+	0x25, 0xab, 0x1c, 0x9c, 0xaa, 0x9a, 0x4e, 0x55, 0xb5, 0x71, 0xa2, 0xca, 0xfc, 0x29, 0x41, 0x99,
+	0xbd, 0x7a, 0x44, 0x42, 0x7a, 0x48, 0xf1, 0xe0, 0x0f, 0xd3, 0x6a, 0x0b, 0xe6, 0x2d, 0xdf, 0xef,
+	0x13, 0x9b, 0x93, 0xb3, 0xbd, 0xa1, 0xcb, 0x65, 0x52, 0x3a, 0x95, 0x44, 0x60, 0x2f, 0xba, 0x37,
+	0x77, 0x00, 0xed, 0x05, 0xd8, 0xa2, 0x98, 0xd5, 0x76, 0xf0, 0xfb, 0x21, 0x0e, 0x29, 0xaa, 0x43,
+	0x91, 0xe3, 0x47, 0x3d, 0x6b, 0x2d, 0x68, 0x58, 0x3e, 0x69, 0xf0, 0x0c, 0x1e, 0x30, 0xff, 0x87,
+	0x85, 0x54, 0x5d, 0xe8, 0x7b, 0x6e, 0x88, 0xb3, 0x4a, 0x99, 0x6b, 0x30, 0x77, 0x80, 0x69, 0x0a,
+	0x3b, 0x9b, 0xf2, 0x4d, 0x82, 0xca, 0x55, 0x8e, 0xc0, 0xb9, 0x95, 0x00, 0x7a, 0x0a, 0x60, 0x33,
+	0x02, 0x4e, 0xcf, 0xa2, 0x4c, 0x7e, 0xad, 0x65, 0x34, 0xb8, 0xf7, 0x1b, 0xb1, 0xf7, 0x1b, 0xaf,
+	0xe2, 0xe1, 0xe8, 0xcc, 0x88, 0xec, 0x5d, 0x1a, 0x95, 0x0e, 0x7d, 0x27, 0x2e, 0x55, 0x6e, 0x2f,
+	0x15, 0xd9, 0xbb, 0x4c, 0xae, 0xd7, 0xec, 0x70, 0x4f, 0xb9, 0xfe, 0x03, 0xd4, 0xc6, 0x7d, 0x4c,
+	0xf1, 0x8d, 0x52, 0x10, 0xa8, 0x44, 0x9e, 0x4b, 0xe5, 0x54, 0xa1, 0xd8, 0x27, 0x03, 0x42, 0x45,
+	0x1a, 0x3f, 0xa0, 0x1a, 0xa8, 0xde, 0xc9, 0x49, 0x88, 0xa9, 0x30, 0x9e, 0x38, 0x4d, 0x72, 0xa6,
+	0x32, 0xc9, 0x99, 0xe6, 0x3b, 0x98, 0x4f, 0x3c, 0x25, 0x54, 0x5f, 0x05, 0x8d, 0x7a, 0xd4, 0xea,
+	0x0b, 0xcf, 0xf0, 0x17, 0x81, 0x5d, 0x31, 0xb7, 0xa0, 0x4d, 0x50, 0x03, 0x1c, 0x0e, 0xfb, 0xd1,
+	0xb3, 0xca, 0x86, 0xd6, 0x42, 0x57, 0x9d, 0xc6, 0xc3, 0xd2, 0x11, 0x19, 0xe6, 0x47, 0x19, 0x4a,
+	0x2c, 0xf2, 0x46, 0x98, 0x77, 0x09, 0xa6, 0x99, 0x18, 0xbd, 0x71, 0xcf, 0x53, 0xec, 0x7c, 0xd8,
+	0x4e, 0x3a, 0x5e, 0x4e, 0x3b, 0x3e, 0xfd, 0x99, 0x95, 0xfb, 0x7c, 0xe6, 0xdc, 0xb0, 0x14, 0xee,
+	0xb5, 0x58, 0x8a, 0x0f, 0x5a, 0x2c, 0xea, 0x0d, 0x8b, 0xe5, 0x93, 0x04, 0xd5, 0xa4, 0x22, 0xe3,
+	0xfd, 0x92, 0x68, 0x5f, 0xba, 0xa9, 0x7d, 0xf9, 0xb7, 0xda, 0x57, 0xf2, 0xed, 0x9b, 0x2f, 0xa0,
+	0x16, 0xcf, 0x9e, 0x20, 0x15, 0xfb, 0xee, 0x21, 0x5f, 0xcb, 0x7c, 0x09, 0x8b, 0x39, 0x38, 0xe1,
+	0xad, 0x1d, 0x28, 0x73, 0xbc, 0x64, 0xa7, 0x5a, 0x6b, 0xfe, 0xca, 0x41, 0x71, 0x45, 0xc9, 0x4e,
+	0x9c, 0x4c, 0x1b, 0xf4, 0xb1, 0x51, 0xc5, 0x5d, 0x78, 0x07, 0x8e, 0xe3, 0xb1, 0x91, 0x27, 0x8f,
+	0x8d, 0x92, 0x1c, 0x1b, 0xd3, 0x83, 0xa5, 0x09, 0x8f, 0xdc, 0x75, 0x2a, 0xb6, 0x33, 0x53, 0xb1,
+	0x94, 0xeb, 0x29, 0x3b, 0x1c, 0xad, 0xaf, 0x45, 0x31, 0x1c, 0x5d, 0x1c, 0x9c, 0x11, 0x1b, 0xa3,
+	0x2e, 0xa8, 0x7c, 0x9f, 0xa2, 0x45, 0x5e, 0x9d, 0x5b, 0xca, 0x86, 0x9e, 0x0f, 0x70, 0x86, 0x66,
+	0xed, 0xc3, 0xf7, 0x1f, 0x9f, 0xe5, 0x8a, 0xa9, 0xb1, 0xdf, 0x0c, 0x4c, 0x82, 0xf0, 0x99, 0xb4,
+	0x89, 0x8e, 0x40, 0x39, 0xc0, 0x14, 0x55, 0x59, 0x61, 0x66, 0x0f, 0x1b, 0x7f, 0x65, 0x6e, 0x05,
+	0x96, 0xce, 0xb0, 0x10, 0xaa, 0x24, 0xb0, 0x9a, 0x97, 0xc4, 0x19, 0xa1, 0xb7, 0xa0, 0xf2, 0xdd,
+	0x27, 0x28, 0xe6, 0x17, 0xa1, 0x51, 0xcb, 0x59, 0x73, 0x3f, 0xfa, 0xf1, 0x61, 0xae, 0x31, 0xd0,
+	0x65, 0xa3, 0x96, 0x02, 0x65, 0x7f, 0x1b, 0xc4, 0x19, 0x45, 0x5c, 0xbb, 0xa0, 0xf2, 0x0d, 0x29,
+	0xd0, 0xf3, 0xeb, 0xf2, 0x5a, 0x74, 0x41, 0x79, 0x33, 0x4f, 0xf9, 0x39, 0x14, 0x22, 0xe9, 0x11,
+	0xef, 0x35, 0xbb, 0x5b, 0x8d, 0x5a, 0xf6, 0x5a, 0x68, 0xb0, 0xc0, 0x00, 0xcb, 0x28, 0xa9, 0x27,
+	0xba, 0x00, 0x38, 0xc0, 0x34, 0x5e, 0x66, 0xcb, 0x29, 0xf5, 0xd2, 0xb3, 0x63, 0xac, 0x4c, 0x0e,
+	0x0a, 0xf4, 0x6d, 0x86, 0xbe, 0x85, 0x1e, 0xe5, 0xc5, 0xe8, 0x11, 0x67, 0xd4, 0x14, 0x03, 0x12,
+	0x36, 0x2f, 0xc5, 0x7f, 0x23, 0x74, 0x06, 0xa5, 0x88, 0x65, 0x6c, 0x4d, 0xf4, 0x77, 0x9a, 0x78,
+	0x66, 0x2e, 0x8c, 0x7f, 0xae, 0x0b, 0x0b, 0x06, 0xeb, 0x8c, 0xc1, 0x1a, 0x5a, 0xbd, 0x85, 0xc1,
+	0xb1, 0xca, 0x94, 0x7e, 0xfc, 0x6b, 0x00, 0xe7, 0x2d, 0xd0, 0xed, 0xa5, 0x0a, 0x00, 0x00,
+}
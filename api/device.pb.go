@@ -27,6 +27,60 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type DeviceFileFormat int32
+
+const (
+	// Comma-separated values, the first row must contain the column names.
+	DeviceFileFormat_CSV DeviceFileFormat = 0
+	// JSON array of device objects.
+	DeviceFileFormat_JSON DeviceFileFormat = 1
+)
+
+var DeviceFileFormat_name = map[int32]string{
+	0: "CSV",
+	1: "JSON",
+}
+var DeviceFileFormat_value = map[string]int32{
+	"CSV":  0,
+	"JSON": 1,
+}
+
+func (x DeviceFileFormat) String() string {
+	return proto.EnumName(DeviceFileFormat_name, int32(x))
+}
+func (DeviceFileFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{0}
+}
+
+type DeviceImportJobStatus int32
+
+const (
+	// The job has not yet started.
+	DeviceImportJobStatus_IMPORT_PENDING DeviceImportJobStatus = 0
+	// The job is running.
+	DeviceImportJobStatus_IMPORT_RUNNING DeviceImportJobStatus = 1
+	// All rows have been processed.
+	DeviceImportJobStatus_IMPORT_COMPLETED DeviceImportJobStatus = 2
+)
+
+var DeviceImportJobStatus_name = map[int32]string{
+	0: "IMPORT_PENDING",
+	1: "IMPORT_RUNNING",
+	2: "IMPORT_COMPLETED",
+}
+var DeviceImportJobStatus_value = map[string]int32{
+	"IMPORT_PENDING":   0,
+	"IMPORT_RUNNING":   1,
+	"IMPORT_COMPLETED": 2,
+}
+
+func (x DeviceImportJobStatus) String() string {
+	return proto.EnumName(DeviceImportJobStatus_name, int32(x))
+}
+func (DeviceImportJobStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{1}
+}
+
 type Device struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -44,10 +98,25 @@ type Device struct {
 	// When using geolocation, this altitude will be used as a reference
 	// (when supported by the geolocation-server) to increase geolocation
 	// accuracy.
-	ReferenceAltitude    float64  `protobuf:"fixed64,7,opt,name=reference_altitude,json=referenceAltitude,proto3" json:"reference_altitude,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ReferenceAltitude float64 `protobuf:"fixed64,7,opt,name=reference_altitude,json=referenceAltitude,proto3" json:"reference_altitude,omitempty"`
+	// Variables (user defined).
+	// These variables are exposed to the payload decoder script of the
+	// application or device-profile. They are never included in the
+	// integration payloads.
+	Variables map[string]string `protobuf:"bytes,8,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Tags (user defined).
+	// These tags are included in all integration payloads and can be
+	// used when searching for devices.
+	Tags map[string]string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Secret variables (user defined).
+	// Unlike variables, these are not exposed to the payload decoder script
+	// and their values are never returned by the API (only the keys are
+	// returned, with an empty value). On update, a secret variable with an
+	// empty value keeps its current value.
+	SecretVariables      map[string]string `protobuf:"bytes,10,rep,name=secret_variables,json=secretVariables,proto3" json:"secret_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Device) Reset()         { *m = Device{} }
@@ -123,6 +192,27 @@ func (m *Device) GetReferenceAltitude() float64 {
 	return 0
 }
 
+func (m *Device) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *Device) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Device) GetSecretVariables() map[string]string {
+	if m != nil {
+		return m.SecretVariables
+	}
+	return nil
+}
+
 type DeviceListItem struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -154,10 +244,14 @@ type DeviceListItem struct {
 	DeviceStatusBatteryLevel float32 `protobuf:"fixed32,12,opt,name=device_status_battery_level,json=deviceStatusBatteryLevel,proto3" json:"device_status_battery_level,omitempty"`
 	// The last time the application-server received any data from the device,
 	// or an empty string when the device never sent any data.
-	LastSeenAt           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Tags (user defined).
+	Tags map[string]string `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Last known value of each field of the decoded uplink objects.
+	LastValues           map[string]*DeviceValue `protobuf:"bytes,14,rep,name=last_values,json=lastValues,proto3" json:"last_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *DeviceListItem) Reset()         { *m = DeviceListItem{} }
@@ -268,6 +362,68 @@ func (m *DeviceListItem) GetLastSeenAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *DeviceListItem) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *DeviceListItem) GetLastValues() map[string]*DeviceValue {
+	if m != nil {
+		return m.LastValues
+	}
+	return nil
+}
+
+type DeviceValue struct {
+	// Value (JSON encoded).
+	ValueJson string `protobuf:"bytes,1,opt,name=value_json,json=valueJSON,proto3" json:"value_json,omitempty"`
+	// Timestamp at which the value was received.
+	ReceivedAt           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceValue) Reset()         { *m = DeviceValue{} }
+func (m *DeviceValue) String() string { return proto.CompactTextString(m) }
+func (*DeviceValue) ProtoMessage()    {}
+func (*DeviceValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{2}
+}
+func (m *DeviceValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceValue.Unmarshal(m, b)
+}
+func (m *DeviceValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceValue.Marshal(b, m, deterministic)
+}
+func (dst *DeviceValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceValue.Merge(dst, src)
+}
+func (m *DeviceValue) XXX_Size() int {
+	return xxx_messageInfo_DeviceValue.Size(m)
+}
+func (m *DeviceValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceValue proto.InternalMessageInfo
+
+func (m *DeviceValue) GetValueJson() string {
+	if m != nil {
+		return m.ValueJson
+	}
+	return ""
+}
+
+func (m *DeviceValue) GetReceivedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
 type DeviceKeys struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -276,7 +432,11 @@ type DeviceKeys struct {
 	NwkKey string `protobuf:"bytes,2,opt,name=nwk_key,json=nwkKey,proto3" json:"nwk_key,omitempty"`
 	// Application root key (HEX encoded).
 	// Note: This field only needs to be set for LoRaWAN 1.1.x devices!
-	AppKey               string   `protobuf:"bytes,3,opt,name=app_key,json=appKey,proto3" json:"app_key,omitempty"`
+	AppKey string `protobuf:"bytes,3,opt,name=app_key,json=appKey,proto3" json:"app_key,omitempty"`
+	// Gen application key (HEX encoded).
+	// This is an optional key that only must be set for LoRaWAN 1.0.x devices
+	// that implement the remote multicast setup.
+	GenAppKey            string   `protobuf:"bytes,4,opt,name=gen_app_key,json=genAppKey,proto3" json:"gen_app_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -286,7 +446,7 @@ func (m *DeviceKeys) Reset()         { *m = DeviceKeys{} }
 func (m *DeviceKeys) String() string { return proto.CompactTextString(m) }
 func (*DeviceKeys) ProtoMessage()    {}
 func (*DeviceKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{2}
+	return fileDescriptor_870276a56ac00da5, []int{3}
 }
 func (m *DeviceKeys) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceKeys.Unmarshal(m, b)
@@ -327,6 +487,13 @@ func (m *DeviceKeys) GetAppKey() string {
 	return ""
 }
 
+func (m *DeviceKeys) GetGenAppKey() string {
+	if m != nil {
+		return m.GenAppKey
+	}
+	return ""
+}
+
 type CreateDeviceRequest struct {
 	// Device object to create.
 	Device               *Device  `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
@@ -339,7 +506,7 @@ func (m *CreateDeviceRequest) Reset()         { *m = CreateDeviceRequest{} }
 func (m *CreateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeviceRequest) ProtoMessage()    {}
 func (*CreateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{3}
+	return fileDescriptor_870276a56ac00da5, []int{4}
 }
 func (m *CreateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDeviceRequest.Unmarshal(m, b)
@@ -378,7 +545,7 @@ func (m *GetDeviceRequest) Reset()         { *m = GetDeviceRequest{} }
 func (m *GetDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceRequest) ProtoMessage()    {}
 func (*GetDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{4}
+	return fileDescriptor_870276a56ac00da5, []int{5}
 }
 func (m *GetDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceRequest.Unmarshal(m, b)
@@ -421,19 +588,21 @@ type GetDeviceResponse struct {
 	// 256:     The device-status is not available.
 	DeviceStatusMargin int32 `protobuf:"varint,20,opt,name=device_status_margin,json=deviceStatusMargin,proto3" json:"device_status_margin,omitempty"`
 	// Device location.
-	// This will set when the network-server was able to resolve the location
-	// using the geolocation-server.
-	Location             *common.Location `protobuf:"bytes,21,opt,name=location,proto3" json:"location,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	// This is the latest location of the device, either resolved by the
+	// geolocation-server, decoded from a GPS payload or set manually.
+	Location *common.Location `protobuf:"bytes,21,opt,name=location,proto3" json:"location,omitempty"`
+	// Last known value of each field of the decoded uplink objects.
+	LastValues           map[string]*DeviceValue `protobuf:"bytes,22,rep,name=last_values,json=lastValues,proto3" json:"last_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *GetDeviceResponse) Reset()         { *m = GetDeviceResponse{} }
 func (m *GetDeviceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceResponse) ProtoMessage()    {}
 func (*GetDeviceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{5}
+	return fileDescriptor_870276a56ac00da5, []int{6}
 }
 func (m *GetDeviceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceResponse.Unmarshal(m, b)
@@ -488,6 +657,13 @@ func (m *GetDeviceResponse) GetLocation() *common.Location {
 	return nil
 }
 
+func (m *GetDeviceResponse) GetLastValues() map[string]*DeviceValue {
+	if m != nil {
+		return m.LastValues
+	}
+	return nil
+}
+
 type ListDeviceRequest struct {
 	// Max number of devices to return in the result-set.
 	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -495,12 +671,14 @@ type ListDeviceRequest struct {
 	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	// Application ID to filter on.
 	ApplicationId int64 `protobuf:"varint,3,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
-	// Search on name or DevEUI.
+	// Search on name, DevEUI or tags (keys and values).
 	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
 	// Multicast-group ID to filter on (string formatted UUID).
 	MulticastGroupId string `protobuf:"bytes,5,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
 	// Service-profile ID to filter on (string formatted UUID).
-	ServiceProfileId     string   `protobuf:"bytes,6,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	ServiceProfileId string `protobuf:"bytes,6,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	// Device-group ID to filter on (string formatted UUID).
+	DeviceGroupId        string   `protobuf:"bytes,7,opt,name=device_group_id,json=deviceGroupID,proto3" json:"device_group_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -510,7 +688,7 @@ func (m *ListDeviceRequest) Reset()         { *m = ListDeviceRequest{} }
 func (m *ListDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceRequest) ProtoMessage()    {}
 func (*ListDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{6}
+	return fileDescriptor_870276a56ac00da5, []int{7}
 }
 func (m *ListDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceRequest.Unmarshal(m, b)
@@ -572,6 +750,13 @@ func (m *ListDeviceRequest) GetServiceProfileId() string {
 	return ""
 }
 
+func (m *ListDeviceRequest) GetDeviceGroupId() string {
+	if m != nil {
+		return m.DeviceGroupId
+	}
+	return ""
+}
+
 type ListDeviceResponse struct {
 	// Total number of devices available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -586,7 +771,7 @@ func (m *ListDeviceResponse) Reset()         { *m = ListDeviceResponse{} }
 func (m *ListDeviceResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceResponse) ProtoMessage()    {}
 func (*ListDeviceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{7}
+	return fileDescriptor_870276a56ac00da5, []int{8}
 }
 func (m *ListDeviceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListDeviceResponse.Unmarshal(m, b)
@@ -632,7 +817,7 @@ func (m *DeleteDeviceRequest) Reset()         { *m = DeleteDeviceRequest{} }
 func (m *DeleteDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDeviceRequest) ProtoMessage()    {}
 func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{8}
+	return fileDescriptor_870276a56ac00da5, []int{9}
 }
 func (m *DeleteDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDeviceRequest.Unmarshal(m, b)
@@ -671,7 +856,7 @@ func (m *UpdateDeviceRequest) Reset()         { *m = UpdateDeviceRequest{} }
 func (m *UpdateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDeviceRequest) ProtoMessage()    {}
 func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{9}
+	return fileDescriptor_870276a56ac00da5, []int{10}
 }
 func (m *UpdateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDeviceRequest.Unmarshal(m, b)
@@ -710,7 +895,7 @@ func (m *CreateDeviceKeysRequest) Reset()         { *m = CreateDeviceKeysRequest
 func (m *CreateDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateDeviceKeysRequest) ProtoMessage()    {}
 func (*CreateDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{10}
+	return fileDescriptor_870276a56ac00da5, []int{11}
 }
 func (m *CreateDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDeviceKeysRequest.Unmarshal(m, b)
@@ -749,7 +934,7 @@ func (m *GetDeviceKeysRequest) Reset()         { *m = GetDeviceKeysRequest{} }
 func (m *GetDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceKeysRequest) ProtoMessage()    {}
 func (*GetDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{11}
+	return fileDescriptor_870276a56ac00da5, []int{12}
 }
 func (m *GetDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceKeysRequest.Unmarshal(m, b)
@@ -788,7 +973,7 @@ func (m *GetDeviceKeysResponse) Reset()         { *m = GetDeviceKeysResponse{} }
 func (m *GetDeviceKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceKeysResponse) ProtoMessage()    {}
 func (*GetDeviceKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{12}
+	return fileDescriptor_870276a56ac00da5, []int{13}
 }
 func (m *GetDeviceKeysResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceKeysResponse.Unmarshal(m, b)
@@ -827,7 +1012,7 @@ func (m *UpdateDeviceKeysRequest) Reset()         { *m = UpdateDeviceKeysRequest
 func (m *UpdateDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDeviceKeysRequest) ProtoMessage()    {}
 func (*UpdateDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{13}
+	return fileDescriptor_870276a56ac00da5, []int{14}
 }
 func (m *UpdateDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDeviceKeysRequest.Unmarshal(m, b)
@@ -866,7 +1051,7 @@ func (m *DeleteDeviceKeysRequest) Reset()         { *m = DeleteDeviceKeysRequest
 func (m *DeleteDeviceKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDeviceKeysRequest) ProtoMessage()    {}
 func (*DeleteDeviceKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{14}
+	return fileDescriptor_870276a56ac00da5, []int{15}
 }
 func (m *DeleteDeviceKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDeviceKeysRequest.Unmarshal(m, b)
@@ -921,7 +1106,7 @@ func (m *DeviceActivation) Reset()         { *m = DeviceActivation{} }
 func (m *DeviceActivation) String() string { return proto.CompactTextString(m) }
 func (*DeviceActivation) ProtoMessage()    {}
 func (*DeviceActivation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{15}
+	return fileDescriptor_870276a56ac00da5, []int{16}
 }
 func (m *DeviceActivation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceActivation.Unmarshal(m, b)
@@ -1015,7 +1200,7 @@ func (m *ActivateDeviceRequest) Reset()         { *m = ActivateDeviceRequest{} }
 func (m *ActivateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateDeviceRequest) ProtoMessage()    {}
 func (*ActivateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{16}
+	return fileDescriptor_870276a56ac00da5, []int{17}
 }
 func (m *ActivateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ActivateDeviceRequest.Unmarshal(m, b)
@@ -1054,7 +1239,7 @@ func (m *DeactivateDeviceRequest) Reset()         { *m = DeactivateDeviceRequest
 func (m *DeactivateDeviceRequest) String() string { return proto.CompactTextString(m) }
 func (*DeactivateDeviceRequest) ProtoMessage()    {}
 func (*DeactivateDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{17}
+	return fileDescriptor_870276a56ac00da5, []int{18}
 }
 func (m *DeactivateDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeactivateDeviceRequest.Unmarshal(m, b)
@@ -1093,7 +1278,7 @@ func (m *GetDeviceActivationRequest) Reset()         { *m = GetDeviceActivationR
 func (m *GetDeviceActivationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceActivationRequest) ProtoMessage()    {}
 func (*GetDeviceActivationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{18}
+	return fileDescriptor_870276a56ac00da5, []int{19}
 }
 func (m *GetDeviceActivationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceActivationRequest.Unmarshal(m, b)
@@ -1132,7 +1317,7 @@ func (m *GetDeviceActivationResponse) Reset()         { *m = GetDeviceActivation
 func (m *GetDeviceActivationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceActivationResponse) ProtoMessage()    {}
 func (*GetDeviceActivationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{19}
+	return fileDescriptor_870276a56ac00da5, []int{20}
 }
 func (m *GetDeviceActivationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceActivationResponse.Unmarshal(m, b)
@@ -1171,7 +1356,7 @@ func (m *GetRandomDevAddrRequest) Reset()         { *m = GetRandomDevAddrRequest
 func (m *GetRandomDevAddrRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrRequest) ProtoMessage()    {}
 func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{20}
+	return fileDescriptor_870276a56ac00da5, []int{21}
 }
 func (m *GetRandomDevAddrRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRandomDevAddrRequest.Unmarshal(m, b)
@@ -1210,7 +1395,7 @@ func (m *GetRandomDevAddrResponse) Reset()         { *m = GetRandomDevAddrRespon
 func (m *GetRandomDevAddrResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrResponse) ProtoMessage()    {}
 func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{21}
+	return fileDescriptor_870276a56ac00da5, []int{22}
 }
 func (m *GetRandomDevAddrResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRandomDevAddrResponse.Unmarshal(m, b)
@@ -1249,7 +1434,7 @@ func (m *StreamDeviceFrameLogsRequest) Reset()         { *m = StreamDeviceFrameL
 func (m *StreamDeviceFrameLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceFrameLogsRequest) ProtoMessage()    {}
 func (*StreamDeviceFrameLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{22}
+	return fileDescriptor_870276a56ac00da5, []int{23}
 }
 func (m *StreamDeviceFrameLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Unmarshal(m, b)
@@ -1290,7 +1475,7 @@ func (m *StreamDeviceFrameLogsResponse) Reset()         { *m = StreamDeviceFrame
 func (m *StreamDeviceFrameLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceFrameLogsResponse) ProtoMessage()    {}
 func (*StreamDeviceFrameLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{23}
+	return fileDescriptor_870276a56ac00da5, []int{24}
 }
 func (m *StreamDeviceFrameLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Unmarshal(m, b)
@@ -1433,7 +1618,7 @@ func (m *StreamDeviceEventLogsRequest) Reset()         { *m = StreamDeviceEventL
 func (m *StreamDeviceEventLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceEventLogsRequest) ProtoMessage()    {}
 func (*StreamDeviceEventLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{24}
+	return fileDescriptor_870276a56ac00da5, []int{25}
 }
 func (m *StreamDeviceEventLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceEventLogsRequest.Unmarshal(m, b)
@@ -1474,7 +1659,7 @@ func (m *StreamDeviceEventLogsResponse) Reset()         { *m = StreamDeviceEvent
 func (m *StreamDeviceEventLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamDeviceEventLogsResponse) ProtoMessage()    {}
 func (*StreamDeviceEventLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{25}
+	return fileDescriptor_870276a56ac00da5, []int{26}
 }
 func (m *StreamDeviceEventLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamDeviceEventLogsResponse.Unmarshal(m, b)
@@ -1508,111 +1693,1850 @@ func (m *StreamDeviceEventLogsResponse) GetPayloadJson() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Device)(nil), "api.Device")
-	proto.RegisterType((*DeviceListItem)(nil), "api.DeviceListItem")
-	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
-	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
-	proto.RegisterType((*GetDeviceRequest)(nil), "api.GetDeviceRequest")
-	proto.RegisterType((*GetDeviceResponse)(nil), "api.GetDeviceResponse")
-	proto.RegisterType((*ListDeviceRequest)(nil), "api.ListDeviceRequest")
-	proto.RegisterType((*ListDeviceResponse)(nil), "api.ListDeviceResponse")
-	proto.RegisterType((*DeleteDeviceRequest)(nil), "api.DeleteDeviceRequest")
-	proto.RegisterType((*UpdateDeviceRequest)(nil), "api.UpdateDeviceRequest")
-	proto.RegisterType((*CreateDeviceKeysRequest)(nil), "api.CreateDeviceKeysRequest")
-	proto.RegisterType((*GetDeviceKeysRequest)(nil), "api.GetDeviceKeysRequest")
-	proto.RegisterType((*GetDeviceKeysResponse)(nil), "api.GetDeviceKeysResponse")
-	proto.RegisterType((*UpdateDeviceKeysRequest)(nil), "api.UpdateDeviceKeysRequest")
-	proto.RegisterType((*DeleteDeviceKeysRequest)(nil), "api.DeleteDeviceKeysRequest")
-	proto.RegisterType((*DeviceActivation)(nil), "api.DeviceActivation")
-	proto.RegisterType((*ActivateDeviceRequest)(nil), "api.ActivateDeviceRequest")
-	proto.RegisterType((*DeactivateDeviceRequest)(nil), "api.DeactivateDeviceRequest")
-	proto.RegisterType((*GetDeviceActivationRequest)(nil), "api.GetDeviceActivationRequest")
-	proto.RegisterType((*GetDeviceActivationResponse)(nil), "api.GetDeviceActivationResponse")
-	proto.RegisterType((*GetRandomDevAddrRequest)(nil), "api.GetRandomDevAddrRequest")
-	proto.RegisterType((*GetRandomDevAddrResponse)(nil), "api.GetRandomDevAddrResponse")
-	proto.RegisterType((*StreamDeviceFrameLogsRequest)(nil), "api.StreamDeviceFrameLogsRequest")
-	proto.RegisterType((*StreamDeviceFrameLogsResponse)(nil), "api.StreamDeviceFrameLogsResponse")
-	proto.RegisterType((*StreamDeviceEventLogsRequest)(nil), "api.StreamDeviceEventLogsRequest")
-	proto.RegisterType((*StreamDeviceEventLogsResponse)(nil), "api.StreamDeviceEventLogsResponse")
+type ListDeviceEventsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Max number of events to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Event type to filter on (e.g. uplink, downlink, ack, join, error).
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Only return events created at or after this timestamp.
+	Start *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	// Only return events created before this timestamp.
+	End                  *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DeviceServiceClient is the client API for DeviceService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DeviceServiceClient interface {
-	// Create creates the given device.
-	Create(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Get returns the device matching the given DevEUI.
-	Get(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error)
-	// List returns the available devices.
-	List(ctx context.Context, in *ListDeviceRequest, opts ...grpc.CallOption) (*ListDeviceResponse, error)
-	// Delete deletes the device matching the given DevEUI.
-	Delete(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Update updates the device matching the given DevEUI.
-	Update(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// CreateKeys creates the given device-keys.
-	CreateKeys(ctx context.Context, in *CreateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetKeys returns the device-keys for the given DevEUI.
-	GetKeys(ctx context.Context, in *GetDeviceKeysRequest, opts ...grpc.CallOption) (*GetDeviceKeysResponse, error)
-	// UpdateKeys updates the device-keys.
-	UpdateKeys(ctx context.Context, in *UpdateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// DeleteKeys deletes the device-keys for the given DevEUI.
-	DeleteKeys(ctx context.Context, in *DeleteDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Activate (re)activates the device (only when ABP is set to true).
-	Activate(ctx context.Context, in *ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Deactivate de-activates the device.
-	Deactivate(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetActivation returns the current activation details of the device (OTAA and ABP).
-	GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error)
-	// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
-	GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error)
-	// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
-	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
-	//   * This endpoint does not work from a web-browser.
-	StreamFrameLogs(ctx context.Context, in *StreamDeviceFrameLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamFrameLogsClient, error)
-	// StreamEventLogs stream the device events (uplink payloads, ACKs, joins, errors).
-	//   * This endpoint is intended for debugging only.
-	//   * This endpoint does not work from a web-browser.
-	StreamEventLogs(ctx context.Context, in *StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamEventLogsClient, error)
+func (m *ListDeviceEventsRequest) Reset()         { *m = ListDeviceEventsRequest{} }
+func (m *ListDeviceEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceEventsRequest) ProtoMessage()    {}
+func (*ListDeviceEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{27}
 }
-
-type deviceServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ListDeviceEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceEventsRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceEventsRequest.Merge(dst, src)
+}
+func (m *ListDeviceEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceEventsRequest.Size(m)
+}
+func (m *ListDeviceEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceEventsRequest.DiscardUnknown(m)
 }
 
-func NewDeviceServiceClient(cc *grpc.ClientConn) DeviceServiceClient {
-	return &deviceServiceClient{cc}
+var xxx_messageInfo_ListDeviceEventsRequest proto.InternalMessageInfo
+
+func (m *ListDeviceEventsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
 }
 
-func (c *deviceServiceClient) Create(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListDeviceEventsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *deviceServiceClient) Get(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error) {
-	out := new(GetDeviceResponse)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListDeviceEventsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *deviceServiceClient) List(ctx context.Context, in *ListDeviceRequest, opts ...grpc.CallOption) (*ListDeviceResponse, error) {
-	out := new(ListDeviceResponse)
+func (m *ListDeviceEventsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListDeviceEventsRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ListDeviceEventsRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+type DeviceEvent struct {
+	// Event ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Timestamp at which the event was created.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The event type.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// The event payload in JSON encoding.
+	PayloadJson          string   `protobuf:"bytes,4,opt,name=payload_json,json=payloadJSON,proto3" json:"payload_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceEvent) Reset()         { *m = DeviceEvent{} }
+func (m *DeviceEvent) String() string { return proto.CompactTextString(m) }
+func (*DeviceEvent) ProtoMessage()    {}
+func (*DeviceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{28}
+}
+func (m *DeviceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceEvent.Unmarshal(m, b)
+}
+func (m *DeviceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceEvent.Marshal(b, m, deterministic)
+}
+func (dst *DeviceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceEvent.Merge(dst, src)
+}
+func (m *DeviceEvent) XXX_Size() int {
+	return xxx_messageInfo_DeviceEvent.Size(m)
+}
+func (m *DeviceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceEvent proto.InternalMessageInfo
+
+func (m *DeviceEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceEvent) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DeviceEvent) GetPayloadJson() string {
+	if m != nil {
+		return m.PayloadJson
+	}
+	return ""
+}
+
+type ListDeviceEventsResponse struct {
+	// Total number of events available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Events within this result-set.
+	Result               []*DeviceEvent `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListDeviceEventsResponse) Reset()         { *m = ListDeviceEventsResponse{} }
+func (m *ListDeviceEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceEventsResponse) ProtoMessage()    {}
+func (*ListDeviceEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{29}
+}
+func (m *ListDeviceEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceEventsResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceEventsResponse.Merge(dst, src)
+}
+func (m *ListDeviceEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceEventsResponse.Size(m)
+}
+func (m *ListDeviceEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceEventsResponse proto.InternalMessageInfo
+
+func (m *ListDeviceEventsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceEventsResponse) GetResult() []*DeviceEvent {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeviceStats struct {
+	// Timestamp of the (aggregated) interval.
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Number of received uplinks.
+	RxPackets uint32 `protobuf:"varint,2,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	// Number of lost uplinks, based on the gaps in the frame-counter.
+	LostPackets uint32 `protobuf:"varint,3,opt,name=lost_packets,json=lostPackets,proto3" json:"lost_packets,omitempty"`
+	// Best RSSI (of the best receiving gateway per uplink).
+	RssiMax int32 `protobuf:"varint,4,opt,name=rssi_max,json=rssiMax,proto3" json:"rssi_max,omitempty"`
+	// Average RSSI (of the best receiving gateway per uplink).
+	RssiAvg float64 `protobuf:"fixed64,5,opt,name=rssi_avg,json=rssiAvg,proto3" json:"rssi_avg,omitempty"`
+	// Best SNR (of the best receiving gateway per uplink).
+	SnrMax float64 `protobuf:"fixed64,6,opt,name=snr_max,json=snrMax,proto3" json:"snr_max,omitempty"`
+	// Average SNR (of the best receiving gateway per uplink).
+	SnrAvg float64 `protobuf:"fixed64,7,opt,name=snr_avg,json=snrAvg,proto3" json:"snr_avg,omitempty"`
+	// Number of received uplinks per spreading-factor.
+	RxPacketsPerSf map[uint32]uint32 `protobuf:"bytes,8,rep,name=rx_packets_per_sf,json=rxPacketsPerSF,proto3" json:"rx_packets_per_sf,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Number of gateways which received one or more uplinks.
+	GatewayCount         uint32   `protobuf:"varint,9,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceStats) Reset()         { *m = DeviceStats{} }
+func (m *DeviceStats) String() string { return proto.CompactTextString(m) }
+func (*DeviceStats) ProtoMessage()    {}
+func (*DeviceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{30}
+}
+func (m *DeviceStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceStats.Unmarshal(m, b)
+}
+func (m *DeviceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceStats.Marshal(b, m, deterministic)
+}
+func (dst *DeviceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceStats.Merge(dst, src)
+}
+func (m *DeviceStats) XXX_Size() int {
+	return xxx_messageInfo_DeviceStats.Size(m)
+}
+func (m *DeviceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceStats proto.InternalMessageInfo
+
+func (m *DeviceStats) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DeviceStats) GetRxPackets() uint32 {
+	if m != nil {
+		return m.RxPackets
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetLostPackets() uint32 {
+	if m != nil {
+		return m.LostPackets
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetRssiMax() int32 {
+	if m != nil {
+		return m.RssiMax
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetRssiAvg() float64 {
+	if m != nil {
+		return m.RssiAvg
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetSnrMax() float64 {
+	if m != nil {
+		return m.SnrMax
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetSnrAvg() float64 {
+	if m != nil {
+		return m.SnrAvg
+	}
+	return 0
+}
+
+func (m *DeviceStats) GetRxPacketsPerSf() map[uint32]uint32 {
+	if m != nil {
+		return m.RxPacketsPerSf
+	}
+	return nil
+}
+
+func (m *DeviceStats) GetGatewayCount() uint32 {
+	if m != nil {
+		return m.GatewayCount
+	}
+	return 0
+}
+
+type GetDeviceStatsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Aggregation interval. One of "hour", "day". Case insensitive.
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Timestamp to start from.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from.
+	EndTimestamp         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceStatsRequest) Reset()         { *m = GetDeviceStatsRequest{} }
+func (m *GetDeviceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceStatsRequest) ProtoMessage()    {}
+func (*GetDeviceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{31}
+}
+func (m *GetDeviceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceStatsRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceStatsRequest.Merge(dst, src)
+}
+func (m *GetDeviceStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceStatsRequest.Size(m)
+}
+func (m *GetDeviceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceStatsRequest proto.InternalMessageInfo
+
+func (m *GetDeviceStatsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *GetDeviceStatsRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *GetDeviceStatsRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceStatsRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+type GetDeviceStatsResponse struct {
+	Result               []*DeviceStats `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetDeviceStatsResponse) Reset()         { *m = GetDeviceStatsResponse{} }
+func (m *GetDeviceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceStatsResponse) ProtoMessage()    {}
+func (*GetDeviceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{32}
+}
+func (m *GetDeviceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceStatsResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceStatsResponse.Merge(dst, src)
+}
+func (m *GetDeviceStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceStatsResponse.Size(m)
+}
+func (m *GetDeviceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceStatsResponse proto.InternalMessageInfo
+
+func (m *GetDeviceStatsResponse) GetResult() []*DeviceStats {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeviceLocation struct {
+	// Timestamp at which the location was stored.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The device location (including source and accuracy).
+	Location             *common.Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DeviceLocation) Reset()         { *m = DeviceLocation{} }
+func (m *DeviceLocation) String() string { return proto.CompactTextString(m) }
+func (*DeviceLocation) ProtoMessage()    {}
+func (*DeviceLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{33}
+}
+func (m *DeviceLocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceLocation.Unmarshal(m, b)
+}
+func (m *DeviceLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceLocation.Marshal(b, m, deterministic)
+}
+func (dst *DeviceLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLocation.Merge(dst, src)
+}
+func (m *DeviceLocation) XXX_Size() int {
+	return xxx_messageInfo_DeviceLocation.Size(m)
+}
+func (m *DeviceLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceLocation proto.InternalMessageInfo
+
+func (m *DeviceLocation) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceLocation) GetLocation() *common.Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+type ListDeviceLocationsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Timestamp to start from.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from.
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Return the track as GeoJSON LineString feature (geo_json field).
+	GeoJson bool `protobuf:"varint,4,opt,name=geo_json,json=geoJSON,proto3" json:"geo_json,omitempty"`
+	// Max number of locations to return in the result-set.
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceLocationsRequest) Reset()         { *m = ListDeviceLocationsRequest{} }
+func (m *ListDeviceLocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceLocationsRequest) ProtoMessage()    {}
+func (*ListDeviceLocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{34}
+}
+func (m *ListDeviceLocationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceLocationsRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceLocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceLocationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceLocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceLocationsRequest.Merge(dst, src)
+}
+func (m *ListDeviceLocationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceLocationsRequest.Size(m)
+}
+func (m *ListDeviceLocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceLocationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceLocationsRequest proto.InternalMessageInfo
+
+func (m *ListDeviceLocationsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ListDeviceLocationsRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *ListDeviceLocationsRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *ListDeviceLocationsRequest) GetGeoJson() bool {
+	if m != nil {
+		return m.GeoJson
+	}
+	return false
+}
+
+func (m *ListDeviceLocationsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceLocationsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDeviceLocationsResponse struct {
+	// Device locations within the given time-range.
+	Result []*DeviceLocation `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// GeoJSON LineString feature of the track (only set when requested).
+	GeoJson string `protobuf:"bytes,2,opt,name=geo_json,json=geoJSON,proto3" json:"geo_json,omitempty"`
+	// Total number of locations within the given time-range.
+	TotalCount           int64    `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceLocationsResponse) Reset()         { *m = ListDeviceLocationsResponse{} }
+func (m *ListDeviceLocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceLocationsResponse) ProtoMessage()    {}
+func (*ListDeviceLocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{35}
+}
+func (m *ListDeviceLocationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceLocationsResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceLocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceLocationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceLocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceLocationsResponse.Merge(dst, src)
+}
+func (m *ListDeviceLocationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceLocationsResponse.Size(m)
+}
+func (m *ListDeviceLocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceLocationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceLocationsResponse proto.InternalMessageInfo
+
+func (m *ListDeviceLocationsResponse) GetResult() []*DeviceLocation {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ListDeviceLocationsResponse) GetGeoJson() string {
+	if m != nil {
+		return m.GeoJson
+	}
+	return ""
+}
+
+func (m *ListDeviceLocationsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+type SetDeviceLocationRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Latitude.
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude.
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude.
+	Altitude float64 `protobuf:"fixed64,4,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Accuracy in meters (0 = unknown).
+	Accuracy             uint32   `protobuf:"varint,5,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDeviceLocationRequest) Reset()         { *m = SetDeviceLocationRequest{} }
+func (m *SetDeviceLocationRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDeviceLocationRequest) ProtoMessage()    {}
+func (*SetDeviceLocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{36}
+}
+func (m *SetDeviceLocationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDeviceLocationRequest.Unmarshal(m, b)
+}
+func (m *SetDeviceLocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDeviceLocationRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetDeviceLocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDeviceLocationRequest.Merge(dst, src)
+}
+func (m *SetDeviceLocationRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDeviceLocationRequest.Size(m)
+}
+func (m *SetDeviceLocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDeviceLocationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDeviceLocationRequest proto.InternalMessageInfo
+
+func (m *SetDeviceLocationRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *SetDeviceLocationRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *SetDeviceLocationRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *SetDeviceLocationRequest) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *SetDeviceLocationRequest) GetAccuracy() uint32 {
+	if m != nil {
+		return m.Accuracy
+	}
+	return 0
+}
+
+type DeviceClockSync struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Timestamp of the last clock synchronization request of the device.
+	LastSyncAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=last_sync_at,json=lastSyncAt,proto3" json:"last_sync_at,omitempty"`
+	// Time correction (in seconds) sent to the device on the last
+	// synchronization.
+	TimeCorrection int32 `protobuf:"varint,3,opt,name=time_correction,json=timeCorrection,proto3" json:"time_correction,omitempty"`
+	// Clock drift (in ppm) of the device, measured between the last two
+	// synchronizations.
+	ClockDrift float64 `protobuf:"fixed64,4,opt,name=clock_drift,json=clockDrift,proto3" json:"clock_drift,omitempty"`
+	// Periodicity as acknowledged by the device (-1 when unknown).
+	// The device synchronizes its clock every 128 * 2^periodicity seconds.
+	Periodicity          int32    `protobuf:"varint,5,opt,name=periodicity,proto3" json:"periodicity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceClockSync) Reset()         { *m = DeviceClockSync{} }
+func (m *DeviceClockSync) String() string { return proto.CompactTextString(m) }
+func (*DeviceClockSync) ProtoMessage()    {}
+func (*DeviceClockSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{37}
+}
+func (m *DeviceClockSync) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceClockSync.Unmarshal(m, b)
+}
+func (m *DeviceClockSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceClockSync.Marshal(b, m, deterministic)
+}
+func (dst *DeviceClockSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceClockSync.Merge(dst, src)
+}
+func (m *DeviceClockSync) XXX_Size() int {
+	return xxx_messageInfo_DeviceClockSync.Size(m)
+}
+func (m *DeviceClockSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceClockSync.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceClockSync proto.InternalMessageInfo
+
+func (m *DeviceClockSync) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeviceClockSync) GetLastSyncAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSyncAt
+	}
+	return nil
+}
+
+func (m *DeviceClockSync) GetTimeCorrection() int32 {
+	if m != nil {
+		return m.TimeCorrection
+	}
+	return 0
+}
+
+func (m *DeviceClockSync) GetClockDrift() float64 {
+	if m != nil {
+		return m.ClockDrift
+	}
+	return 0
+}
+
+func (m *DeviceClockSync) GetPeriodicity() int32 {
+	if m != nil {
+		return m.Periodicity
+	}
+	return 0
+}
+
+type GetDeviceClockSyncRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceClockSyncRequest) Reset()         { *m = GetDeviceClockSyncRequest{} }
+func (m *GetDeviceClockSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceClockSyncRequest) ProtoMessage()    {}
+func (*GetDeviceClockSyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{38}
+}
+func (m *GetDeviceClockSyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceClockSyncRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceClockSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceClockSyncRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceClockSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceClockSyncRequest.Merge(dst, src)
+}
+func (m *GetDeviceClockSyncRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceClockSyncRequest.Size(m)
+}
+func (m *GetDeviceClockSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceClockSyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceClockSyncRequest proto.InternalMessageInfo
+
+func (m *GetDeviceClockSyncRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceClockSyncResponse struct {
+	// Device clock synchronization object.
+	DeviceClockSync      *DeviceClockSync `protobuf:"bytes,1,opt,name=device_clock_sync,json=deviceClockSync,proto3" json:"device_clock_sync,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetDeviceClockSyncResponse) Reset()         { *m = GetDeviceClockSyncResponse{} }
+func (m *GetDeviceClockSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceClockSyncResponse) ProtoMessage()    {}
+func (*GetDeviceClockSyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{39}
+}
+func (m *GetDeviceClockSyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceClockSyncResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceClockSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceClockSyncResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceClockSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceClockSyncResponse.Merge(dst, src)
+}
+func (m *GetDeviceClockSyncResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceClockSyncResponse.Size(m)
+}
+func (m *GetDeviceClockSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceClockSyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceClockSyncResponse proto.InternalMessageInfo
+
+func (m *GetDeviceClockSyncResponse) GetDeviceClockSync() *DeviceClockSync {
+	if m != nil {
+		return m.DeviceClockSync
+	}
+	return nil
+}
+
+type SetDeviceClockSyncPeriodicityRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Periodicity (0 - 15).
+	Period               uint32   `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDeviceClockSyncPeriodicityRequest) Reset()         { *m = SetDeviceClockSyncPeriodicityRequest{} }
+func (m *SetDeviceClockSyncPeriodicityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDeviceClockSyncPeriodicityRequest) ProtoMessage()    {}
+func (*SetDeviceClockSyncPeriodicityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{40}
+}
+func (m *SetDeviceClockSyncPeriodicityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDeviceClockSyncPeriodicityRequest.Unmarshal(m, b)
+}
+func (m *SetDeviceClockSyncPeriodicityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDeviceClockSyncPeriodicityRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetDeviceClockSyncPeriodicityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDeviceClockSyncPeriodicityRequest.Merge(dst, src)
+}
+func (m *SetDeviceClockSyncPeriodicityRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDeviceClockSyncPeriodicityRequest.Size(m)
+}
+func (m *SetDeviceClockSyncPeriodicityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDeviceClockSyncPeriodicityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDeviceClockSyncPeriodicityRequest proto.InternalMessageInfo
+
+func (m *SetDeviceClockSyncPeriodicityRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *SetDeviceClockSyncPeriodicityRequest) GetPeriod() uint32 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+type ForceDeviceClockResyncRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Number of times the device must send the clock synchronization
+	// request (1 - 7).
+	NbTransmissions      uint32   `protobuf:"varint,2,opt,name=nb_transmissions,json=nbTransmissions,proto3" json:"nb_transmissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForceDeviceClockResyncRequest) Reset()         { *m = ForceDeviceClockResyncRequest{} }
+func (m *ForceDeviceClockResyncRequest) String() string { return proto.CompactTextString(m) }
+func (*ForceDeviceClockResyncRequest) ProtoMessage()    {}
+func (*ForceDeviceClockResyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{41}
+}
+func (m *ForceDeviceClockResyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForceDeviceClockResyncRequest.Unmarshal(m, b)
+}
+func (m *ForceDeviceClockResyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForceDeviceClockResyncRequest.Marshal(b, m, deterministic)
+}
+func (dst *ForceDeviceClockResyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceDeviceClockResyncRequest.Merge(dst, src)
+}
+func (m *ForceDeviceClockResyncRequest) XXX_Size() int {
+	return xxx_messageInfo_ForceDeviceClockResyncRequest.Size(m)
+}
+func (m *ForceDeviceClockResyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceDeviceClockResyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceDeviceClockResyncRequest proto.InternalMessageInfo
+
+func (m *ForceDeviceClockResyncRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ForceDeviceClockResyncRequest) GetNbTransmissions() uint32 {
+	if m != nil {
+		return m.NbTransmissions
+	}
+	return 0
+}
+
+type ImportDevicesRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// File format.
+	Format DeviceFileFormat `protobuf:"varint,2,opt,name=format,proto3,enum=api.DeviceFileFormat" json:"format,omitempty"`
+	// File content.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Only validate the file, do not create the devices.
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportDevicesRequest) Reset()         { *m = ImportDevicesRequest{} }
+func (m *ImportDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportDevicesRequest) ProtoMessage()    {}
+func (*ImportDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{42}
+}
+func (m *ImportDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDevicesRequest.Unmarshal(m, b)
+}
+func (m *ImportDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDevicesRequest.Merge(dst, src)
+}
+func (m *ImportDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportDevicesRequest.Size(m)
+}
+func (m *ImportDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDevicesRequest proto.InternalMessageInfo
+
+func (m *ImportDevicesRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ImportDevicesRequest) GetFormat() DeviceFileFormat {
+	if m != nil {
+		return m.Format
+	}
+	return DeviceFileFormat_CSV
+}
+
+func (m *ImportDevicesRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ImportDevicesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ImportDevicesError struct {
+	// Row number (starting at 1, not counting the CSV header).
+	Row uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// Device EUI (HEX encoded), when it could be parsed.
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Error message.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportDevicesError) Reset()         { *m = ImportDevicesError{} }
+func (m *ImportDevicesError) String() string { return proto.CompactTextString(m) }
+func (*ImportDevicesError) ProtoMessage()    {}
+func (*ImportDevicesError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{43}
+}
+func (m *ImportDevicesError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDevicesError.Unmarshal(m, b)
+}
+func (m *ImportDevicesError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDevicesError.Marshal(b, m, deterministic)
+}
+func (dst *ImportDevicesError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDevicesError.Merge(dst, src)
+}
+func (m *ImportDevicesError) XXX_Size() int {
+	return xxx_messageInfo_ImportDevicesError.Size(m)
+}
+func (m *ImportDevicesError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDevicesError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDevicesError proto.InternalMessageInfo
+
+func (m *ImportDevicesError) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportDevicesError) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ImportDevicesError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ImportDevicesResponse struct {
+	// Import job ID (string formatted UUID).
+	// This is not set on validation errors or dry-run.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobID,proto3" json:"job_id,omitempty"`
+	// Validation errors per row.
+	Errors               []*ImportDevicesError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ImportDevicesResponse) Reset()         { *m = ImportDevicesResponse{} }
+func (m *ImportDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportDevicesResponse) ProtoMessage()    {}
+func (*ImportDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{44}
+}
+func (m *ImportDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportDevicesResponse.Unmarshal(m, b)
+}
+func (m *ImportDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportDevicesResponse.Merge(dst, src)
+}
+func (m *ImportDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportDevicesResponse.Size(m)
+}
+func (m *ImportDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportDevicesResponse proto.InternalMessageInfo
+
+func (m *ImportDevicesResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *ImportDevicesResponse) GetErrors() []*ImportDevicesError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type GetDeviceImportJobRequest struct {
+	// ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceImportJobRequest) Reset()         { *m = GetDeviceImportJobRequest{} }
+func (m *GetDeviceImportJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceImportJobRequest) ProtoMessage()    {}
+func (*GetDeviceImportJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{45}
+}
+func (m *GetDeviceImportJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceImportJobRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceImportJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceImportJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceImportJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceImportJobRequest.Merge(dst, src)
+}
+func (m *GetDeviceImportJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceImportJobRequest.Size(m)
+}
+func (m *GetDeviceImportJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceImportJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceImportJobRequest proto.InternalMessageInfo
+
+func (m *GetDeviceImportJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeviceImportJob struct {
+	// ID (string formatted UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,2,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Status of the job.
+	Status DeviceImportJobStatus `protobuf:"varint,3,opt,name=status,proto3,enum=api.DeviceImportJobStatus" json:"status,omitempty"`
+	// Number of rows within the file.
+	TotalCount uint32 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Number of processed rows (including the failed rows).
+	ProcessedCount uint32 `protobuf:"varint,5,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	// Number of imported devices.
+	ImportedCount uint32 `protobuf:"varint,6,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Completed at timestamp.
+	CompletedAt          *timestamp.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceImportJob) Reset()         { *m = DeviceImportJob{} }
+func (m *DeviceImportJob) String() string { return proto.CompactTextString(m) }
+func (*DeviceImportJob) ProtoMessage()    {}
+func (*DeviceImportJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{46}
+}
+func (m *DeviceImportJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceImportJob.Unmarshal(m, b)
+}
+func (m *DeviceImportJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceImportJob.Marshal(b, m, deterministic)
+}
+func (dst *DeviceImportJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceImportJob.Merge(dst, src)
+}
+func (m *DeviceImportJob) XXX_Size() int {
+	return xxx_messageInfo_DeviceImportJob.Size(m)
+}
+func (m *DeviceImportJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceImportJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceImportJob proto.InternalMessageInfo
+
+func (m *DeviceImportJob) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeviceImportJob) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DeviceImportJob) GetStatus() DeviceImportJobStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DeviceImportJobStatus_IMPORT_PENDING
+}
+
+func (m *DeviceImportJob) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DeviceImportJob) GetProcessedCount() uint32 {
+	if m != nil {
+		return m.ProcessedCount
+	}
+	return 0
+}
+
+func (m *DeviceImportJob) GetImportedCount() uint32 {
+	if m != nil {
+		return m.ImportedCount
+	}
+	return 0
+}
+
+func (m *DeviceImportJob) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceImportJob) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *DeviceImportJob) GetCompletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return nil
+}
+
+type GetDeviceImportJobResponse struct {
+	// Device import job object.
+	Job *DeviceImportJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Import errors per row.
+	Errors               []*ImportDevicesError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetDeviceImportJobResponse) Reset()         { *m = GetDeviceImportJobResponse{} }
+func (m *GetDeviceImportJobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceImportJobResponse) ProtoMessage()    {}
+func (*GetDeviceImportJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{47}
+}
+func (m *GetDeviceImportJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceImportJobResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceImportJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceImportJobResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceImportJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceImportJobResponse.Merge(dst, src)
+}
+func (m *GetDeviceImportJobResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceImportJobResponse.Size(m)
+}
+func (m *GetDeviceImportJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceImportJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceImportJobResponse proto.InternalMessageInfo
+
+func (m *GetDeviceImportJobResponse) GetJob() *DeviceImportJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *GetDeviceImportJobResponse) GetErrors() []*ImportDevicesError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ExportDevicesRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// File format.
+	Format               DeviceFileFormat `protobuf:"varint,2,opt,name=format,proto3,enum=api.DeviceFileFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportDevicesRequest) Reset()         { *m = ExportDevicesRequest{} }
+func (m *ExportDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDevicesRequest) ProtoMessage()    {}
+func (*ExportDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{48}
+}
+func (m *ExportDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDevicesRequest.Unmarshal(m, b)
+}
+func (m *ExportDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDevicesRequest.Merge(dst, src)
+}
+func (m *ExportDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportDevicesRequest.Size(m)
+}
+func (m *ExportDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDevicesRequest proto.InternalMessageInfo
+
+func (m *ExportDevicesRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ExportDevicesRequest) GetFormat() DeviceFileFormat {
+	if m != nil {
+		return m.Format
+	}
+	return DeviceFileFormat_CSV
+}
+
+type ExportDevicesResponse struct {
+	// File content.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportDevicesResponse) Reset()         { *m = ExportDevicesResponse{} }
+func (m *ExportDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDevicesResponse) ProtoMessage()    {}
+func (*ExportDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{49}
+}
+func (m *ExportDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDevicesResponse.Unmarshal(m, b)
+}
+func (m *ExportDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDevicesResponse.Merge(dst, src)
+}
+func (m *ExportDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportDevicesResponse.Size(m)
+}
+func (m *ExportDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportDevicesResponse proto.InternalMessageInfo
+
+func (m *ExportDevicesResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type DeviceTwin struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// FPort used for the downlinks containing the desired state.
+	FPort uint32 `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Desired state (JSON object).
+	DesiredJson string `protobuf:"bytes,3,opt,name=desired_json,json=desiredJSON,proto3" json:"desired_json,omitempty"`
+	// Reported state (JSON object).
+	// This is set from the decoded uplink objects and can not be updated.
+	ReportedJson         string   `protobuf:"bytes,4,opt,name=reported_json,json=reportedJSON,proto3" json:"reported_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceTwin) Reset()         { *m = DeviceTwin{} }
+func (m *DeviceTwin) String() string { return proto.CompactTextString(m) }
+func (*DeviceTwin) ProtoMessage()    {}
+func (*DeviceTwin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{50}
+}
+func (m *DeviceTwin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceTwin.Unmarshal(m, b)
+}
+func (m *DeviceTwin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceTwin.Marshal(b, m, deterministic)
+}
+func (dst *DeviceTwin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceTwin.Merge(dst, src)
+}
+func (m *DeviceTwin) XXX_Size() int {
+	return xxx_messageInfo_DeviceTwin.Size(m)
+}
+func (m *DeviceTwin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceTwin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceTwin proto.InternalMessageInfo
+
+func (m *DeviceTwin) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeviceTwin) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DeviceTwin) GetDesiredJson() string {
+	if m != nil {
+		return m.DesiredJson
+	}
+	return ""
+}
+
+func (m *DeviceTwin) GetReportedJson() string {
+	if m != nil {
+		return m.ReportedJson
+	}
+	return ""
+}
+
+type GetDeviceTwinRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceTwinRequest) Reset()         { *m = GetDeviceTwinRequest{} }
+func (m *GetDeviceTwinRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTwinRequest) ProtoMessage()    {}
+func (*GetDeviceTwinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{51}
+}
+func (m *GetDeviceTwinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceTwinRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceTwinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceTwinRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceTwinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTwinRequest.Merge(dst, src)
+}
+func (m *GetDeviceTwinRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceTwinRequest.Size(m)
+}
+func (m *GetDeviceTwinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTwinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceTwinRequest proto.InternalMessageInfo
+
+func (m *GetDeviceTwinRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceTwinResponse struct {
+	// Device twin object.
+	Twin *DeviceTwin `protobuf:"bytes,1,opt,name=twin,proto3" json:"twin,omitempty"`
+	// Desired state fields not matching the reported state (JSON object).
+	DeltaJson string `protobuf:"bytes,2,opt,name=delta_json,json=deltaJSON,proto3" json:"delta_json,omitempty"`
+	// Last update of the reported state.
+	ReportedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	// Last downlink containing the desired state.
+	SyncAt               *timestamp.Timestamp `protobuf:"bytes,4,opt,name=sync_at,json=syncAt,proto3" json:"sync_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceTwinResponse) Reset()         { *m = GetDeviceTwinResponse{} }
+func (m *GetDeviceTwinResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTwinResponse) ProtoMessage()    {}
+func (*GetDeviceTwinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{52}
+}
+func (m *GetDeviceTwinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceTwinResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceTwinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceTwinResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceTwinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTwinResponse.Merge(dst, src)
+}
+func (m *GetDeviceTwinResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceTwinResponse.Size(m)
+}
+func (m *GetDeviceTwinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTwinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceTwinResponse proto.InternalMessageInfo
+
+func (m *GetDeviceTwinResponse) GetTwin() *DeviceTwin {
+	if m != nil {
+		return m.Twin
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetDeltaJson() string {
+	if m != nil {
+		return m.DeltaJson
+	}
+	return ""
+}
+
+func (m *GetDeviceTwinResponse) GetReportedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReportedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetSyncAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.SyncAt
+	}
+	return nil
+}
+
+type UpdateDeviceTwinRequest struct {
+	// Device twin object to update.
+	Twin                 *DeviceTwin `protobuf:"bytes,1,opt,name=twin,proto3" json:"twin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *UpdateDeviceTwinRequest) Reset()         { *m = UpdateDeviceTwinRequest{} }
+func (m *UpdateDeviceTwinRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceTwinRequest) ProtoMessage()    {}
+func (*UpdateDeviceTwinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{53}
+}
+func (m *UpdateDeviceTwinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceTwinRequest.Unmarshal(m, b)
+}
+func (m *UpdateDeviceTwinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceTwinRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceTwinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceTwinRequest.Merge(dst, src)
+}
+func (m *UpdateDeviceTwinRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceTwinRequest.Size(m)
+}
+func (m *UpdateDeviceTwinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceTwinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceTwinRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceTwinRequest) GetTwin() *DeviceTwin {
+	if m != nil {
+		return m.Twin
+	}
+	return nil
+}
+
+type MoveDeviceRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// ID of the application to move the device to.
+	ApplicationId int64 `protobuf:"varint,2,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device-profile ID (string formatted UUID).
+	// When not set, the current device-profile is used. When moving the
+	// device to an other organization, a device-profile of that
+	// organization must be given.
+	DeviceProfileId      string   `protobuf:"bytes,3,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveDeviceRequest) Reset()         { *m = MoveDeviceRequest{} }
+func (m *MoveDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveDeviceRequest) ProtoMessage()    {}
+func (*MoveDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{54}
+}
+func (m *MoveDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveDeviceRequest.Unmarshal(m, b)
+}
+func (m *MoveDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveDeviceRequest.Marshal(b, m, deterministic)
+}
+func (dst *MoveDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveDeviceRequest.Merge(dst, src)
+}
+func (m *MoveDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveDeviceRequest.Size(m)
+}
+func (m *MoveDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveDeviceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveDeviceRequest proto.InternalMessageInfo
+
+func (m *MoveDeviceRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *MoveDeviceRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *MoveDeviceRequest) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Device)(nil), "api.Device")
+	proto.RegisterMapType((map[string]string)(nil), "api.Device.SecretVariablesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.Device.TagsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.Device.VariablesEntry")
+	proto.RegisterType((*DeviceListItem)(nil), "api.DeviceListItem")
+	proto.RegisterMapType((map[string]*DeviceValue)(nil), "api.DeviceListItem.LastValuesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.DeviceListItem.TagsEntry")
+	proto.RegisterType((*DeviceValue)(nil), "api.DeviceValue")
+	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
+	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
+	proto.RegisterType((*GetDeviceRequest)(nil), "api.GetDeviceRequest")
+	proto.RegisterType((*GetDeviceResponse)(nil), "api.GetDeviceResponse")
+	proto.RegisterMapType((map[string]*DeviceValue)(nil), "api.GetDeviceResponse.LastValuesEntry")
+	proto.RegisterType((*ListDeviceRequest)(nil), "api.ListDeviceRequest")
+	proto.RegisterType((*ListDeviceResponse)(nil), "api.ListDeviceResponse")
+	proto.RegisterType((*DeleteDeviceRequest)(nil), "api.DeleteDeviceRequest")
+	proto.RegisterType((*UpdateDeviceRequest)(nil), "api.UpdateDeviceRequest")
+	proto.RegisterType((*CreateDeviceKeysRequest)(nil), "api.CreateDeviceKeysRequest")
+	proto.RegisterType((*GetDeviceKeysRequest)(nil), "api.GetDeviceKeysRequest")
+	proto.RegisterType((*GetDeviceKeysResponse)(nil), "api.GetDeviceKeysResponse")
+	proto.RegisterType((*UpdateDeviceKeysRequest)(nil), "api.UpdateDeviceKeysRequest")
+	proto.RegisterType((*DeleteDeviceKeysRequest)(nil), "api.DeleteDeviceKeysRequest")
+	proto.RegisterType((*DeviceActivation)(nil), "api.DeviceActivation")
+	proto.RegisterType((*ActivateDeviceRequest)(nil), "api.ActivateDeviceRequest")
+	proto.RegisterType((*DeactivateDeviceRequest)(nil), "api.DeactivateDeviceRequest")
+	proto.RegisterType((*GetDeviceActivationRequest)(nil), "api.GetDeviceActivationRequest")
+	proto.RegisterType((*GetDeviceActivationResponse)(nil), "api.GetDeviceActivationResponse")
+	proto.RegisterType((*GetRandomDevAddrRequest)(nil), "api.GetRandomDevAddrRequest")
+	proto.RegisterType((*GetRandomDevAddrResponse)(nil), "api.GetRandomDevAddrResponse")
+	proto.RegisterType((*StreamDeviceFrameLogsRequest)(nil), "api.StreamDeviceFrameLogsRequest")
+	proto.RegisterType((*StreamDeviceFrameLogsResponse)(nil), "api.StreamDeviceFrameLogsResponse")
+	proto.RegisterType((*StreamDeviceEventLogsRequest)(nil), "api.StreamDeviceEventLogsRequest")
+	proto.RegisterType((*StreamDeviceEventLogsResponse)(nil), "api.StreamDeviceEventLogsResponse")
+	proto.RegisterType((*ListDeviceEventsRequest)(nil), "api.ListDeviceEventsRequest")
+	proto.RegisterType((*DeviceEvent)(nil), "api.DeviceEvent")
+	proto.RegisterType((*ListDeviceEventsResponse)(nil), "api.ListDeviceEventsResponse")
+	proto.RegisterType((*DeviceStats)(nil), "api.DeviceStats")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "api.DeviceStats.RxPacketsPerSfEntry")
+	proto.RegisterType((*GetDeviceStatsRequest)(nil), "api.GetDeviceStatsRequest")
+	proto.RegisterType((*GetDeviceStatsResponse)(nil), "api.GetDeviceStatsResponse")
+	proto.RegisterType((*DeviceLocation)(nil), "api.DeviceLocation")
+	proto.RegisterType((*ListDeviceLocationsRequest)(nil), "api.ListDeviceLocationsRequest")
+	proto.RegisterType((*ListDeviceLocationsResponse)(nil), "api.ListDeviceLocationsResponse")
+	proto.RegisterType((*SetDeviceLocationRequest)(nil), "api.SetDeviceLocationRequest")
+	proto.RegisterType((*DeviceClockSync)(nil), "api.DeviceClockSync")
+	proto.RegisterType((*GetDeviceClockSyncRequest)(nil), "api.GetDeviceClockSyncRequest")
+	proto.RegisterType((*GetDeviceClockSyncResponse)(nil), "api.GetDeviceClockSyncResponse")
+	proto.RegisterType((*SetDeviceClockSyncPeriodicityRequest)(nil), "api.SetDeviceClockSyncPeriodicityRequest")
+	proto.RegisterType((*ForceDeviceClockResyncRequest)(nil), "api.ForceDeviceClockResyncRequest")
+	proto.RegisterType((*ImportDevicesRequest)(nil), "api.ImportDevicesRequest")
+	proto.RegisterType((*ImportDevicesError)(nil), "api.ImportDevicesError")
+	proto.RegisterType((*ImportDevicesResponse)(nil), "api.ImportDevicesResponse")
+	proto.RegisterType((*GetDeviceImportJobRequest)(nil), "api.GetDeviceImportJobRequest")
+	proto.RegisterType((*DeviceImportJob)(nil), "api.DeviceImportJob")
+	proto.RegisterType((*GetDeviceImportJobResponse)(nil), "api.GetDeviceImportJobResponse")
+	proto.RegisterType((*ExportDevicesRequest)(nil), "api.ExportDevicesRequest")
+	proto.RegisterType((*ExportDevicesResponse)(nil), "api.ExportDevicesResponse")
+	proto.RegisterType((*DeviceTwin)(nil), "api.DeviceTwin")
+	proto.RegisterType((*GetDeviceTwinRequest)(nil), "api.GetDeviceTwinRequest")
+	proto.RegisterType((*GetDeviceTwinResponse)(nil), "api.GetDeviceTwinResponse")
+	proto.RegisterType((*UpdateDeviceTwinRequest)(nil), "api.UpdateDeviceTwinRequest")
+	proto.RegisterType((*MoveDeviceRequest)(nil), "api.MoveDeviceRequest")
+	proto.RegisterEnum("api.DeviceFileFormat", DeviceFileFormat_name, DeviceFileFormat_value)
+	proto.RegisterEnum("api.DeviceImportJobStatus", DeviceImportJobStatus_name, DeviceImportJobStatus_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceServiceClient is the client API for DeviceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceServiceClient interface {
+	// Create creates the given device.
+	Create(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Get returns the device matching the given DevEUI.
+	Get(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error)
+	// List returns the available devices.
+	List(ctx context.Context, in *ListDeviceRequest, opts ...grpc.CallOption) (*ListDeviceResponse, error)
+	// Delete deletes the device matching the given DevEUI.
+	Delete(ctx context.Context, in *DeleteDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Update updates the device matching the given DevEUI.
+	Update(ctx context.Context, in *UpdateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateKeys creates the given device-keys.
+	CreateKeys(ctx context.Context, in *CreateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetKeys returns the device-keys for the given DevEUI.
+	GetKeys(ctx context.Context, in *GetDeviceKeysRequest, opts ...grpc.CallOption) (*GetDeviceKeysResponse, error)
+	// UpdateKeys updates the device-keys.
+	UpdateKeys(ctx context.Context, in *UpdateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteKeys deletes the device-keys for the given DevEUI.
+	DeleteKeys(ctx context.Context, in *DeleteDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Activate (re)activates the device (only when ABP is set to true).
+	Activate(ctx context.Context, in *ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Deactivate de-activates the device.
+	Deactivate(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetActivation returns the current activation details of the device (OTAA and ABP).
+	GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error)
+	// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
+	GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error)
+	// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
+	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
+	//   * This endpoint does not work from a web-browser.
+	StreamFrameLogs(ctx context.Context, in *StreamDeviceFrameLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamFrameLogsClient, error)
+	// StreamEventLogs stream the device events (uplink payloads, ACKs, joins, errors).
+	//   * This endpoint is intended for debugging only.
+	//   * This endpoint does not work from a web-browser.
+	StreamEventLogs(ctx context.Context, in *StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamEventLogsClient, error)
+	// ListEvents returns the persisted events (uplinks, downlinks, ACKs,
+	// joins, errors) of the given DevEUI, most recent first.
+	ListEvents(ctx context.Context, in *ListDeviceEventsRequest, opts ...grpc.CallOption) (*ListDeviceEventsResponse, error)
+	// GetStats returns the link and traffic statistics of the device, for
+	// the given interval and time-range.
+	GetStats(ctx context.Context, in *GetDeviceStatsRequest, opts ...grpc.CallOption) (*GetDeviceStatsResponse, error)
+	// ListLocations returns the location history (track) of the device for
+	// the given time-range, ordered by time (oldest first).
+	ListLocations(ctx context.Context, in *ListDeviceLocationsRequest, opts ...grpc.CallOption) (*ListDeviceLocationsResponse, error)
+	// SetLocation sets the (manual) location of the device. The location
+	// is stored in the location history of the device with source CONFIG.
+	SetLocation(ctx context.Context, in *SetDeviceLocationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetClockSync returns the clock synchronization state of the device.
+	GetClockSync(ctx context.Context, in *GetDeviceClockSyncRequest, opts ...grpc.CallOption) (*GetDeviceClockSyncResponse, error)
+	// SetClockSyncPeriodicity requests the device to synchronize its clock
+	// every 128 * 2^period seconds.
+	SetClockSyncPeriodicity(ctx context.Context, in *SetDeviceClockSyncPeriodicityRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ForceClockResync requests the device to re-synchronize its clock.
+	ForceClockResync(ctx context.Context, in *ForceDeviceClockResyncRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Import creates the devices (and their keys or activation) from the
+	// given file. All rows are validated first, an import job is only
+	// created when none of the rows contain an error. The devices are
+	// created in the background by this job.
+	Import(ctx context.Context, in *ImportDevicesRequest, opts ...grpc.CallOption) (*ImportDevicesResponse, error)
+	// GetImportJob returns the progress and the per-row errors of the
+	// given device import job.
+	GetImportJob(ctx context.Context, in *GetDeviceImportJobRequest, opts ...grpc.CallOption) (*GetDeviceImportJobResponse, error)
+	// Export returns the devices (and their keys or activation) of the
+	// given application, in the same format as used by Import.
+	Export(ctx context.Context, in *ExportDevicesRequest, opts ...grpc.CallOption) (*ExportDevicesResponse, error)
+	// GetTwin returns the desired and reported state of the device twin.
+	GetTwin(ctx context.Context, in *GetDeviceTwinRequest, opts ...grpc.CallOption) (*GetDeviceTwinResponse, error)
+	// UpdateTwin updates the desired state of the device twin. When the
+	// desired state differs from the reported state, the difference is
+	// encoded using the codec and sent to the device. This is retried on
+	// later uplinks until the reported state matches the desired state.
+	UpdateTwin(ctx context.Context, in *UpdateDeviceTwinRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Move moves the device to the given application, which might belong to
+	// an other organization when it uses the same network-server. The keys,
+	// activation, device-queue and history of the device are retained.
+	// Memberships of multicast-groups and device-groups which are not
+	// available to the target application are removed.
+	Move(ctx context.Context, in *MoveDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type deviceServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceServiceClient(cc *grpc.ClientConn) DeviceServiceClient {
+	return &deviceServiceClient{cc}
+}
+
+func (c *deviceServiceClient) Create(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) Get(ctx context.Context, in *GetDeviceRequest, opts ...grpc.CallOption) (*GetDeviceResponse, error) {
+	out := new(GetDeviceResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) List(ctx context.Context, in *ListDeviceRequest, opts ...grpc.CallOption) (*ListDeviceResponse, error) {
+	out := new(ListDeviceResponse)
 	err := c.cc.Invoke(ctx, "/api.DeviceService/List", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1685,93 +3609,210 @@ func (c *deviceServiceClient) Activate(ctx context.Context, in *ActivateDeviceRe
 
 func (c *deviceServiceClient) Deactivate(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/Deactivate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Deactivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error) {
+	out := new(GetDeviceActivationResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetActivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error) {
+	out := new(GetRandomDevAddrResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetRandomDevAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) StreamFrameLogs(ctx context.Context, in *StreamDeviceFrameLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamFrameLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[0], "/api.DeviceService/StreamFrameLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceServiceStreamFrameLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceService_StreamFrameLogsClient interface {
+	Recv() (*StreamDeviceFrameLogsResponse, error)
+	grpc.ClientStream
+}
+
+type deviceServiceStreamFrameLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceServiceStreamFrameLogsClient) Recv() (*StreamDeviceFrameLogsResponse, error) {
+	m := new(StreamDeviceFrameLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceServiceClient) StreamEventLogs(ctx context.Context, in *StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamEventLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[1], "/api.DeviceService/StreamEventLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceServiceStreamEventLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceService_StreamEventLogsClient interface {
+	Recv() (*StreamDeviceEventLogsResponse, error)
+	grpc.ClientStream
+}
+
+type deviceServiceStreamEventLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceServiceStreamEventLogsClient) Recv() (*StreamDeviceEventLogsResponse, error) {
+	m := new(StreamDeviceEventLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceServiceClient) ListEvents(ctx context.Context, in *ListDeviceEventsRequest, opts ...grpc.CallOption) (*ListDeviceEventsResponse, error) {
+	out := new(ListDeviceEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetStats(ctx context.Context, in *GetDeviceStatsRequest, opts ...grpc.CallOption) (*GetDeviceStatsResponse, error) {
+	out := new(GetDeviceStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ListLocations(ctx context.Context, in *ListDeviceLocationsRequest, opts ...grpc.CallOption) (*ListDeviceLocationsResponse, error) {
+	out := new(ListDeviceLocationsResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ListLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) SetLocation(ctx context.Context, in *SetDeviceLocationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/SetLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetClockSync(ctx context.Context, in *GetDeviceClockSyncRequest, opts ...grpc.CallOption) (*GetDeviceClockSyncResponse, error) {
+	out := new(GetDeviceClockSyncResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetClockSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) SetClockSyncPeriodicity(ctx context.Context, in *SetDeviceClockSyncPeriodicityRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/SetClockSyncPeriodicity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ForceClockResync(ctx context.Context, in *ForceDeviceClockResyncRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ForceClockResync", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error) {
-	out := new(GetDeviceActivationResponse)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/GetActivation", in, out, opts...)
+func (c *deviceServiceClient) Import(ctx context.Context, in *ImportDevicesRequest, opts ...grpc.CallOption) (*ImportDevicesResponse, error) {
+	out := new(ImportDevicesResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Import", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error) {
-	out := new(GetRandomDevAddrResponse)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/GetRandomDevAddr", in, out, opts...)
+func (c *deviceServiceClient) GetImportJob(ctx context.Context, in *GetDeviceImportJobRequest, opts ...grpc.CallOption) (*GetDeviceImportJobResponse, error) {
+	out := new(GetDeviceImportJobResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetImportJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) StreamFrameLogs(ctx context.Context, in *StreamDeviceFrameLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamFrameLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[0], "/api.DeviceService/StreamFrameLogs", opts...)
+func (c *deviceServiceClient) Export(ctx context.Context, in *ExportDevicesRequest, opts ...grpc.CallOption) (*ExportDevicesResponse, error) {
+	out := new(ExportDevicesResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Export", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &deviceServiceStreamFrameLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type DeviceService_StreamFrameLogsClient interface {
-	Recv() (*StreamDeviceFrameLogsResponse, error)
-	grpc.ClientStream
-}
-
-type deviceServiceStreamFrameLogsClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *deviceServiceStreamFrameLogsClient) Recv() (*StreamDeviceFrameLogsResponse, error) {
-	m := new(StreamDeviceFrameLogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *deviceServiceClient) GetTwin(ctx context.Context, in *GetDeviceTwinRequest, opts ...grpc.CallOption) (*GetDeviceTwinResponse, error) {
+	out := new(GetDeviceTwinResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetTwin", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *deviceServiceClient) StreamEventLogs(ctx context.Context, in *StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (DeviceService_StreamEventLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_DeviceService_serviceDesc.Streams[1], "/api.DeviceService/StreamEventLogs", opts...)
+func (c *deviceServiceClient) UpdateTwin(ctx context.Context, in *UpdateDeviceTwinRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/UpdateTwin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &deviceServiceStreamEventLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type DeviceService_StreamEventLogsClient interface {
-	Recv() (*StreamDeviceEventLogsResponse, error)
-	grpc.ClientStream
-}
-
-type deviceServiceStreamEventLogsClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *deviceServiceStreamEventLogsClient) Recv() (*StreamDeviceEventLogsResponse, error) {
-	m := new(StreamDeviceEventLogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *deviceServiceClient) Move(ctx context.Context, in *MoveDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Move", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
 // DeviceServiceServer is the server API for DeviceService service.
@@ -1810,6 +3851,49 @@ type DeviceServiceServer interface {
 	//   * This endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
 	StreamEventLogs(*StreamDeviceEventLogsRequest, DeviceService_StreamEventLogsServer) error
+	// ListEvents returns the persisted events (uplinks, downlinks, ACKs,
+	// joins, errors) of the given DevEUI, most recent first.
+	ListEvents(context.Context, *ListDeviceEventsRequest) (*ListDeviceEventsResponse, error)
+	// GetStats returns the link and traffic statistics of the device, for
+	// the given interval and time-range.
+	GetStats(context.Context, *GetDeviceStatsRequest) (*GetDeviceStatsResponse, error)
+	// ListLocations returns the location history (track) of the device for
+	// the given time-range, ordered by time (oldest first).
+	ListLocations(context.Context, *ListDeviceLocationsRequest) (*ListDeviceLocationsResponse, error)
+	// SetLocation sets the (manual) location of the device. The location
+	// is stored in the location history of the device with source CONFIG.
+	SetLocation(context.Context, *SetDeviceLocationRequest) (*empty.Empty, error)
+	// GetClockSync returns the clock synchronization state of the device.
+	GetClockSync(context.Context, *GetDeviceClockSyncRequest) (*GetDeviceClockSyncResponse, error)
+	// SetClockSyncPeriodicity requests the device to synchronize its clock
+	// every 128 * 2^period seconds.
+	SetClockSyncPeriodicity(context.Context, *SetDeviceClockSyncPeriodicityRequest) (*empty.Empty, error)
+	// ForceClockResync requests the device to re-synchronize its clock.
+	ForceClockResync(context.Context, *ForceDeviceClockResyncRequest) (*empty.Empty, error)
+	// Import creates the devices (and their keys or activation) from the
+	// given file. All rows are validated first, an import job is only
+	// created when none of the rows contain an error. The devices are
+	// created in the background by this job.
+	Import(context.Context, *ImportDevicesRequest) (*ImportDevicesResponse, error)
+	// GetImportJob returns the progress and the per-row errors of the
+	// given device import job.
+	GetImportJob(context.Context, *GetDeviceImportJobRequest) (*GetDeviceImportJobResponse, error)
+	// Export returns the devices (and their keys or activation) of the
+	// given application, in the same format as used by Import.
+	Export(context.Context, *ExportDevicesRequest) (*ExportDevicesResponse, error)
+	// GetTwin returns the desired and reported state of the device twin.
+	GetTwin(context.Context, *GetDeviceTwinRequest) (*GetDeviceTwinResponse, error)
+	// UpdateTwin updates the desired state of the device twin. When the
+	// desired state differs from the reported state, the difference is
+	// encoded using the codec and sent to the device. This is retried on
+	// later uplinks until the reported state matches the desired state.
+	UpdateTwin(context.Context, *UpdateDeviceTwinRequest) (*empty.Empty, error)
+	// Move moves the device to the given application, which might belong to
+	// an other organization when it uses the same network-server. The keys,
+	// activation, device-queue and history of the device are retained.
+	// Memberships of multicast-groups and device-groups which are not
+	// available to the target application are removed.
+	Move(context.Context, *MoveDeviceRequest) (*empty.Empty, error)
 }
 
 func RegisterDeviceServiceServer(s *grpc.Server, srv DeviceServiceServer) {
@@ -2092,6 +4176,240 @@ func (x *deviceServiceStreamEventLogsServer) Send(m *StreamDeviceEventLogsRespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DeviceService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ListEvents(ctx, req.(*ListDeviceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetStats(ctx, req.(*GetDeviceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ListLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ListLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ListLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ListLocations(ctx, req.(*ListDeviceLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_SetLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/SetLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetLocation(ctx, req.(*SetDeviceLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetClockSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceClockSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetClockSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetClockSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetClockSync(ctx, req.(*GetDeviceClockSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_SetClockSyncPeriodicity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceClockSyncPeriodicityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetClockSyncPeriodicity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/SetClockSyncPeriodicity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetClockSyncPeriodicity(ctx, req.(*SetDeviceClockSyncPeriodicityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ForceClockResync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceDeviceClockResyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ForceClockResync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ForceClockResync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ForceClockResync(ctx, req.(*ForceDeviceClockResyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Import(ctx, req.(*ImportDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetImportJob(ctx, req.(*GetDeviceImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Export(ctx, req.(*ExportDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetTwin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceTwinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetTwin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetTwin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetTwin(ctx, req.(*GetDeviceTwinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_UpdateTwin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceTwinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).UpdateTwin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/UpdateTwin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).UpdateTwin(ctx, req.(*UpdateDeviceTwinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Move(ctx, req.(*MoveDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceService",
 	HandlerType: (*DeviceServiceServer)(nil),
@@ -2148,6 +4466,58 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRandomDevAddr",
 			Handler:    _DeviceService_GetRandomDevAddr_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _DeviceService_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _DeviceService_GetStats_Handler,
+		},
+		{
+			MethodName: "ListLocations",
+			Handler:    _DeviceService_ListLocations_Handler,
+		},
+		{
+			MethodName: "SetLocation",
+			Handler:    _DeviceService_SetLocation_Handler,
+		},
+		{
+			MethodName: "GetClockSync",
+			Handler:    _DeviceService_GetClockSync_Handler,
+		},
+		{
+			MethodName: "SetClockSyncPeriodicity",
+			Handler:    _DeviceService_SetClockSyncPeriodicity_Handler,
+		},
+		{
+			MethodName: "ForceClockResync",
+			Handler:    _DeviceService_ForceClockResync_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _DeviceService_Import_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _DeviceService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _DeviceService_Export_Handler,
+		},
+		{
+			MethodName: "GetTwin",
+			Handler:    _DeviceService_GetTwin_Handler,
+		},
+		{
+			MethodName: "UpdateTwin",
+			Handler:    _DeviceService_UpdateTwin_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _DeviceService_Move_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2167,106 +4537,219 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("device.proto", fileDescriptor_870276a56ac00da5) }
 
 var fileDescriptor_870276a56ac00da5 = []byte{
-	// 1615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x73, 0xdb, 0x46,
-	0x12, 0x5e, 0x88, 0x12, 0x25, 0x35, 0x49, 0x3d, 0x46, 0x2f, 0x98, 0xb2, 0x56, 0x14, 0xb4, 0x2e,
-	0xd3, 0xb2, 0x97, 0xd4, 0x6a, 0xcb, 0xbb, 0x5b, 0x2e, 0xef, 0x56, 0xc9, 0x92, 0xac, 0xd5, 0x4a,
-	0xf6, 0xba, 0x40, 0x6b, 0xb7, 0x2a, 0x39, 0xa0, 0x46, 0xc0, 0x90, 0x46, 0x08, 0x0e, 0x10, 0x60,
-	0x48, 0x85, 0x95, 0xb8, 0x2a, 0xf1, 0x31, 0xd7, 0xfc, 0x83, 0xdc, 0xf3, 0x6b, 0x72, 0xcd, 0x31,
-	0x97, 0xfc, 0x8b, 0xd4, 0x3c, 0x48, 0x0d, 0x1f, 0xd0, 0x23, 0xc9, 0x25, 0x27, 0x12, 0xdd, 0x5f,
-	0x3f, 0xbe, 0x9e, 0x9e, 0x6e, 0x00, 0xf2, 0x1e, 0xe9, 0xf8, 0x2e, 0xa9, 0x44, 0x71, 0xc8, 0x42,
-	0x94, 0xc1, 0x91, 0x5f, 0x7c, 0xda, 0xf0, 0xd9, 0xbb, 0xf6, 0x45, 0xc5, 0x0d, 0x5b, 0xd5, 0x8b,
-	0x38, 0x74, 0x31, 0x8e, 0xab, 0x41, 0x18, 0xe3, 0x84, 0xc4, 0x1d, 0x12, 0x57, 0x71, 0xe4, 0x57,
-	0xdd, 0xb0, 0xd5, 0x0a, 0xa9, 0xfa, 0x91, 0xb6, 0xc5, 0xfb, 0x8d, 0x30, 0x6c, 0x04, 0x44, 0xe8,
-	0x31, 0xa5, 0x21, 0xc3, 0xcc, 0x0f, 0x69, 0xa2, 0xb4, 0x9b, 0x4a, 0x2b, 0x9e, 0x2e, 0xda, 0xf5,
-	0x2a, 0xf3, 0x5b, 0x24, 0x61, 0xb8, 0x15, 0x29, 0xc0, 0xfa, 0x30, 0x80, 0xb4, 0x22, 0xd6, 0x55,
-	0xca, 0xbc, 0x1e, 0xc9, 0xfa, 0x30, 0x01, 0xd9, 0x43, 0x91, 0x36, 0x5a, 0x83, 0x69, 0x8f, 0x74,
-	0x1c, 0xd2, 0xf6, 0x4d, 0xa3, 0x64, 0x94, 0x67, 0xed, 0xac, 0x47, 0x3a, 0x47, 0xe7, 0x27, 0x08,
-	0xc1, 0x24, 0xc5, 0x2d, 0x62, 0x4e, 0x08, 0xa9, 0xf8, 0x8f, 0x1e, 0xc0, 0x1c, 0x8e, 0xa2, 0xc0,
-	0x77, 0x45, 0x66, 0x8e, 0xef, 0x99, 0x99, 0x92, 0x51, 0xce, 0xd8, 0x05, 0x4d, 0x7a, 0x72, 0x88,
-	0x4a, 0x90, 0xf3, 0x48, 0xe2, 0xc6, 0x7e, 0xc4, 0x05, 0xe6, 0xa4, 0xf0, 0xa0, 0x8b, 0xd0, 0x0e,
-	0x2c, 0xca, 0xb2, 0x39, 0x51, 0x1c, 0xd6, 0xfd, 0x80, 0x70, 0x5f, 0x53, 0x02, 0x37, 0x2f, 0x15,
-	0x6f, 0xa4, 0xfc, 0xe4, 0x10, 0x3d, 0x84, 0x85, 0xa4, 0xe9, 0x47, 0x4e, 0xdd, 0x71, 0x29, 0x73,
-	0xdc, 0x77, 0xc4, 0x6d, 0x9a, 0xd9, 0x92, 0x51, 0x9e, 0xb1, 0x0b, 0x5c, 0xfe, 0xf2, 0x80, 0xb2,
-	0x03, 0x2e, 0x44, 0x7f, 0x06, 0x14, 0x93, 0x3a, 0x89, 0x09, 0x75, 0x89, 0x83, 0x03, 0xe6, 0xb3,
-	0xb6, 0x47, 0xcc, 0xe9, 0x92, 0x51, 0x36, 0xec, 0xc5, 0xbe, 0x66, 0x5f, 0x29, 0xac, 0x9f, 0x26,
-	0x61, 0x4e, 0x16, 0xe1, 0xcc, 0x4f, 0xd8, 0x09, 0x23, 0xad, 0xdf, 0x41, 0x31, 0x2a, 0xb0, 0x34,
-	0x84, 0x15, 0x79, 0x65, 0x05, 0x7a, 0x71, 0x00, 0xfd, 0x9a, 0x27, 0xb9, 0x07, 0x2b, 0x0a, 0x9f,
-	0x30, 0xcc, 0xda, 0x89, 0x73, 0x81, 0x19, 0x23, 0x71, 0x57, 0x94, 0xa5, 0x60, 0x2b, 0x67, 0x35,
-	0xa1, 0x7b, 0x21, 0x55, 0x68, 0x17, 0x96, 0x07, 0x6d, 0x5a, 0x38, 0x6e, 0xf8, 0xd4, 0x9c, 0x29,
-	0x19, 0xe5, 0x29, 0x1b, 0xe9, 0x26, 0xaf, 0x84, 0x06, 0x9d, 0xc1, 0xf6, 0xa0, 0x05, 0xf9, 0x8c,
-	0x91, 0x98, 0xe2, 0xc0, 0x89, 0xc2, 0x4b, 0x12, 0x3b, 0x49, 0xd8, 0x8e, 0x5d, 0x62, 0x82, 0x38,
-	0xb5, 0x4d, 0xdd, 0xc1, 0x91, 0x02, 0xbe, 0xe1, 0xb8, 0x9a, 0x80, 0xa1, 0xb7, 0xf0, 0x70, 0x6c,
-	0xce, 0x4e, 0x40, 0x3a, 0x24, 0x70, 0xda, 0x14, 0x77, 0xb0, 0x1f, 0xe0, 0x8b, 0x80, 0x98, 0x39,
-	0xe1, 0x71, 0x7b, 0x0c, 0x8b, 0x33, 0x8e, 0x3d, 0xbf, 0x82, 0xa2, 0x7f, 0xc2, 0xfa, 0x35, 0x5e,
-	0xcd, 0x7c, 0xc9, 0x28, 0x4f, 0xd8, 0x66, 0x9a, 0x27, 0xf4, 0x1c, 0xf2, 0x01, 0x4e, 0x98, 0x93,
-	0x10, 0x42, 0x1d, 0xcc, 0xcc, 0xd9, 0x92, 0x51, 0xce, 0xed, 0x15, 0x2b, 0xf2, 0xd2, 0x55, 0x7a,
-	0x97, 0xae, 0xf2, 0xb6, 0x77, 0x2b, 0x6d, 0xe0, 0xf8, 0x1a, 0x21, 0x74, 0x9f, 0x59, 0xff, 0x07,
-	0x90, 0xad, 0x76, 0x4a, 0xba, 0x49, 0x7a, 0x9b, 0xad, 0xc1, 0x34, 0xbd, 0x6c, 0x3a, 0x4d, 0xd2,
-	0x55, 0x9d, 0x96, 0xa5, 0x97, 0xcd, 0x53, 0xd2, 0xe5, 0x0a, 0x1c, 0x45, 0x42, 0x91, 0x91, 0x0a,
-	0x1c, 0x45, 0xa7, 0xa4, 0x6b, 0x3d, 0x83, 0xa5, 0x83, 0x98, 0x60, 0x46, 0xa4, 0x7b, 0x9b, 0x7c,
-	0xda, 0x26, 0x09, 0x43, 0xdb, 0x90, 0x95, 0x4c, 0x44, 0x80, 0xdc, 0x5e, 0xae, 0x82, 0x23, 0xbf,
-	0xa2, 0x30, 0x4a, 0x65, 0x3d, 0x86, 0x85, 0x63, 0xc2, 0x06, 0x0d, 0xd3, 0x52, 0xb3, 0xbe, 0x9e,
-	0x80, 0x45, 0x0d, 0x9d, 0x44, 0x21, 0x4d, 0xc8, 0xad, 0xe2, 0x8c, 0x94, 0x6e, 0xea, 0x2e, 0xa5,
-	0x4b, 0xef, 0xe0, 0xec, 0xdd, 0x3b, 0x78, 0x39, 0xb5, 0x83, 0x9f, 0xc0, 0x4c, 0x10, 0xca, 0x3b,
-	0x6b, 0xae, 0x88, 0xfc, 0x16, 0x2a, 0x6a, 0x64, 0x9e, 0x29, 0xb9, 0xdd, 0x47, 0x58, 0x3f, 0x18,
-	0xb0, 0xc8, 0x87, 0xc6, 0x60, 0xed, 0x96, 0x61, 0x2a, 0xf0, 0x5b, 0x3e, 0x13, 0xb5, 0xc8, 0xd8,
-	0xf2, 0x01, 0xad, 0x42, 0x36, 0xac, 0xd7, 0x13, 0xc2, 0xc4, 0x91, 0x66, 0x6c, 0xf5, 0x74, 0xdb,
-	0xf1, 0xb1, 0x0a, 0xd9, 0x84, 0xe0, 0xd8, 0x7d, 0xa7, 0x26, 0x87, 0x7a, 0x42, 0x4f, 0x00, 0xb5,
-	0xda, 0x01, 0xf3, 0x5d, 0x5e, 0xd9, 0x46, 0x1c, 0xb6, 0xa3, 0xab, 0xa9, 0xb1, 0xd0, 0xd7, 0x1c,
-	0x73, 0xc5, 0xc9, 0x21, 0x47, 0xf3, 0xe5, 0x33, 0x34, 0x63, 0xe4, 0xd4, 0x58, 0x50, 0x9a, 0xfe,
-	0x90, 0xb1, 0x2e, 0x00, 0xe9, 0xec, 0xd4, 0x59, 0x6f, 0x42, 0x8e, 0x85, 0x0c, 0x07, 0x8e, 0x1b,
-	0xb6, 0x69, 0x8f, 0x24, 0x08, 0xd1, 0x01, 0x97, 0xa0, 0xc7, 0x90, 0x8d, 0x49, 0xd2, 0x0e, 0x38,
-	0xd3, 0x4c, 0x39, 0xb7, 0xb7, 0xa4, 0x35, 0x43, 0x6f, 0xc4, 0xda, 0x0a, 0x62, 0x55, 0x60, 0xe9,
-	0x90, 0x04, 0x64, 0xb8, 0x71, 0x53, 0xfb, 0xef, 0x19, 0x2c, 0x9d, 0x47, 0xde, 0x2f, 0x6b, 0xf4,
-	0x53, 0x58, 0xd3, 0x2f, 0x09, 0xbf, 0x83, 0x3d, 0xfb, 0x5d, 0x3e, 0x9d, 0x45, 0x5d, 0x9a, 0xa4,
-	0x9b, 0x28, 0x27, 0xf3, 0x9a, 0x13, 0x01, 0x06, 0xaf, 0xff, 0xdf, 0xaa, 0xc2, 0x72, 0xff, 0x1e,
-	0xe8, 0x9e, 0x52, 0x33, 0x3f, 0x81, 0x95, 0x21, 0x03, 0x55, 0xd0, 0xbb, 0xc7, 0x3e, 0x85, 0x35,
-	0xbd, 0x08, 0xbf, 0x8e, 0xc8, 0x1e, 0xac, 0xe9, 0x27, 0x70, 0x2b, 0x2e, 0xdf, 0x4d, 0xc0, 0x82,
-	0x84, 0xef, 0xbb, 0xcc, 0xef, 0x88, 0x26, 0x4d, 0x1f, 0x67, 0xf7, 0x60, 0x86, 0x2b, 0xb0, 0xe7,
-	0xc5, 0x6a, 0x9e, 0x71, 0xe0, 0xbe, 0xe7, 0xc5, 0xa8, 0x08, 0xb3, 0x7c, 0xa0, 0x25, 0xda, 0x48,
-	0xe3, 0x13, 0xae, 0xc6, 0x87, 0xdd, 0x16, 0x14, 0xf8, 0x14, 0x4c, 0x1c, 0x42, 0x5d, 0xa1, 0x97,
-	0x9d, 0x0f, 0xf4, 0xb2, 0x59, 0x3b, 0xa2, 0x2e, 0x87, 0xfc, 0x09, 0xe6, 0x13, 0x47, 0x82, 0x7c,
-	0xca, 0x04, 0x68, 0x46, 0x2e, 0xd6, 0xe4, 0xf5, 0x65, 0xb3, 0x76, 0x42, 0x99, 0x42, 0xd5, 0x87,
-	0x50, 0xb3, 0x12, 0x55, 0xd7, 0x50, 0x26, 0xcc, 0xc8, 0x57, 0x8b, 0x76, 0x24, 0xee, 0x4f, 0xc1,
-	0xce, 0xd6, 0x0f, 0x28, 0x3b, 0x8f, 0xd0, 0x26, 0xe4, 0xa9, 0x7a, 0xed, 0xf0, 0xc2, 0x4b, 0xaa,
-	0x26, 0xce, 0x2c, 0xe5, 0xaf, 0x1c, 0x87, 0xe1, 0x25, 0xe5, 0x00, 0xac, 0x03, 0x40, 0x02, 0x70,
-	0x0f, 0x60, 0x7d, 0x0c, 0x2b, 0xaa, 0x50, 0x43, 0x7d, 0xfb, 0xa2, 0xbf, 0xf3, 0x71, 0xbf, 0x90,
-	0xea, 0xd0, 0x56, 0xb4, 0x43, 0xbb, 0xaa, 0xb2, 0xbd, 0xe0, 0x0d, 0x49, 0xe4, 0x01, 0xe2, 0xb1,
-	0xee, 0x53, 0x0f, 0xf0, 0x29, 0x14, 0xfb, 0xcd, 0xa8, 0x39, 0xbf, 0xc9, 0x0c, 0xc3, 0xfa, 0x58,
-	0x33, 0xd5, 0xc9, 0xbf, 0x11, 0x9b, 0x63, 0xc2, 0x6c, 0x4c, 0xbd, 0xb0, 0x75, 0x28, 0xbb, 0xe4,
-	0x16, 0x6c, 0xcc, 0x51, 0x1b, 0x95, 0x93, 0xde, 0x7c, 0xc6, 0x40, 0xf3, 0x59, 0x7f, 0x87, 0xfb,
-	0x35, 0x16, 0x13, 0xdc, 0x92, 0x69, 0xbd, 0x8c, 0x71, 0x8b, 0x9c, 0x85, 0x8d, 0x9b, 0xdb, 0xff,
-	0x5b, 0x03, 0x36, 0x52, 0x2c, 0x55, 0xd4, 0x7f, 0x40, 0xbe, 0x1d, 0x05, 0x3e, 0x6d, 0x3a, 0x75,
-	0xae, 0x53, 0x45, 0x90, 0x93, 0xf0, 0x5c, 0x28, 0x7a, 0x36, 0xff, 0xfe, 0x83, 0x9d, 0x6b, 0x5f,
-	0x49, 0xd0, 0xbf, 0x60, 0x8e, 0xf7, 0x90, 0x66, 0x3b, 0xa1, 0x17, 0x50, 0xa9, 0x34, 0xeb, 0x82,
-	0xa7, 0xcb, 0x5e, 0x4c, 0xc3, 0x94, 0x30, 0x1b, 0x66, 0x77, 0xd4, 0x21, 0x94, 0xdd, 0x8a, 0xdd,
-	0xff, 0x06, 0xc9, 0x69, 0x86, 0x8a, 0x1c, 0x82, 0x49, 0xd6, 0x8d, 0x88, 0x32, 0x13, 0xff, 0xd1,
-	0x16, 0xe4, 0x23, 0xdc, 0x0d, 0x42, 0xec, 0x39, 0x9f, 0x24, 0x21, 0x55, 0xf7, 0x3c, 0xa7, 0x64,
-	0xff, 0xa9, 0xfd, 0xf7, 0xf5, 0xde, 0x87, 0x02, 0x14, 0xa4, 0xcb, 0x9a, 0xdc, 0x34, 0xa8, 0x06,
-	0x59, 0x39, 0x90, 0x91, 0x29, 0xd8, 0x8d, 0x79, 0x85, 0x29, 0xae, 0x8e, 0xbc, 0x1f, 0x1c, 0xf1,
-	0xef, 0x19, 0x6b, 0xed, 0xc3, 0xf7, 0x3f, 0x7e, 0x33, 0xb1, 0x68, 0xe5, 0xc5, 0x77, 0x92, 0x6c,
-	0xa3, 0xe4, 0x99, 0xb1, 0x83, 0xde, 0x42, 0xe6, 0x98, 0x30, 0x24, 0xeb, 0x35, 0xfc, 0x62, 0x53,
-	0x5c, 0x1d, 0x16, 0x4b, 0x4e, 0xd6, 0x1f, 0x85, 0x3b, 0x13, 0xad, 0xea, 0xee, 0xaa, 0x9f, 0xab,
-	0x0a, 0xbd, 0x47, 0xaf, 0x60, 0x92, 0xef, 0x2e, 0x24, 0xed, 0x47, 0x96, 0x7e, 0x71, 0x6d, 0x44,
-	0xae, 0x1c, 0x2f, 0x0b, 0xc7, 0x73, 0x68, 0x20, 0x4f, 0xf4, 0x11, 0xff, 0xf0, 0xe2, 0x43, 0x57,
-	0x31, 0x1f, 0xb3, 0x03, 0x53, 0x99, 0xab, 0x54, 0x77, 0xd2, 0x52, 0xf5, 0x20, 0x2b, 0xb7, 0x83,
-	0xf2, 0x3d, 0x66, 0x5f, 0xa6, 0xfa, 0x2e, 0x0b, 0xdf, 0x56, 0x71, 0x63, 0xc4, 0x37, 0xff, 0xb6,
-	0xed, 0x85, 0xe0, 0x65, 0xee, 0x00, 0xc8, 0xe3, 0x12, 0xaf, 0xb2, 0xf7, 0x47, 0xce, 0x4f, 0xdb,
-	0x23, 0xa9, 0xd1, 0xf6, 0x44, 0xb4, 0x27, 0xd6, 0xc3, 0x71, 0xd1, 0xc4, 0x02, 0xeb, 0x87, 0xac,
-	0xf2, 0x27, 0x1e, 0x97, 0xc0, 0xf4, 0x31, 0x61, 0x22, 0xe8, 0xbd, 0xc1, 0xb3, 0xd4, 0x23, 0x16,
-	0xc7, 0xa9, 0xd4, 0x89, 0x6c, 0x8b, 0xa8, 0x1b, 0x68, 0x7d, 0x7c, 0xfd, 0x44, 0x24, 0x4e, 0x4f,
-	0xd6, 0x4d, 0xa3, 0x97, 0xb2, 0x73, 0x6f, 0xa2, 0x57, 0xbc, 0x0b, 0xbd, 0x06, 0xff, 0x42, 0xe0,
-	0xbd, 0xa0, 0xc5, 0x4d, 0x59, 0xcf, 0xa9, 0x71, 0x15, 0xc1, 0x9d, 0x6b, 0x09, 0x7e, 0x01, 0x33,
-	0xbd, 0x95, 0x84, 0x64, 0xb5, 0xc6, 0x6e, 0xa8, 0xd4, 0x20, 0xcf, 0x45, 0x90, 0xbf, 0x59, 0x7f,
-	0x19, 0x4b, 0xee, 0x6a, 0xfe, 0x5f, 0x51, 0xec, 0xad, 0x28, 0x4e, 0xb3, 0xc5, 0x69, 0xf6, 0x04,
-	0x7d, 0x9a, 0xf8, 0x4e, 0x19, 0x3c, 0x12, 0x19, 0x6c, 0xef, 0x6c, 0xa5, 0xd0, 0xbc, 0xca, 0x01,
-	0xbd, 0x87, 0xc2, 0x31, 0x61, 0xda, 0xbb, 0xca, 0xe6, 0x60, 0x7f, 0x8c, 0xac, 0xc0, 0x62, 0x29,
-	0x1d, 0xa0, 0xda, 0x48, 0x85, 0x47, 0xb7, 0x08, 0xff, 0xa5, 0x21, 0x3e, 0xb1, 0x06, 0x16, 0x94,
-	0x22, 0x9d, 0xb2, 0xeb, 0x8a, 0x1b, 0x29, 0x5a, 0x15, 0xbc, 0x2a, 0x82, 0x3f, 0x1a, 0x73, 0x73,
-	0x64, 0xf0, 0xc6, 0x70, 0xb4, 0xaf, 0x0c, 0x98, 0x97, 0x53, 0xbd, 0xbf, 0xac, 0xd0, 0x96, 0x88,
-	0x71, 0xdd, 0x0a, 0x2c, 0x5a, 0xd7, 0x41, 0x54, 0x2e, 0x0f, 0x44, 0x2e, 0x9b, 0x68, 0x23, 0x25,
-	0x17, 0xb1, 0x8e, 0x92, 0x5d, 0x43, 0xcb, 0xa1, 0xbf, 0x53, 0xc6, 0xe4, 0x30, 0xbc, 0xa8, 0xc6,
-	0xe4, 0x30, 0xb2, 0x92, 0x6e, 0xcc, 0x81, 0x70, 0x8b, 0x64, 0xd7, 0xb8, 0xc8, 0x8a, 0x26, 0xfa,
-	0xeb, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x64, 0x32, 0x7c, 0xaf, 0x13, 0x00, 0x00,
+	// 3416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x5d, 0x6f, 0xdb, 0x58,
+	0x76, 0x43, 0xc9, 0x96, 0xad, 0x23, 0xcb, 0x96, 0x6f, 0xec, 0x58, 0x51, 0xe2, 0xd8, 0xa1, 0xf3,
+	0xe1, 0x38, 0xb1, 0x9d, 0x71, 0x3a, 0x9d, 0x4c, 0x3a, 0x33, 0xad, 0x63, 0x3b, 0xae, 0x27, 0x5f,
+	0x06, 0x65, 0xa7, 0x40, 0x0b, 0x0c, 0x41, 0x93, 0x57, 0x0a, 0x63, 0x8a, 0x64, 0xc9, 0x2b, 0xd9,
+	0x9a, 0x99, 0xa0, 0x5f, 0x83, 0xbe, 0xf6, 0xa1, 0x40, 0x81, 0x02, 0x7d, 0x1a, 0x74, 0x5f, 0x16,
+	0xd8, 0x9f, 0xb2, 0x2f, 0xbb, 0xfb, 0x13, 0xf6, 0x71, 0x81, 0xfd, 0x0b, 0x8b, 0xfb, 0x41, 0xea,
+	0x8a, 0x22, 0x2d, 0x79, 0x66, 0xb0, 0xc0, 0x3e, 0xc5, 0x3c, 0xdf, 0xe7, 0xdc, 0x73, 0xce, 0x3d,
+	0xf7, 0x28, 0x30, 0x65, 0xe1, 0x8e, 0x6d, 0xe2, 0x0d, 0x3f, 0xf0, 0x88, 0x87, 0xf2, 0x86, 0x6f,
+	0xd7, 0x3e, 0x69, 0xda, 0xe4, 0x5d, 0xfb, 0x64, 0xc3, 0xf4, 0x5a, 0x9b, 0x27, 0x81, 0x67, 0x1a,
+	0x46, 0xb0, 0xe9, 0x78, 0x81, 0x11, 0xe2, 0xa0, 0x83, 0x83, 0x4d, 0xc3, 0xb7, 0x37, 0x4d, 0xaf,
+	0xd5, 0xf2, 0x5c, 0xf1, 0x0f, 0xe7, 0xad, 0xdd, 0x68, 0x7a, 0x5e, 0xd3, 0xc1, 0x0c, 0x6f, 0xb8,
+	0xae, 0x47, 0x0c, 0x62, 0x7b, 0x6e, 0x28, 0xb0, 0x4b, 0x02, 0xcb, 0xbe, 0x4e, 0xda, 0x8d, 0x4d,
+	0x62, 0xb7, 0x70, 0x48, 0x8c, 0x96, 0x2f, 0x08, 0xae, 0x27, 0x09, 0x70, 0xcb, 0x27, 0x5d, 0x81,
+	0x9c, 0x92, 0x35, 0xa9, 0x7f, 0x1c, 0x83, 0xc2, 0x2e, 0x33, 0x1b, 0x2d, 0xc0, 0x84, 0x85, 0x3b,
+	0x3a, 0x6e, 0xdb, 0x55, 0x65, 0x59, 0x59, 0x2d, 0x6a, 0x05, 0x0b, 0x77, 0xf6, 0x8e, 0x0f, 0x10,
+	0x82, 0x31, 0xd7, 0x68, 0xe1, 0x6a, 0x8e, 0x41, 0xd9, 0xdf, 0xe8, 0x0e, 0x4c, 0x1b, 0xbe, 0xef,
+	0xd8, 0x26, 0xb3, 0x4c, 0xb7, 0xad, 0x6a, 0x7e, 0x59, 0x59, 0xcd, 0x6b, 0x65, 0x09, 0x7a, 0xb0,
+	0x8b, 0x96, 0xa1, 0x64, 0xe1, 0xd0, 0x0c, 0x6c, 0x9f, 0x02, 0xaa, 0x63, 0x4c, 0x82, 0x0c, 0x42,
+	0x6b, 0x30, 0xcb, 0xc3, 0xa6, 0xfb, 0x81, 0xd7, 0xb0, 0x1d, 0x4c, 0x65, 0x8d, 0x33, 0xba, 0x19,
+	0x8e, 0x38, 0xe4, 0xf0, 0x83, 0x5d, 0x74, 0x0f, 0x2a, 0xe1, 0xa9, 0xed, 0xeb, 0x0d, 0xdd, 0x74,
+	0x89, 0x6e, 0xbe, 0xc3, 0xe6, 0x69, 0xb5, 0xb0, 0xac, 0xac, 0x4e, 0x6a, 0x65, 0x0a, 0x7f, 0xbe,
+	0xe3, 0x92, 0x1d, 0x0a, 0x44, 0xeb, 0x80, 0x02, 0xdc, 0xc0, 0x01, 0x76, 0x4d, 0xac, 0x1b, 0x0e,
+	0xb1, 0x49, 0xdb, 0xc2, 0xd5, 0x89, 0x65, 0x65, 0x55, 0xd1, 0x66, 0x63, 0xcc, 0xb6, 0x40, 0xa0,
+	0x27, 0x50, 0xec, 0x18, 0x81, 0x6d, 0x9c, 0x38, 0x38, 0xac, 0x4e, 0x2e, 0xe7, 0x57, 0x4b, 0x5b,
+	0xb5, 0x0d, 0xc3, 0xb7, 0x37, 0x78, 0x64, 0x36, 0xde, 0x46, 0xc8, 0x3d, 0x97, 0x04, 0x5d, 0xad,
+	0x47, 0x8c, 0xee, 0xc3, 0x18, 0x31, 0x9a, 0x61, 0xb5, 0xc8, 0x98, 0xe6, 0x65, 0xa6, 0x23, 0xa3,
+	0x29, 0xe8, 0x19, 0x09, 0x7a, 0x01, 0x95, 0x10, 0x9b, 0x01, 0x26, 0x7a, 0x4f, 0x17, 0x30, 0xb6,
+	0x65, 0x99, 0xad, 0xce, 0x68, 0x12, 0x1a, 0x67, 0xc2, 0x7e, 0x68, 0xed, 0x73, 0x98, 0xee, 0x27,
+	0x41, 0x15, 0xc8, 0x9f, 0xe2, 0xae, 0x38, 0x39, 0xfa, 0x27, 0x9a, 0x83, 0xf1, 0x8e, 0xe1, 0xb4,
+	0xa3, 0x73, 0xe3, 0x1f, 0x4f, 0x73, 0x4f, 0x94, 0xda, 0xa7, 0x50, 0x8c, 0xad, 0xbb, 0x14, 0xe3,
+	0x33, 0x98, 0x4b, 0xb3, 0xef, 0x32, 0x32, 0xd4, 0x3f, 0x14, 0x60, 0x9a, 0xfb, 0xfa, 0xd2, 0x0e,
+	0xc9, 0x01, 0xc1, 0xad, 0xbf, 0x80, 0xcc, 0xdb, 0x80, 0x2b, 0x09, 0x5a, 0x66, 0x57, 0x81, 0x51,
+	0xcf, 0xf6, 0x51, 0xbf, 0xa6, 0x46, 0x6e, 0xc1, 0xbc, 0xa0, 0x0f, 0x89, 0x41, 0xda, 0xa1, 0x7e,
+	0x62, 0x10, 0x82, 0x83, 0x2e, 0xcb, 0xc1, 0xb2, 0x26, 0x84, 0xd5, 0x19, 0xee, 0x19, 0x47, 0xa1,
+	0x47, 0x30, 0xd7, 0xcf, 0xd3, 0x32, 0x82, 0xa6, 0xed, 0x56, 0x27, 0x97, 0x95, 0xd5, 0x71, 0x0d,
+	0xc9, 0x2c, 0xaf, 0x18, 0x06, 0xbd, 0x84, 0x95, 0x7e, 0x0e, 0x7c, 0x4e, 0x70, 0xe0, 0x1a, 0x8e,
+	0xee, 0x7b, 0x67, 0x38, 0xd0, 0x43, 0xaf, 0x1d, 0x98, 0xb8, 0x0a, 0xac, 0x44, 0x96, 0x64, 0x01,
+	0x7b, 0x82, 0xf0, 0x90, 0xd2, 0xd5, 0x19, 0x19, 0x3a, 0x82, 0x7b, 0xa9, 0x36, 0xeb, 0x0e, 0xee,
+	0x60, 0x47, 0x6f, 0xbb, 0x46, 0xc7, 0xb0, 0x1d, 0x7a, 0xea, 0xd5, 0x12, 0x93, 0xb8, 0x92, 0xe2,
+	0xc5, 0x4b, 0x4a, 0x7b, 0xdc, 0x23, 0x45, 0x5f, 0xc0, 0xf5, 0x0b, 0xa4, 0x56, 0xa7, 0x96, 0x95,
+	0xd5, 0x9c, 0x56, 0xcd, 0x92, 0x84, 0x3e, 0x87, 0x29, 0xc7, 0x08, 0x89, 0x1e, 0x62, 0xec, 0xea,
+	0x06, 0xa9, 0x16, 0x97, 0x15, 0x56, 0x9d, 0xbc, 0xc3, 0x6d, 0x44, 0x1d, 0x6e, 0xe3, 0x28, 0x6a,
+	0x81, 0x1a, 0x50, 0xfa, 0x3a, 0xc6, 0xee, 0x36, 0x41, 0x1f, 0x8b, 0xf2, 0x2c, 0xb3, 0x3a, 0x5b,
+	0x94, 0xea, 0x2c, 0xca, 0xbd, 0x81, 0x32, 0xdd, 0x85, 0x12, 0x53, 0xc8, 0x12, 0x36, 0xac, 0x4e,
+	0x33, 0xce, 0x95, 0x34, 0xce, 0x97, 0x46, 0x48, 0xde, 0x32, 0x2a, 0xce, 0x0f, 0x4e, 0x0c, 0xf8,
+	0xf1, 0x15, 0xf6, 0x06, 0x66, 0x12, 0x72, 0x53, 0xd8, 0xef, 0xca, 0xec, 0xa5, 0xad, 0x8a, 0x64,
+	0x1d, 0x63, 0x94, 0xcb, 0xcd, 0x86, 0x92, 0x84, 0x41, 0x8b, 0x00, 0x0c, 0xa7, 0xbf, 0x0f, 0x3d,
+	0x57, 0xc8, 0x2c, 0x32, 0xc8, 0x57, 0xf5, 0x37, 0xaf, 0xd1, 0xdf, 0x40, 0x29, 0xc0, 0x26, 0xb6,
+	0x3b, 0xd8, 0xa2, 0xd1, 0xce, 0x0d, 0x8f, 0x76, 0x44, 0xbe, 0x4d, 0xd4, 0x33, 0x00, 0xae, 0xea,
+	0x05, 0xee, 0x86, 0xd9, 0x45, 0xbd, 0x00, 0x13, 0xee, 0xd9, 0xa9, 0x4e, 0x7d, 0xe2, 0xee, 0x17,
+	0xdc, 0xb3, 0xd3, 0x17, 0xb8, 0x4b, 0x11, 0x86, 0xef, 0x33, 0x44, 0x9e, 0x23, 0x0c, 0xdf, 0xa7,
+	0x88, 0x9b, 0x50, 0x6a, 0xd2, 0xe3, 0x17, 0x48, 0x5e, 0xcb, 0xc5, 0x26, 0x76, 0xb7, 0x19, 0x5e,
+	0x7d, 0x0a, 0x57, 0x76, 0x02, 0x6c, 0x10, 0xcc, 0xd5, 0x6b, 0xf8, 0x9f, 0xdb, 0x38, 0x24, 0x68,
+	0x05, 0x0a, 0x3c, 0xaf, 0x98, 0x01, 0xa5, 0xad, 0x92, 0x14, 0x27, 0x4d, 0xa0, 0xd4, 0x07, 0x50,
+	0xd9, 0xc7, 0xa4, 0x9f, 0x31, 0xcb, 0x74, 0xf5, 0xff, 0xf3, 0x30, 0x2b, 0x51, 0x87, 0xbe, 0xe7,
+	0x86, 0x78, 0x24, 0x3d, 0x03, 0x89, 0x3c, 0x7e, 0xa9, 0x44, 0xce, 0xec, 0x27, 0x85, 0xcb, 0xf7,
+	0x93, 0xb9, 0xcc, 0x7e, 0xf2, 0x10, 0x26, 0x1d, 0x8f, 0x77, 0xd0, 0xea, 0xbc, 0x48, 0x2d, 0x31,
+	0x2d, 0xbc, 0x14, 0x70, 0x2d, 0xa6, 0x40, 0xfb, 0xfd, 0x95, 0x72, 0x95, 0x55, 0xca, 0x5d, 0xe6,
+	0xfb, 0x40, 0x8c, 0x2e, 0x2c, 0x96, 0x9f, 0x3d, 0xe7, 0xbf, 0xcf, 0xc1, 0x2c, 0x2d, 0xd3, 0xfe,
+	0x53, 0x9d, 0x83, 0x71, 0xc7, 0x6e, 0xd9, 0x84, 0x49, 0xcd, 0x6b, 0xfc, 0x03, 0x5d, 0x85, 0x82,
+	0xd7, 0x68, 0x84, 0x98, 0x27, 0x7b, 0x5e, 0x13, 0x5f, 0xa3, 0x5e, 0x33, 0x57, 0xa1, 0x10, 0x62,
+	0x23, 0x30, 0xdf, 0x89, 0xac, 0x14, 0x5f, 0xe8, 0x21, 0xa0, 0x56, 0xdb, 0x21, 0xb6, 0x49, 0x23,
+	0xd4, 0x0c, 0xbc, 0xb6, 0xdf, 0xbb, 0x5d, 0x2a, 0x31, 0x66, 0x9f, 0x22, 0x0e, 0x76, 0x29, 0x35,
+	0x9d, 0x08, 0x13, 0x77, 0x11, 0xbf, 0x5d, 0x2a, 0x02, 0xd3, 0xbb, 0x8c, 0xee, 0x82, 0xb8, 0x9f,
+	0x7a, 0x82, 0x27, 0x18, 0x69, 0x99, 0x83, 0x85, 0x54, 0xf5, 0x04, 0x90, 0x1c, 0x05, 0x91, 0xad,
+	0x4b, 0x50, 0x22, 0x1e, 0x31, 0x1c, 0xdd, 0xf4, 0xda, 0x6e, 0x14, 0x0c, 0x60, 0xa0, 0x1d, 0x0a,
+	0x41, 0x0f, 0xa0, 0x10, 0xe0, 0xb0, 0xed, 0xd0, 0x88, 0xd0, 0x23, 0xbd, 0x92, 0xd2, 0xfc, 0x34,
+	0x41, 0xa2, 0x6e, 0xc0, 0x95, 0x5d, 0xec, 0x60, 0x82, 0x47, 0xac, 0xa0, 0xa7, 0x70, 0xe5, 0xd8,
+	0xb7, 0x7e, 0x5c, 0xa9, 0xbe, 0x80, 0x05, 0xb9, 0xcc, 0x69, 0x97, 0x89, 0xf8, 0x1f, 0xd1, 0xdb,
+	0x9e, 0x85, 0xe4, 0x14, 0x77, 0x43, 0x21, 0x64, 0x46, 0x12, 0xc2, 0x88, 0xc1, 0x8a, 0xff, 0x56,
+	0x37, 0x61, 0x2e, 0xce, 0x52, 0x59, 0x52, 0xa6, 0xe5, 0x07, 0x30, 0x9f, 0x60, 0x10, 0x01, 0xbd,
+	0xbc, 0xee, 0x17, 0xb0, 0x20, 0x07, 0xe1, 0xa7, 0x39, 0xb2, 0x05, 0x0b, 0xf2, 0x09, 0x8c, 0xe4,
+	0xcb, 0xaf, 0x72, 0x50, 0xe1, 0xe4, 0xdb, 0x26, 0xb1, 0x3b, 0xbc, 0x9e, 0x33, 0x1b, 0xf6, 0x35,
+	0x98, 0xa4, 0x08, 0xc3, 0xb2, 0x02, 0xd1, 0xb1, 0x29, 0xe1, 0xb6, 0x65, 0x05, 0xa8, 0x06, 0x45,
+	0xda, 0x95, 0x43, 0xa9, 0x69, 0xd3, 0x1e, 0x5e, 0xa7, 0x5d, 0xfb, 0x16, 0x94, 0x69, 0x9f, 0x0f,
+	0x75, 0xec, 0x9a, 0x52, 0xdf, 0x06, 0xf7, 0xec, 0xb4, 0xbe, 0xe7, 0x9a, 0x94, 0xe4, 0x36, 0xcc,
+	0x84, 0x3a, 0x27, 0xb2, 0x5d, 0xc2, 0x88, 0x26, 0xf9, 0xa0, 0x16, 0xbe, 0x3e, 0x3b, 0xad, 0x1f,
+	0xb8, 0x44, 0x50, 0x35, 0x12, 0x54, 0x45, 0x4e, 0xd5, 0x90, 0xa8, 0xaa, 0x30, 0xc9, 0xdf, 0x05,
+	0x6d, 0x9f, 0xd5, 0x59, 0x59, 0x2b, 0x34, 0x76, 0x5c, 0x72, 0xec, 0xa3, 0x25, 0x98, 0x72, 0xc5,
+	0x9b, 0xc1, 0xf2, 0xce, 0x5c, 0xd1, 0x33, 0x8b, 0x2e, 0x7d, 0x2f, 0xec, 0x7a, 0x67, 0x2e, 0x25,
+	0x30, 0x64, 0x02, 0xe0, 0x04, 0x46, 0x44, 0xa0, 0xfe, 0x13, 0xcc, 0x8b, 0x40, 0x25, 0xf2, 0xf6,
+	0x59, 0x3c, 0x43, 0x1a, 0x71, 0x20, 0xc5, 0xa1, 0xc9, 0x8f, 0x81, 0x5e, 0x94, 0xb5, 0x8a, 0x95,
+	0x80, 0xf0, 0x03, 0x34, 0x52, 0xc5, 0x67, 0x1e, 0xe0, 0x27, 0x50, 0x8b, 0x93, 0x51, 0x12, 0x3e,
+	0x8c, 0xcd, 0x80, 0xeb, 0xa9, 0x6c, 0x22, 0x93, 0x7f, 0x26, 0x6f, 0xf6, 0x31, 0xd1, 0x0c, 0xd7,
+	0xf2, 0x5a, 0xbb, 0x3c, 0x4b, 0x46, 0xf0, 0xa6, 0x3a, 0xc8, 0x23, 0x6c, 0x92, 0x93, 0x4f, 0xe9,
+	0x4b, 0x3e, 0xf5, 0x53, 0xb8, 0x51, 0x27, 0x01, 0x36, 0x5a, 0xdc, 0xac, 0xe7, 0x81, 0xd1, 0xc2,
+	0x2f, 0xbd, 0xe6, 0xf0, 0xf4, 0xff, 0x41, 0x81, 0xc5, 0x0c, 0x4e, 0xa1, 0xf5, 0x09, 0x4c, 0xb5,
+	0x7d, 0xc7, 0x76, 0x4f, 0xf5, 0x06, 0xc5, 0x89, 0x20, 0xf0, 0x4e, 0x78, 0xcc, 0x10, 0x11, 0xcf,
+	0xdf, 0x7f, 0xa4, 0x95, 0xda, 0x3d, 0x08, 0xfa, 0x12, 0xa6, 0x69, 0x0e, 0x49, 0xbc, 0x39, 0x39,
+	0x80, 0x02, 0x25, 0x71, 0x97, 0x2d, 0x19, 0xf6, 0x6c, 0x02, 0xc6, 0x19, 0x5b, 0xd2, 0xbb, 0xbd,
+	0x0e, 0x76, 0xc9, 0x48, 0xde, 0xbd, 0x85, 0xc5, 0x0c, 0x46, 0xe1, 0x1c, 0x82, 0x31, 0xd2, 0xf5,
+	0xb1, 0x60, 0x63, 0x7f, 0xa3, 0x5b, 0x30, 0xe5, 0x1b, 0x5d, 0xc7, 0x33, 0x2c, 0x3e, 0x19, 0xf2,
+	0x3a, 0x2f, 0x09, 0x18, 0x9d, 0x0d, 0xd5, 0xdf, 0x29, 0xb0, 0xd0, 0xbb, 0x4f, 0x98, 0xd8, 0xa1,
+	0xc6, 0xf4, 0x2e, 0xdd, 0x5c, 0xfa, 0xa5, 0x9b, 0xef, 0xbb, 0x74, 0x23, 0xcb, 0xc6, 0x24, 0xcb,
+	0x1e, 0xc1, 0x78, 0x48, 0x8c, 0x60, 0x94, 0x89, 0x89, 0x13, 0xa2, 0x87, 0x90, 0xc7, 0x2e, 0xbf,
+	0x3e, 0x2f, 0xa6, 0xa7, 0x64, 0xea, 0x7f, 0x29, 0xd1, 0x84, 0xcc, 0x5c, 0x42, 0xd3, 0x90, 0xb3,
+	0x2d, 0x71, 0x2d, 0xe6, 0x6c, 0x0b, 0x7d, 0x06, 0x60, 0xb2, 0x5b, 0x67, 0xc4, 0x89, 0xb8, 0x28,
+	0xa8, 0xb7, 0x7b, 0xee, 0xe4, 0x2f, 0x08, 0xf4, 0xd8, 0x60, 0xa0, 0x31, 0x54, 0x07, 0xe3, 0x3c,
+	0xea, 0xed, 0xbd, 0x9a, 0xb8, 0xbd, 0xe5, 0x41, 0x89, 0xc9, 0x8a, 0xaf, 0xee, 0x5f, 0xe6, 0x23,
+	0xc7, 0xe9, 0x10, 0x18, 0xd2, 0x2d, 0x48, 0xbc, 0x48, 0xaa, 0x2a, 0xc3, 0xfd, 0x8c, 0x89, 0xe9,
+	0xa3, 0x22, 0x38, 0xd7, 0x7d, 0xc3, 0x3c, 0xc5, 0x24, 0x64, 0x21, 0x2a, 0x6b, 0xc5, 0xe0, 0xfc,
+	0x90, 0x03, 0xa8, 0xcb, 0x8e, 0x17, 0x92, 0x98, 0x20, 0xcf, 0x08, 0x4a, 0x14, 0x16, 0x91, 0x5c,
+	0x83, 0xc9, 0x20, 0x0c, 0x6d, 0xbd, 0x65, 0x9c, 0xb3, 0x88, 0x8c, 0x6b, 0x13, 0xf4, 0xfb, 0x95,
+	0x71, 0x1e, 0xa3, 0x8c, 0x4e, 0x93, 0xa5, 0x80, 0xc2, 0x51, 0xdb, 0x9d, 0x26, 0xcd, 0xba, 0xd0,
+	0x0d, 0x18, 0x53, 0x81, 0x61, 0x0a, 0xa1, 0x1b, 0x50, 0x1e, 0x81, 0xa0, 0x2c, 0x13, 0x31, 0x82,
+	0x72, 0xbc, 0x81, 0xd9, 0x9e, 0xa5, 0xba, 0x4f, 0xdf, 0xc8, 0x0d, 0xb1, 0xf1, 0xb9, 0x2d, 0x05,
+	0x8a, 0x05, 0x64, 0x43, 0x8b, 0x3c, 0x38, 0xc4, 0x41, 0xbd, 0xc1, 0xe7, 0xd6, 0xe9, 0x40, 0x06,
+	0x3e, 0x47, 0x2b, 0x50, 0x6e, 0x1a, 0x04, 0x9f, 0x19, 0x5d, 0x71, 0x22, 0x45, 0xe6, 0xdc, 0x94,
+	0x00, 0xb2, 0x33, 0xa9, 0x6d, 0xc3, 0x95, 0x14, 0x59, 0xf2, 0x90, 0x5b, 0x4e, 0x79, 0x17, 0x96,
+	0xe5, 0x91, 0xf6, 0x37, 0x8a, 0x34, 0x7e, 0x30, 0xf3, 0x86, 0x96, 0x5e, 0x0d, 0x26, 0x6d, 0x97,
+	0xe0, 0xa0, 0x63, 0x38, 0xa2, 0x9c, 0xe3, 0x6f, 0xb4, 0x03, 0x33, 0xac, 0x56, 0xf4, 0xde, 0x89,
+	0xe7, 0x87, 0x9e, 0xf8, 0x34, 0x63, 0x89, 0xbf, 0xd1, 0xdf, 0x42, 0x19, 0xbb, 0x96, 0x24, 0x62,
+	0x6c, 0xa8, 0x88, 0x29, 0xec, 0x5a, 0xf1, 0x97, 0xfa, 0x0c, 0xae, 0x26, 0x7d, 0x12, 0x69, 0xde,
+	0xcb, 0x62, 0x65, 0x20, 0x8b, 0x39, 0x65, 0x94, 0xc5, 0xdd, 0x78, 0x9b, 0x14, 0xbd, 0x4b, 0xfa,
+	0x0b, 0x56, 0xb9, 0x4c, 0xc1, 0xca, 0x0f, 0xa0, 0xdc, 0xb0, 0x07, 0x10, 0x7d, 0x66, 0xd4, 0x7a,
+	0x85, 0x1a, 0x11, 0x0c, 0x3f, 0x98, 0x94, 0xe0, 0xe7, 0x7e, 0x7a, 0xf0, 0xf3, 0x97, 0x0b, 0x3e,
+	0xad, 0xab, 0x26, 0xf6, 0x7a, 0x4d, 0x68, 0x52, 0x9b, 0x68, 0x62, 0x8f, 0x6d, 0x01, 0xe2, 0xa6,
+	0x3d, 0x9e, 0xde, 0xb4, 0x0b, 0x72, 0xd3, 0x56, 0xff, 0x53, 0x81, 0xeb, 0xa9, 0x61, 0x10, 0x67,
+	0xf9, 0x20, 0x71, 0x96, 0x7d, 0xef, 0x89, 0x28, 0xaa, 0x82, 0xa4, 0xcf, 0x2a, 0x31, 0x6b, 0x46,
+	0x56, 0x25, 0x5a, 0x5f, 0x3e, 0xd9, 0xfa, 0xd4, 0x5f, 0x28, 0x50, 0xad, 0xe3, 0x84, 0x1d, 0xa3,
+	0x94, 0x89, 0x63, 0x88, 0x0d, 0x71, 0x8e, 0x35, 0x8b, 0xf8, 0x1b, 0xdd, 0x80, 0xa2, 0xe3, 0xb9,
+	0x4d, 0x8e, 0xcc, 0x33, 0x64, 0x0f, 0x40, 0x39, 0xe3, 0xdd, 0xf2, 0x18, 0xe7, 0x8c, 0xbe, 0x19,
+	0xce, 0x34, 0xdb, 0x81, 0x61, 0x76, 0xc5, 0x34, 0x1a, 0x7f, 0xd3, 0x8b, 0x74, 0x86, 0x1b, 0xb9,
+	0xe3, 0x78, 0xe6, 0x69, 0xbd, 0xeb, 0x9a, 0xd9, 0xe6, 0xc5, 0x7b, 0x83, 0xae, 0x6b, 0x8e, 0xb8,
+	0x92, 0xa1, 0xf4, 0x54, 0xe8, 0x36, 0x41, 0xf7, 0x60, 0x86, 0x66, 0x88, 0x6e, 0x7a, 0x41, 0x80,
+	0x4d, 0x96, 0xd7, 0x79, 0xd6, 0x5e, 0xa7, 0x29, 0x78, 0x27, 0x86, 0xd2, 0xe0, 0x9a, 0xd4, 0x18,
+	0xdd, 0x0a, 0xec, 0x06, 0x11, 0xee, 0x00, 0x03, 0xed, 0x52, 0x08, 0xdd, 0xa7, 0xfa, 0x38, 0xb0,
+	0x3d, 0xcb, 0x36, 0x6d, 0xc2, 0x7d, 0x1a, 0xd7, 0x64, 0x90, 0xfa, 0x57, 0x70, 0x2d, 0xae, 0xe6,
+	0xd8, 0xb1, 0xa1, 0xd3, 0xca, 0xd7, 0x50, 0x4b, 0xe3, 0x12, 0xb9, 0xf3, 0x77, 0xf1, 0x44, 0xca,
+	0xad, 0xa3, 0x51, 0x10, 0x25, 0x3d, 0x27, 0xa5, 0x51, 0x8f, 0x71, 0xc6, 0xea, 0x07, 0xa8, 0xff,
+	0x00, 0xb7, 0xeb, 0x03, 0xf2, 0x0f, 0x7b, 0x66, 0x0f, 0xcd, 0x8f, 0xab, 0x50, 0xe0, 0x5e, 0x8a,
+	0xa6, 0x2c, 0xbe, 0x54, 0x13, 0x16, 0x9f, 0x7b, 0x81, 0x89, 0x25, 0xd1, 0x1a, 0x0e, 0x47, 0x70,
+	0x19, 0xdd, 0x87, 0x8a, 0x7b, 0xa2, 0x93, 0xc0, 0x70, 0xc3, 0x96, 0x1d, 0x86, 0xb4, 0x58, 0x84,
+	0xec, 0x19, 0xf7, 0xe4, 0x48, 0x06, 0xab, 0xff, 0xab, 0xc0, 0xdc, 0x41, 0xcb, 0xf7, 0x02, 0xe1,
+	0x41, 0xdc, 0x5c, 0x06, 0xd7, 0x13, 0x4a, 0xda, 0x7a, 0x62, 0x1d, 0x0a, 0x0d, 0x2f, 0x68, 0x89,
+	0xbc, 0x99, 0xee, 0x1b, 0xe3, 0x9f, 0xdb, 0x0e, 0x7e, 0xce, 0x90, 0x9a, 0x20, 0xa2, 0x03, 0x8b,
+	0x65, 0x10, 0x83, 0xe5, 0xc8, 0x94, 0xc6, 0xfe, 0x66, 0x6e, 0x04, 0x5d, 0x3d, 0x68, 0x47, 0x6d,
+	0xa2, 0x60, 0x05, 0x5d, 0xad, 0xed, 0xaa, 0xc7, 0x80, 0xfa, 0x4c, 0xdb, 0x0b, 0x02, 0x2f, 0xa0,
+	0x97, 0x5a, 0xe0, 0x9d, 0x45, 0x97, 0x5a, 0xe0, 0x9d, 0xc9, 0x71, 0xc8, 0x25, 0x67, 0x43, 0x4c,
+	0x79, 0xc4, 0x7c, 0xc4, 0x3f, 0x54, 0x1d, 0xe6, 0x13, 0x1e, 0x8b, 0x5c, 0x98, 0x87, 0xc2, 0x7b,
+	0xef, 0x24, 0x72, 0xb5, 0xa8, 0x8d, 0xbf, 0xf7, 0x4e, 0x0e, 0x76, 0xd1, 0x26, 0x14, 0x18, 0x63,
+	0x28, 0x06, 0x9e, 0x05, 0xe6, 0xe2, 0xa0, 0x65, 0x9a, 0x20, 0x53, 0x1f, 0x48, 0x79, 0xca, 0xc9,
+	0xbe, 0xf2, 0x4e, 0xa2, 0xb8, 0xf6, 0xa6, 0xbf, 0x22, 0x9d, 0xfe, 0xd4, 0x1f, 0xf2, 0x30, 0x93,
+	0x20, 0x4d, 0xd2, 0xa4, 0x9c, 0x45, 0x2e, 0xed, 0x2c, 0xb6, 0xa0, 0xc0, 0x17, 0x71, 0xcc, 0xdf,
+	0xe9, 0xbe, 0x9f, 0x98, 0x62, 0xe1, 0x7c, 0x1f, 0xa7, 0x09, 0xca, 0x64, 0xcf, 0x1b, 0x63, 0x51,
+	0x95, 0xc7, 0xbd, 0x7b, 0x30, 0xe3, 0x07, 0x9e, 0x89, 0xc3, 0x10, 0x5b, 0x82, 0x88, 0xb7, 0x9b,
+	0xe9, 0x18, 0xcc, 0x09, 0xef, 0xc0, 0xb4, 0xcd, 0x94, 0xc4, 0x74, 0xfc, 0x19, 0x5c, 0x8e, 0xa0,
+	0x9c, 0xac, 0xff, 0xf2, 0x9c, 0xb8, 0xcc, 0xe5, 0xf9, 0x19, 0x40, 0xdb, 0xb7, 0x22, 0xd6, 0xc9,
+	0xe1, 0xac, 0x82, 0x7a, 0x9b, 0xa0, 0x2f, 0x80, 0xfe, 0x2a, 0xe9, 0x3b, 0x58, 0x30, 0x0f, 0xdf,
+	0xf2, 0x97, 0x62, 0xfa, 0x6d, 0xa2, 0xb6, 0xa5, 0x1e, 0x22, 0x9d, 0xa8, 0xc8, 0x9b, 0xbb, 0x90,
+	0x7f, 0xef, 0x9d, 0xa4, 0x74, 0x8d, 0x1e, 0x29, 0x25, 0xb8, 0x7c, 0x22, 0x39, 0x30, 0xb7, 0x77,
+	0xfe, 0xe7, 0xaa, 0x4d, 0xf5, 0x01, 0xcc, 0x27, 0xb4, 0xf5, 0x9e, 0x73, 0xac, 0x68, 0x95, 0x5e,
+	0xd1, 0xaa, 0xdf, 0x2b, 0xd1, 0x2e, 0xfe, 0xe8, 0xcc, 0xbe, 0x60, 0xb5, 0x33, 0x0f, 0x85, 0x86,
+	0x4e, 0x85, 0x46, 0x13, 0x67, 0xe3, 0xd0, 0x0b, 0x08, 0x9d, 0xd8, 0x2d, 0x1c, 0xda, 0x01, 0x16,
+	0x8f, 0x94, 0x7c, 0xfc, 0xeb, 0x19, 0x85, 0xb1, 0xdb, 0x78, 0x05, 0xca, 0x01, 0x16, 0xf9, 0x24,
+	0x3d, 0x64, 0xa6, 0x22, 0x20, 0x7b, 0xc9, 0xc8, 0x4b, 0x36, 0x6a, 0xc8, 0xd0, 0xdb, 0xe0, 0xd7,
+	0xf2, 0x98, 0xcb, 0x39, 0xe2, 0x25, 0xfb, 0x18, 0x39, 0xb3, 0xdd, 0x94, 0x8d, 0x18, 0x23, 0x63,
+	0x48, 0xfa, 0x10, 0xb1, 0xb0, 0x43, 0x0c, 0x79, 0x7e, 0x28, 0x32, 0x48, 0xef, 0xd7, 0x0d, 0x61,
+	0xb3, 0x41, 0x46, 0x98, 0x98, 0x20, 0x22, 0xdf, 0x26, 0xe8, 0x31, 0x4c, 0x44, 0x77, 0xf0, 0xf0,
+	0x39, 0xb7, 0x10, 0xb2, 0xfb, 0x57, 0xfd, 0xb2, 0x7f, 0xd3, 0x27, 0xc7, 0x60, 0x14, 0x87, 0xd4,
+	0x7f, 0x81, 0xd9, 0x57, 0x5e, 0x67, 0xc4, 0xad, 0xd0, 0xa8, 0x8d, 0x28, 0xf5, 0x87, 0xcf, 0x7c,
+	0xea, 0x0f, 0x9f, 0x6b, 0x77, 0xa0, 0x92, 0xcc, 0x48, 0x34, 0x01, 0xf9, 0x9d, 0xfa, 0xdb, 0xca,
+	0x47, 0x68, 0x12, 0xc6, 0x68, 0x5c, 0x2b, 0xca, 0xda, 0x31, 0xcc, 0xa7, 0x36, 0x32, 0x84, 0x60,
+	0xfa, 0xe0, 0xd5, 0xe1, 0x1b, 0xed, 0x48, 0x3f, 0xdc, 0x7b, 0xbd, 0x7b, 0xf0, 0x7a, 0xbf, 0xf2,
+	0x91, 0x04, 0xd3, 0x8e, 0x5f, 0xbf, 0xa6, 0x30, 0x05, 0xcd, 0x41, 0x45, 0xc0, 0x76, 0xde, 0xbc,
+	0x3a, 0x7c, 0xb9, 0x77, 0xb4, 0xb7, 0x5b, 0xc9, 0x6d, 0xfd, 0xdf, 0x35, 0x28, 0x8b, 0xa1, 0x9f,
+	0x2f, 0xc1, 0x51, 0x1d, 0x0a, 0x7c, 0x07, 0x8c, 0xaa, 0x2c, 0x62, 0x29, 0xbf, 0xfb, 0xd4, 0xae,
+	0x0e, 0x1c, 0xcc, 0x1e, 0xfd, 0xff, 0x0f, 0xea, 0xc2, 0xbf, 0xff, 0xf6, 0xf7, 0xff, 0x9d, 0x9b,
+	0x55, 0xa7, 0xd8, 0xff, 0xab, 0xe0, 0x9e, 0x86, 0x4f, 0x95, 0x35, 0x74, 0x04, 0xf9, 0x7d, 0x4c,
+	0xd0, 0x7c, 0xf2, 0xb7, 0x8b, 0x48, 0x5c, 0xea, 0x4f, 0x1a, 0xea, 0x4d, 0x26, 0xae, 0x8a, 0xae,
+	0xca, 0xe2, 0x36, 0xbf, 0x15, 0x47, 0xf3, 0x01, 0xbd, 0x82, 0x31, 0x3a, 0x16, 0x23, 0xce, 0x3f,
+	0xf0, 0x7b, 0x44, 0x6d, 0x61, 0x00, 0x2e, 0x04, 0xcf, 0x31, 0xc1, 0xd3, 0xa8, 0xcf, 0x4e, 0xf4,
+	0x8f, 0xf4, 0x3f, 0x6a, 0x38, 0x38, 0xf6, 0x3c, 0x65, 0xed, 0x9e, 0xe9, 0xb9, 0x30, 0x75, 0x2d,
+	0xcb, 0x54, 0x0b, 0x0a, 0x3c, 0x4d, 0x85, 0xec, 0x94, 0x15, 0x7d, 0xa6, 0xec, 0x55, 0x26, 0x5b,
+	0xad, 0x2d, 0x0e, 0xc8, 0xb6, 0x4d, 0xbc, 0x11, 0xa9, 0xa0, 0x61, 0xee, 0x00, 0xf0, 0xe3, 0x62,
+	0xbf, 0x0f, 0xde, 0x18, 0x38, 0x3f, 0x69, 0x75, 0x9d, 0xa9, 0x6d, 0x8b, 0x69, 0x7b, 0xa8, 0xde,
+	0x4b, 0xd3, 0xc6, 0x76, 0xe6, 0xb1, 0xca, 0x4d, 0xfa, 0x45, 0xf5, 0x62, 0x98, 0xd8, 0xc7, 0x84,
+	0x29, 0xbd, 0xd6, 0x7f, 0x96, 0xb2, 0xc6, 0x5a, 0x1a, 0x4a, 0x9c, 0xc8, 0x0a, 0xd3, 0xba, 0x88,
+	0xae, 0xa7, 0xc7, 0x8f, 0x69, 0xa2, 0xee, 0xf1, 0xb8, 0x49, 0xee, 0x65, 0xac, 0xf9, 0x87, 0xb9,
+	0x57, 0xbb, 0x8c, 0x7b, 0x4d, 0x00, 0x9e, 0x0b, 0x92, 0xde, 0x8c, 0x5f, 0x04, 0x32, 0xf5, 0x0a,
+	0x07, 0xd7, 0x2e, 0x74, 0xf0, 0x3b, 0x98, 0x8c, 0xb6, 0xe0, 0x88, 0x47, 0x2b, 0x75, 0x29, 0x9e,
+	0xa9, 0xe4, 0x73, 0xa6, 0xe4, 0xaf, 0xd5, 0x8f, 0x53, 0x9d, 0xeb, 0xad, 0x9c, 0x7b, 0x2e, 0x0a,
+	0x18, 0xa6, 0x6e, 0xb6, 0xa8, 0x9b, 0x11, 0x20, 0x76, 0xd3, 0xb8, 0x94, 0x05, 0xf7, 0x99, 0x05,
+	0x2b, 0x6b, 0xb7, 0x32, 0xdc, 0xec, 0xd9, 0x80, 0x3e, 0x40, 0x79, 0x1f, 0x13, 0xe9, 0xe7, 0x91,
+	0xa5, 0xfe, 0xfc, 0x18, 0xd8, 0xba, 0xd7, 0x96, 0xb3, 0x09, 0x44, 0x1a, 0x09, 0xf5, 0x68, 0x04,
+	0xf5, 0xff, 0xaa, 0x40, 0x25, 0xb9, 0x13, 0x17, 0x4e, 0x67, 0xac, 0xd7, 0x6b, 0x8b, 0x19, 0x58,
+	0xa1, 0x7c, 0x93, 0x29, 0xbf, 0xaf, 0xde, 0xcb, 0x50, 0xde, 0x4c, 0x6a, 0xfb, 0x37, 0x05, 0x66,
+	0xf8, 0x22, 0x39, 0xde, 0x8f, 0xa3, 0x5b, 0x4c, 0xc7, 0x45, 0x5b, 0xf7, 0x9a, 0x7a, 0x11, 0x89,
+	0xb0, 0xe5, 0x0e, 0xb3, 0x65, 0x09, 0x2d, 0x66, 0xd8, 0xc2, 0x36, 0xe0, 0xe1, 0x23, 0x45, 0xb2,
+	0x21, 0x5e, 0x63, 0xa7, 0xd8, 0x90, 0xdc, 0x8d, 0xd7, 0xd4, 0x8b, 0x48, 0x46, 0xb4, 0x01, 0x53,
+	0x0e, 0x6a, 0xc3, 0x39, 0x00, 0x6d, 0xd2, 0x4c, 0x42, 0x54, 0x5f, 0x19, 0x7b, 0xf0, 0xda, 0x62,
+	0x06, 0x56, 0xe8, 0x5c, 0x67, 0x3a, 0xef, 0xa1, 0x3b, 0x17, 0xea, 0xdc, 0x7c, 0x67, 0x87, 0xc4,
+	0x0b, 0xba, 0xc8, 0x86, 0xc9, 0x7d, 0x4c, 0xf8, 0x76, 0x36, 0xd1, 0x9e, 0xe4, 0x15, 0x60, 0xed,
+	0x7a, 0x2a, 0x4e, 0xe8, 0xbc, 0xcd, 0x74, 0xde, 0x44, 0x37, 0x32, 0x74, 0x86, 0x4c, 0xfc, 0x77,
+	0x50, 0xa6, 0x56, 0xc7, 0xdb, 0x1b, 0x91, 0xee, 0xd9, 0xeb, 0xad, 0xda, 0x72, 0x36, 0x81, 0xd0,
+	0x2c, 0x6e, 0x06, 0xb4, 0x9c, 0xa1, 0xd9, 0x89, 0x95, 0xf9, 0x50, 0xaa, 0xe3, 0x58, 0x39, 0xe2,
+	0x51, 0xcc, 0x5a, 0xe5, 0x64, 0x56, 0xf7, 0x1a, 0xd3, 0x77, 0x5b, 0x5d, 0x1a, 0xa2, 0x8f, 0x76,
+	0x93, 0x6f, 0x60, 0x6a, 0x1f, 0x93, 0xde, 0xfe, 0xe5, 0x66, 0x7f, 0x08, 0x93, 0xfb, 0x8b, 0xda,
+	0x52, 0x26, 0x7e, 0xc4, 0xda, 0x66, 0xfb, 0x8b, 0x75, 0x3a, 0x16, 0xa2, 0xff, 0x51, 0x60, 0xa1,
+	0x8e, 0x49, 0xda, 0x36, 0x02, 0xdd, 0xef, 0x77, 0xfd, 0x82, 0x8d, 0x45, 0x66, 0x18, 0x9e, 0x30,
+	0x4b, 0xb6, 0xd4, 0xf5, 0xa1, 0x96, 0x6c, 0x4a, 0xeb, 0x1b, 0x1a, 0x94, 0xff, 0x50, 0xa0, 0xc2,
+	0x76, 0x1a, 0xd2, 0x36, 0x03, 0xf1, 0x5a, 0xba, 0x70, 0xd5, 0x91, 0x69, 0xca, 0x63, 0x66, 0xca,
+	0xba, 0xba, 0x3a, 0xdc, 0x94, 0x80, 0x09, 0xa4, 0x56, 0x7c, 0x80, 0x02, 0x9f, 0x22, 0xc5, 0x6d,
+	0x9d, 0xb6, 0xff, 0xa8, 0xd5, 0xd2, 0x50, 0xe2, 0x28, 0xfa, 0xef, 0x19, 0x69, 0xf6, 0x0d, 0x37,
+	0xbf, 0xed, 0x9f, 0x8f, 0x3f, 0xc4, 0x36, 0xf1, 0x57, 0x30, 0x55, 0xdf, 0x66, 0x99, 0xd1, 0x7b,
+	0xed, 0x27, 0x32, 0x23, 0xb9, 0x31, 0xa8, 0x2d, 0x65, 0xe2, 0x2f, 0x28, 0xc0, 0x75, 0xae, 0x6f,
+	0xfd, 0xbd, 0x77, 0x12, 0x6e, 0x7e, 0x6b, 0x5b, 0x1f, 0xd0, 0x37, 0x50, 0xd8, 0x3b, 0x97, 0xbc,
+	0xde, 0x3b, 0xcf, 0xf4, 0x3a, 0xf5, 0x19, 0xa8, 0x7e, 0xc6, 0xd4, 0x3c, 0x46, 0x97, 0xf1, 0x1a,
+	0x73, 0x8d, 0x7c, 0x40, 0x62, 0x2f, 0xc5, 0xc4, 0x80, 0x24, 0x3d, 0x58, 0x6a, 0xb5, 0x34, 0xd4,
+	0x88, 0x03, 0x12, 0x7b, 0x9d, 0x79, 0xd1, 0x80, 0xc4, 0x34, 0x0d, 0x0e, 0x48, 0xb2, 0xb2, 0xac,
+	0x8c, 0x7a, 0xc0, 0x14, 0xdd, 0xa9, 0x25, 0x7a, 0x0a, 0x95, 0xbf, 0xd1, 0xa7, 0x8d, 0x1e, 0xe5,
+	0xd7, 0x30, 0x46, 0x5f, 0x4f, 0x62, 0x02, 0x1f, 0x78, 0x48, 0x65, 0x2a, 0xb9, 0xcb, 0x94, 0x2c,
+	0xab, 0x59, 0xde, 0xb4, 0xbc, 0x0e, 0x1d, 0x49, 0x4e, 0x0a, 0x8c, 0xef, 0xf1, 0x9f, 0x06, 0x00,
+	0x0e, 0xdb, 0x23, 0xb1, 0xf9, 0x2d, 0x00, 0x00,
 }
@@ -0,0 +1,1790 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: deviceGroup.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DeviceGroupJobAction int32
+
+const (
+	// Enqueue the given downlink payload.
+	DeviceGroupJobAction_ENQUEUE_DOWNLINK DeviceGroupJobAction = 0
+	// Change the device-profile to the given device-profile.
+	DeviceGroupJobAction_CHANGE_DEVICE_PROFILE DeviceGroupJobAction = 1
+	// Add the device to the given multicast-group.
+	DeviceGroupJobAction_ADD_TO_MULTICAST_GROUP DeviceGroupJobAction = 2
+	// Flush the device-queue.
+	DeviceGroupJobAction_FLUSH_QUEUE DeviceGroupJobAction = 3
+	// Delete the device.
+	DeviceGroupJobAction_DELETE_DEVICES DeviceGroupJobAction = 4
+)
+
+var DeviceGroupJobAction_name = map[int32]string{
+	0: "ENQUEUE_DOWNLINK",
+	1: "CHANGE_DEVICE_PROFILE",
+	2: "ADD_TO_MULTICAST_GROUP",
+	3: "FLUSH_QUEUE",
+	4: "DELETE_DEVICES",
+}
+var DeviceGroupJobAction_value = map[string]int32{
+	"ENQUEUE_DOWNLINK":       0,
+	"CHANGE_DEVICE_PROFILE":  1,
+	"ADD_TO_MULTICAST_GROUP": 2,
+	"FLUSH_QUEUE":            3,
+	"DELETE_DEVICES":         4,
+}
+
+func (x DeviceGroupJobAction) String() string {
+	return proto.EnumName(DeviceGroupJobAction_name, int32(x))
+}
+func (DeviceGroupJobAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{0}
+}
+
+type DeviceGroupJobStatus int32
+
+const (
+	// The job has not yet started.
+	DeviceGroupJobStatus_JOB_PENDING DeviceGroupJobStatus = 0
+	// The job is running.
+	DeviceGroupJobStatus_JOB_RUNNING DeviceGroupJobStatus = 1
+	// All devices have been processed.
+	DeviceGroupJobStatus_JOB_COMPLETED DeviceGroupJobStatus = 2
+)
+
+var DeviceGroupJobStatus_name = map[int32]string{
+	0: "JOB_PENDING",
+	1: "JOB_RUNNING",
+	2: "JOB_COMPLETED",
+}
+var DeviceGroupJobStatus_value = map[string]int32{
+	"JOB_PENDING":   0,
+	"JOB_RUNNING":   1,
+	"JOB_COMPLETED": 2,
+}
+
+func (x DeviceGroupJobStatus) String() string {
+	return proto.EnumName(DeviceGroupJobStatus_name, int32(x))
+}
+func (DeviceGroupJobStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{1}
+}
+
+type DeviceGroup struct {
+	// ID (string formatted UUID).
+	// This will be generated automatically on create.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Application ID.
+	// This can not be changed after the device-group has been created.
+	ApplicationId int64 `protobuf:"varint,2,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Name of the device-group.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the device-group.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// The device-group is dynamic.
+	// A static device-group contains the devices which have been added
+	// explicitly, a dynamic device-group contains all the devices of the
+	// application matching the filters.
+	Dynamic bool `protobuf:"varint,5,opt,name=dynamic,proto3" json:"dynamic,omitempty"`
+	// Tags to filter on (dynamic device-group only).
+	// A device matches when it has all the given tags, with equal values.
+	FilterTags map[string]string `protobuf:"bytes,6,rep,name=filter_tags,json=filterTags,proto3" json:"filter_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Device-profile ID to filter on (dynamic device-group only, string
+	// formatted UUID).
+	FilterDeviceProfileId string   `protobuf:"bytes,7,opt,name=filter_device_profile_id,json=filterDeviceProfileID,proto3" json:"filter_device_profile_id,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *DeviceGroup) Reset()         { *m = DeviceGroup{} }
+func (m *DeviceGroup) String() string { return proto.CompactTextString(m) }
+func (*DeviceGroup) ProtoMessage()    {}
+func (*DeviceGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{0}
+}
+func (m *DeviceGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceGroup.Unmarshal(m, b)
+}
+func (m *DeviceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceGroup.Marshal(b, m, deterministic)
+}
+func (dst *DeviceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceGroup.Merge(dst, src)
+}
+func (m *DeviceGroup) XXX_Size() int {
+	return xxx_messageInfo_DeviceGroup.Size(m)
+}
+func (m *DeviceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceGroup proto.InternalMessageInfo
+
+func (m *DeviceGroup) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeviceGroup) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DeviceGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceGroup) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeviceGroup) GetDynamic() bool {
+	if m != nil {
+		return m.Dynamic
+	}
+	return false
+}
+
+func (m *DeviceGroup) GetFilterTags() map[string]string {
+	if m != nil {
+		return m.FilterTags
+	}
+	return nil
+}
+
+func (m *DeviceGroup) GetFilterDeviceProfileId() string {
+	if m != nil {
+		return m.FilterDeviceProfileId
+	}
+	return ""
+}
+
+type DeviceGroupListItem struct {
+	// ID (string formatted UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the device-group.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the device-group.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The device-group is dynamic.
+	Dynamic              bool     `protobuf:"varint,4,opt,name=dynamic,proto3" json:"dynamic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceGroupListItem) Reset()         { *m = DeviceGroupListItem{} }
+func (m *DeviceGroupListItem) String() string { return proto.CompactTextString(m) }
+func (*DeviceGroupListItem) ProtoMessage()    {}
+func (*DeviceGroupListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{1}
+}
+func (m *DeviceGroupListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceGroupListItem.Unmarshal(m, b)
+}
+func (m *DeviceGroupListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceGroupListItem.Marshal(b, m, deterministic)
+}
+func (dst *DeviceGroupListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceGroupListItem.Merge(dst, src)
+}
+func (m *DeviceGroupListItem) XXX_Size() int {
+	return xxx_messageInfo_DeviceGroupListItem.Size(m)
+}
+func (m *DeviceGroupListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceGroupListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceGroupListItem proto.InternalMessageInfo
+
+func (m *DeviceGroupListItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeviceGroupListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceGroupListItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DeviceGroupListItem) GetDynamic() bool {
+	if m != nil {
+		return m.Dynamic
+	}
+	return false
+}
+
+type DeviceGroupJob struct {
+	// ID (string formatted UUID).
+	// This will be generated automatically on create.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Device-group ID (string formatted UUID).
+	DeviceGroupId string `protobuf:"bytes,2,opt,name=device_group_id,json=deviceGroupID,proto3" json:"device_group_id,omitempty"`
+	// Action to execute for each device.
+	Action DeviceGroupJobAction `protobuf:"varint,3,opt,name=action,proto3,enum=api.DeviceGroupJobAction" json:"action,omitempty"`
+	// FPort used (ENQUEUE_DOWNLINK, must be > 0).
+	FPort uint32 `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Downlink is confirmed (ENQUEUE_DOWNLINK).
+	Confirmed bool `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Base64 encoded data (ENQUEUE_DOWNLINK).
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Device-profile ID (CHANGE_DEVICE_PROFILE, string formatted UUID).
+	DeviceProfileId string `protobuf:"bytes,7,opt,name=device_profile_id,json=deviceProfileID,proto3" json:"device_profile_id,omitempty"`
+	// Multicast-group ID (ADD_TO_MULTICAST_GROUP, string formatted UUID).
+	MulticastGroupId     string   `protobuf:"bytes,8,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceGroupJob) Reset()         { *m = DeviceGroupJob{} }
+func (m *DeviceGroupJob) String() string { return proto.CompactTextString(m) }
+func (*DeviceGroupJob) ProtoMessage()    {}
+func (*DeviceGroupJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{2}
+}
+func (m *DeviceGroupJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceGroupJob.Unmarshal(m, b)
+}
+func (m *DeviceGroupJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceGroupJob.Marshal(b, m, deterministic)
+}
+func (dst *DeviceGroupJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceGroupJob.Merge(dst, src)
+}
+func (m *DeviceGroupJob) XXX_Size() int {
+	return xxx_messageInfo_DeviceGroupJob.Size(m)
+}
+func (m *DeviceGroupJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceGroupJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceGroupJob proto.InternalMessageInfo
+
+func (m *DeviceGroupJob) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeviceGroupJob) GetDeviceGroupId() string {
+	if m != nil {
+		return m.DeviceGroupId
+	}
+	return ""
+}
+
+func (m *DeviceGroupJob) GetAction() DeviceGroupJobAction {
+	if m != nil {
+		return m.Action
+	}
+	return DeviceGroupJobAction_ENQUEUE_DOWNLINK
+}
+
+func (m *DeviceGroupJob) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DeviceGroupJob) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DeviceGroupJob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DeviceGroupJob) GetDeviceProfileId() string {
+	if m != nil {
+		return m.DeviceProfileId
+	}
+	return ""
+}
+
+func (m *DeviceGroupJob) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+type DeviceGroupJobProgress struct {
+	// Status of the job.
+	Status DeviceGroupJobStatus `protobuf:"varint,1,opt,name=status,proto3,enum=api.DeviceGroupJobStatus" json:"status,omitempty"`
+	// Number of devices within the device-group when the job was created.
+	TotalCount uint32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Number of processed devices (including the failed devices).
+	ProcessedCount uint32 `protobuf:"varint,3,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	// Number of devices for which the action failed.
+	ErrorCount uint32 `protobuf:"varint,4,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	// Last error (prefixed with the DevEUI of the device).
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Completed at timestamp.
+	CompletedAt          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceGroupJobProgress) Reset()         { *m = DeviceGroupJobProgress{} }
+func (m *DeviceGroupJobProgress) String() string { return proto.CompactTextString(m) }
+func (*DeviceGroupJobProgress) ProtoMessage()    {}
+func (*DeviceGroupJobProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{3}
+}
+func (m *DeviceGroupJobProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceGroupJobProgress.Unmarshal(m, b)
+}
+func (m *DeviceGroupJobProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceGroupJobProgress.Marshal(b, m, deterministic)
+}
+func (dst *DeviceGroupJobProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceGroupJobProgress.Merge(dst, src)
+}
+func (m *DeviceGroupJobProgress) XXX_Size() int {
+	return xxx_messageInfo_DeviceGroupJobProgress.Size(m)
+}
+func (m *DeviceGroupJobProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceGroupJobProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceGroupJobProgress proto.InternalMessageInfo
+
+func (m *DeviceGroupJobProgress) GetStatus() DeviceGroupJobStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DeviceGroupJobStatus_JOB_PENDING
+}
+
+func (m *DeviceGroupJobProgress) GetTotalCount() uint32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *DeviceGroupJobProgress) GetProcessedCount() uint32 {
+	if m != nil {
+		return m.ProcessedCount
+	}
+	return 0
+}
+
+func (m *DeviceGroupJobProgress) GetErrorCount() uint32 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *DeviceGroupJobProgress) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *DeviceGroupJobProgress) GetCompletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return nil
+}
+
+type DeviceGroupJobListItem struct {
+	// ID (string formatted UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Action executed for each device.
+	Action DeviceGroupJobAction `protobuf:"varint,2,opt,name=action,proto3,enum=api.DeviceGroupJobAction" json:"action,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Progress of the job.
+	Progress             *DeviceGroupJobProgress `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DeviceGroupJobListItem) Reset()         { *m = DeviceGroupJobListItem{} }
+func (m *DeviceGroupJobListItem) String() string { return proto.CompactTextString(m) }
+func (*DeviceGroupJobListItem) ProtoMessage()    {}
+func (*DeviceGroupJobListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{4}
+}
+func (m *DeviceGroupJobListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceGroupJobListItem.Unmarshal(m, b)
+}
+func (m *DeviceGroupJobListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceGroupJobListItem.Marshal(b, m, deterministic)
+}
+func (dst *DeviceGroupJobListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceGroupJobListItem.Merge(dst, src)
+}
+func (m *DeviceGroupJobListItem) XXX_Size() int {
+	return xxx_messageInfo_DeviceGroupJobListItem.Size(m)
+}
+func (m *DeviceGroupJobListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceGroupJobListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceGroupJobListItem proto.InternalMessageInfo
+
+func (m *DeviceGroupJobListItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeviceGroupJobListItem) GetAction() DeviceGroupJobAction {
+	if m != nil {
+		return m.Action
+	}
+	return DeviceGroupJobAction_ENQUEUE_DOWNLINK
+}
+
+func (m *DeviceGroupJobListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceGroupJobListItem) GetProgress() *DeviceGroupJobProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type CreateDeviceGroupRequest struct {
+	// Device-group object to create.
+	DeviceGroup          *DeviceGroup `protobuf:"bytes,1,opt,name=device_group,json=deviceGroup,proto3" json:"device_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateDeviceGroupRequest) Reset()         { *m = CreateDeviceGroupRequest{} }
+func (m *CreateDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceGroupRequest) ProtoMessage()    {}
+func (*CreateDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{5}
+}
+func (m *CreateDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *CreateDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceGroupRequest.Merge(dst, src)
+}
+func (m *CreateDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceGroupRequest.Size(m)
+}
+func (m *CreateDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *CreateDeviceGroupRequest) GetDeviceGroup() *DeviceGroup {
+	if m != nil {
+		return m.DeviceGroup
+	}
+	return nil
+}
+
+type CreateDeviceGroupResponse struct {
+	// ID of the created device-group (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeviceGroupResponse) Reset()         { *m = CreateDeviceGroupResponse{} }
+func (m *CreateDeviceGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceGroupResponse) ProtoMessage()    {}
+func (*CreateDeviceGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{6}
+}
+func (m *CreateDeviceGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceGroupResponse.Unmarshal(m, b)
+}
+func (m *CreateDeviceGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceGroupResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceGroupResponse.Merge(dst, src)
+}
+func (m *CreateDeviceGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceGroupResponse.Size(m)
+}
+func (m *CreateDeviceGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceGroupResponse proto.InternalMessageInfo
+
+func (m *CreateDeviceGroupResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetDeviceGroupRequest struct {
+	// ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceGroupRequest) Reset()         { *m = GetDeviceGroupRequest{} }
+func (m *GetDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceGroupRequest) ProtoMessage()    {}
+func (*GetDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{7}
+}
+func (m *GetDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceGroupRequest.Merge(dst, src)
+}
+func (m *GetDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceGroupRequest.Size(m)
+}
+func (m *GetDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *GetDeviceGroupRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetDeviceGroupResponse struct {
+	// Device-group object.
+	DeviceGroup *DeviceGroup `protobuf:"bytes,1,opt,name=device_group,json=deviceGroup,proto3" json:"device_group,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Number of devices within the device-group.
+	DeviceCount          uint32   `protobuf:"varint,4,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceGroupResponse) Reset()         { *m = GetDeviceGroupResponse{} }
+func (m *GetDeviceGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceGroupResponse) ProtoMessage()    {}
+func (*GetDeviceGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{8}
+}
+func (m *GetDeviceGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceGroupResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceGroupResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceGroupResponse.Merge(dst, src)
+}
+func (m *GetDeviceGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceGroupResponse.Size(m)
+}
+func (m *GetDeviceGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceGroupResponse proto.InternalMessageInfo
+
+func (m *GetDeviceGroupResponse) GetDeviceGroup() *DeviceGroup {
+	if m != nil {
+		return m.DeviceGroup
+	}
+	return nil
+}
+
+func (m *GetDeviceGroupResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceGroupResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceGroupResponse) GetDeviceCount() uint32 {
+	if m != nil {
+		return m.DeviceCount
+	}
+	return 0
+}
+
+type UpdateDeviceGroupRequest struct {
+	// Device-group object to update.
+	DeviceGroup          *DeviceGroup `protobuf:"bytes,1,opt,name=device_group,json=deviceGroup,proto3" json:"device_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *UpdateDeviceGroupRequest) Reset()         { *m = UpdateDeviceGroupRequest{} }
+func (m *UpdateDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceGroupRequest) ProtoMessage()    {}
+func (*UpdateDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{9}
+}
+func (m *UpdateDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *UpdateDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceGroupRequest.Merge(dst, src)
+}
+func (m *UpdateDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceGroupRequest.Size(m)
+}
+func (m *UpdateDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceGroupRequest) GetDeviceGroup() *DeviceGroup {
+	if m != nil {
+		return m.DeviceGroup
+	}
+	return nil
+}
+
+type DeleteDeviceGroupRequest struct {
+	// ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDeviceGroupRequest) Reset()         { *m = DeleteDeviceGroupRequest{} }
+func (m *DeleteDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDeviceGroupRequest) ProtoMessage()    {}
+func (*DeleteDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{10}
+}
+func (m *DeleteDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *DeleteDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDeviceGroupRequest.Merge(dst, src)
+}
+func (m *DeleteDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDeviceGroupRequest.Size(m)
+}
+func (m *DeleteDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *DeleteDeviceGroupRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListDeviceGroupRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Application ID to filter on.
+	ApplicationId int64 `protobuf:"varint,3,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Device EUI (HEX encoded) to filter on.
+	// When set, only the device-groups containing the device are returned.
+	DevEui               string   `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceGroupRequest) Reset()         { *m = ListDeviceGroupRequest{} }
+func (m *ListDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceGroupRequest) ProtoMessage()    {}
+func (*ListDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{11}
+}
+func (m *ListDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceGroupRequest.Merge(dst, src)
+}
+func (m *ListDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceGroupRequest.Size(m)
+}
+func (m *ListDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *ListDeviceGroupRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type ListDeviceGroupResponse struct {
+	// Total number of device-groups.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Result-set.
+	Result               []*DeviceGroupListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListDeviceGroupResponse) Reset()         { *m = ListDeviceGroupResponse{} }
+func (m *ListDeviceGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceGroupResponse) ProtoMessage()    {}
+func (*ListDeviceGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{12}
+}
+func (m *ListDeviceGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceGroupResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceGroupResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceGroupResponse.Merge(dst, src)
+}
+func (m *ListDeviceGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceGroupResponse.Size(m)
+}
+func (m *ListDeviceGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceGroupResponse proto.InternalMessageInfo
+
+func (m *ListDeviceGroupResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupResponse) GetResult() []*DeviceGroupListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type AddDeviceToDeviceGroupRequest struct {
+	// Device-group ID (string formatted UUID).
+	DeviceGroupId string `protobuf:"bytes,1,opt,name=device_group_id,json=deviceGroupID,proto3" json:"device_group_id,omitempty"`
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDeviceToDeviceGroupRequest) Reset()         { *m = AddDeviceToDeviceGroupRequest{} }
+func (m *AddDeviceToDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceToDeviceGroupRequest) ProtoMessage()    {}
+func (*AddDeviceToDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{13}
+}
+func (m *AddDeviceToDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDeviceToDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *AddDeviceToDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDeviceToDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *AddDeviceToDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDeviceToDeviceGroupRequest.Merge(dst, src)
+}
+func (m *AddDeviceToDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_AddDeviceToDeviceGroupRequest.Size(m)
+}
+func (m *AddDeviceToDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDeviceToDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDeviceToDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *AddDeviceToDeviceGroupRequest) GetDeviceGroupId() string {
+	if m != nil {
+		return m.DeviceGroupId
+	}
+	return ""
+}
+
+func (m *AddDeviceToDeviceGroupRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type RemoveDeviceFromDeviceGroupRequest struct {
+	// Device-group ID (string formatted UUID).
+	DeviceGroupId string `protobuf:"bytes,1,opt,name=device_group_id,json=deviceGroupID,proto3" json:"device_group_id,omitempty"`
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveDeviceFromDeviceGroupRequest) Reset()         { *m = RemoveDeviceFromDeviceGroupRequest{} }
+func (m *RemoveDeviceFromDeviceGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceFromDeviceGroupRequest) ProtoMessage()    {}
+func (*RemoveDeviceFromDeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{14}
+}
+func (m *RemoveDeviceFromDeviceGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDeviceFromDeviceGroupRequest.Unmarshal(m, b)
+}
+func (m *RemoveDeviceFromDeviceGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDeviceFromDeviceGroupRequest.Marshal(b, m, deterministic)
+}
+func (dst *RemoveDeviceFromDeviceGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDeviceFromDeviceGroupRequest.Merge(dst, src)
+}
+func (m *RemoveDeviceFromDeviceGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveDeviceFromDeviceGroupRequest.Size(m)
+}
+func (m *RemoveDeviceFromDeviceGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDeviceFromDeviceGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDeviceFromDeviceGroupRequest proto.InternalMessageInfo
+
+func (m *RemoveDeviceFromDeviceGroupRequest) GetDeviceGroupId() string {
+	if m != nil {
+		return m.DeviceGroupId
+	}
+	return ""
+}
+
+func (m *RemoveDeviceFromDeviceGroupRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type CreateDeviceGroupJobRequest struct {
+	// Device-group job object to create.
+	Job                  *DeviceGroupJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateDeviceGroupJobRequest) Reset()         { *m = CreateDeviceGroupJobRequest{} }
+func (m *CreateDeviceGroupJobRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceGroupJobRequest) ProtoMessage()    {}
+func (*CreateDeviceGroupJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{15}
+}
+func (m *CreateDeviceGroupJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceGroupJobRequest.Unmarshal(m, b)
+}
+func (m *CreateDeviceGroupJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceGroupJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceGroupJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceGroupJobRequest.Merge(dst, src)
+}
+func (m *CreateDeviceGroupJobRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceGroupJobRequest.Size(m)
+}
+func (m *CreateDeviceGroupJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceGroupJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceGroupJobRequest proto.InternalMessageInfo
+
+func (m *CreateDeviceGroupJobRequest) GetJob() *DeviceGroupJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type CreateDeviceGroupJobResponse struct {
+	// ID of the created job (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDeviceGroupJobResponse) Reset()         { *m = CreateDeviceGroupJobResponse{} }
+func (m *CreateDeviceGroupJobResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDeviceGroupJobResponse) ProtoMessage()    {}
+func (*CreateDeviceGroupJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{16}
+}
+func (m *CreateDeviceGroupJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDeviceGroupJobResponse.Unmarshal(m, b)
+}
+func (m *CreateDeviceGroupJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDeviceGroupJobResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDeviceGroupJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDeviceGroupJobResponse.Merge(dst, src)
+}
+func (m *CreateDeviceGroupJobResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDeviceGroupJobResponse.Size(m)
+}
+func (m *CreateDeviceGroupJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDeviceGroupJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDeviceGroupJobResponse proto.InternalMessageInfo
+
+func (m *CreateDeviceGroupJobResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetDeviceGroupJobRequest struct {
+	// ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceGroupJobRequest) Reset()         { *m = GetDeviceGroupJobRequest{} }
+func (m *GetDeviceGroupJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceGroupJobRequest) ProtoMessage()    {}
+func (*GetDeviceGroupJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{17}
+}
+func (m *GetDeviceGroupJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceGroupJobRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceGroupJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceGroupJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceGroupJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceGroupJobRequest.Merge(dst, src)
+}
+func (m *GetDeviceGroupJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceGroupJobRequest.Size(m)
+}
+func (m *GetDeviceGroupJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceGroupJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceGroupJobRequest proto.InternalMessageInfo
+
+func (m *GetDeviceGroupJobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetDeviceGroupJobResponse struct {
+	// Device-group job object.
+	Job *DeviceGroupJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Progress of the job.
+	Progress             *DeviceGroupJobProgress `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetDeviceGroupJobResponse) Reset()         { *m = GetDeviceGroupJobResponse{} }
+func (m *GetDeviceGroupJobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceGroupJobResponse) ProtoMessage()    {}
+func (*GetDeviceGroupJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{18}
+}
+func (m *GetDeviceGroupJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceGroupJobResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceGroupJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceGroupJobResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceGroupJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceGroupJobResponse.Merge(dst, src)
+}
+func (m *GetDeviceGroupJobResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceGroupJobResponse.Size(m)
+}
+func (m *GetDeviceGroupJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceGroupJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceGroupJobResponse proto.InternalMessageInfo
+
+func (m *GetDeviceGroupJobResponse) GetJob() *DeviceGroupJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *GetDeviceGroupJobResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceGroupJobResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *GetDeviceGroupJobResponse) GetProgress() *DeviceGroupJobProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type ListDeviceGroupJobRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Device-group ID (string formatted UUID).
+	DeviceGroupId        string   `protobuf:"bytes,3,opt,name=device_group_id,json=deviceGroupID,proto3" json:"device_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceGroupJobRequest) Reset()         { *m = ListDeviceGroupJobRequest{} }
+func (m *ListDeviceGroupJobRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceGroupJobRequest) ProtoMessage()    {}
+func (*ListDeviceGroupJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{19}
+}
+func (m *ListDeviceGroupJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceGroupJobRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceGroupJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceGroupJobRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceGroupJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceGroupJobRequest.Merge(dst, src)
+}
+func (m *ListDeviceGroupJobRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceGroupJobRequest.Size(m)
+}
+func (m *ListDeviceGroupJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceGroupJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceGroupJobRequest proto.InternalMessageInfo
+
+func (m *ListDeviceGroupJobRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupJobRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupJobRequest) GetDeviceGroupId() string {
+	if m != nil {
+		return m.DeviceGroupId
+	}
+	return ""
+}
+
+type ListDeviceGroupJobResponse struct {
+	// Total number of jobs.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Result-set.
+	Result               []*DeviceGroupJobListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListDeviceGroupJobResponse) Reset()         { *m = ListDeviceGroupJobResponse{} }
+func (m *ListDeviceGroupJobResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceGroupJobResponse) ProtoMessage()    {}
+func (*ListDeviceGroupJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_318b433fe723324e, []int{20}
+}
+func (m *ListDeviceGroupJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceGroupJobResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceGroupJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceGroupJobResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceGroupJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceGroupJobResponse.Merge(dst, src)
+}
+func (m *ListDeviceGroupJobResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceGroupJobResponse.Size(m)
+}
+func (m *ListDeviceGroupJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceGroupJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceGroupJobResponse proto.InternalMessageInfo
+
+func (m *ListDeviceGroupJobResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceGroupJobResponse) GetResult() []*DeviceGroupJobListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeviceGroup)(nil), "api.DeviceGroup")
+	proto.RegisterMapType((map[string]string)(nil), "api.DeviceGroup.FilterTagsEntry")
+	proto.RegisterType((*DeviceGroupListItem)(nil), "api.DeviceGroupListItem")
+	proto.RegisterType((*DeviceGroupJob)(nil), "api.DeviceGroupJob")
+	proto.RegisterType((*DeviceGroupJobProgress)(nil), "api.DeviceGroupJobProgress")
+	proto.RegisterType((*DeviceGroupJobListItem)(nil), "api.DeviceGroupJobListItem")
+	proto.RegisterType((*CreateDeviceGroupRequest)(nil), "api.CreateDeviceGroupRequest")
+	proto.RegisterType((*CreateDeviceGroupResponse)(nil), "api.CreateDeviceGroupResponse")
+	proto.RegisterType((*GetDeviceGroupRequest)(nil), "api.GetDeviceGroupRequest")
+	proto.RegisterType((*GetDeviceGroupResponse)(nil), "api.GetDeviceGroupResponse")
+	proto.RegisterType((*UpdateDeviceGroupRequest)(nil), "api.UpdateDeviceGroupRequest")
+	proto.RegisterType((*DeleteDeviceGroupRequest)(nil), "api.DeleteDeviceGroupRequest")
+	proto.RegisterType((*ListDeviceGroupRequest)(nil), "api.ListDeviceGroupRequest")
+	proto.RegisterType((*ListDeviceGroupResponse)(nil), "api.ListDeviceGroupResponse")
+	proto.RegisterType((*AddDeviceToDeviceGroupRequest)(nil), "api.AddDeviceToDeviceGroupRequest")
+	proto.RegisterType((*RemoveDeviceFromDeviceGroupRequest)(nil), "api.RemoveDeviceFromDeviceGroupRequest")
+	proto.RegisterType((*CreateDeviceGroupJobRequest)(nil), "api.CreateDeviceGroupJobRequest")
+	proto.RegisterType((*CreateDeviceGroupJobResponse)(nil), "api.CreateDeviceGroupJobResponse")
+	proto.RegisterType((*GetDeviceGroupJobRequest)(nil), "api.GetDeviceGroupJobRequest")
+	proto.RegisterType((*GetDeviceGroupJobResponse)(nil), "api.GetDeviceGroupJobResponse")
+	proto.RegisterType((*ListDeviceGroupJobRequest)(nil), "api.ListDeviceGroupJobRequest")
+	proto.RegisterType((*ListDeviceGroupJobResponse)(nil), "api.ListDeviceGroupJobResponse")
+	proto.RegisterEnum("api.DeviceGroupJobAction", DeviceGroupJobAction_name, DeviceGroupJobAction_value)
+	proto.RegisterEnum("api.DeviceGroupJobStatus", DeviceGroupJobStatus_name, DeviceGroupJobStatus_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeviceGroupServiceClient is the client API for DeviceGroupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeviceGroupServiceClient interface {
+	// Create creates the given device-group.
+	Create(ctx context.Context, in *CreateDeviceGroupRequest, opts ...grpc.CallOption) (*CreateDeviceGroupResponse, error)
+	// Get returns the device-group matching the given ID.
+	Get(ctx context.Context, in *GetDeviceGroupRequest, opts ...grpc.CallOption) (*GetDeviceGroupResponse, error)
+	// Update updates the given device-group.
+	Update(ctx context.Context, in *UpdateDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the device-group matching the given ID.
+	// This does not delete the devices of the device-group.
+	Delete(ctx context.Context, in *DeleteDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the device-groups of the given application.
+	List(ctx context.Context, in *ListDeviceGroupRequest, opts ...grpc.CallOption) (*ListDeviceGroupResponse, error)
+	// AddDevice adds the given device to the (static) device-group.
+	AddDevice(ctx context.Context, in *AddDeviceToDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// RemoveDevice removes the given device from the (static) device-group.
+	RemoveDevice(ctx context.Context, in *RemoveDeviceFromDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateJob creates a job executing the given action for each device
+	// of the device-group. The job is executed in the background.
+	CreateJob(ctx context.Context, in *CreateDeviceGroupJobRequest, opts ...grpc.CallOption) (*CreateDeviceGroupJobResponse, error)
+	// GetJob returns the device-group job (and its progress) matching the
+	// given ID.
+	GetJob(ctx context.Context, in *GetDeviceGroupJobRequest, opts ...grpc.CallOption) (*GetDeviceGroupJobResponse, error)
+	// ListJobs lists the jobs of the given device-group.
+	ListJobs(ctx context.Context, in *ListDeviceGroupJobRequest, opts ...grpc.CallOption) (*ListDeviceGroupJobResponse, error)
+}
+
+type deviceGroupServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeviceGroupServiceClient(cc *grpc.ClientConn) DeviceGroupServiceClient {
+	return &deviceGroupServiceClient{cc}
+}
+
+func (c *deviceGroupServiceClient) Create(ctx context.Context, in *CreateDeviceGroupRequest, opts ...grpc.CallOption) (*CreateDeviceGroupResponse, error) {
+	out := new(CreateDeviceGroupResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) Get(ctx context.Context, in *GetDeviceGroupRequest, opts ...grpc.CallOption) (*GetDeviceGroupResponse, error) {
+	out := new(GetDeviceGroupResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) Update(ctx context.Context, in *UpdateDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) Delete(ctx context.Context, in *DeleteDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) List(ctx context.Context, in *ListDeviceGroupRequest, opts ...grpc.CallOption) (*ListDeviceGroupResponse, error) {
+	out := new(ListDeviceGroupResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) AddDevice(ctx context.Context, in *AddDeviceToDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/AddDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) RemoveDevice(ctx context.Context, in *RemoveDeviceFromDeviceGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/RemoveDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) CreateJob(ctx context.Context, in *CreateDeviceGroupJobRequest, opts ...grpc.CallOption) (*CreateDeviceGroupJobResponse, error) {
+	out := new(CreateDeviceGroupJobResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/CreateJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) GetJob(ctx context.Context, in *GetDeviceGroupJobRequest, opts ...grpc.CallOption) (*GetDeviceGroupJobResponse, error) {
+	out := new(GetDeviceGroupJobResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceGroupServiceClient) ListJobs(ctx context.Context, in *ListDeviceGroupJobRequest, opts ...grpc.CallOption) (*ListDeviceGroupJobResponse, error) {
+	out := new(ListDeviceGroupJobResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceGroupService/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceGroupServiceServer is the server API for DeviceGroupService service.
+type DeviceGroupServiceServer interface {
+	// Create creates the given device-group.
+	Create(context.Context, *CreateDeviceGroupRequest) (*CreateDeviceGroupResponse, error)
+	// Get returns the device-group matching the given ID.
+	Get(context.Context, *GetDeviceGroupRequest) (*GetDeviceGroupResponse, error)
+	// Update updates the given device-group.
+	Update(context.Context, *UpdateDeviceGroupRequest) (*empty.Empty, error)
+	// Delete deletes the device-group matching the given ID.
+	// This does not delete the devices of the device-group.
+	Delete(context.Context, *DeleteDeviceGroupRequest) (*empty.Empty, error)
+	// List lists the device-groups of the given application.
+	List(context.Context, *ListDeviceGroupRequest) (*ListDeviceGroupResponse, error)
+	// AddDevice adds the given device to the (static) device-group.
+	AddDevice(context.Context, *AddDeviceToDeviceGroupRequest) (*empty.Empty, error)
+	// RemoveDevice removes the given device from the (static) device-group.
+	RemoveDevice(context.Context, *RemoveDeviceFromDeviceGroupRequest) (*empty.Empty, error)
+	// CreateJob creates a job executing the given action for each device
+	// of the device-group. The job is executed in the background.
+	CreateJob(context.Context, *CreateDeviceGroupJobRequest) (*CreateDeviceGroupJobResponse, error)
+	// GetJob returns the device-group job (and its progress) matching the
+	// given ID.
+	GetJob(context.Context, *GetDeviceGroupJobRequest) (*GetDeviceGroupJobResponse, error)
+	// ListJobs lists the jobs of the given device-group.
+	ListJobs(context.Context, *ListDeviceGroupJobRequest) (*ListDeviceGroupJobResponse, error)
+}
+
+func RegisterDeviceGroupServiceServer(s *grpc.Server, srv DeviceGroupServiceServer) {
+	s.RegisterService(&_DeviceGroupService_serviceDesc, srv)
+}
+
+func _DeviceGroupService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).Create(ctx, req.(*CreateDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).Get(ctx, req.(*GetDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).Update(ctx, req.(*UpdateDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).Delete(ctx, req.(*DeleteDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).List(ctx, req.(*ListDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_AddDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeviceToDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).AddDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/AddDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).AddDevice(ctx, req.(*AddDeviceToDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_RemoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceFromDeviceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).RemoveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/RemoveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).RemoveDevice(ctx, req.(*RemoveDeviceFromDeviceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceGroupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).CreateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/CreateJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).CreateJob(ctx, req.(*CreateDeviceGroupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceGroupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).GetJob(ctx, req.(*GetDeviceGroupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceGroupService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceGroupJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceGroupServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceGroupService/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceGroupServiceServer).ListJobs(ctx, req.(*ListDeviceGroupJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeviceGroupService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DeviceGroupService",
+	HandlerType: (*DeviceGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DeviceGroupService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DeviceGroupService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DeviceGroupService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DeviceGroupService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DeviceGroupService_List_Handler,
+		},
+		{
+			MethodName: "AddDevice",
+			Handler:    _DeviceGroupService_AddDevice_Handler,
+		},
+		{
+			MethodName: "RemoveDevice",
+			Handler:    _DeviceGroupService_RemoveDevice_Handler,
+		},
+		{
+			MethodName: "CreateJob",
+			Handler:    _DeviceGroupService_CreateJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _DeviceGroupService_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _DeviceGroupService_ListJobs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "deviceGroup.proto",
+}
+
+func init() { proto.RegisterFile("deviceGroup.proto", fileDescriptor_318b433fe723324e) }
+
+var fileDescriptor_318b433fe723324e = []byte{
+	// 1432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xdb, 0xd6,
+	0x12, 0x0e, 0x45, 0x5b, 0xb1, 0x46, 0xfe, 0x51, 0x4e, 0xfc, 0x43, 0xd3, 0x76, 0xac, 0xf0, 0xde,
+	0xdc, 0x38, 0x4a, 0x22, 0xdf, 0x38, 0xc0, 0xcd, 0x0f, 0x90, 0x0b, 0xa8, 0x16, 0xed, 0xc8, 0x71,
+	0x64, 0x97, 0xb6, 0xda, 0x55, 0xc1, 0x50, 0xe2, 0x91, 0xc1, 0x54, 0xd2, 0x61, 0xc8, 0x23, 0x03,
+	0x46, 0xe0, 0xa2, 0xe8, 0xa2, 0x28, 0xba, 0xe9, 0xa2, 0x5d, 0x17, 0x7d, 0xa3, 0x2c, 0xfa, 0x0a,
+	0x45, 0xf7, 0x7d, 0x83, 0xe2, 0x1c, 0x1e, 0xc9, 0x34, 0x75, 0x98, 0xd8, 0x41, 0x8b, 0xee, 0xc8,
+	0x99, 0xcf, 0x33, 0xdf, 0x7c, 0x33, 0xc3, 0xb1, 0xe0, 0x9a, 0x8b, 0x8f, 0xbd, 0x16, 0xde, 0x0e,
+	0x48, 0xdf, 0x2f, 0xfb, 0x01, 0xa1, 0x04, 0xa9, 0x8e, 0xef, 0xe9, 0xcb, 0x47, 0x84, 0x1c, 0x75,
+	0xf0, 0xba, 0xe3, 0x7b, 0xeb, 0x4e, 0xaf, 0x47, 0xa8, 0x43, 0x3d, 0xd2, 0x0b, 0x23, 0x88, 0xbe,
+	0x2a, 0xbc, 0xfc, 0xad, 0xd9, 0x6f, 0xaf, 0x53, 0xaf, 0x8b, 0x43, 0xea, 0x74, 0x45, 0x0c, 0x7d,
+	0x29, 0x09, 0xc0, 0x5d, 0x9f, 0x9e, 0x44, 0x4e, 0xe3, 0x5d, 0x06, 0xf2, 0xd5, 0xb3, 0xb4, 0x68,
+	0x1a, 0x32, 0x9e, 0xab, 0x29, 0x45, 0x65, 0x2d, 0x67, 0x65, 0x3c, 0x17, 0xdd, 0x82, 0x69, 0xc7,
+	0xf7, 0x3b, 0x5e, 0x8b, 0xe7, 0xb4, 0x3d, 0x57, 0xcb, 0x14, 0x95, 0x35, 0xd5, 0x9a, 0x8a, 0x59,
+	0x6b, 0x55, 0x84, 0x60, 0xac, 0xe7, 0x74, 0xb1, 0xa6, 0xf2, 0x3f, 0xe4, 0xcf, 0xa8, 0x08, 0x79,
+	0x17, 0x87, 0xad, 0xc0, 0xf3, 0x19, 0x48, 0x1b, 0xe3, 0xae, 0xb8, 0x09, 0x69, 0x70, 0xd5, 0x3d,
+	0xe9, 0x39, 0x5d, 0xaf, 0xa5, 0x8d, 0x17, 0x95, 0xb5, 0x09, 0x6b, 0xf0, 0x8a, 0x2a, 0x90, 0x6f,
+	0x7b, 0x1d, 0x8a, 0x03, 0x9b, 0x3a, 0x47, 0xa1, 0x96, 0x2d, 0xaa, 0x6b, 0xf9, 0x8d, 0x62, 0xd9,
+	0xf1, 0xbd, 0x72, 0x8c, 0x6d, 0x79, 0x8b, 0x63, 0x0e, 0x9d, 0xa3, 0xd0, 0xec, 0xd1, 0xe0, 0xc4,
+	0x82, 0xf6, 0xd0, 0x80, 0x1e, 0x81, 0x26, 0x42, 0x44, 0xb2, 0xda, 0x7e, 0x40, 0xda, 0x5e, 0x07,
+	0xb3, 0x1a, 0xae, 0x72, 0x2e, 0x73, 0x91, 0x3f, 0x0a, 0xb8, 0x1f, 0x79, 0x6b, 0x55, 0xfd, 0x19,
+	0xcc, 0x24, 0xe2, 0xa2, 0x02, 0xa8, 0x5f, 0xe2, 0x13, 0x21, 0x0b, 0x7b, 0x44, 0xb3, 0x30, 0x7e,
+	0xec, 0x74, 0xfa, 0x98, 0xcb, 0x91, 0xb3, 0xa2, 0x97, 0xa7, 0x99, 0xc7, 0x8a, 0xd1, 0x87, 0xeb,
+	0x31, 0x8a, 0xbb, 0x5e, 0x48, 0x6b, 0x14, 0x77, 0x47, 0x84, 0x1d, 0x28, 0x96, 0x49, 0x57, 0x4c,
+	0x7d, 0xaf, 0x62, 0x63, 0xe7, 0x14, 0x33, 0x7e, 0xce, 0xc0, 0x74, 0x2c, 0xef, 0x0e, 0x69, 0x8e,
+	0xa4, 0xfc, 0x0f, 0xcc, 0x08, 0x29, 0x8e, 0x18, 0x64, 0xd0, 0xcc, 0x9c, 0x35, 0x15, 0x1b, 0xbc,
+	0x5a, 0x15, 0x3d, 0x80, 0xac, 0xd3, 0x1a, 0x32, 0x98, 0xde, 0x58, 0x4c, 0xea, 0xbe, 0x43, 0x9a,
+	0x15, 0x0e, 0xb0, 0x04, 0x10, 0xcd, 0x41, 0xb6, 0x6d, 0xfb, 0x24, 0xa0, 0x9c, 0xd6, 0x94, 0x35,
+	0xde, 0xde, 0x27, 0x01, 0x45, 0xcb, 0x90, 0x6b, 0x91, 0x5e, 0xdb, 0x0b, 0xba, 0xd8, 0x15, 0x2d,
+	0x3e, 0x33, 0x30, 0x09, 0x5c, 0x87, 0x3a, 0x5a, 0xb6, 0xa8, 0xac, 0x4d, 0x5a, 0xfc, 0x19, 0x95,
+	0xe0, 0x5a, 0x5a, 0xbb, 0x66, 0xdc, 0xf3, 0x8d, 0x42, 0xf7, 0x00, 0x75, 0xfb, 0x1d, 0xea, 0xb5,
+	0x9c, 0x90, 0x9e, 0x95, 0x34, 0xc1, 0xc1, 0x85, 0xa1, 0x47, 0x54, 0x65, 0xfc, 0x94, 0x81, 0xf9,
+	0xf3, 0x35, 0xec, 0x07, 0xe4, 0x28, 0xc0, 0x61, 0xc8, 0x0a, 0x0e, 0xa9, 0x43, 0xfb, 0xa1, 0xa6,
+	0xa4, 0x16, 0x7c, 0xc0, 0x01, 0x96, 0x00, 0xa2, 0x55, 0xc8, 0x53, 0x42, 0x9d, 0x8e, 0xdd, 0x22,
+	0xfd, 0x1e, 0xe5, 0x3a, 0x4e, 0x59, 0xc0, 0x4d, 0x9b, 0xcc, 0x82, 0x6e, 0xc3, 0x8c, 0x1f, 0x90,
+	0x16, 0x0e, 0x43, 0xec, 0x0a, 0x90, 0xca, 0x41, 0xd3, 0x43, 0x73, 0x04, 0x5c, 0x85, 0x3c, 0x0e,
+	0x02, 0x12, 0x08, 0x50, 0xa4, 0x1f, 0x70, 0x53, 0x04, 0x58, 0x01, 0xe8, 0xb0, 0x0a, 0xb9, 0x89,
+	0xab, 0x98, 0xb3, 0x72, 0xcc, 0x62, 0x32, 0x03, 0x7a, 0x06, 0x93, 0x2d, 0xd2, 0xf5, 0x3b, 0x98,
+	0x62, 0xd7, 0x76, 0x28, 0x57, 0x33, 0xbf, 0xa1, 0x97, 0xa3, 0xad, 0x2f, 0x0f, 0xb6, 0xbe, 0x7c,
+	0x38, 0xf8, 0x2c, 0x58, 0xf9, 0x21, 0xbe, 0x42, 0x8d, 0x77, 0x4a, 0x52, 0x96, 0xd4, 0x91, 0x3d,
+	0x9b, 0x8b, 0xcc, 0x45, 0xe7, 0xe2, 0x09, 0x40, 0x2b, 0xc0, 0x8e, 0xa0, 0xa6, 0x7e, 0x90, 0x5a,
+	0x4e, 0xa0, 0x2b, 0x14, 0x3d, 0x82, 0x09, 0x5f, 0x34, 0x88, 0x8b, 0x92, 0xdf, 0x58, 0x92, 0xe4,
+	0x1b, 0xf4, 0xd0, 0x1a, 0x82, 0x8d, 0x3d, 0xd0, 0x36, 0x79, 0x94, 0x18, 0xd2, 0xc2, 0x6f, 0xfa,
+	0x38, 0xa4, 0xe8, 0x21, 0x4c, 0xc6, 0x57, 0x80, 0x17, 0x97, 0xdf, 0x28, 0x24, 0x03, 0xb3, 0xa5,
+	0x1b, 0xbe, 0x18, 0x77, 0x61, 0x51, 0x12, 0x30, 0xf4, 0x49, 0x2f, 0xc4, 0x49, 0x91, 0x8c, 0xdb,
+	0x30, 0xb7, 0x8d, 0xa9, 0x24, 0x75, 0x12, 0xf8, 0xbb, 0x02, 0xf3, 0x49, 0xa4, 0x88, 0xf9, 0x31,
+	0x2c, 0x13, 0x52, 0x67, 0x2e, 0x23, 0xf5, 0x13, 0x80, 0xbe, 0xef, 0x5e, 0xa2, 0x4b, 0x02, 0x5d,
+	0xa1, 0xe8, 0xe6, 0x90, 0x6a, 0x7c, 0x7c, 0x05, 0x31, 0x3e, 0xbf, 0xac, 0x1f, 0x0d, 0x8e, 0xff,
+	0xab, 0xfa, 0x51, 0x02, 0xad, 0x8a, 0xd9, 0xfc, 0x5e, 0x40, 0xe5, 0x6f, 0x15, 0x98, 0x67, 0x03,
+	0x2d, 0x81, 0xce, 0xc2, 0x78, 0xc7, 0xeb, 0x7a, 0x94, 0xa3, 0x55, 0x2b, 0x7a, 0x41, 0xf3, 0x90,
+	0x25, 0xed, 0x76, 0x88, 0xa9, 0x38, 0x74, 0xe2, 0x4d, 0x72, 0x08, 0x55, 0xd9, 0x21, 0x5c, 0x80,
+	0xab, 0x2e, 0x3e, 0xb6, 0x71, 0xdf, 0x13, 0x07, 0x2f, 0xeb, 0xe2, 0x63, 0xb3, 0x51, 0x33, 0x3a,
+	0xb0, 0x30, 0xc2, 0x43, 0xb4, 0x3b, 0xf1, 0x2d, 0x89, 0xe8, 0xc4, 0xbf, 0x25, 0xff, 0x85, 0x6c,
+	0x80, 0xc3, 0x7e, 0x87, 0x71, 0x62, 0x87, 0x50, 0x4b, 0xea, 0x33, 0x58, 0x59, 0x4b, 0xe0, 0x8c,
+	0x57, 0xb0, 0x52, 0x71, 0xdd, 0x08, 0x71, 0x48, 0x24, 0xc5, 0x4b, 0x6e, 0x81, 0x22, 0xbb, 0x05,
+	0xb1, 0x7a, 0x32, 0xe7, 0xea, 0xc1, 0x60, 0x58, 0xb8, 0x4b, 0x8e, 0x45, 0x13, 0xb6, 0x02, 0xd2,
+	0xfd, 0x3b, 0xd2, 0x54, 0x61, 0x69, 0x64, 0xf7, 0x76, 0x48, 0x73, 0x10, 0xff, 0x16, 0xa8, 0xaf,
+	0x49, 0x53, 0x8c, 0xcd, 0x75, 0xc9, 0xf7, 0xc1, 0x62, 0x7e, 0xa3, 0x0c, 0xcb, 0xf2, 0x28, 0x29,
+	0x4b, 0x5c, 0x02, 0xed, 0xfc, 0x6a, 0xc6, 0x52, 0x26, 0xb1, 0x7f, 0x28, 0xb0, 0x28, 0x01, 0x8b,
+	0xc8, 0x17, 0x23, 0xf8, 0x0f, 0x2d, 0xef, 0x47, 0x7f, 0x62, 0xdf, 0xc0, 0x62, 0x62, 0x98, 0x63,
+	0x02, 0x5d, 0x6e, 0xaf, 0x24, 0x13, 0xa2, 0x4a, 0x26, 0xc4, 0x08, 0x40, 0x97, 0xa5, 0xbc, 0xe8,
+	0x0a, 0x3d, 0x4c, 0xac, 0x90, 0xac, 0xd0, 0xe4, 0x16, 0x95, 0xbe, 0x53, 0x60, 0x56, 0x76, 0xde,
+	0xd0, 0x2c, 0x14, 0xcc, 0xfa, 0xa7, 0x0d, 0xb3, 0x61, 0xda, 0xd5, 0xbd, 0xcf, 0xeb, 0xbb, 0xb5,
+	0xfa, 0x8b, 0xc2, 0x15, 0xb4, 0x08, 0x73, 0x9b, 0xcf, 0x2b, 0xf5, 0x6d, 0xd3, 0xae, 0x9a, 0x9f,
+	0xd5, 0x36, 0x4d, 0x7b, 0xdf, 0xda, 0xdb, 0xaa, 0xed, 0x9a, 0x05, 0x05, 0xe9, 0x30, 0x5f, 0xa9,
+	0x56, 0xed, 0xc3, 0x3d, 0xfb, 0x65, 0x63, 0xf7, 0xb0, 0xb6, 0x59, 0x39, 0x38, 0xb4, 0xb7, 0xad,
+	0xbd, 0xc6, 0x7e, 0x21, 0x83, 0x66, 0x20, 0xbf, 0xb5, 0xdb, 0x38, 0x78, 0x6e, 0xf3, 0x80, 0x05,
+	0x15, 0x21, 0x98, 0xae, 0x9a, 0xbb, 0xe6, 0xe1, 0x20, 0xce, 0x41, 0x61, 0xac, 0xf4, 0x02, 0x66,
+	0x65, 0xff, 0x8f, 0xb0, 0x3f, 0xde, 0xd9, 0xfb, 0xc4, 0xde, 0x37, 0xeb, 0xd5, 0x5a, 0x7d, 0xbb,
+	0x70, 0x65, 0x60, 0xb0, 0x1a, 0xf5, 0x3a, 0x33, 0x28, 0xe8, 0x1a, 0x4c, 0x31, 0xc3, 0xe6, 0xde,
+	0xcb, 0x7d, 0x16, 0xb3, 0x5a, 0xc8, 0x6c, 0xfc, 0x92, 0x03, 0x14, 0x8b, 0x76, 0x80, 0x03, 0xf6,
+	0x8c, 0xda, 0x90, 0x8d, 0xb6, 0x04, 0xad, 0x70, 0x75, 0xd2, 0xae, 0xa8, 0x7e, 0x23, 0xcd, 0x1d,
+	0x75, 0xc3, 0x58, 0xf9, 0xe6, 0xd7, 0xdf, 0x7e, 0xcc, 0x2c, 0x18, 0x88, 0xff, 0x64, 0x89, 0xfa,
+	0x78, 0x9f, 0xb7, 0x37, 0x7c, 0xaa, 0x94, 0x90, 0x0d, 0xea, 0x36, 0xa6, 0x48, 0xe7, 0x51, 0xa4,
+	0xc7, 0x52, 0x5f, 0x92, 0xfa, 0x44, 0xf8, 0x55, 0x1e, 0x7e, 0x11, 0x2d, 0x8c, 0x86, 0x5f, 0x7f,
+	0xeb, 0xb9, 0xa7, 0xc8, 0x87, 0x6c, 0x74, 0x71, 0x44, 0x21, 0x69, 0xe7, 0x47, 0x9f, 0x1f, 0xd9,
+	0x13, 0x93, 0xfd, 0x36, 0x32, 0xd6, 0x79, 0x86, 0x3b, 0xfa, 0xbf, 0x65, 0x19, 0xe2, 0xe3, 0x5a,
+	0xf6, 0xdc, 0x53, 0x56, 0xd2, 0x2b, 0xc8, 0x46, 0x27, 0x49, 0x64, 0x4c, 0xbb, 0x4f, 0xa9, 0x19,
+	0x45, 0x4d, 0xa5, 0xd4, 0x9a, 0xbe, 0x80, 0x31, 0x36, 0x9f, 0x28, 0x52, 0x46, 0x7e, 0xd2, 0xf4,
+	0x65, 0xb9, 0x53, 0xe8, 0xa6, 0xf3, 0x1c, 0xb3, 0x48, 0xd2, 0x16, 0xf4, 0xb5, 0x02, 0xb9, 0xe1,
+	0xc5, 0x40, 0x06, 0x8f, 0xf3, 0xde, 0x0b, 0x92, 0x5a, 0xc9, 0x23, 0x9e, 0xe5, 0x81, 0x71, 0xef,
+	0x43, 0xda, 0xd9, 0x9e, 0x7b, 0x2a, 0xfc, 0x7c, 0x2c, 0x7e, 0x50, 0x60, 0x32, 0x7e, 0x52, 0xd0,
+	0x6d, 0xce, 0xe2, 0xc3, 0x57, 0x26, 0x95, 0xca, 0xff, 0x39, 0x95, 0xc7, 0xa5, 0xff, 0x5d, 0x86,
+	0xca, 0xfa, 0x5b, 0x71, 0x89, 0x4e, 0xd1, 0xf7, 0x0a, 0xe4, 0xa2, 0x29, 0x67, 0x3f, 0xa7, 0x8a,
+	0xf2, 0xa9, 0x3f, 0xfb, 0xf2, 0xe9, 0x37, 0xdf, 0x83, 0x10, 0x3d, 0x78, 0xcc, 0x29, 0x6d, 0x18,
+	0xf7, 0x65, 0x94, 0x5e, 0x93, 0x66, 0x79, 0x84, 0xd6, 0x6b, 0xd2, 0xe4, 0xf2, 0x74, 0x20, 0xbb,
+	0x8d, 0x29, 0x23, 0xb2, 0x22, 0x59, 0x8e, 0x18, 0x8b, 0x1b, 0x69, 0x6e, 0x41, 0xe1, 0x5f, 0x9c,
+	0xc2, 0x0a, 0x5a, 0x1a, 0xa1, 0x70, 0x9f, 0xe5, 0x8a, 0xc6, 0xed, 0x2b, 0x98, 0x60, 0x63, 0xb4,
+	0x43, 0x9a, 0x21, 0xba, 0x21, 0x9b, 0xaa, 0x58, 0xc2, 0xd5, 0x54, 0xbf, 0xc8, 0xf8, 0x80, 0x67,
+	0xbc, 0x8b, 0xee, 0x5c, 0xa8, 0x0f, 0x8c, 0x44, 0x33, 0xcb, 0x5b, 0xf9, 0xf0, 0xcf, 0x01, 0x00,
+	0xa1, 0xa9, 0x85, 0x20, 0x14, 0x11, 0x00, 0x00,
+}
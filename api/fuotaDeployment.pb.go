@@ -0,0 +1,1034 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fuotaDeployment.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type FUOTADeployment struct {
+	// FUOTA deployment ID.
+	// This will be generated automatically on create.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,2,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Multicast-group ID (optional).
+	// When set, the fragments will be sent using this multicast-group,
+	// else the fragments will be sent to each device (unicast).
+	MulticastGroupId string `protobuf:"bytes,3,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	// Name of the deployment.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Payload (e.g. the firmware image) to transfer.
+	// This value is not returned by Get.
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Fragment size (bytes).
+	FragSize uint32 `protobuf:"varint,6,opt,name=frag_size,json=fragSize,proto3" json:"frag_size,omitempty"`
+	// Number of redundancy (parity) fragments.
+	Redundancy uint32 `protobuf:"varint,7,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	// Fragmentation session index (0 - 3).
+	FragIndex uint32 `protobuf:"varint,8,opt,name=frag_index,json=fragIndex,proto3" json:"frag_index,omitempty"`
+	// Multicast-group index (0 - 3) as known by the devices.
+	// This is only used when a multicast-group is set.
+	McGroupId uint32 `protobuf:"varint,9,opt,name=mc_group_id,json=mcGroupID,proto3" json:"mc_group_id,omitempty"`
+	// Block ack delay (0 - 7).
+	BlockAckDelay uint32 `protobuf:"varint,10,opt,name=block_ack_delay,json=blockAckDelay,proto3" json:"block_ack_delay,omitempty"`
+	// Descriptor (HEX encoded, 4 bytes).
+	// This is an application specific value, e.g. the firmware version.
+	Descriptor_ string `protobuf:"bytes,11,opt,name=descriptor,proto3" json:"descriptor,omitempty"`
+	// Timeout (seconds) of each deployment step.
+	Timeout uint32 `protobuf:"varint,12,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Deployment state.
+	// This value is set by the server.
+	State string `protobuf:"bytes,13,opt,name=state,proto3" json:"state,omitempty"`
+	// Next step after.
+	// This value is set by the server.
+	NextStepAfter        *timestamp.Timestamp `protobuf:"bytes,14,opt,name=next_step_after,json=nextStepAfter,proto3" json:"next_step_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FUOTADeployment) Reset()         { *m = FUOTADeployment{} }
+func (m *FUOTADeployment) String() string { return proto.CompactTextString(m) }
+func (*FUOTADeployment) ProtoMessage()    {}
+func (*FUOTADeployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{0}
+}
+func (m *FUOTADeployment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FUOTADeployment.Unmarshal(m, b)
+}
+func (m *FUOTADeployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FUOTADeployment.Marshal(b, m, deterministic)
+}
+func (dst *FUOTADeployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTADeployment.Merge(dst, src)
+}
+func (m *FUOTADeployment) XXX_Size() int {
+	return xxx_messageInfo_FUOTADeployment.Size(m)
+}
+func (m *FUOTADeployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTADeployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTADeployment proto.InternalMessageInfo
+
+func (m *FUOTADeployment) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+func (m *FUOTADeployment) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FUOTADeployment) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FUOTADeployment) GetFragSize() uint32 {
+	if m != nil {
+		return m.FragSize
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetRedundancy() uint32 {
+	if m != nil {
+		return m.Redundancy
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetFragIndex() uint32 {
+	if m != nil {
+		return m.FragIndex
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetMcGroupId() uint32 {
+	if m != nil {
+		return m.McGroupId
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetBlockAckDelay() uint32 {
+	if m != nil {
+		return m.BlockAckDelay
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetDescriptor_() string {
+	if m != nil {
+		return m.Descriptor_
+	}
+	return ""
+}
+
+func (m *FUOTADeployment) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FUOTADeployment) GetNextStepAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextStepAfter
+	}
+	return nil
+}
+
+type FUOTADeploymentListItem struct {
+	// FUOTA deployment ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Name of the deployment.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Deployment state.
+	State string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	// Next step after.
+	NextStepAfter        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=next_step_after,json=nextStepAfter,proto3" json:"next_step_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FUOTADeploymentListItem) Reset()         { *m = FUOTADeploymentListItem{} }
+func (m *FUOTADeploymentListItem) String() string { return proto.CompactTextString(m) }
+func (*FUOTADeploymentListItem) ProtoMessage()    {}
+func (*FUOTADeploymentListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{1}
+}
+func (m *FUOTADeploymentListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FUOTADeploymentListItem.Unmarshal(m, b)
+}
+func (m *FUOTADeploymentListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FUOTADeploymentListItem.Marshal(b, m, deterministic)
+}
+func (dst *FUOTADeploymentListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTADeploymentListItem.Merge(dst, src)
+}
+func (m *FUOTADeploymentListItem) XXX_Size() int {
+	return xxx_messageInfo_FUOTADeploymentListItem.Size(m)
+}
+func (m *FUOTADeploymentListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTADeploymentListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTADeploymentListItem proto.InternalMessageInfo
+
+func (m *FUOTADeploymentListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *FUOTADeploymentListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *FUOTADeploymentListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentListItem) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentListItem) GetNextStepAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextStepAfter
+	}
+	return nil
+}
+
+type FUOTADeploymentDeviceListItem struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Device state.
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// Error message (set when the state is ERROR).
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Number of fragments received, as reported by the device.
+	NbFragReceived uint32 `protobuf:"varint,5,opt,name=nb_frag_received,json=nbFragReceived,proto3" json:"nb_frag_received,omitempty"`
+	// Number of missing fragments, as reported by the device.
+	MissingFrag uint32 `protobuf:"varint,6,opt,name=missing_frag,json=missingFrag,proto3" json:"missing_frag,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FUOTADeploymentDeviceListItem) Reset()         { *m = FUOTADeploymentDeviceListItem{} }
+func (m *FUOTADeploymentDeviceListItem) String() string { return proto.CompactTextString(m) }
+func (*FUOTADeploymentDeviceListItem) ProtoMessage()    {}
+func (*FUOTADeploymentDeviceListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{2}
+}
+func (m *FUOTADeploymentDeviceListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FUOTADeploymentDeviceListItem.Unmarshal(m, b)
+}
+func (m *FUOTADeploymentDeviceListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FUOTADeploymentDeviceListItem.Marshal(b, m, deterministic)
+}
+func (dst *FUOTADeploymentDeviceListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FUOTADeploymentDeviceListItem.Merge(dst, src)
+}
+func (m *FUOTADeploymentDeviceListItem) XXX_Size() int {
+	return xxx_messageInfo_FUOTADeploymentDeviceListItem.Size(m)
+}
+func (m *FUOTADeploymentDeviceListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FUOTADeploymentDeviceListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FUOTADeploymentDeviceListItem proto.InternalMessageInfo
+
+func (m *FUOTADeploymentDeviceListItem) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetNbFragReceived() uint32 {
+	if m != nil {
+		return m.NbFragReceived
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetMissingFrag() uint32 {
+	if m != nil {
+		return m.MissingFrag
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *FUOTADeploymentDeviceListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type CreateFUOTADeploymentRequest struct {
+	// FUOTA deployment object to create.
+	FuotaDeployment *FUOTADeployment `protobuf:"bytes,1,opt,name=fuota_deployment,json=fuotaDeployment,proto3" json:"fuota_deployment,omitempty"`
+	// Device EUIs (HEX encoded) of the devices to include in the deployment.
+	// When empty and a multicast-group is set, the devices of the
+	// multicast-group will be used.
+	DevEuis              []string `protobuf:"bytes,2,rep,name=dev_euis,json=devEUIs,proto3" json:"dev_euis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateFUOTADeploymentRequest) Reset()         { *m = CreateFUOTADeploymentRequest{} }
+func (m *CreateFUOTADeploymentRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateFUOTADeploymentRequest) ProtoMessage()    {}
+func (*CreateFUOTADeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{3}
+}
+func (m *CreateFUOTADeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateFUOTADeploymentRequest.Unmarshal(m, b)
+}
+func (m *CreateFUOTADeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateFUOTADeploymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateFUOTADeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFUOTADeploymentRequest.Merge(dst, src)
+}
+func (m *CreateFUOTADeploymentRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateFUOTADeploymentRequest.Size(m)
+}
+func (m *CreateFUOTADeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFUOTADeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFUOTADeploymentRequest proto.InternalMessageInfo
+
+func (m *CreateFUOTADeploymentRequest) GetFuotaDeployment() *FUOTADeployment {
+	if m != nil {
+		return m.FuotaDeployment
+	}
+	return nil
+}
+
+func (m *CreateFUOTADeploymentRequest) GetDevEuis() []string {
+	if m != nil {
+		return m.DevEuis
+	}
+	return nil
+}
+
+type CreateFUOTADeploymentResponse struct {
+	// ID of the created FUOTA deployment.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateFUOTADeploymentResponse) Reset()         { *m = CreateFUOTADeploymentResponse{} }
+func (m *CreateFUOTADeploymentResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateFUOTADeploymentResponse) ProtoMessage()    {}
+func (*CreateFUOTADeploymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{4}
+}
+func (m *CreateFUOTADeploymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateFUOTADeploymentResponse.Unmarshal(m, b)
+}
+func (m *CreateFUOTADeploymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateFUOTADeploymentResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateFUOTADeploymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateFUOTADeploymentResponse.Merge(dst, src)
+}
+func (m *CreateFUOTADeploymentResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateFUOTADeploymentResponse.Size(m)
+}
+func (m *CreateFUOTADeploymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateFUOTADeploymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateFUOTADeploymentResponse proto.InternalMessageInfo
+
+func (m *CreateFUOTADeploymentResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetFUOTADeploymentRequest struct {
+	// FUOTA deployment ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFUOTADeploymentRequest) Reset()         { *m = GetFUOTADeploymentRequest{} }
+func (m *GetFUOTADeploymentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFUOTADeploymentRequest) ProtoMessage()    {}
+func (*GetFUOTADeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{5}
+}
+func (m *GetFUOTADeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFUOTADeploymentRequest.Unmarshal(m, b)
+}
+func (m *GetFUOTADeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFUOTADeploymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetFUOTADeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFUOTADeploymentRequest.Merge(dst, src)
+}
+func (m *GetFUOTADeploymentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFUOTADeploymentRequest.Size(m)
+}
+func (m *GetFUOTADeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFUOTADeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFUOTADeploymentRequest proto.InternalMessageInfo
+
+func (m *GetFUOTADeploymentRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetFUOTADeploymentResponse struct {
+	// FUOTA deployment object.
+	FuotaDeployment *FUOTADeployment `protobuf:"bytes,1,opt,name=fuota_deployment,json=fuotaDeployment,proto3" json:"fuota_deployment,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetFUOTADeploymentResponse) Reset()         { *m = GetFUOTADeploymentResponse{} }
+func (m *GetFUOTADeploymentResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFUOTADeploymentResponse) ProtoMessage()    {}
+func (*GetFUOTADeploymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{6}
+}
+func (m *GetFUOTADeploymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFUOTADeploymentResponse.Unmarshal(m, b)
+}
+func (m *GetFUOTADeploymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFUOTADeploymentResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetFUOTADeploymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFUOTADeploymentResponse.Merge(dst, src)
+}
+func (m *GetFUOTADeploymentResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFUOTADeploymentResponse.Size(m)
+}
+func (m *GetFUOTADeploymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFUOTADeploymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFUOTADeploymentResponse proto.InternalMessageInfo
+
+func (m *GetFUOTADeploymentResponse) GetFuotaDeployment() *FUOTADeployment {
+	if m != nil {
+		return m.FuotaDeployment
+	}
+	return nil
+}
+
+func (m *GetFUOTADeploymentResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetFUOTADeploymentResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type ListFUOTADeploymentRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Application ID to filter on.
+	ApplicationId        int64    `protobuf:"varint,3,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListFUOTADeploymentRequest) Reset()         { *m = ListFUOTADeploymentRequest{} }
+func (m *ListFUOTADeploymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentRequest) ProtoMessage()    {}
+func (*ListFUOTADeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{7}
+}
+func (m *ListFUOTADeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFUOTADeploymentRequest.Unmarshal(m, b)
+}
+func (m *ListFUOTADeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFUOTADeploymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListFUOTADeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFUOTADeploymentRequest.Merge(dst, src)
+}
+func (m *ListFUOTADeploymentRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFUOTADeploymentRequest.Size(m)
+}
+func (m *ListFUOTADeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFUOTADeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFUOTADeploymentRequest proto.InternalMessageInfo
+
+func (m *ListFUOTADeploymentRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type ListFUOTADeploymentResponse struct {
+	// Total number of FUOTA deployments.
+	TotalCount           int64                      `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*FUOTADeploymentListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ListFUOTADeploymentResponse) Reset()         { *m = ListFUOTADeploymentResponse{} }
+func (m *ListFUOTADeploymentResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentResponse) ProtoMessage()    {}
+func (*ListFUOTADeploymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{8}
+}
+func (m *ListFUOTADeploymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFUOTADeploymentResponse.Unmarshal(m, b)
+}
+func (m *ListFUOTADeploymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFUOTADeploymentResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListFUOTADeploymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFUOTADeploymentResponse.Merge(dst, src)
+}
+func (m *ListFUOTADeploymentResponse) XXX_Size() int {
+	return xxx_messageInfo_ListFUOTADeploymentResponse.Size(m)
+}
+func (m *ListFUOTADeploymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFUOTADeploymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFUOTADeploymentResponse proto.InternalMessageInfo
+
+func (m *ListFUOTADeploymentResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentResponse) GetResult() []*FUOTADeploymentListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListFUOTADeploymentDevicesRequest struct {
+	// FUOTA deployment ID.
+	FuotaDeploymentId int64 `protobuf:"varint,1,opt,name=fuota_deployment_id,json=fuotaDeploymentID,proto3" json:"fuota_deployment_id,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) Reset()         { *m = ListFUOTADeploymentDevicesRequest{} }
+func (m *ListFUOTADeploymentDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentDevicesRequest) ProtoMessage()    {}
+func (*ListFUOTADeploymentDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{9}
+}
+func (m *ListFUOTADeploymentDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFUOTADeploymentDevicesRequest.Unmarshal(m, b)
+}
+func (m *ListFUOTADeploymentDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFUOTADeploymentDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListFUOTADeploymentDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFUOTADeploymentDevicesRequest.Merge(dst, src)
+}
+func (m *ListFUOTADeploymentDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFUOTADeploymentDevicesRequest.Size(m)
+}
+func (m *ListFUOTADeploymentDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFUOTADeploymentDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFUOTADeploymentDevicesRequest proto.InternalMessageInfo
+
+func (m *ListFUOTADeploymentDevicesRequest) GetFuotaDeploymentId() int64 {
+	if m != nil {
+		return m.FuotaDeploymentId
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListFUOTADeploymentDevicesResponse struct {
+	// Total number of devices.
+	TotalCount           int64                            `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*FUOTADeploymentDeviceListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ListFUOTADeploymentDevicesResponse) Reset()         { *m = ListFUOTADeploymentDevicesResponse{} }
+func (m *ListFUOTADeploymentDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentDevicesResponse) ProtoMessage()    {}
+func (*ListFUOTADeploymentDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f4a0a6fe690dc29, []int{10}
+}
+func (m *ListFUOTADeploymentDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFUOTADeploymentDevicesResponse.Unmarshal(m, b)
+}
+func (m *ListFUOTADeploymentDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFUOTADeploymentDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListFUOTADeploymentDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFUOTADeploymentDevicesResponse.Merge(dst, src)
+}
+func (m *ListFUOTADeploymentDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListFUOTADeploymentDevicesResponse.Size(m)
+}
+func (m *ListFUOTADeploymentDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFUOTADeploymentDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListFUOTADeploymentDevicesResponse proto.InternalMessageInfo
+
+func (m *ListFUOTADeploymentDevicesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentDevicesResponse) GetResult() []*FUOTADeploymentDeviceListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FUOTADeployment)(nil), "api.FUOTADeployment")
+	proto.RegisterType((*FUOTADeploymentListItem)(nil), "api.FUOTADeploymentListItem")
+	proto.RegisterType((*FUOTADeploymentDeviceListItem)(nil), "api.FUOTADeploymentDeviceListItem")
+	proto.RegisterType((*CreateFUOTADeploymentRequest)(nil), "api.CreateFUOTADeploymentRequest")
+	proto.RegisterType((*CreateFUOTADeploymentResponse)(nil), "api.CreateFUOTADeploymentResponse")
+	proto.RegisterType((*GetFUOTADeploymentRequest)(nil), "api.GetFUOTADeploymentRequest")
+	proto.RegisterType((*GetFUOTADeploymentResponse)(nil), "api.GetFUOTADeploymentResponse")
+	proto.RegisterType((*ListFUOTADeploymentRequest)(nil), "api.ListFUOTADeploymentRequest")
+	proto.RegisterType((*ListFUOTADeploymentResponse)(nil), "api.ListFUOTADeploymentResponse")
+	proto.RegisterType((*ListFUOTADeploymentDevicesRequest)(nil), "api.ListFUOTADeploymentDevicesRequest")
+	proto.RegisterType((*ListFUOTADeploymentDevicesResponse)(nil), "api.ListFUOTADeploymentDevicesResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// FUOTADeploymentServiceClient is the client API for FUOTADeploymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FUOTADeploymentServiceClient interface {
+	// Create creates the given FUOTA deployment.
+	// The deployment will be started immediately.
+	Create(ctx context.Context, in *CreateFUOTADeploymentRequest, opts ...grpc.CallOption) (*CreateFUOTADeploymentResponse, error)
+	// Get returns the FUOTA deployment matching the given ID.
+	Get(ctx context.Context, in *GetFUOTADeploymentRequest, opts ...grpc.CallOption) (*GetFUOTADeploymentResponse, error)
+	// List lists the FUOTA deployments of the given application.
+	List(ctx context.Context, in *ListFUOTADeploymentRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentResponse, error)
+	// ListDeploymentDevices lists the devices (and their state) of the given
+	// FUOTA deployment.
+	ListDeploymentDevices(ctx context.Context, in *ListFUOTADeploymentDevicesRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentDevicesResponse, error)
+}
+
+type fUOTADeploymentServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFUOTADeploymentServiceClient(cc *grpc.ClientConn) FUOTADeploymentServiceClient {
+	return &fUOTADeploymentServiceClient{cc}
+}
+
+func (c *fUOTADeploymentServiceClient) Create(ctx context.Context, in *CreateFUOTADeploymentRequest, opts ...grpc.CallOption) (*CreateFUOTADeploymentResponse, error) {
+	out := new(CreateFUOTADeploymentResponse)
+	err := c.cc.Invoke(ctx, "/api.FUOTADeploymentService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentServiceClient) Get(ctx context.Context, in *GetFUOTADeploymentRequest, opts ...grpc.CallOption) (*GetFUOTADeploymentResponse, error) {
+	out := new(GetFUOTADeploymentResponse)
+	err := c.cc.Invoke(ctx, "/api.FUOTADeploymentService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentServiceClient) List(ctx context.Context, in *ListFUOTADeploymentRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentResponse, error) {
+	out := new(ListFUOTADeploymentResponse)
+	err := c.cc.Invoke(ctx, "/api.FUOTADeploymentService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentServiceClient) ListDeploymentDevices(ctx context.Context, in *ListFUOTADeploymentDevicesRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentDevicesResponse, error) {
+	out := new(ListFUOTADeploymentDevicesResponse)
+	err := c.cc.Invoke(ctx, "/api.FUOTADeploymentService/ListDeploymentDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FUOTADeploymentServiceServer is the server API for FUOTADeploymentService service.
+type FUOTADeploymentServiceServer interface {
+	// Create creates the given FUOTA deployment.
+	// The deployment will be started immediately.
+	Create(context.Context, *CreateFUOTADeploymentRequest) (*CreateFUOTADeploymentResponse, error)
+	// Get returns the FUOTA deployment matching the given ID.
+	Get(context.Context, *GetFUOTADeploymentRequest) (*GetFUOTADeploymentResponse, error)
+	// List lists the FUOTA deployments of the given application.
+	List(context.Context, *ListFUOTADeploymentRequest) (*ListFUOTADeploymentResponse, error)
+	// ListDeploymentDevices lists the devices (and their state) of the given
+	// FUOTA deployment.
+	ListDeploymentDevices(context.Context, *ListFUOTADeploymentDevicesRequest) (*ListFUOTADeploymentDevicesResponse, error)
+}
+
+func RegisterFUOTADeploymentServiceServer(s *grpc.Server, srv FUOTADeploymentServiceServer) {
+	s.RegisterService(&_FUOTADeploymentService_serviceDesc, srv)
+}
+
+func _FUOTADeploymentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).Create(ctx, req.(*CreateFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeploymentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).Get(ctx, req.(*GetFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeploymentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).List(ctx, req.(*ListFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeploymentService_ListDeploymentDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTADeploymentDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).ListDeploymentDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/ListDeploymentDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).ListDeploymentDevices(ctx, req.(*ListFUOTADeploymentDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FUOTADeploymentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.FUOTADeploymentService",
+	HandlerType: (*FUOTADeploymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _FUOTADeploymentService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _FUOTADeploymentService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _FUOTADeploymentService_List_Handler,
+		},
+		{
+			MethodName: "ListDeploymentDevices",
+			Handler:    _FUOTADeploymentService_ListDeploymentDevices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fuotaDeployment.proto",
+}
+
+func init() {
+	proto.RegisterFile("fuotaDeployment.proto", fileDescriptor_4f4a0a6fe690dc29)
+}
+
+var fileDescriptor_4f4a0a6fe690dc29 = []byte{
+	// 946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x06, 0x49, 0x5b, 0xb6, 0x46, 0x96, 0xed, 0x6e, 0x1d, 0x87, 0xa1, 0x5f, 0x32, 0x83, 0xa6,
+	0x42, 0x1f, 0x12, 0xe0, 0x06, 0x05, 0x1a, 0x14, 0x28, 0xd4, 0xa8, 0x31, 0x04, 0xf4, 0x01, 0xd0,
+	0xc9, 0x99, 0x58, 0x91, 0x23, 0x61, 0x61, 0xbe, 0xc2, 0x5d, 0x1a, 0xb1, 0x8b, 0x1c, 0xda, 0x7b,
+	0x4e, 0xfd, 0x0f, 0xfd, 0x43, 0x39, 0xf4, 0xd2, 0x63, 0x7f, 0x48, 0xb1, 0x4b, 0x52, 0x96, 0x19,
+	0xd2, 0x6e, 0xda, 0x43, 0x6f, 0xda, 0x99, 0x6f, 0x1e, 0xfb, 0x7d, 0x33, 0x5c, 0xc1, 0xbd, 0x59,
+	0x16, 0x0b, 0x3a, 0xc6, 0x24, 0x88, 0x2f, 0x43, 0x8c, 0xc4, 0x20, 0x49, 0x63, 0x11, 0x13, 0x83,
+	0x26, 0xcc, 0xda, 0x9f, 0xc7, 0xf1, 0x3c, 0xc0, 0x21, 0x4d, 0xd8, 0x90, 0x46, 0x51, 0x2c, 0xa8,
+	0x60, 0x71, 0xc4, 0x73, 0x88, 0x75, 0x54, 0x78, 0xd5, 0x69, 0x9a, 0xcd, 0x86, 0x82, 0x85, 0xc8,
+	0x05, 0x0d, 0x93, 0x1c, 0x60, 0xff, 0x69, 0xc0, 0xd6, 0xb3, 0x17, 0x3f, 0x3d, 0x1f, 0x5d, 0x67,
+	0x27, 0x9b, 0xa0, 0x33, 0xdf, 0xd4, 0x7a, 0x5a, 0xdf, 0x70, 0x74, 0xe6, 0x93, 0x8f, 0x60, 0x93,
+	0x26, 0x49, 0xc0, 0x3c, 0x95, 0xda, 0x65, 0xbe, 0xa9, 0x2b, 0x5f, 0x77, 0xc9, 0x3a, 0x19, 0x93,
+	0xcf, 0x80, 0x84, 0x59, 0x20, 0x98, 0x47, 0xb9, 0x70, 0xe7, 0x69, 0x9c, 0x25, 0x12, 0x6a, 0xf4,
+	0xb4, 0x7e, 0xdb, 0xd9, 0x5e, 0x78, 0x4e, 0xa5, 0x63, 0x32, 0x26, 0x04, 0x56, 0x22, 0x1a, 0xa2,
+	0xb9, 0xa2, 0xfc, 0xea, 0x37, 0x31, 0x61, 0x2d, 0xa1, 0x97, 0x41, 0x4c, 0x7d, 0x73, 0xb5, 0xa7,
+	0xf5, 0x37, 0x9c, 0xf2, 0x48, 0xf6, 0xa0, 0x3d, 0x4b, 0xe9, 0xdc, 0xe5, 0xec, 0x0a, 0xcd, 0x56,
+	0x4f, 0xeb, 0x77, 0x9d, 0x75, 0x69, 0x38, 0x63, 0x57, 0x48, 0x0e, 0x01, 0x52, 0xf4, 0xb3, 0xc8,
+	0xa7, 0x91, 0x77, 0x69, 0xae, 0x29, 0xef, 0x92, 0x85, 0x1c, 0x00, 0xa8, 0x60, 0x16, 0xf9, 0xf8,
+	0xca, 0x5c, 0x57, 0x7e, 0x95, 0x6e, 0x22, 0x0d, 0xe4, 0x10, 0x3a, 0xa1, 0x77, 0xdd, 0x70, 0x3b,
+	0xf7, 0x87, 0x5e, 0xd9, 0xe9, 0x23, 0xd8, 0x9a, 0x06, 0xb1, 0x77, 0xee, 0x52, 0xef, 0xdc, 0xf5,
+	0x31, 0xa0, 0x97, 0x26, 0x28, 0x4c, 0x57, 0x99, 0x47, 0xde, 0xf9, 0x58, 0x1a, 0x65, 0x1b, 0x3e,
+	0x72, 0x2f, 0x65, 0x89, 0x88, 0x53, 0xb3, 0xa3, 0xee, 0xb5, 0x64, 0x91, 0xb7, 0x93, 0xec, 0xc7,
+	0x99, 0x30, 0x37, 0x54, 0x7c, 0x79, 0x24, 0x3b, 0xb0, 0xca, 0x05, 0x15, 0x68, 0x76, 0x55, 0x50,
+	0x7e, 0x20, 0xdf, 0xc2, 0x56, 0x84, 0xaf, 0x84, 0xcb, 0x05, 0x26, 0x2e, 0x9d, 0x09, 0x4c, 0xcd,
+	0xcd, 0x9e, 0xd6, 0xef, 0x9c, 0x58, 0x83, 0x5c, 0xd5, 0x41, 0xa9, 0xea, 0xe0, 0x79, 0xa9, 0xaa,
+	0xd3, 0x95, 0x21, 0x67, 0x02, 0x93, 0x91, 0x0c, 0xb0, 0xdf, 0xe8, 0x70, 0xbf, 0x22, 0xef, 0xf7,
+	0x8c, 0x8b, 0x89, 0xc0, 0xf0, 0x1d, 0x99, 0xbf, 0x02, 0xf0, 0x52, 0xa4, 0x02, 0x7d, 0x97, 0x0a,
+	0x53, 0xbf, 0xb3, 0x54, 0xbb, 0x40, 0x8f, 0x84, 0x0c, 0xcd, 0x12, 0xbf, 0x0c, 0x35, 0xee, 0x0e,
+	0x2d, 0xd0, 0x23, 0x51, 0x3b, 0x07, 0x0b, 0x3e, 0x56, 0xef, 0xe0, 0xa3, 0xf5, 0xbe, 0x7c, 0xfc,
+	0xa1, 0xc3, 0x41, 0x85, 0x8f, 0x31, 0x5e, 0x30, 0x0f, 0x17, 0xac, 0xdc, 0x87, 0x35, 0x1f, 0x2f,
+	0x5c, 0xcc, 0x98, 0xa2, 0xa6, 0xed, 0xb4, 0x7c, 0xbc, 0xf8, 0xee, 0xc5, 0x84, 0x1c, 0x41, 0xc7,
+	0x57, 0x50, 0x57, 0xf5, 0xab, 0x97, 0xfa, 0x4a, 0xd3, 0x8f, 0x37, 0xba, 0x36, 0x96, 0xbb, 0x7e,
+	0x08, 0x5d, 0x4c, 0xd3, 0x38, 0x75, 0x43, 0xe4, 0x9c, 0xce, 0xcb, 0x8b, 0x6e, 0x28, 0xe3, 0x0f,
+	0xb9, 0x8d, 0xf4, 0x61, 0x3b, 0x9a, 0xba, 0x6a, 0x48, 0x53, 0xf4, 0x90, 0x5d, 0x60, 0xbe, 0x01,
+	0x5d, 0x67, 0x33, 0x9a, 0x3e, 0x4b, 0xe9, 0xdc, 0x29, 0xac, 0xe4, 0x18, 0x36, 0x42, 0xc6, 0x39,
+	0x8b, 0xe6, 0x0a, 0x5e, 0xec, 0x42, 0xa7, 0xb0, 0x49, 0x68, 0x45, 0xc7, 0xb5, 0x7f, 0xaf, 0xe3,
+	0xfa, 0x7b, 0xe8, 0x68, 0x5f, 0xc1, 0xfe, 0x53, 0x95, 0xa7, 0x42, 0xaf, 0x83, 0x2f, 0x33, 0xe4,
+	0x82, 0x7c, 0x03, 0xdb, 0xea, 0x2b, 0xe6, 0xfa, 0x0b, 0x97, 0x22, 0xb8, 0x73, 0xb2, 0x33, 0xa0,
+	0x09, 0x1b, 0x54, 0xc3, 0xb6, 0x2a, 0xdf, 0x3c, 0xf2, 0x00, 0xd6, 0x0b, 0x61, 0xb8, 0xa9, 0xf7,
+	0x8c, 0x7e, 0xdb, 0x59, 0xcb, 0x95, 0xe1, 0xf6, 0x10, 0x0e, 0x1a, 0x6a, 0xf3, 0x24, 0x8e, 0x38,
+	0x56, 0x47, 0xdd, 0xfe, 0x14, 0x1e, 0x9c, 0xa2, 0x68, 0xe8, 0xb4, 0x0a, 0x7e, 0xab, 0x81, 0x55,
+	0x87, 0x2e, 0x72, 0xff, 0xe7, 0x8b, 0xfd, 0x2f, 0x7b, 0x67, 0xbf, 0x04, 0x4b, 0xce, 0x7c, 0x03,
+	0x07, 0x3b, 0xb0, 0x1a, 0xb0, 0x90, 0x89, 0x82, 0x86, 0xfc, 0x40, 0x76, 0xa1, 0x15, 0xcf, 0x66,
+	0x1c, 0x45, 0xf1, 0x00, 0x14, 0xa7, 0x9a, 0x07, 0xc2, 0xa8, 0x79, 0x20, 0x6c, 0x01, 0x7b, 0xb5,
+	0x25, 0x0b, 0x22, 0x8f, 0xa0, 0x23, 0x62, 0x41, 0x03, 0xd7, 0x8b, 0xb3, 0xa8, 0xac, 0x0c, 0xca,
+	0xf4, 0x54, 0x5a, 0xc8, 0x63, 0x68, 0xa5, 0xc8, 0xb3, 0x40, 0x28, 0xfd, 0x3b, 0x27, 0xfb, 0x75,
+	0xfc, 0x96, 0x8b, 0xec, 0x14, 0x58, 0xfb, 0x17, 0x0d, 0x8e, 0x6b, 0xca, 0xe6, 0x6b, 0xcf, 0xcb,
+	0x0b, 0x0f, 0xe0, 0xc3, 0xaa, 0x8a, 0xee, 0x62, 0x0a, 0x3e, 0xa8, 0x48, 0x36, 0x19, 0x5f, 0x13,
+	0xa4, 0xd7, 0x13, 0x64, 0x2c, 0x13, 0x24, 0x7b, 0xb0, 0x6f, 0xeb, 0xe1, 0x9f, 0x32, 0xf0, 0xa4,
+	0xc2, 0x80, 0x5d, 0xc7, 0xc0, 0xcd, 0x0f, 0x5a, 0xc9, 0xc3, 0xc9, 0x9b, 0x15, 0xd8, 0xad, 0x20,
+	0xcf, 0x30, 0x95, 0x50, 0x92, 0x42, 0x2b, 0xdf, 0x1f, 0x72, 0xac, 0x12, 0xde, 0xb6, 0xc8, 0x96,
+	0x7d, 0x1b, 0x24, 0xbf, 0x88, 0x7d, 0xfc, 0xeb, 0xdb, 0xbf, 0x7e, 0xd3, 0xf7, 0xec, 0x5d, 0xf5,
+	0xb7, 0x44, 0xb1, 0xf7, 0xf9, 0x35, 0xb1, 0xfc, 0x89, 0xf6, 0x09, 0x39, 0x07, 0xe3, 0x14, 0x05,
+	0x39, 0x54, 0xd9, 0x1a, 0x97, 0xd1, 0x3a, 0x6a, 0xf4, 0x17, 0xa5, 0x1e, 0xaa, 0x52, 0x07, 0x64,
+	0xaf, 0xbe, 0xd4, 0xf0, 0x67, 0xe6, 0xbf, 0x26, 0x0c, 0x56, 0x24, 0x1f, 0x24, 0xcf, 0xd6, 0x3c,
+	0xf7, 0x56, 0xaf, 0x19, 0x50, 0xd4, 0x3b, 0x54, 0xf5, 0x4c, 0xd2, 0x70, 0x35, 0xf2, 0xbb, 0x06,
+	0xf7, 0x64, 0xfc, 0x3b, 0x2a, 0x93, 0x47, 0x4d, 0xb9, 0x6f, 0x8e, 0xa2, 0xf5, 0xf1, 0x9d, 0xb8,
+	0xa2, 0x95, 0xaf, 0x55, 0x2b, 0x5f, 0x92, 0xc7, 0x4d, 0x57, 0xaf, 0x99, 0xe8, 0xd7, 0xc3, 0xfc,
+	0xc5, 0xe2, 0xd3, 0x96, 0xfa, 0x3e, 0x7c, 0xf1, 0xf7, 0x00, 0x35, 0x94, 0xbc, 0xf8, 0x5d, 0x0a,
+	0x00, 0x00,
+}
@@ -30,6 +30,10 @@ protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --go_out=plugins=grpc:
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    codec.proto \
+    fuotaDeployment.proto \
+    scheduledDownlink.proto \
+    deviceGroup.proto \
     internal.proto
 
 # generate the JSON interface code
@@ -47,6 +51,10 @@ protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=log
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    codec.proto \
+    fuotaDeployment.proto \
+    scheduledDownlink.proto \
+    deviceGroup.proto \
     internal.proto
 
 # generate the swagger definitions
@@ -64,6 +72,10 @@ protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --swagger_out=logtostd
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    codec.proto \
+    fuotaDeployment.proto \
+    scheduledDownlink.proto \
+    deviceGroup.proto \
     internal.proto
 
 # merge the swagger code into one file
@@ -190,6 +190,93 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
+type RequestPasswordResetRequest struct {
+	// Username of the user.
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{3}
+}
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (dst *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(dst, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	// Password reset token (as sent by e-mail).
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// New password.
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{4}
+}
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(dst, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	// The JWT tag to be used to access lora-app-server interfaces.
 	Jwt                  string   `protobuf:"bytes,1,opt,name=jwt,proto3" json:"jwt,omitempty"`
@@ -202,7 +289,7 @@ func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
 func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
 func (*LoginResponse) ProtoMessage()    {}
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{3}
+	return fileDescriptor_41f4a519b878ee3b, []int{5}
 }
 func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
@@ -245,7 +332,7 @@ func (m *ProfileResponse) Reset()         { *m = ProfileResponse{} }
 func (m *ProfileResponse) String() string { return proto.CompactTextString(m) }
 func (*ProfileResponse) ProtoMessage()    {}
 func (*ProfileResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{4}
+	return fileDescriptor_41f4a519b878ee3b, []int{6}
 }
 func (m *ProfileResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileResponse.Unmarshal(m, b)
@@ -302,7 +389,7 @@ func (m *GlobalSearchRequest) Reset()         { *m = GlobalSearchRequest{} }
 func (m *GlobalSearchRequest) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchRequest) ProtoMessage()    {}
 func (*GlobalSearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{5}
+	return fileDescriptor_41f4a519b878ee3b, []int{7}
 }
 func (m *GlobalSearchRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchRequest.Unmarshal(m, b)
@@ -354,7 +441,7 @@ func (m *GlobalSearchResponse) Reset()         { *m = GlobalSearchResponse{} }
 func (m *GlobalSearchResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchResponse) ProtoMessage()    {}
 func (*GlobalSearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{6}
+	return fileDescriptor_41f4a519b878ee3b, []int{8}
 }
 func (m *GlobalSearchResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchResponse.Unmarshal(m, b)
@@ -411,7 +498,7 @@ func (m *GlobalSearchResult) Reset()         { *m = GlobalSearchResult{} }
 func (m *GlobalSearchResult) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchResult) ProtoMessage()    {}
 func (*GlobalSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{7}
+	return fileDescriptor_41f4a519b878ee3b, []int{9}
 }
 func (m *GlobalSearchResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchResult.Unmarshal(m, b)
@@ -517,7 +604,7 @@ func (m *BrandingResponse) Reset()         { *m = BrandingResponse{} }
 func (m *BrandingResponse) String() string { return proto.CompactTextString(m) }
 func (*BrandingResponse) ProtoMessage()    {}
 func (*BrandingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{8}
+	return fileDescriptor_41f4a519b878ee3b, []int{10}
 }
 func (m *BrandingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BrandingResponse.Unmarshal(m, b)
@@ -562,6 +649,8 @@ func init() {
 	proto.RegisterType((*ProfileSettings)(nil), "api.ProfileSettings")
 	proto.RegisterType((*OrganizationLink)(nil), "api.OrganizationLink")
 	proto.RegisterType((*LoginRequest)(nil), "api.LoginRequest")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "api.RequestPasswordResetRequest")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "api.ResetPasswordRequest")
 	proto.RegisterType((*LoginResponse)(nil), "api.LoginResponse")
 	proto.RegisterType((*ProfileResponse)(nil), "api.ProfileResponse")
 	proto.RegisterType((*GlobalSearchRequest)(nil), "api.GlobalSearchRequest")
@@ -590,6 +679,13 @@ type InternalServiceClient interface {
 	Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error)
 	// Perform a global search.
 	GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error)
+	// RequestPasswordReset sends a password reset e-mail to the user
+	// matching the given username. For security reasons, the response
+	// does not indicate if the user exists.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ResetPassword sets the password of the user using the token sent by
+	// RequestPasswordReset.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type internalServiceClient struct {
@@ -636,6 +732,24 @@ func (c *internalServiceClient) GlobalSearch(ctx context.Context, in *GlobalSear
 	return out, nil
 }
 
+func (c *internalServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.InternalService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.InternalService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalServiceServer is the server API for InternalService service.
 type InternalServiceServer interface {
 	// Log in a user
@@ -646,6 +760,13 @@ type InternalServiceServer interface {
 	Branding(context.Context, *empty.Empty) (*BrandingResponse, error)
 	// Perform a global search.
 	GlobalSearch(context.Context, *GlobalSearchRequest) (*GlobalSearchResponse, error)
+	// RequestPasswordReset sends a password reset e-mail to the user
+	// matching the given username. For security reasons, the response
+	// does not indicate if the user exists.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*empty.Empty, error)
+	// ResetPassword sets the password of the user using the token sent by
+	// RequestPasswordReset.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error)
 }
 
 func RegisterInternalServiceServer(s *grpc.Server, srv InternalServiceServer) {
@@ -724,6 +845,42 @@ func _InternalService_GlobalSearch_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InternalService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.InternalService",
 	HandlerType: (*InternalServiceServer)(nil),
@@ -744,6 +901,14 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalSearch",
 			Handler:    _InternalService_GlobalSearch_Handler,
 		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _InternalService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _InternalService_ResetPassword_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal.proto",
@@ -752,56 +917,62 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 810 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x8e, 0xdb, 0x44,
-	0x14, 0x86, 0xe5, 0x38, 0xbb, 0x9b, 0x9c, 0x64, 0x77, 0xd3, 0x69, 0x9a, 0xba, 0xa1, 0xcb, 0xa6,
-	0x16, 0x88, 0x00, 0x52, 0x82, 0xc2, 0x15, 0x70, 0x15, 0xd8, 0xa5, 0x8a, 0x54, 0x0a, 0xf2, 0xb6,
-	0x48, 0x88, 0x0b, 0x6b, 0x12, 0x4f, 0xcc, 0x50, 0x7b, 0xc6, 0x78, 0x26, 0xbb, 0x94, 0x4b, 0x5e,
-	0x81, 0x07, 0xe0, 0xa1, 0x78, 0x05, 0x5e, 0x80, 0x2b, 0xc4, 0x1d, 0x9a, 0xe3, 0xb1, 0x65, 0xa7,
-	0xbb, 0x82, 0x3b, 0x9f, 0x33, 0xdf, 0xfc, 0x3e, 0xf3, 0xcf, 0x39, 0x03, 0x27, 0x5c, 0x68, 0x96,
-	0x0b, 0x9a, 0xcc, 0xb2, 0x5c, 0x6a, 0x49, 0x5c, 0x9a, 0xf1, 0xf1, 0xe3, 0x58, 0xca, 0x38, 0x61,
-	0x73, 0x9a, 0xf1, 0x39, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14, 0xaa, 0x40, 0xc6, 0xe7, 0x76, 0x15,
-	0xa3, 0xf5, 0x6e, 0x3b, 0xd7, 0x3c, 0x65, 0x4a, 0xd3, 0x34, 0xb3, 0xc0, 0x5b, 0xfb, 0x00, 0x4b,
-	0x33, 0xfd, 0xda, 0x2e, 0xc2, 0x4e, 0xb1, 0xbc, 0xf8, 0xf6, 0x5f, 0xc0, 0xe9, 0x37, 0xb9, 0xdc,
-	0xf2, 0x84, 0x5d, 0x31, 0xad, 0xb9, 0x88, 0x15, 0x59, 0xc2, 0x59, 0xc4, 0x15, 0x5d, 0x27, 0x2c,
-	0xa4, 0x4a, 0xf1, 0x58, 0x84, 0xec, 0x67, 0xae, 0xcc, 0x5a, 0x68, 0x36, 0x2a, 0xcf, 0x99, 0x38,
-	0xd3, 0x4e, 0x30, 0xb6, 0xd0, 0x12, 0x99, 0x4b, 0x8b, 0xbc, 0x34, 0x84, 0xff, 0x8f, 0x03, 0x83,
-	0xaf, 0xf3, 0x98, 0x0a, 0xfe, 0x0b, 0xd6, 0xfd, 0x8c, 0x8b, 0x57, 0xe4, 0x3d, 0x38, 0x95, 0xb5,
-	0x5c, 0xc8, 0x23, 0x54, 0x72, 0x83, 0x93, 0x7a, 0x7a, 0x75, 0x41, 0x3e, 0x84, 0x7b, 0x0d, 0x50,
-	0xd0, 0x94, 0x79, 0xad, 0x89, 0x33, 0xed, 0x06, 0x83, 0xfa, 0xc2, 0x73, 0x9a, 0x32, 0xf2, 0x08,
-	0x3a, 0x5c, 0x85, 0x34, 0x4a, 0xb9, 0xf0, 0x5c, 0x2c, 0xec, 0x88, 0xab, 0xa5, 0x09, 0xc9, 0x27,
-	0x00, 0x9b, 0x9c, 0x51, 0xcd, 0xa2, 0x90, 0x6a, 0xaf, 0x3d, 0x71, 0xa6, 0xbd, 0xc5, 0x78, 0x56,
-	0x38, 0x33, 0x2b, 0x9d, 0x99, 0xbd, 0x28, 0xad, 0x0b, 0xba, 0x96, 0x5e, 0x6a, 0xb3, 0x75, 0x97,
-	0x45, 0xe5, 0xd6, 0x83, 0xff, 0xde, 0x6a, 0xe9, 0xa5, 0xf6, 0xbf, 0x84, 0xfe, 0x33, 0x19, 0x73,
-	0x11, 0xb0, 0x9f, 0x76, 0x4c, 0x69, 0x32, 0x86, 0x8e, 0xb1, 0x0d, 0x0f, 0xe1, 0xe0, 0x21, 0xaa,
-	0xd8, 0xac, 0x65, 0x54, 0xa9, 0x1b, 0x99, 0x47, 0xf6, 0x80, 0x55, 0xec, 0x3f, 0x81, 0x63, 0xab,
-	0xa3, 0x32, 0x29, 0x14, 0x23, 0x03, 0x70, 0x7f, 0xbc, 0xd1, 0x56, 0xc3, 0x7c, 0xfa, 0xbf, 0x3b,
-	0xd5, 0xed, 0x55, 0xd4, 0x19, 0xb4, 0x8d, 0x3c, 0x62, 0xbd, 0x45, 0x77, 0x46, 0x33, 0x3e, 0x33,
-	0x97, 0x12, 0x60, 0x9a, 0x7c, 0x06, 0xc7, 0x75, 0x0b, 0x95, 0xe7, 0x4e, 0xdc, 0x69, 0x6f, 0xf1,
-	0x00, 0xb9, 0xfd, 0x2b, 0x0b, 0x9a, 0x2c, 0xf9, 0x08, 0x3a, 0xca, 0x76, 0x89, 0xb5, 0x73, 0x88,
-	0xfb, 0xf6, 0x3a, 0x28, 0xa8, 0x28, 0xff, 0x7b, 0xb8, 0xff, 0x34, 0x91, 0x6b, 0x9a, 0x5c, 0x31,
-	0x9a, 0x6f, 0x7e, 0x28, 0x3d, 0x19, 0xc1, 0xa1, 0xc2, 0x84, 0x3d, 0x8d, 0x8d, 0xc8, 0x10, 0x0e,
-	0x12, 0x9e, 0x72, 0x8d, 0x66, 0xb8, 0x41, 0x11, 0x18, 0x5a, 0x6e, 0xb7, 0x8a, 0x69, 0xbc, 0x60,
-	0x37, 0xb0, 0x91, 0xff, 0x14, 0x86, 0x4d, 0x71, 0x6b, 0xc1, 0x1c, 0x0e, 0x73, 0xa6, 0x76, 0x89,
-	0xf1, 0xca, 0x1c, 0xee, 0x21, 0x16, 0xb9, 0x87, 0xee, 0x12, 0x1d, 0x58, 0xcc, 0xff, 0xab, 0x05,
-	0xe4, 0xcd, 0x65, 0x42, 0xa0, 0xfd, 0x8a, 0x8b, 0xc8, 0xd6, 0x88, 0xdf, 0xa6, 0x42, 0xb5, 0x91,
-	0x79, 0xd1, 0x8f, 0xad, 0xa0, 0x08, 0x6e, 0x6b, 0x6d, 0xf7, 0xff, 0xb7, 0x76, 0xfb, 0x8e, 0xd6,
-	0x7e, 0x17, 0x4e, 0x68, 0x96, 0x25, 0x7c, 0x53, 0x89, 0x1e, 0xa0, 0xe8, 0x71, 0x2d, 0xbb, 0xba,
-	0x20, 0xef, 0xc3, 0xa0, 0x8e, 0xa1, 0xe4, 0x21, 0x4a, 0x9e, 0xd6, 0xf2, 0xa8, 0xf8, 0x0e, 0x9c,
-	0x44, 0xec, 0x9a, 0x6f, 0x58, 0x18, 0xb1, 0xeb, 0x90, 0xed, 0xb8, 0x77, 0x84, 0x60, 0xbf, 0xc8,
-	0x5e, 0xb0, 0xeb, 0xcb, 0x97, 0x2b, 0x72, 0x0e, 0x3d, 0x4b, 0xa1, 0x56, 0x07, 0x11, 0x28, 0x52,
-	0x28, 0x73, 0x0e, 0xbd, 0x98, 0x6a, 0x76, 0x43, 0x5f, 0x87, 0x29, 0xdd, 0x78, 0xdd, 0x02, 0xb0,
-	0xa9, 0xaf, 0x96, 0x5f, 0x90, 0x27, 0xd0, 0x2f, 0x01, 0x94, 0x00, 0x24, 0xca, 0x4d, 0x46, 0xc3,
-	0x5f, 0xc3, 0xe0, 0xf3, 0x9c, 0x8a, 0x88, 0x8b, 0xb8, 0xba, 0x38, 0x02, 0xed, 0x44, 0xc6, 0xb2,
-	0x34, 0xdc, 0x7c, 0x13, 0x1f, 0xfa, 0x39, 0x8b, 0xb9, 0xd2, 0x39, 0x1e, 0xc3, 0x8e, 0x49, 0x23,
-	0x67, 0x1a, 0x64, 0x2b, 0xa5, 0x66, 0x39, 0xba, 0xde, 0x0d, 0x6c, 0xb4, 0xf8, 0xbb, 0x05, 0xa7,
-	0x2b, 0xfb, 0xb8, 0x5e, 0xb1, 0xdc, 0xd4, 0x4f, 0x9e, 0xc3, 0x01, 0x8e, 0x15, 0xb9, 0x87, 0x5d,
-	0x51, 0x1f, 0xd5, 0x31, 0xa9, 0xa7, 0x8a, 0x9a, 0xfc, 0xb7, 0x7f, 0xfd, 0xe3, 0xcf, 0xdf, 0x5a,
-	0x9e, 0x7f, 0x1f, 0x9f, 0xe2, 0xf2, 0xa9, 0x9e, 0x27, 0x06, 0xfa, 0xd4, 0xf9, 0x80, 0x7c, 0x0b,
-	0x47, 0xb6, 0xfd, 0xc9, 0xe8, 0x8d, 0x07, 0xe2, 0xd2, 0xbc, 0xba, 0xe3, 0xc6, 0x90, 0x54, 0xc2,
-	0x67, 0x28, 0xfc, 0x90, 0x3c, 0x68, 0x0a, 0x67, 0x56, 0xec, 0x3b, 0xe8, 0x94, 0xfe, 0xdc, 0x29,
-	0x5c, 0x4c, 0xed, 0xbe, 0x8d, 0x65, 0xc9, 0x64, 0xd4, 0x54, 0x5e, 0x97, 0x72, 0x14, 0xfa, 0xf5,
-	0x6e, 0x27, 0xde, 0x2d, 0xf3, 0x51, 0x18, 0xf2, 0xe8, 0xb6, 0xc9, 0x29, 0x7e, 0xf2, 0x18, 0x7f,
-	0x32, 0x22, 0xc3, 0xe6, 0x4f, 0x8a, 0x41, 0x5e, 0x1f, 0x62, 0xa5, 0x1f, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x59, 0x32, 0x94, 0x5a, 0xdc, 0x06, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5f, 0x73, 0xdb, 0x44,
+	0x10, 0x1f, 0x45, 0x4e, 0x62, 0xaf, 0x9d, 0xc4, 0xbd, 0xba, 0xa9, 0xe2, 0x26, 0xc4, 0xd1, 0x94,
+	0x69, 0x5a, 0x06, 0x9b, 0x09, 0x4f, 0x2d, 0x4f, 0x86, 0x84, 0x92, 0x99, 0x52, 0x3a, 0x4a, 0xcb,
+	0x0c, 0xc3, 0x83, 0xe6, 0x6c, 0x9d, 0xc5, 0x11, 0xe9, 0x4e, 0xe8, 0xce, 0x09, 0xe1, 0x91, 0xaf,
+	0xc0, 0x07, 0xe0, 0x43, 0xf1, 0x15, 0x98, 0xe1, 0x99, 0x47, 0xde, 0x98, 0x5b, 0x9d, 0x8c, 0xe4,
+	0xfc, 0xa1, 0x6f, 0xda, 0xbd, 0xdf, 0xfe, 0x6e, 0xf7, 0x77, 0xbb, 0x2b, 0xd8, 0xe4, 0x42, 0xb3,
+	0x5c, 0xd0, 0x64, 0x98, 0xe5, 0x52, 0x4b, 0xe2, 0xd2, 0x8c, 0xf7, 0x77, 0x63, 0x29, 0xe3, 0x84,
+	0x8d, 0x68, 0xc6, 0x47, 0x54, 0x08, 0xa9, 0xa9, 0xe6, 0x52, 0xa8, 0x02, 0xd2, 0xdf, 0xb7, 0xa7,
+	0x68, 0x4d, 0xe6, 0xb3, 0x91, 0xe6, 0x29, 0x53, 0x9a, 0xa6, 0x99, 0x05, 0x3c, 0x5a, 0x06, 0xb0,
+	0x34, 0xd3, 0x57, 0xf6, 0x10, 0xe6, 0x8a, 0xe5, 0xc5, 0xb7, 0xff, 0x16, 0xb6, 0xde, 0xe4, 0x72,
+	0xc6, 0x13, 0x76, 0xc6, 0xb4, 0xe6, 0x22, 0x56, 0x64, 0x0c, 0x7b, 0x11, 0x57, 0x74, 0x92, 0xb0,
+	0x90, 0x2a, 0xc5, 0x63, 0x11, 0xb2, 0x9f, 0xb9, 0x32, 0x67, 0xa1, 0x09, 0x54, 0x9e, 0x33, 0x70,
+	0x0e, 0x9b, 0x41, 0xdf, 0x82, 0xc6, 0x88, 0x39, 0xb1, 0x90, 0x77, 0x06, 0xe1, 0xff, 0xe3, 0x40,
+	0xf7, 0x9b, 0x3c, 0xa6, 0x82, 0xff, 0x82, 0x79, 0xbf, 0xe2, 0xe2, 0x9c, 0x3c, 0x81, 0x2d, 0x59,
+	0xf1, 0x85, 0x3c, 0x42, 0x26, 0x37, 0xd8, 0xac, 0xba, 0x4f, 0x8f, 0xc9, 0x47, 0x70, 0xaf, 0x06,
+	0x14, 0x34, 0x65, 0xde, 0xca, 0xc0, 0x39, 0x6c, 0x05, 0xdd, 0xea, 0xc1, 0x6b, 0x9a, 0x32, 0xb2,
+	0x03, 0x4d, 0xae, 0x42, 0x1a, 0xa5, 0x5c, 0x78, 0x2e, 0x26, 0xb6, 0xce, 0xd5, 0xd8, 0x98, 0xe4,
+	0x39, 0xc0, 0x34, 0x67, 0x54, 0xb3, 0x28, 0xa4, 0xda, 0x6b, 0x0c, 0x9c, 0xc3, 0xf6, 0x51, 0x7f,
+	0x58, 0x28, 0x33, 0x2c, 0x95, 0x19, 0xbe, 0x2d, 0xa5, 0x0b, 0x5a, 0x16, 0x3d, 0xd6, 0x26, 0x74,
+	0x9e, 0x45, 0x65, 0xe8, 0xea, 0xff, 0x87, 0x5a, 0xf4, 0x58, 0xfb, 0x5f, 0x42, 0xe7, 0x95, 0x8c,
+	0xb9, 0x08, 0xd8, 0x4f, 0x73, 0xa6, 0x34, 0xe9, 0x43, 0xd3, 0xc8, 0x86, 0x45, 0x38, 0x58, 0xc4,
+	0xc2, 0x36, 0x67, 0x19, 0x55, 0xea, 0x52, 0xe6, 0x91, 0x2d, 0x70, 0x61, 0xfb, 0xcf, 0xe1, 0x91,
+	0xa5, 0x78, 0x63, 0x5d, 0x01, 0x53, 0x4c, 0xbf, 0x07, 0xad, 0xff, 0x15, 0xf4, 0x10, 0xfb, 0x5f,
+	0x60, 0x11, 0xd3, 0x83, 0x55, 0x2d, 0xcf, 0x99, 0xb0, 0x01, 0x85, 0x71, 0x67, 0x12, 0x07, 0xb0,
+	0x61, 0x8b, 0x51, 0x99, 0x14, 0x8a, 0x91, 0x2e, 0xb8, 0x3f, 0x5e, 0x6a, 0x4b, 0x60, 0x3e, 0xfd,
+	0xdf, 0x9d, 0x45, 0x0b, 0x2d, 0x50, 0x7b, 0xd0, 0x30, 0xc9, 0x20, 0xac, 0x7d, 0xd4, 0x1a, 0xd2,
+	0x8c, 0x0f, 0x4d, 0x67, 0x04, 0xe8, 0x26, 0x9f, 0xc1, 0x46, 0xf5, 0x1d, 0x95, 0xe7, 0x0e, 0xdc,
+	0xc3, 0xf6, 0xd1, 0x03, 0xc4, 0x2d, 0xf7, 0x4d, 0x50, 0xc7, 0x92, 0x4f, 0xa0, 0xa9, 0x6c, 0xab,
+	0xda, 0x37, 0xed, 0x61, 0xdc, 0x52, 0x1b, 0x07, 0x0b, 0x94, 0xff, 0x3d, 0xdc, 0x7f, 0x99, 0xc8,
+	0x09, 0x4d, 0xce, 0x18, 0xcd, 0xa7, 0x3f, 0x94, 0x6a, 0x6c, 0xc3, 0x9a, 0x42, 0x87, 0xad, 0xc6,
+	0x5a, 0x46, 0xa5, 0x84, 0xa7, 0x5c, 0xa3, 0x18, 0x6e, 0x50, 0x18, 0x06, 0x2d, 0x67, 0x33, 0xc5,
+	0x34, 0x76, 0x99, 0x1b, 0x58, 0xcb, 0x7f, 0x09, 0xbd, 0x3a, 0xb9, 0x95, 0x60, 0x04, 0x6b, 0x39,
+	0x53, 0xf3, 0xc4, 0x68, 0x65, 0x8a, 0x7b, 0x88, 0x49, 0x2e, 0x41, 0xe7, 0x89, 0x0e, 0x2c, 0xcc,
+	0xff, 0x7b, 0x05, 0xc8, 0xf5, 0x63, 0x42, 0xa0, 0x71, 0xce, 0x45, 0x64, 0x73, 0xc4, 0x6f, 0x93,
+	0xa1, 0x9a, 0xca, 0xbc, 0x18, 0x8a, 0x95, 0xa0, 0x30, 0x6e, 0x9a, 0x2f, 0xf7, 0xfd, 0xe7, 0xab,
+	0x71, 0xcb, 0x7c, 0x7d, 0x08, 0x9b, 0x34, 0xcb, 0x12, 0x3e, 0x5d, 0x90, 0xae, 0x22, 0xe9, 0x46,
+	0xc5, 0x7b, 0x7a, 0x4c, 0x9e, 0x42, 0xb7, 0x0a, 0x43, 0xca, 0x35, 0xa4, 0xdc, 0xaa, 0xf8, 0x91,
+	0xf1, 0x31, 0x6c, 0x46, 0xec, 0x82, 0x4f, 0x59, 0x18, 0xb1, 0x8b, 0x90, 0xcd, 0xb9, 0xb7, 0x8e,
+	0xc0, 0x4e, 0xe1, 0x3d, 0x66, 0x17, 0x27, 0xef, 0x4e, 0xc9, 0x3e, 0xb4, 0x2d, 0x0a, 0xb9, 0x9a,
+	0x08, 0x81, 0xc2, 0x85, 0x34, 0xfb, 0xd0, 0x8e, 0xa9, 0x66, 0x97, 0xf4, 0x2a, 0x4c, 0xe9, 0xd4,
+	0x6b, 0x15, 0x00, 0xeb, 0xfa, 0x7a, 0xfc, 0x05, 0x39, 0x80, 0x4e, 0x09, 0x40, 0x0a, 0x40, 0x44,
+	0x19, 0x64, 0x38, 0xfc, 0x09, 0x74, 0x3f, 0xcf, 0xa9, 0x88, 0xb8, 0x88, 0x17, 0x0f, 0x47, 0xa0,
+	0x91, 0xc8, 0x58, 0x96, 0x82, 0x9b, 0x6f, 0xe2, 0x43, 0x27, 0x67, 0x31, 0x57, 0x3a, 0xc7, 0x32,
+	0xec, 0x98, 0xd4, 0x7c, 0xa6, 0x41, 0x66, 0x52, 0x6a, 0x96, 0xa3, 0xea, 0xad, 0xc0, 0x5a, 0x47,
+	0x7f, 0x35, 0x60, 0xeb, 0xd4, 0x6e, 0xf8, 0x33, 0x96, 0x9b, 0xfc, 0xc9, 0x6b, 0x58, 0xc5, 0xb1,
+	0x22, 0xf7, 0xb0, 0x2b, 0xaa, 0xfb, 0xa2, 0x4f, 0xaa, 0xae, 0x22, 0x27, 0xff, 0x83, 0x5f, 0xff,
+	0xf8, 0xf3, 0xb7, 0x15, 0xcf, 0xbf, 0x8f, 0xff, 0x83, 0xf2, 0x7f, 0x31, 0x4a, 0x0c, 0xe8, 0x85,
+	0xf3, 0x8c, 0x7c, 0x0b, 0xeb, 0xb6, 0xfd, 0xc9, 0xf6, 0xb5, 0x2d, 0x75, 0x62, 0x56, 0x7f, 0xbf,
+	0x36, 0x24, 0x0b, 0xe2, 0x3d, 0x24, 0x7e, 0x48, 0x1e, 0xd4, 0x89, 0x33, 0x4b, 0xf6, 0x1d, 0x34,
+	0x4b, 0x7d, 0x6e, 0x25, 0x2e, 0xa6, 0x76, 0x59, 0xc6, 0x32, 0x65, 0xb2, 0x5d, 0x67, 0x9e, 0x94,
+	0x74, 0x14, 0x3a, 0xd5, 0x6e, 0x27, 0xde, 0x0d, 0xf3, 0x51, 0x08, 0xb2, 0x73, 0xc3, 0x89, 0xbd,
+	0x64, 0x17, 0x2f, 0xd9, 0x26, 0xbd, 0xfa, 0x25, 0x76, 0x90, 0xaf, 0xa0, 0x67, 0x39, 0x6a, 0x1b,
+	0x94, 0x0c, 0x90, 0xf0, 0x8e, 0xe5, 0xda, 0xbf, 0xa5, 0x56, 0xff, 0x09, 0xde, 0x77, 0xe0, 0xef,
+	0x2e, 0xc9, 0x65, 0x39, 0x3e, 0xce, 0x0d, 0x89, 0x79, 0x10, 0x05, 0x1b, 0xb5, 0x0d, 0x4c, 0x76,
+	0xec, 0x9d, 0xd7, 0xb7, 0xf2, 0xad, 0x97, 0x8d, 0xf0, 0xb2, 0xa7, 0xfe, 0xe3, 0xbb, 0x2e, 0x1b,
+	0x4d, 0xa5, 0x98, 0xf1, 0x3c, 0x7d, 0xe1, 0x3c, 0x9b, 0xac, 0x21, 0xc1, 0xa7, 0xff, 0x0e, 0x00,
+	0x9d, 0x47, 0xf5, 0x90, 0x51, 0x08, 0x00, 0x00,
 }
@@ -26,6 +26,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type RemoteMulticastSetupState int32
+
+const (
+	// The multicast-group setup is pending.
+	RemoteMulticastSetupState_MC_GROUP_SETUP RemoteMulticastSetupState = 0
+	// The multicast session setup is pending.
+	RemoteMulticastSetupState_MC_SESSION_SETUP RemoteMulticastSetupState = 1
+	// The remote multicast setup has been completed.
+	RemoteMulticastSetupState_COMPLETED RemoteMulticastSetupState = 2
+	// The remote multicast setup failed.
+	RemoteMulticastSetupState_ERROR RemoteMulticastSetupState = 3
+)
+
+var RemoteMulticastSetupState_name = map[int32]string{
+	0: "MC_GROUP_SETUP",
+	1: "MC_SESSION_SETUP",
+	2: "COMPLETED",
+	3: "ERROR",
+}
+var RemoteMulticastSetupState_value = map[string]int32{
+	"MC_GROUP_SETUP":   0,
+	"MC_SESSION_SETUP": 1,
+	"COMPLETED":        2,
+	"ERROR":            3,
+}
+
+func (x RemoteMulticastSetupState) String() string {
+	return proto.EnumName(RemoteMulticastSetupState_name, int32(x))
+}
+func (RemoteMulticastSetupState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cdc9b44b08fa59a4, []int{0}
+}
+
 type MulticastGroupType int32
 
 const (
@@ -39,7 +72,6 @@ var MulticastGroupType_name = map[int32]string{
 	0: "CLASS_C",
 	1: "CLASS_B",
 }
-
 var MulticastGroupType_value = map[string]int32{
 	"CLASS_C": 0,
 	"CLASS_B": 1,
@@ -48,9 +80,8 @@ var MulticastGroupType_value = map[string]int32{
 func (x MulticastGroupType) String() string {
 	return proto.EnumName(MulticastGroupType_name, int32(x))
 }
-
 func (MulticastGroupType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_cdc9b44b08fa59a4, []int{0}
+	return fileDescriptor_cdc9b44b08fa59a4, []int{1}
 }
 
 type MulticastGroup struct {
@@ -78,7 +109,13 @@ type MulticastGroup struct {
 	PingSlotPeriod uint32 `protobuf:"varint,10,opt,name=ping_slot_period,json=pingSlotPeriod,proto3" json:"ping_slot_period,omitempty"`
 	// Service-profile ID.
 	// After creation, this can not be updated.
-	ServiceProfileId     string   `protobuf:"bytes,11,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	ServiceProfileId string `protobuf:"bytes,11,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	// Multicast key (HEX encoded AES128 key).
+	// When set, the multicast session keys are derived from this key and
+	// the multicast-group is configured on the devices using the remote
+	// multicast setup. In this case mc_nwk_s_key and mc_app_s_key are
+	// ignored.
+	McKey                string   `protobuf:"bytes,12,opt,name=mc_key,json=mcKey,proto3" json:"mc_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -185,6 +222,13 @@ func (m *MulticastGroup) GetServiceProfileId() string {
 	return ""
 }
 
+func (m *MulticastGroup) GetMcKey() string {
+	if m != nil {
+		return m.McKey
+	}
+	return ""
+}
+
 type MulticastGroupListItem struct {
 	// ID (string formatted UUID).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -993,6 +1037,203 @@ func (m *ListMulticastGroupQueueItemsResponse) GetMulticastQueueItems() []*Multi
 	return nil
 }
 
+type ListRemoteMulticastSetupRequest struct {
+	// Multicast-group ID (string formatted UUID).
+	MulticastGroupId string `protobuf:"bytes,1,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRemoteMulticastSetupRequest) Reset()         { *m = ListRemoteMulticastSetupRequest{} }
+func (m *ListRemoteMulticastSetupRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRemoteMulticastSetupRequest) ProtoMessage()    {}
+func (*ListRemoteMulticastSetupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdc9b44b08fa59a4, []int{18}
+}
+func (m *ListRemoteMulticastSetupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRemoteMulticastSetupRequest.Unmarshal(m, b)
+}
+func (m *ListRemoteMulticastSetupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRemoteMulticastSetupRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRemoteMulticastSetupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRemoteMulticastSetupRequest.Merge(dst, src)
+}
+func (m *ListRemoteMulticastSetupRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRemoteMulticastSetupRequest.Size(m)
+}
+func (m *ListRemoteMulticastSetupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRemoteMulticastSetupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRemoteMulticastSetupRequest proto.InternalMessageInfo
+
+func (m *ListRemoteMulticastSetupRequest) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+func (m *ListRemoteMulticastSetupRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListRemoteMulticastSetupRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type RemoteMulticastSetupListItem struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Device name.
+	DeviceName string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Multicast-group index on the device (0 - 3).
+	McGroupId uint32 `protobuf:"varint,3,opt,name=mc_group_id,json=mcGroupID,proto3" json:"mc_group_id,omitempty"`
+	// Remote multicast setup state.
+	State RemoteMulticastSetupState `protobuf:"varint,4,opt,name=state,proto3,enum=api.RemoteMulticastSetupState" json:"state,omitempty"`
+	// Error message (in case of the error state).
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Number of times the pending command has been sent.
+	RetryCount uint32 `protobuf:"varint,6,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RemoteMulticastSetupListItem) Reset()         { *m = RemoteMulticastSetupListItem{} }
+func (m *RemoteMulticastSetupListItem) String() string { return proto.CompactTextString(m) }
+func (*RemoteMulticastSetupListItem) ProtoMessage()    {}
+func (*RemoteMulticastSetupListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdc9b44b08fa59a4, []int{19}
+}
+func (m *RemoteMulticastSetupListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoteMulticastSetupListItem.Unmarshal(m, b)
+}
+func (m *RemoteMulticastSetupListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoteMulticastSetupListItem.Marshal(b, m, deterministic)
+}
+func (dst *RemoteMulticastSetupListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteMulticastSetupListItem.Merge(dst, src)
+}
+func (m *RemoteMulticastSetupListItem) XXX_Size() int {
+	return xxx_messageInfo_RemoteMulticastSetupListItem.Size(m)
+}
+func (m *RemoteMulticastSetupListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteMulticastSetupListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteMulticastSetupListItem proto.InternalMessageInfo
+
+func (m *RemoteMulticastSetupListItem) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *RemoteMulticastSetupListItem) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *RemoteMulticastSetupListItem) GetMcGroupId() uint32 {
+	if m != nil {
+		return m.McGroupId
+	}
+	return 0
+}
+
+func (m *RemoteMulticastSetupListItem) GetState() RemoteMulticastSetupState {
+	if m != nil {
+		return m.State
+	}
+	return RemoteMulticastSetupState_MC_GROUP_SETUP
+}
+
+func (m *RemoteMulticastSetupListItem) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *RemoteMulticastSetupListItem) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *RemoteMulticastSetupListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type ListRemoteMulticastSetupResponse struct {
+	// Total number of devices.
+	TotalCount           int64                           `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*RemoteMulticastSetupListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ListRemoteMulticastSetupResponse) Reset()         { *m = ListRemoteMulticastSetupResponse{} }
+func (m *ListRemoteMulticastSetupResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRemoteMulticastSetupResponse) ProtoMessage()    {}
+func (*ListRemoteMulticastSetupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdc9b44b08fa59a4, []int{20}
+}
+func (m *ListRemoteMulticastSetupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRemoteMulticastSetupResponse.Unmarshal(m, b)
+}
+func (m *ListRemoteMulticastSetupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRemoteMulticastSetupResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRemoteMulticastSetupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRemoteMulticastSetupResponse.Merge(dst, src)
+}
+func (m *ListRemoteMulticastSetupResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRemoteMulticastSetupResponse.Size(m)
+}
+func (m *ListRemoteMulticastSetupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRemoteMulticastSetupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRemoteMulticastSetupResponse proto.InternalMessageInfo
+
+func (m *ListRemoteMulticastSetupResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListRemoteMulticastSetupResponse) GetResult() []*RemoteMulticastSetupListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MulticastGroup)(nil), "api.MulticastGroup")
 	proto.RegisterType((*MulticastGroupListItem)(nil), "api.MulticastGroupListItem")
@@ -1012,6 +1253,10 @@ func init() {
 	proto.RegisterType((*FlushMulticastGroupQueueItemsRequest)(nil), "api.FlushMulticastGroupQueueItemsRequest")
 	proto.RegisterType((*ListMulticastGroupQueueItemsRequest)(nil), "api.ListMulticastGroupQueueItemsRequest")
 	proto.RegisterType((*ListMulticastGroupQueueItemsResponse)(nil), "api.ListMulticastGroupQueueItemsResponse")
+	proto.RegisterType((*ListRemoteMulticastSetupRequest)(nil), "api.ListRemoteMulticastSetupRequest")
+	proto.RegisterType((*RemoteMulticastSetupListItem)(nil), "api.RemoteMulticastSetupListItem")
+	proto.RegisterType((*ListRemoteMulticastSetupResponse)(nil), "api.ListRemoteMulticastSetupResponse")
+	proto.RegisterEnum("api.RemoteMulticastSetupState", RemoteMulticastSetupState_name, RemoteMulticastSetupState_value)
 	proto.RegisterEnum("api.MulticastGroupType", MulticastGroupType_name, MulticastGroupType_value)
 }
 
@@ -1047,6 +1292,9 @@ type MulticastGroupServiceClient interface {
 	FlushQueue(ctx context.Context, in *FlushMulticastGroupQueueItemsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// ListQueue lists the items in the multicast-group queue.
 	ListQueue(ctx context.Context, in *ListMulticastGroupQueueItemsRequest, opts ...grpc.CallOption) (*ListMulticastGroupQueueItemsResponse, error)
+	// ListRemoteMulticastSetup lists the remote multicast setup state of
+	// the devices within the multicast-group.
+	ListRemoteMulticastSetup(ctx context.Context, in *ListRemoteMulticastSetupRequest, opts ...grpc.CallOption) (*ListRemoteMulticastSetupResponse, error)
 }
 
 type multicastGroupServiceClient struct {
@@ -1147,6 +1395,15 @@ func (c *multicastGroupServiceClient) ListQueue(ctx context.Context, in *ListMul
 	return out, nil
 }
 
+func (c *multicastGroupServiceClient) ListRemoteMulticastSetup(ctx context.Context, in *ListRemoteMulticastSetupRequest, opts ...grpc.CallOption) (*ListRemoteMulticastSetupResponse, error) {
+	out := new(ListRemoteMulticastSetupResponse)
+	err := c.cc.Invoke(ctx, "/api.MulticastGroupService/ListRemoteMulticastSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MulticastGroupServiceServer is the server API for MulticastGroupService service.
 type MulticastGroupServiceServer interface {
 	// Create creates the given multicast-group.
@@ -1169,6 +1426,9 @@ type MulticastGroupServiceServer interface {
 	FlushQueue(context.Context, *FlushMulticastGroupQueueItemsRequest) (*empty.Empty, error)
 	// ListQueue lists the items in the multicast-group queue.
 	ListQueue(context.Context, *ListMulticastGroupQueueItemsRequest) (*ListMulticastGroupQueueItemsResponse, error)
+	// ListRemoteMulticastSetup lists the remote multicast setup state of
+	// the devices within the multicast-group.
+	ListRemoteMulticastSetup(context.Context, *ListRemoteMulticastSetupRequest) (*ListRemoteMulticastSetupResponse, error)
 }
 
 func RegisterMulticastGroupServiceServer(s *grpc.Server, srv MulticastGroupServiceServer) {
@@ -1355,6 +1615,24 @@ func _MulticastGroupService_ListQueue_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MulticastGroupService_ListRemoteMulticastSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemoteMulticastSetupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).ListRemoteMulticastSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/ListRemoteMulticastSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).ListRemoteMulticastSetup(ctx, req.(*ListRemoteMulticastSetupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MulticastGroupService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.MulticastGroupService",
 	HandlerType: (*MulticastGroupServiceServer)(nil),
@@ -1399,87 +1677,108 @@ var _MulticastGroupService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListQueue",
 			Handler:    _MulticastGroupService_ListQueue_Handler,
 		},
+		{
+			MethodName: "ListRemoteMulticastSetup",
+			Handler:    _MulticastGroupService_ListRemoteMulticastSetup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "multicastGroup.proto",
 }
 
-func init() { proto.RegisterFile("multicastGroup.proto", fileDescriptor_cdc9b44b08fa59a4) }
+func init() {
+	proto.RegisterFile("multicastGroup.proto", fileDescriptor_cdc9b44b08fa59a4)
+}
 
 var fileDescriptor_cdc9b44b08fa59a4 = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xc6, 0xbb, 0xc9, 0x86, 0x3d, 0xdb, 0x6e, 0xa3, 0x69, 0x93, 0xb8, 0x4e, 0x4a, 0xb6, 0xa6,
-	0x85, 0xed, 0xaa, 0xdd, 0xad, 0xb6, 0x02, 0x0a, 0x2a, 0x48, 0x4b, 0x92, 0x46, 0xa1, 0xa5, 0x0a,
-	0xde, 0xf4, 0x8a, 0x0b, 0xcb, 0xb5, 0xc7, 0x9b, 0x51, 0xd6, 0x1e, 0xd7, 0x1e, 0xa7, 0x5a, 0xaa,
-	0xdc, 0xf4, 0xae, 0xd7, 0xdc, 0x20, 0xc4, 0x13, 0xf0, 0x08, 0xbc, 0x04, 0x42, 0xbc, 0x01, 0xe2,
-	0x41, 0x90, 0x8f, 0x67, 0x7f, 0x63, 0x6f, 0x12, 0xaa, 0xde, 0x79, 0x66, 0xce, 0x9c, 0xef, 0x3b,
-	0xdf, 0x9c, 0x1f, 0xc3, 0x35, 0x2f, 0xee, 0x0b, 0x66, 0x5b, 0x91, 0xd8, 0x0d, 0x79, 0x1c, 0x34,
-	0x83, 0x90, 0x0b, 0x4e, 0x8a, 0x56, 0xc0, 0xb4, 0x8d, 0x1e, 0xe7, 0xbd, 0x3e, 0x6d, 0x59, 0x01,
-	0x6b, 0x59, 0xbe, 0xcf, 0x85, 0x25, 0x18, 0xf7, 0xa3, 0xd4, 0x44, 0xdb, 0x94, 0xa7, 0xb8, 0x7a,
-	0x11, 0xbb, 0x2d, 0xc1, 0x3c, 0x1a, 0x09, 0xcb, 0x93, 0x3e, 0xb4, 0xf5, 0x59, 0x03, 0xea, 0x05,
-	0x62, 0x90, 0x1e, 0xea, 0xff, 0x14, 0xa0, 0xfa, 0xfd, 0x14, 0x32, 0xa9, 0x42, 0x81, 0x39, 0xaa,
-	0x52, 0x53, 0xea, 0x65, 0xa3, 0xc0, 0x1c, 0x42, 0x60, 0xc1, 0xb7, 0x3c, 0xaa, 0x16, 0x70, 0x07,
-	0xbf, 0xc9, 0x1a, 0x2c, 0x79, 0xb6, 0x69, 0x39, 0x4e, 0xa8, 0x16, 0x71, 0xbb, 0xe4, 0xd9, 0x1d,
-	0xc7, 0x09, 0xc9, 0x26, 0x5c, 0xf2, 0x6c, 0xd3, 0x7f, 0x75, 0x64, 0x46, 0xe6, 0x11, 0x1d, 0xa8,
-	0x0b, 0x78, 0x5a, 0xf6, 0xec, 0x67, 0xaf, 0x8e, 0xba, 0x4f, 0xe8, 0x40, 0x1a, 0x58, 0x41, 0x20,
-	0x0d, 0x16, 0x87, 0x06, 0x9d, 0x20, 0x40, 0x83, 0xab, 0xb0, 0xe8, 0x9a, 0xb6, 0x2f, 0xd4, 0x52,
-	0x4d, 0xa9, 0x5f, 0x36, 0x16, 0xdc, 0x2d, 0x5f, 0x90, 0xcf, 0x01, 0x7a, 0x09, 0x39, 0x53, 0x0c,
-	0x02, 0xaa, 0x2e, 0xd5, 0x94, 0x7a, 0xb5, 0xbd, 0xd6, 0xb4, 0x02, 0xd6, 0x9c, 0x26, 0x7f, 0x30,
-	0x08, 0xa8, 0x51, 0xee, 0x0d, 0x3f, 0x93, 0x58, 0x9c, 0x50, 0xfd, 0x10, 0x3d, 0x15, 0x9c, 0x90,
-	0x6c, 0x40, 0xd9, 0x0d, 0xe9, 0xcb, 0x98, 0xfa, 0xf6, 0x40, 0x2d, 0xe3, 0xf6, 0x78, 0x83, 0xd4,
-	0x61, 0x39, 0x60, 0x7e, 0xcf, 0x8c, 0xfa, 0x5c, 0x98, 0x01, 0x0d, 0x19, 0x77, 0x54, 0x40, 0xa3,
-	0x6a, 0xb2, 0xdf, 0xed, 0x73, 0xb1, 0x8f, 0xbb, 0xe4, 0x2e, 0x90, 0x88, 0x86, 0xc7, 0xcc, 0xa6,
-	0x66, 0x10, 0x72, 0x97, 0xf5, 0xa9, 0xc9, 0x1c, 0xb5, 0x82, 0xb1, 0x2c, 0xcb, 0x93, 0xfd, 0xf4,
-	0x60, 0x6f, 0x5b, 0xff, 0x4d, 0x81, 0xd5, 0x69, 0x9e, 0x4f, 0x59, 0x24, 0xf6, 0x04, 0xf5, 0xce,
-	0x25, 0x76, 0x36, 0x58, 0x31, 0x1b, 0x8c, 0xdc, 0x87, 0x6b, 0xb3, 0xd6, 0xe8, 0x31, 0x7d, 0x09,
-	0x32, 0x6d, 0xff, 0xcc, 0xf2, 0xa8, 0xfe, 0x23, 0xac, 0x6f, 0x85, 0xd4, 0x12, 0x74, 0x9a, 0xa3,
-	0x91, 0xc8, 0x12, 0x09, 0xf2, 0x08, 0xae, 0x8c, 0x72, 0xd3, 0x44, 0x69, 0x91, 0x6f, 0xa5, 0x7d,
-	0x35, 0xe3, 0x01, 0x8c, 0xea, 0x74, 0x1e, 0xeb, 0x4d, 0xd8, 0xc8, 0x76, 0x1e, 0x05, 0xdc, 0x8f,
-	0xe8, 0xac, 0x00, 0x7a, 0x03, 0xd4, 0x5d, 0x2a, 0xb2, 0x99, 0xcc, 0xda, 0xfe, 0xa9, 0xc0, 0xf5,
-	0x0c, 0x63, 0xe9, 0xf9, 0x9d, 0x78, 0x93, 0x2f, 0x01, 0x6c, 0xe4, 0xed, 0x98, 0x96, 0xc0, 0xe7,
-	0xa8, 0xb4, 0xb5, 0x66, 0x5a, 0x4a, 0xcd, 0x61, 0x29, 0x35, 0x0f, 0x86, 0xb5, 0x66, 0x94, 0xa5,
-	0x75, 0x47, 0x24, 0x57, 0xe3, 0xc0, 0x19, 0x5e, 0x2d, 0x9e, 0x7d, 0x55, 0x5a, 0x77, 0x44, 0xf2,
-	0x14, 0xcf, 0x71, 0xf1, 0x3e, 0x9e, 0xe2, 0x1e, 0xac, 0x6f, 0xd3, 0x3e, 0xcd, 0x73, 0x3e, 0xab,
-	0x2e, 0x83, 0x5a, 0xc7, 0x71, 0xb6, 0x69, 0x92, 0x2e, 0x07, 0x3c, 0xfb, 0xce, 0x5d, 0x20, 0x33,
-	0x84, 0xcc, 0x91, 0x8f, 0xe5, 0x69, 0xf8, 0xbd, 0xed, 0xa4, 0x6b, 0x38, 0xf4, 0xd8, 0xa4, 0x31,
-	0x93, 0xf9, 0x5d, 0x72, 0xe8, 0xf1, 0xce, 0xf3, 0x3d, 0xdd, 0x87, 0xdb, 0x06, 0xf5, 0xf8, 0x31,
-	0x4d, 0xd1, 0x1e, 0x87, 0xdc, 0x7b, 0xaf, 0x78, 0x7f, 0x29, 0x70, 0x3d, 0x29, 0xc1, 0x6c, 0x90,
-	0x6b, 0xb0, 0xd8, 0x67, 0x1e, 0x13, 0xe8, 0xb7, 0x68, 0xa4, 0x0b, 0xb2, 0x0a, 0x25, 0xee, 0xba,
-	0x11, 0x4d, 0x93, 0xa1, 0x68, 0xc8, 0x15, 0xf9, 0x14, 0xae, 0xf0, 0xb0, 0x67, 0xf9, 0xec, 0x27,
-	0x6c, 0xcb, 0xc3, 0xd2, 0x2c, 0x1a, 0xd5, 0xc9, 0xed, 0x69, 0x36, 0x0b, 0x93, 0x6c, 0x72, 0xea,
-	0x7b, 0x31, 0xa7, 0xbe, 0x57, 0xa1, 0x14, 0x51, 0x2b, 0xb4, 0x0f, 0xb1, 0x41, 0x96, 0x0d, 0xb9,
-	0xd2, 0x43, 0xd0, 0xb2, 0x42, 0x92, 0xc5, 0xb0, 0x09, 0x15, 0xc1, 0x85, 0xd5, 0x37, 0x6d, 0x1e,
-	0xfb, 0xc3, 0xc8, 0x00, 0xb7, 0xb6, 0x92, 0x1d, 0xf2, 0x00, 0x4a, 0x21, 0x8d, 0xe2, 0x7e, 0x12,
-	0x5e, 0xb1, 0x5e, 0x69, 0xaf, 0x67, 0x64, 0xd4, 0xb0, 0x6b, 0x19, 0xd2, 0x54, 0x7f, 0xa3, 0x00,
-	0x19, 0x99, 0xfc, 0x10, 0xd3, 0x98, 0x62, 0x53, 0xbb, 0xd8, 0x2b, 0x8d, 0x1a, 0x7e, 0x61, 0xa2,
-	0xe1, 0xaf, 0x40, 0xc9, 0x35, 0x03, 0x1e, 0xa6, 0xf5, 0x73, 0xd9, 0x58, 0x74, 0xf7, 0x79, 0x28,
-	0x92, 0xf6, 0xe8, 0x58, 0xc2, 0x42, 0x01, 0x2f, 0x19, 0xf8, 0xad, 0x7b, 0x50, 0xdb, 0xf1, 0x5f,
-	0x26, 0xe0, 0xa7, 0xa9, 0x0c, 0x9f, 0x74, 0x6f, 0x62, 0xbe, 0x9a, 0x68, 0x6b, 0x32, 0x41, 0x3d,
-	0x59, 0x3d, 0x33, 0x93, 0x64, 0x7c, 0x7b, 0x1c, 0xc6, 0x68, 0x4f, 0x7f, 0x08, 0x37, 0xe7, 0xc0,
-	0x49, 0xb9, 0x47, 0x31, 0x29, 0xe3, 0x98, 0xf4, 0x03, 0xb8, 0xf5, 0xb8, 0x1f, 0x47, 0x87, 0xd3,
-	0xa2, 0x8e, 0x2e, 0x47, 0xff, 0x2b, 0xc9, 0xf5, 0x2e, 0x7c, 0x7c, 0xfa, 0xdd, 0xdf, 0xd5, 0x69,
-	0x04, 0xb7, 0xe6, 0x3b, 0x95, 0x71, 0x3e, 0x81, 0x95, 0x2c, 0x5d, 0x23, 0x55, 0xc1, 0x24, 0xca,
-	0x15, 0xf6, 0xea, 0x69, 0x61, 0xa3, 0x46, 0x73, 0x22, 0x99, 0x46, 0xd3, 0x9c, 0x54, 0x60, 0x69,
-	0xeb, 0x69, 0xa7, 0xdb, 0x35, 0xb7, 0x96, 0x3f, 0x18, 0x2f, 0xbe, 0x5d, 0x56, 0xda, 0x7f, 0x00,
-	0xac, 0x4c, 0x5f, 0xe8, 0xa6, 0xc5, 0x42, 0x38, 0x94, 0xd2, 0xa1, 0x43, 0x6a, 0xc8, 0x60, 0xce,
-	0x78, 0xd3, 0x6e, 0xce, 0xb1, 0x48, 0xa3, 0xd4, 0x6b, 0x6f, 0xfe, 0xfe, 0xf7, 0xe7, 0x82, 0xa6,
-	0xaf, 0xe0, 0x2f, 0xd8, 0x88, 0xfa, 0x3d, 0x94, 0x31, 0xfa, 0x4a, 0x69, 0x90, 0x43, 0x28, 0xee,
-	0x52, 0x41, 0x6e, 0xa0, 0xaf, 0xbc, 0xf9, 0xa5, 0x7d, 0x94, 0x77, 0x2c, 0x71, 0x74, 0xc4, 0xd9,
-	0x20, 0x5a, 0x26, 0x4e, 0xeb, 0x35, 0x73, 0x4e, 0xc8, 0x00, 0x4a, 0xe9, 0x84, 0x90, 0xa1, 0xcd,
-	0x19, 0x17, 0xda, 0xea, 0xa9, 0xa1, 0xb3, 0x93, 0xfc, 0xfa, 0xe9, 0x9f, 0x21, 0x4e, 0x4b, 0x6b,
-	0xe4, 0xe0, 0xcc, 0x24, 0x4a, 0x93, 0x39, 0x27, 0x49, 0x90, 0x2e, 0x94, 0xd2, 0xf9, 0x21, 0xa1,
-	0xe7, 0x0c, 0x93, 0x5c, 0x68, 0x19, 0x62, 0x63, 0x5e, 0x88, 0x2e, 0x2c, 0x24, 0xc9, 0x47, 0x52,
-	0xb9, 0x72, 0xfb, 0xb4, 0xb6, 0x99, 0x7b, 0x2e, 0xf5, 0xbc, 0x81, 0x60, 0x6b, 0x24, 0xfb, 0xdd,
-	0xc8, 0x5b, 0x05, 0xca, 0xa3, 0x09, 0x47, 0x6e, 0xa3, 0xb7, 0xb3, 0x26, 0x5e, 0x6e, 0x60, 0x5f,
-	0x23, 0xd6, 0x17, 0x7a, 0xfb, 0x7c, 0x9a, 0x9a, 0xcc, 0x39, 0x69, 0x39, 0x88, 0x84, 0x09, 0xf4,
-	0x8b, 0x02, 0x97, 0x26, 0x47, 0x20, 0x69, 0x20, 0x9d, 0x73, 0x4d, 0xc5, 0x5c, 0x4e, 0xdb, 0xc8,
-	0xe9, 0x9b, 0xc6, 0xa3, 0x8b, 0x73, 0x6a, 0xbd, 0x96, 0xb3, 0xea, 0x84, 0xfc, 0xae, 0xc0, 0x92,
-	0xec, 0x78, 0x52, 0xa4, 0xb3, 0xda, 0xad, 0xf6, 0xc9, 0x59, 0x66, 0xf2, 0x81, 0xf6, 0x91, 0xe0,
-	0x77, 0xfa, 0xce, 0x99, 0x04, 0xc7, 0xbd, 0xa5, 0x99, 0xc5, 0x1a, 0x8f, 0x13, 0x1d, 0xdf, 0x2a,
-	0x00, 0xd8, 0x64, 0x11, 0x8c, 0xdc, 0x41, 0x22, 0xe7, 0xe9, 0xba, 0xb9, 0x22, 0x3e, 0x44, 0x8e,
-	0xed, 0xc6, 0xfd, 0x0b, 0x88, 0x98, 0x8a, 0xf5, 0xab, 0x02, 0xe5, 0x24, 0x3b, 0x53, 0x2a, 0xf5,
-	0x9c, 0x6c, 0x3d, 0xcd, 0xe4, 0xce, 0x39, 0x2c, 0xa5, 0x80, 0x92, 0x1c, 0xb9, 0x30, 0xb9, 0x17,
-	0x25, 0x0c, 0xf3, 0xc1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x26, 0x0a, 0x40, 0x7b, 0x78, 0x0e,
-	0x00, 0x00,
+	// 1424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0xd3, 0x56,
+	0x14, 0xc7, 0x49, 0x93, 0x2e, 0x27, 0x6d, 0x88, 0x2e, 0x6d, 0x09, 0x6e, 0xa1, 0xc1, 0xc0, 0x56,
+	0x22, 0x48, 0x50, 0xd8, 0x06, 0x4c, 0x6c, 0x53, 0x97, 0x86, 0xaa, 0x83, 0xd2, 0xcc, 0x69, 0x9f,
+	0xf6, 0x70, 0x65, 0xec, 0x9b, 0x60, 0x11, 0xff, 0xc1, 0xbe, 0x29, 0xca, 0x50, 0xf7, 0xc0, 0x1b,
+	0xcf, 0x7b, 0x99, 0xa6, 0x7d, 0x80, 0x69, 0x2f, 0xfb, 0x28, 0xd3, 0xb4, 0xaf, 0xb0, 0x7d, 0x88,
+	0xbd, 0x4d, 0x3e, 0xbe, 0x71, 0x93, 0xd4, 0x4e, 0x5a, 0x10, 0x6f, 0xbe, 0xe7, 0x9e, 0x7b, 0xce,
+	0xef, 0xfc, 0x3f, 0x86, 0x25, 0xab, 0xdf, 0xe3, 0xa6, 0xae, 0xf9, 0x7c, 0xdb, 0x73, 0xfa, 0x6e,
+	0xd5, 0xf5, 0x1c, 0xee, 0x90, 0xb4, 0xe6, 0x9a, 0xf2, 0x5a, 0xd7, 0x71, 0xba, 0x3d, 0x56, 0xd3,
+	0x5c, 0xb3, 0xa6, 0xd9, 0xb6, 0xc3, 0x35, 0x6e, 0x3a, 0xb6, 0x1f, 0xb2, 0xc8, 0xeb, 0xe2, 0x16,
+	0x4f, 0xcf, 0xfa, 0x9d, 0x1a, 0x37, 0x2d, 0xe6, 0x73, 0xcd, 0x12, 0x32, 0xe4, 0xd5, 0x49, 0x06,
+	0x66, 0xb9, 0x7c, 0x10, 0x5e, 0x2a, 0xff, 0xa5, 0xa0, 0xb0, 0x3b, 0xa6, 0x99, 0x14, 0x20, 0x65,
+	0x1a, 0x25, 0xa9, 0x2c, 0x6d, 0xe4, 0xd4, 0x94, 0x69, 0x10, 0x02, 0x73, 0xb6, 0x66, 0xb1, 0x52,
+	0x0a, 0x29, 0xf8, 0x4d, 0x2e, 0xc2, 0xbc, 0xa5, 0x53, 0xcd, 0x30, 0xbc, 0x52, 0x1a, 0xc9, 0x59,
+	0x4b, 0xdf, 0x34, 0x0c, 0x8f, 0xac, 0xc3, 0x82, 0xa5, 0x53, 0xfb, 0xd5, 0x0b, 0xea, 0xd3, 0x17,
+	0x6c, 0x50, 0x9a, 0xc3, 0xdb, 0x9c, 0xa5, 0x3f, 0x7d, 0xf5, 0xa2, 0xfd, 0x98, 0x0d, 0x04, 0x83,
+	0xe6, 0xba, 0x82, 0x21, 0x33, 0x64, 0xd8, 0x74, 0x5d, 0x64, 0xb8, 0x00, 0x99, 0x0e, 0xd5, 0x6d,
+	0x5e, 0xca, 0x96, 0xa5, 0x8d, 0x45, 0x75, 0xae, 0xd3, 0xb0, 0x39, 0xf9, 0x1c, 0xa0, 0x1b, 0x80,
+	0xa3, 0x7c, 0xe0, 0xb2, 0xd2, 0x7c, 0x59, 0xda, 0x28, 0xd4, 0x2f, 0x56, 0x35, 0xd7, 0xac, 0x8e,
+	0x83, 0xdf, 0x1f, 0xb8, 0x4c, 0xcd, 0x75, 0x87, 0x9f, 0x81, 0x2d, 0x86, 0x57, 0xfa, 0x08, 0x25,
+	0xa5, 0x0c, 0x8f, 0xac, 0x41, 0xae, 0xe3, 0xb1, 0x97, 0x7d, 0x66, 0xeb, 0x83, 0x52, 0x0e, 0xc9,
+	0xc7, 0x04, 0xb2, 0x01, 0x45, 0xd7, 0xb4, 0xbb, 0xd4, 0xef, 0x39, 0x9c, 0xba, 0xcc, 0x33, 0x1d,
+	0xa3, 0x04, 0xc8, 0x54, 0x08, 0xe8, 0xed, 0x9e, 0xc3, 0x5b, 0x48, 0x25, 0xb7, 0x80, 0xf8, 0xcc,
+	0x3b, 0x34, 0x75, 0x46, 0x5d, 0xcf, 0xe9, 0x98, 0x3d, 0x46, 0x4d, 0xa3, 0x94, 0x47, 0x5b, 0x8a,
+	0xe2, 0xa6, 0x15, 0x5e, 0xec, 0x6c, 0x91, 0x65, 0xc8, 0x5a, 0x3a, 0x5a, 0xbb, 0x80, 0x1c, 0x19,
+	0x4b, 0x7f, 0xcc, 0x06, 0xca, 0xaf, 0x12, 0xac, 0x8c, 0xc3, 0x7f, 0x62, 0xfa, 0x7c, 0x87, 0x33,
+	0xeb, 0x54, 0x31, 0x88, 0xc7, 0x90, 0x4e, 0xc0, 0x70, 0x07, 0x96, 0x26, 0xb9, 0x51, 0x62, 0x18,
+	0x20, 0x32, 0xce, 0xff, 0x54, 0xb3, 0x98, 0xf2, 0x3d, 0xac, 0x36, 0x3c, 0xa6, 0x71, 0x36, 0x8e,
+	0x51, 0x0d, 0xbc, 0xe5, 0x73, 0xf2, 0x10, 0xce, 0x47, 0x29, 0x4b, 0xd1, 0xe3, 0x88, 0x37, 0x5f,
+	0xbf, 0x10, 0x13, 0x17, 0xb5, 0x30, 0x9e, 0xde, 0x4a, 0x15, 0xd6, 0xe2, 0x85, 0xfb, 0xae, 0x63,
+	0xfb, 0x6c, 0xd2, 0x01, 0x4a, 0x05, 0x4a, 0xdb, 0x8c, 0xc7, 0x23, 0x99, 0xe4, 0xfd, 0x53, 0x82,
+	0x4b, 0x31, 0xcc, 0x42, 0xf2, 0x7b, 0xe1, 0x26, 0x0f, 0x00, 0x74, 0xc4, 0x6d, 0x50, 0x8d, 0x63,
+	0x38, 0xf2, 0x75, 0xb9, 0x1a, 0x56, 0x58, 0x75, 0x58, 0x61, 0xd5, 0xfd, 0x61, 0x09, 0xaa, 0x39,
+	0xc1, 0xbd, 0xc9, 0x83, 0xa7, 0x7d, 0xd7, 0x18, 0x3e, 0x4d, 0xcf, 0x7e, 0x2a, 0xb8, 0x37, 0x79,
+	0x10, 0x8a, 0x03, 0x3c, 0x7c, 0x88, 0x50, 0xdc, 0x86, 0xd5, 0x2d, 0xd6, 0x63, 0x9c, 0x9d, 0xce,
+	0xbb, 0x26, 0x94, 0x37, 0x0d, 0x63, 0x8b, 0x05, 0xe9, 0xb2, 0xef, 0xc4, 0xbf, 0xb9, 0x05, 0x64,
+	0x02, 0x10, 0x8d, 0x64, 0x14, 0xc7, 0xd5, 0xef, 0x6c, 0x05, 0xcd, 0xc4, 0x60, 0x87, 0x94, 0xf5,
+	0x4d, 0x91, 0xdf, 0x59, 0x83, 0x1d, 0x36, 0x0f, 0x76, 0x14, 0x1b, 0x6e, 0xa8, 0xcc, 0x72, 0x0e,
+	0x59, 0xa8, 0xed, 0x91, 0xe7, 0x58, 0x1f, 0x54, 0xdf, 0x5f, 0x12, 0x5c, 0x0a, 0x4a, 0x30, 0x5e,
+	0xc9, 0x12, 0x64, 0x7a, 0xa6, 0x65, 0x72, 0x94, 0x9b, 0x56, 0xc3, 0x03, 0x59, 0x81, 0xac, 0xd3,
+	0xe9, 0xf8, 0x2c, 0x4c, 0x86, 0xb4, 0x2a, 0x4e, 0xe4, 0x13, 0x38, 0xef, 0x78, 0x5d, 0xcd, 0x36,
+	0x7f, 0xc0, 0x6e, 0x3d, 0x2c, 0xcd, 0xb4, 0x5a, 0x18, 0x25, 0x8f, 0xa3, 0x99, 0x1b, 0x45, 0x93,
+	0x50, 0xdf, 0x99, 0x84, 0xfa, 0x5e, 0x81, 0xac, 0xcf, 0x34, 0x4f, 0x7f, 0x8e, 0x7d, 0x33, 0xa7,
+	0x8a, 0x93, 0xe2, 0x81, 0x1c, 0x67, 0x92, 0x28, 0x86, 0x75, 0xc8, 0x73, 0x87, 0x6b, 0x3d, 0xaa,
+	0x3b, 0x7d, 0x7b, 0x68, 0x19, 0x20, 0xa9, 0x11, 0x50, 0xc8, 0x5d, 0xc8, 0x7a, 0xcc, 0xef, 0xf7,
+	0x02, 0xf3, 0xd2, 0x1b, 0xf9, 0xfa, 0x6a, 0x4c, 0x46, 0x0d, 0xbb, 0x96, 0x2a, 0x58, 0x95, 0x37,
+	0x12, 0x90, 0x88, 0xe5, 0xbb, 0x3e, 0xeb, 0xb3, 0xe0, 0xfa, 0x8c, 0x51, 0x8a, 0xe6, 0x40, 0x6a,
+	0x64, 0x0e, 0x2c, 0x43, 0xb6, 0x43, 0x5d, 0xc7, 0x0b, 0xeb, 0x67, 0x51, 0xcd, 0x74, 0x5a, 0x8e,
+	0xc7, 0x83, 0xf6, 0x68, 0x68, 0x5c, 0x43, 0x07, 0x2e, 0xa8, 0xf8, 0xad, 0x58, 0x50, 0x6e, 0xda,
+	0x2f, 0x03, 0xe5, 0x27, 0xa1, 0x0c, 0x43, 0xba, 0x33, 0x32, 0x76, 0x29, 0xf2, 0x52, 0x93, 0x33,
+	0x4b, 0x54, 0xcf, 0xc4, 0x80, 0x39, 0x7e, 0x4d, 0xac, 0x13, 0x34, 0xe5, 0x3e, 0x5c, 0x9d, 0xa2,
+	0x4e, 0xb8, 0x3b, 0xb2, 0x49, 0x3a, 0xb6, 0x49, 0xd9, 0x87, 0xeb, 0x8f, 0x7a, 0x7d, 0xff, 0xf9,
+	0xb8, 0x53, 0xa3, 0xc7, 0xfe, 0x3b, 0x25, 0xb9, 0xd2, 0x86, 0x6b, 0x27, 0xe3, 0xfe, 0xbe, 0x42,
+	0x7d, 0xb8, 0x3e, 0x5d, 0xa8, 0xb0, 0xf3, 0x31, 0x2c, 0xc7, 0xf9, 0xd5, 0x2f, 0x49, 0xe5, 0xf4,
+	0x34, 0xc7, 0x5e, 0x38, 0xe9, 0x58, 0x5f, 0x39, 0x82, 0xf5, 0x40, 0x69, 0xd0, 0x09, 0x46, 0x7a,
+	0x54, 0x9b, 0xf1, 0x77, 0xad, 0xff, 0xa8, 0x90, 0x53, 0xf1, 0x85, 0x9c, 0x1e, 0x2d, 0x64, 0xe5,
+	0x8f, 0x14, 0xac, 0xc5, 0xe9, 0x8e, 0x66, 0xf5, 0x48, 0x01, 0x4b, 0x63, 0x05, 0xbc, 0x0e, 0x79,
+	0x03, 0x1b, 0x17, 0x1d, 0x99, 0xdd, 0x10, 0x92, 0x82, 0x09, 0x4b, 0xae, 0x40, 0xde, 0xd2, 0x8f,
+	0xf1, 0x86, 0x29, 0x9d, 0xb3, 0xf4, 0x21, 0xd0, 0x4f, 0x21, 0xe3, 0x73, 0x8d, 0x87, 0x43, 0xba,
+	0x50, 0xbf, 0x82, 0x6e, 0x8b, 0xc3, 0xd2, 0x0e, 0xb8, 0xd4, 0x90, 0x99, 0x5c, 0x83, 0x45, 0xe6,
+	0x79, 0x8e, 0x47, 0x2d, 0xe6, 0xfb, 0x5a, 0x97, 0x89, 0x96, 0xb1, 0x80, 0xc4, 0xdd, 0x90, 0x16,
+	0x60, 0xf3, 0x18, 0xf7, 0x06, 0xa2, 0xf0, 0xc3, 0x5d, 0x0b, 0x90, 0x14, 0x16, 0xfe, 0xf8, 0xb4,
+	0x9a, 0x3f, 0xcb, 0xb4, 0xfa, 0x11, 0xca, 0xc9, 0x01, 0x3b, 0x6d, 0xe3, 0x79, 0x30, 0xd1, 0x78,
+	0xae, 0x26, 0x1a, 0x3f, 0xd9, 0x7e, 0x2a, 0x14, 0x2e, 0x25, 0x3a, 0x89, 0x10, 0x28, 0xec, 0x36,
+	0xe8, 0xb6, 0xba, 0x77, 0xd0, 0xa2, 0xed, 0xe6, 0xfe, 0x41, 0xab, 0x78, 0x8e, 0x2c, 0x41, 0x71,
+	0xb7, 0x41, 0xdb, 0xcd, 0x76, 0x7b, 0x67, 0xef, 0xa9, 0xa0, 0x4a, 0x64, 0x11, 0x72, 0x8d, 0xbd,
+	0xdd, 0xd6, 0x93, 0xe6, 0x7e, 0x73, 0xab, 0x98, 0x22, 0x39, 0xc8, 0x34, 0x55, 0x75, 0x4f, 0x2d,
+	0xa6, 0x2b, 0xd5, 0x91, 0xf6, 0x16, 0xad, 0x9d, 0x24, 0x0f, 0xf3, 0x8d, 0x27, 0x9b, 0xed, 0x36,
+	0x6d, 0x14, 0xcf, 0x1d, 0x1f, 0xbe, 0x29, 0x4a, 0xf5, 0x7f, 0xf3, 0xb0, 0x3c, 0xfe, 0xa0, 0x1d,
+	0xb6, 0x6f, 0xe2, 0x40, 0x36, 0x5c, 0x83, 0x48, 0x19, 0xed, 0x9b, 0xb2, 0x70, 0xc9, 0x57, 0xa7,
+	0x70, 0x84, 0x5e, 0x55, 0xca, 0x6f, 0xfe, 0xfe, 0xe7, 0xa7, 0x94, 0xac, 0x2c, 0xe3, 0xbf, 0x42,
+	0x94, 0xf8, 0xb7, 0x31, 0xc5, 0xfc, 0x2f, 0xa4, 0x0a, 0x79, 0x0e, 0xe9, 0x6d, 0xc6, 0xc9, 0x65,
+	0x94, 0x95, 0xb4, 0x51, 0xc9, 0x57, 0x92, 0xae, 0x85, 0x1e, 0x05, 0xf5, 0xac, 0x11, 0x39, 0x56,
+	0x4f, 0xed, 0xb5, 0x69, 0x1c, 0x91, 0x01, 0x64, 0xc3, 0x9d, 0x45, 0x98, 0x36, 0x65, 0x81, 0x91,
+	0x57, 0x4e, 0x24, 0x56, 0x33, 0xf8, 0x47, 0x51, 0x3e, 0x43, 0x3d, 0x35, 0xb9, 0x92, 0xa0, 0x67,
+	0xa2, 0xe8, 0xab, 0xa6, 0x71, 0x14, 0x18, 0xd9, 0x81, 0x6c, 0xb8, 0xd1, 0x08, 0xd5, 0x53, 0xd6,
+	0x9b, 0x44, 0xd5, 0xc2, 0xc4, 0xca, 0x34, 0x13, 0x3b, 0x30, 0x17, 0x24, 0x1f, 0x09, 0xdd, 0x95,
+	0xb8, 0x39, 0xc8, 0xeb, 0x89, 0xf7, 0xc2, 0x9f, 0x97, 0x51, 0xd9, 0x45, 0x12, 0x1f, 0x37, 0xf2,
+	0x56, 0x82, 0x5c, 0xb4, 0x73, 0x91, 0x1b, 0x28, 0x6d, 0xd6, 0x0e, 0x96, 0x68, 0xd8, 0x97, 0xa8,
+	0xeb, 0x9e, 0x52, 0x3f, 0x9d, 0x4f, 0xa9, 0x69, 0x1c, 0xd5, 0xc2, 0x9e, 0x85, 0x09, 0xf4, 0xb3,
+	0x04, 0x0b, 0xa3, 0x4b, 0x19, 0xa9, 0x44, 0x85, 0x39, 0x73, 0x4f, 0x4b, 0xc4, 0xb4, 0x85, 0x98,
+	0xbe, 0xaa, 0x3c, 0x3c, 0x3b, 0xa6, 0xda, 0x6b, 0xd1, 0x7c, 0x8f, 0xc8, 0xef, 0x12, 0xcc, 0x8b,
+	0x19, 0x2c, 0x9c, 0x34, 0x6b, 0x01, 0x90, 0x3f, 0x9e, 0xc5, 0x26, 0x02, 0xd4, 0x42, 0x80, 0xdf,
+	0x2a, 0xcd, 0x99, 0x00, 0x8f, 0xa7, 0x5d, 0x35, 0x0e, 0x35, 0x5e, 0x07, 0x7e, 0x7c, 0x2b, 0x01,
+	0xe0, 0xd8, 0x47, 0x65, 0xe4, 0x26, 0x02, 0x39, 0xcd, 0x1e, 0x90, 0xe8, 0xc4, 0xfb, 0x88, 0xb1,
+	0x5e, 0xb9, 0x73, 0x06, 0x27, 0x86, 0xce, 0xfa, 0x45, 0x82, 0x5c, 0x90, 0x9d, 0x21, 0x94, 0x8d,
+	0x84, 0x6c, 0x3d, 0x89, 0xe4, 0xe6, 0x29, 0x38, 0x85, 0x03, 0x05, 0x38, 0x72, 0x76, 0x70, 0xbf,
+	0x49, 0x50, 0x4a, 0x1a, 0x27, 0xe4, 0x7a, 0x84, 0x60, 0xca, 0x7a, 0x20, 0xdf, 0x98, 0xc1, 0x25,
+	0x30, 0x7e, 0x8d, 0x18, 0x1f, 0x90, 0x7b, 0x67, 0xc0, 0xe8, 0xa1, 0xc0, 0xdb, 0x7e, 0x20, 0xe8,
+	0x59, 0x16, 0x23, 0x72, 0xf7, 0xff, 0x01, 0x00, 0x1b, 0xc3, 0xc5, 0x37, 0xcc, 0x11, 0x00, 0x00,
 }
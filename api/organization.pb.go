@@ -34,10 +34,13 @@ type Organization struct {
 	// Organization display name.
 	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	// Can the organization create and "own" Gateways?
-	CanHaveGateways      bool     `protobuf:"varint,4,opt,name=can_have_gateways,json=canHaveGateways,proto3" json:"can_have_gateways,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CanHaveGateways bool `protobuf:"varint,4,opt,name=can_have_gateways,json=canHaveGateways,proto3" json:"can_have_gateways,omitempty"`
+	// Number of days the device events are kept (0 = use the server default).
+	// This can only be set by global admin users.
+	DeviceEventRetentionDays uint32   `protobuf:"varint,5,opt,name=device_event_retention_days,json=deviceEventRetentionDays,proto3" json:"device_event_retention_days,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *Organization) Reset()         { *m = Organization{} }
@@ -92,6 +95,13 @@ func (m *Organization) GetCanHaveGateways() bool {
 	return false
 }
 
+func (m *Organization) GetDeviceEventRetentionDays() uint32 {
+	if m != nil {
+		return m.DeviceEventRetentionDays
+	}
+	return 0
+}
+
 type OrganizationListItem struct {
 	// Organization ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1424,66 +1434,68 @@ var _OrganizationService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("organization.proto", fileDescriptor_8d10c68ef159b9ed) }
 
 var fileDescriptor_8d10c68ef159b9ed = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0x96, 0x93, 0x34, 0x6d, 0xdf, 0x54, 0xbb, 0x5b, 0x53, 0x9a, 0x64, 0xda, 0x90, 0xac, 0x85,
-	0x44, 0x08, 0xab, 0x44, 0x04, 0x16, 0x09, 0xb4, 0x97, 0xb0, 0x45, 0xa1, 0x12, 0x02, 0x69, 0x60,
-	0x25, 0x2e, 0x30, 0x78, 0x33, 0x6e, 0x6b, 0x29, 0x99, 0x99, 0x8d, 0x3d, 0x5d, 0x95, 0x55, 0x0f,
-	0x70, 0xd8, 0x03, 0x7b, 0xe4, 0x77, 0xf0, 0x37, 0xf8, 0x03, 0x1c, 0xb8, 0x72, 0xe0, 0x37, 0x70,
-	0x65, 0x65, 0x8f, 0x53, 0x39, 0xf3, 0xd1, 0xaf, 0xad, 0xd4, 0xdb, 0xd8, 0x7e, 0xfc, 0x3e, 0xcf,
-	0xfb, 0xf8, 0x7d, 0xed, 0x01, 0x1c, 0xce, 0x0f, 0x69, 0xc0, 0x7f, 0xa6, 0x92, 0x87, 0x41, 0x3f,
-	0x9a, 0x87, 0x32, 0xc4, 0x65, 0x1a, 0x71, 0x67, 0xf7, 0x30, 0x0c, 0x0f, 0xa7, 0x6c, 0x40, 0x23,
-	0x3e, 0xa0, 0x41, 0x10, 0x4a, 0x8d, 0x10, 0x09, 0xc4, 0x69, 0x9b, 0x55, 0x3d, 0x7a, 0x1a, 0x1f,
-	0x0c, 0x24, 0x9f, 0x31, 0x21, 0xe9, 0x2c, 0x32, 0x80, 0x9d, 0x34, 0x80, 0xcd, 0x22, 0x79, 0x92,
-	0x2c, 0x92, 0x5f, 0x10, 0x6c, 0x7c, 0x63, 0xf1, 0xe2, 0x3b, 0x50, 0xe2, 0x7e, 0x03, 0x75, 0x50,
-	0xb7, 0xec, 0x96, 0xb8, 0x8f, 0x31, 0x54, 0x02, 0x3a, 0x63, 0x8d, 0x52, 0x07, 0x75, 0xd7, 0x5d,
-	0xfd, 0x8d, 0xef, 0xc3, 0x86, 0xcf, 0x45, 0x34, 0xa5, 0x27, 0x9e, 0x5e, 0x2b, 0xeb, 0xb5, 0x9a,
-	0x99, 0xfb, 0x5a, 0x41, 0x7a, 0xb0, 0x39, 0xa1, 0x81, 0x77, 0x44, 0x8f, 0x99, 0x77, 0x48, 0x25,
-	0x7b, 0x4e, 0x4f, 0x44, 0xa3, 0xd2, 0x41, 0xdd, 0x35, 0xf7, 0xee, 0x84, 0x06, 0x5f, 0xd2, 0x63,
-	0x36, 0x36, 0xd3, 0xe4, 0x7f, 0x04, 0x5b, 0xb6, 0x86, 0xaf, 0xb8, 0x90, 0xfb, 0x92, 0xcd, 0x6e,
-	0x41, 0x0b, 0xfe, 0x14, 0x60, 0x32, 0x67, 0x54, 0x32, 0xdf, 0xa3, 0xb2, 0xb1, 0xd2, 0x41, 0xdd,
-	0xda, 0xd0, 0xe9, 0x27, 0x0e, 0xf6, 0x17, 0x0e, 0xf6, 0xbf, 0x5b, 0x58, 0xec, 0xae, 0x1b, 0xf4,
-	0x48, 0xaa, 0xad, 0x71, 0xe4, 0x2f, 0xb6, 0x56, 0x2f, 0xde, 0x6a, 0xd0, 0x23, 0x49, 0xba, 0xb0,
-	0x3d, 0x66, 0xd2, 0xf6, 0xc0, 0x65, 0xcf, 0x62, 0x26, 0x64, 0xda, 0x02, 0xf2, 0x27, 0x82, 0x7a,
-	0x06, 0x2a, 0xa2, 0x30, 0x10, 0x0c, 0x3f, 0x84, 0x0d, 0xbb, 0x84, 0xf4, 0xae, 0xda, 0x70, 0xb3,
-	0x4f, 0x23, 0xde, 0x5f, 0xda, 0xb0, 0x04, 0x4b, 0xa5, 0x5c, 0xba, 0x7e, 0xca, 0xe5, 0xab, 0xa4,
-	0xec, 0x42, 0xf3, 0xb1, 0x8e, 0x93, 0x97, 0xf5, 0xf5, 0x32, 0x21, 0x0f, 0xc0, 0xc9, 0x8b, 0x69,
-	0xec, 0x49, 0x5b, 0xe9, 0x42, 0xf3, 0x89, 0x96, 0x73, 0x83, 0x0a, 0x3e, 0x80, 0xe6, 0x1e, 0x9b,
-	0xb2, 0xfc, 0x98, 0x69, 0x01, 0x1e, 0xd4, 0x55, 0xa9, 0xe7, 0x41, 0xb7, 0x60, 0x65, 0xca, 0x67,
-	0x5c, 0x1a, 0x74, 0x32, 0xc0, 0xdb, 0x50, 0x0d, 0x0f, 0x0e, 0x04, 0x4b, 0x4e, 0xa9, 0xec, 0x9a,
-	0x91, 0x9a, 0x17, 0x8c, 0xce, 0x27, 0x47, 0xa6, 0xfa, 0xcd, 0x88, 0x04, 0xd0, 0xc8, 0x12, 0x18,
-	0x37, 0xda, 0x50, 0x93, 0xa1, 0xa4, 0x53, 0x6f, 0x12, 0xc6, 0xc1, 0x82, 0x07, 0xf4, 0xd4, 0x63,
-	0x35, 0x83, 0x3f, 0x84, 0xea, 0x9c, 0x89, 0x78, 0xaa, 0xc8, 0xca, 0xdd, 0xda, 0xb0, 0x99, 0xc9,
-	0x7d, 0xd1, 0xa7, 0xae, 0x01, 0x92, 0x57, 0x08, 0xee, 0xd9, 0x80, 0x27, 0x82, 0xcd, 0xf1, 0x7b,
-	0x70, 0xd7, 0xb6, 0xc8, 0x3b, 0xb3, 0xe0, 0x8e, 0x3d, 0xbd, 0xbf, 0x87, 0xeb, 0xb0, 0x1a, 0x0b,
-	0x36, 0x57, 0x00, 0x93, 0x9e, 0x1a, 0xee, 0xef, 0xe1, 0x26, 0xac, 0x71, 0xe1, 0x51, 0x7f, 0xc6,
-	0x03, 0x9d, 0xe0, 0x9a, 0xbb, 0xca, 0xc5, 0x48, 0x0d, 0xb1, 0x03, 0x6b, 0x0a, 0xa4, 0x3b, 0xbf,
-	0xa2, 0x73, 0x3f, 0x1b, 0x93, 0x7f, 0x10, 0x34, 0xd2, 0x6a, 0xce, 0xae, 0x16, 0x8b, 0x0c, 0x2d,
-	0x91, 0xd9, 0x11, 0x4b, 0xcb, 0x11, 0xcf, 0x13, 0xb2, 0xdc, 0x44, 0x95, 0xeb, 0x37, 0xd1, 0xca,
-	0x55, 0x9a, 0xe8, 0x27, 0x70, 0x46, 0xbe, 0x9f, 0x4e, 0x72, 0x51, 0x44, 0x9f, 0xc3, 0xe6, 0x92,
-	0xf3, 0x2a, 0x0f, 0x53, 0xc8, 0x6f, 0x67, 0x0e, 0x53, 0x6f, 0xbc, 0x17, 0xa6, 0x66, 0xc8, 0x04,
-	0x5a, 0xd9, 0x26, 0xb9, 0x69, 0x12, 0x0a, 0xad, 0x6c, 0xd7, 0xd8, 0x24, 0x6f, 0x5c, 0x43, 0x24,
-	0x86, 0xdd, 0x74, 0x2b, 0x28, 0x02, 0x71, 0x65, 0x86, 0xb3, 0xce, 0x54, 0xf1, 0x57, 0xb2, 0x9d,
-	0x59, 0xd6, 0xd3, 0x66, 0x44, 0x9e, 0x43, 0xab, 0x80, 0xf6, 0xb2, 0x6d, 0xf8, 0x30, 0xd5, 0x86,
-	0xad, 0x5c, 0x53, 0x33, 0xad, 0xf8, 0x23, 0x38, 0xa9, 0x67, 0xe2, 0x66, 0xfd, 0xfc, 0x1b, 0xc1,
-	0x4e, 0x2e, 0x81, 0xc9, 0xeb, 0x06, 0xca, 0xe2, 0x76, 0x1e, 0xa6, 0xe1, 0x7f, 0xeb, 0xf0, 0x96,
-	0x2d, 0xee, 0x5b, 0x36, 0x3f, 0xe6, 0x13, 0x86, 0x3d, 0xa8, 0x28, 0x97, 0xf1, 0xae, 0x96, 0x5f,
-	0x70, 0x71, 0x3b, 0xad, 0x82, 0xd5, 0xc4, 0x16, 0xe2, 0xfc, 0xfa, 0xd7, 0xbf, 0xbf, 0x97, 0xb6,
-	0x30, 0xd6, 0x3f, 0x73, 0x76, 0xc6, 0x02, 0x53, 0x28, 0x8f, 0x99, 0xc4, 0x3b, 0x3a, 0x42, 0xfe,
-	0xef, 0x80, 0xb3, 0x9b, 0xbf, 0x68, 0xa2, 0xb7, 0x75, 0xf4, 0x26, 0xae, 0x67, 0xa3, 0x0f, 0x5e,
-	0x70, 0xff, 0x14, 0x1f, 0x41, 0x35, 0x79, 0x20, 0xf1, 0x3b, 0x3a, 0x50, 0xe1, 0x0b, 0xec, 0xb4,
-	0x0b, 0xd7, 0x0d, 0x57, 0x4b, 0x73, 0xd5, 0x49, 0x4e, 0x26, 0x9f, 0xa1, 0x1e, 0x7e, 0x06, 0xd5,
-	0xe4, 0xde, 0x30, 0x4c, 0x85, 0x2f, 0xad, 0xb3, 0x9d, 0x39, 0x96, 0x2f, 0xd4, 0xff, 0x29, 0x19,
-	0x68, 0x82, 0xf7, 0x9d, 0x77, 0xf3, 0x92, 0x59, 0xfa, 0x55, 0xe6, 0xfe, 0xa9, 0xa2, 0xa4, 0x50,
-	0x4d, 0x6e, 0x11, 0x43, 0x59, 0xf8, 0x10, 0x17, 0x52, 0x1a, 0xff, 0x7a, 0x85, 0xfe, 0xbd, 0x44,
-	0xb0, 0xae, 0xce, 0x56, 0xf7, 0x30, 0xbe, 0x9f, 0x7b, 0xd6, 0xf6, 0xb5, 0xe2, 0x90, 0xf3, 0x20,
-	0xc6, 0xc9, 0xa1, 0x66, 0x7d, 0x80, 0x7b, 0x17, 0x25, 0xea, 0x71, 0xff, 0x74, 0x10, 0x6b, 0xea,
-	0xdf, 0x10, 0xac, 0x8e, 0x99, 0xd6, 0x81, 0xdb, 0x79, 0x35, 0x61, 0x75, 0xbb, 0xd3, 0x29, 0x06,
-	0x18, 0x09, 0x8f, 0xb4, 0x84, 0x4f, 0xf0, 0xc7, 0x97, 0x97, 0x30, 0x78, 0x61, 0x2e, 0x86, 0x53,
-	0xfc, 0x0a, 0xc1, 0xea, 0xc8, 0xf7, 0x2d, 0x31, 0xc5, 0x8f, 0x52, 0xa1, 0xf7, 0x63, 0x2d, 0x61,
-	0x44, 0x1e, 0x5d, 0x28, 0x41, 0xf1, 0xf6, 0xf3, 0x45, 0xa9, 0x32, 0xf8, 0x03, 0x01, 0x24, 0xd5,
-	0xa6, 0x05, 0x91, 0x82, 0xf2, 0xbb, 0x8c, 0xa6, 0x89, 0xd6, 0xf4, 0x83, 0xf3, 0xfd, 0x9b, 0x68,
-	0xca, 0x43, 0x2e, 0xac, 0x53, 0x7a, 0x5f, 0x22, 0x80, 0xa4, 0x54, 0x2d, 0xbd, 0xe7, 0x3e, 0x87,
-	0x85, 0x7a, 0xcd, 0x31, 0xf6, 0xae, 0x75, 0x8c, 0x4f, 0xab, 0x3a, 0xda, 0x47, 0xaf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x3c, 0xad, 0xf9, 0x9b, 0x88, 0x0e, 0x00, 0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xd6, 0xd8, 0x8e, 0x93, 0xbc, 0x2e, 0x6d, 0x33, 0x84, 0xd8, 0xde, 0xc4, 0xd8, 0x1d, 0x21,
+	0x61, 0x4c, 0x65, 0x0b, 0x43, 0x91, 0x40, 0xe5, 0x60, 0x9a, 0xca, 0x44, 0x42, 0x20, 0x2d, 0x54,
+	0xe2, 0x02, 0xcb, 0xd4, 0x3b, 0x49, 0x46, 0xb2, 0x77, 0xb7, 0x3b, 0xe3, 0x54, 0xa1, 0xca, 0x85,
+	0x43, 0x0f, 0xf4, 0xc8, 0xef, 0xe0, 0x3f, 0x70, 0xe2, 0x0f, 0x70, 0xe0, 0xca, 0x81, 0xdf, 0xc0,
+	0x15, 0x34, 0xb3, 0xe3, 0x68, 0xbc, 0x1f, 0xf9, 0x96, 0x7a, 0xdb, 0x77, 0xe6, 0x99, 0xf7, 0x79,
+	0xde, 0xaf, 0x99, 0x05, 0x1c, 0xc6, 0x07, 0x34, 0xe0, 0x3f, 0x51, 0xc9, 0xc3, 0xa0, 0x1f, 0xc5,
+	0xa1, 0x0c, 0x71, 0x99, 0x46, 0xdc, 0xd9, 0x39, 0x08, 0xc3, 0x83, 0x29, 0x1b, 0xd0, 0x88, 0x0f,
+	0x68, 0x10, 0x84, 0x52, 0x23, 0x44, 0x02, 0x71, 0xda, 0x66, 0x57, 0x5b, 0x4f, 0xe7, 0xfb, 0x03,
+	0xc9, 0x67, 0x4c, 0x48, 0x3a, 0x8b, 0x0c, 0x60, 0x3b, 0x0d, 0x60, 0xb3, 0x48, 0x1e, 0x27, 0x9b,
+	0xe4, 0x77, 0x04, 0xb7, 0xbe, 0xb6, 0x78, 0xf1, 0x6d, 0x28, 0x71, 0xbf, 0x81, 0x3a, 0xa8, 0x5b,
+	0x76, 0x4b, 0xdc, 0xc7, 0x18, 0x2a, 0x01, 0x9d, 0xb1, 0x46, 0xa9, 0x83, 0xba, 0xeb, 0xae, 0xfe,
+	0xc6, 0xf7, 0xe0, 0x96, 0xcf, 0x45, 0x34, 0xa5, 0xc7, 0x9e, 0xde, 0x2b, 0xeb, 0xbd, 0x9a, 0x59,
+	0xfb, 0x4a, 0x41, 0x7a, 0xb0, 0x31, 0xa1, 0x81, 0x77, 0x48, 0x8f, 0x98, 0x77, 0x40, 0x25, 0x7b,
+	0x4e, 0x8f, 0x45, 0xa3, 0xd2, 0x41, 0xdd, 0x35, 0xf7, 0xce, 0x84, 0x06, 0x5f, 0xd0, 0x23, 0x36,
+	0x36, 0xcb, 0xf8, 0x33, 0xd8, 0xf6, 0xd9, 0x11, 0x9f, 0x30, 0x8f, 0x1d, 0xb1, 0x40, 0x7a, 0x31,
+	0x93, 0x2c, 0x50, 0x62, 0x3c, 0x5f, 0x9d, 0x5a, 0xe9, 0xa0, 0xee, 0x1b, 0x6e, 0x23, 0x81, 0x3c,
+	0x56, 0x08, 0x77, 0x01, 0xd8, 0xa5, 0xc7, 0x82, 0xfc, 0x87, 0x60, 0xd3, 0x0e, 0xe1, 0x4b, 0x2e,
+	0xe4, 0x9e, 0x64, 0xb3, 0xd7, 0x11, 0xca, 0x27, 0x00, 0x93, 0x98, 0x51, 0xc9, 0x7c, 0x8f, 0x4a,
+	0xad, 0xbc, 0x36, 0x74, 0xfa, 0x49, 0x01, 0xfa, 0x8b, 0x02, 0xf4, 0xbf, 0x5d, 0x54, 0xc8, 0x5d,
+	0x37, 0xe8, 0x91, 0x54, 0x47, 0xe7, 0x91, 0xbf, 0x38, 0x5a, 0x3d, 0xff, 0xa8, 0x41, 0x8f, 0x24,
+	0xe9, 0xc2, 0xd6, 0x98, 0x49, 0x3b, 0x07, 0x2e, 0x7b, 0x36, 0x67, 0x42, 0xa6, 0x53, 0x40, 0xfe,
+	0x40, 0x50, 0xcf, 0x40, 0x45, 0x14, 0x06, 0x82, 0xe1, 0x07, 0x70, 0xcb, 0xee, 0x40, 0x7d, 0xaa,
+	0x36, 0xdc, 0xe8, 0xd3, 0x88, 0xf7, 0x97, 0x0e, 0x2c, 0xc1, 0x52, 0x21, 0x97, 0xae, 0x1e, 0x72,
+	0xf9, 0x32, 0x21, 0xbb, 0xd0, 0x7c, 0xa4, 0xfd, 0xe4, 0x45, 0x7d, 0xb5, 0x48, 0xc8, 0x7d, 0x70,
+	0xf2, 0x7c, 0x9a, 0xf4, 0xa4, 0x53, 0xe9, 0x42, 0xf3, 0x49, 0xe4, 0x67, 0xd0, 0xd7, 0x52, 0xf0,
+	0x3e, 0x34, 0x77, 0xd9, 0x94, 0xe5, 0xfb, 0x4c, 0x0b, 0xf0, 0xa0, 0xae, 0x5a, 0x3d, 0x0f, 0xba,
+	0x09, 0x2b, 0x53, 0x3e, 0xe3, 0xd2, 0xa0, 0x13, 0x03, 0x6f, 0x41, 0x35, 0xdc, 0xdf, 0x17, 0x2c,
+	0xa9, 0x52, 0xd9, 0x35, 0x96, 0x5a, 0x17, 0x8c, 0xc6, 0x93, 0x43, 0xd3, 0xfd, 0xc6, 0x22, 0x01,
+	0x34, 0xb2, 0x04, 0x26, 0x1b, 0x6d, 0xa8, 0xc9, 0x50, 0xd2, 0xa9, 0x37, 0x09, 0xe7, 0xc1, 0x82,
+	0x07, 0xf4, 0xd2, 0x23, 0xb5, 0x82, 0x3f, 0x80, 0x6a, 0xcc, 0xc4, 0x7c, 0xaa, 0xc8, 0xca, 0xdd,
+	0xda, 0xb0, 0x99, 0x89, 0x7d, 0x31, 0xa7, 0xae, 0x01, 0x92, 0x57, 0x08, 0xee, 0xda, 0x80, 0x27,
+	0x82, 0xc5, 0xf8, 0x5d, 0xb8, 0x63, 0xa7, 0xc8, 0x3b, 0x4d, 0xc1, 0x6d, 0x7b, 0x79, 0x6f, 0x17,
+	0xd7, 0x61, 0x75, 0x2e, 0x58, 0xac, 0x00, 0x26, 0x3c, 0x65, 0xee, 0xed, 0xe2, 0x26, 0xac, 0x71,
+	0xe1, 0x51, 0x7f, 0xc6, 0x03, 0x1d, 0xe0, 0x9a, 0xbb, 0xca, 0xc5, 0x48, 0x99, 0xd8, 0x81, 0x35,
+	0x05, 0xd2, 0x93, 0x5f, 0xd1, 0xb1, 0x9f, 0xda, 0xe4, 0x6f, 0x04, 0x8d, 0xb4, 0x9a, 0xd3, 0xab,
+	0xc5, 0x22, 0x43, 0x4b, 0x64, 0xb6, 0xc7, 0xd2, 0xb2, 0xc7, 0xb3, 0x84, 0x2c, 0x0f, 0x51, 0xe5,
+	0xea, 0x43, 0xb4, 0x72, 0x99, 0x21, 0xfa, 0x11, 0x9c, 0x91, 0xef, 0xa7, 0x83, 0x5c, 0x34, 0xd1,
+	0xe7, 0xb0, 0xb1, 0x94, 0x79, 0x15, 0x87, 0x69, 0xe4, 0xb7, 0x32, 0xc5, 0xd4, 0x07, 0xef, 0x86,
+	0xa9, 0x15, 0x32, 0x81, 0x56, 0x76, 0x48, 0x6e, 0x9a, 0x84, 0x42, 0x2b, 0x3b, 0x35, 0x36, 0xc9,
+	0xb5, 0x7b, 0x88, 0xcc, 0x61, 0x27, 0x3d, 0x0a, 0x8a, 0x40, 0x5c, 0x9a, 0xe1, 0x74, 0x32, 0x95,
+	0xff, 0x95, 0xec, 0x64, 0x96, 0xf5, 0xb2, 0xb1, 0xc8, 0x73, 0x68, 0x15, 0xd0, 0x5e, 0x74, 0x0c,
+	0x1f, 0xa4, 0xc6, 0xb0, 0x95, 0x9b, 0xd4, 0xcc, 0x28, 0xfe, 0x00, 0x4e, 0xea, 0x99, 0xb8, 0xd9,
+	0x7c, 0xfe, 0x85, 0x60, 0x3b, 0x97, 0xc0, 0xc4, 0x75, 0x03, 0x6d, 0xf1, 0x7a, 0x1e, 0xa6, 0xe1,
+	0xbf, 0xeb, 0xf0, 0xa6, 0x2d, 0xee, 0x1b, 0x16, 0xab, 0xff, 0x16, 0xec, 0x41, 0x45, 0x65, 0x19,
+	0xef, 0x68, 0xf9, 0x05, 0x17, 0xb7, 0xd3, 0x2a, 0xd8, 0x4d, 0xd2, 0x42, 0x9c, 0x9f, 0xff, 0xfc,
+	0xe7, 0xd7, 0xd2, 0x26, 0xc6, 0xfa, 0x5f, 0xd0, 0x8e, 0x58, 0x60, 0x0a, 0xe5, 0x31, 0x93, 0x78,
+	0x5b, 0x7b, 0xc8, 0xff, 0x1d, 0x70, 0x76, 0xf2, 0x37, 0x8d, 0xf7, 0xb6, 0xf6, 0xde, 0xc4, 0xf5,
+	0xac, 0xf7, 0xc1, 0x0b, 0xee, 0x9f, 0xe0, 0x43, 0xa8, 0x26, 0x0f, 0x24, 0x7e, 0x5b, 0x3b, 0x2a,
+	0x7c, 0x81, 0x9d, 0x76, 0xe1, 0xbe, 0xe1, 0x6a, 0x69, 0xae, 0x3a, 0xc9, 0x89, 0xe4, 0x53, 0xd4,
+	0xc3, 0xcf, 0xa0, 0x9a, 0xdc, 0x1b, 0x86, 0xa9, 0xf0, 0xa5, 0x75, 0xb6, 0x32, 0x65, 0x79, 0xac,
+	0x7e, 0x6f, 0xc9, 0x40, 0x13, 0xbc, 0xe7, 0xbc, 0x93, 0x17, 0x8c, 0x6d, 0xf6, 0xb9, 0x7f, 0xa2,
+	0x28, 0x29, 0x54, 0x93, 0x5b, 0xc4, 0x50, 0x16, 0x3e, 0xc4, 0x85, 0x94, 0x26, 0x7f, 0xbd, 0xc2,
+	0xfc, 0xbd, 0x44, 0xb0, 0xae, 0x6a, 0xab, 0x67, 0x18, 0xdf, 0xcb, 0xad, 0xb5, 0x7d, 0xad, 0x38,
+	0xe4, 0x2c, 0x88, 0xc9, 0xe4, 0x50, 0xb3, 0xde, 0xc7, 0xbd, 0xf3, 0x02, 0xf5, 0xb8, 0x7f, 0x32,
+	0x98, 0x6b, 0xea, 0x5f, 0x10, 0xac, 0x8e, 0x99, 0xd6, 0x81, 0xdb, 0x79, 0x3d, 0x61, 0x4d, 0xbb,
+	0xd3, 0x29, 0x06, 0x18, 0x09, 0x0f, 0xb5, 0x84, 0x8f, 0xf1, 0x47, 0x17, 0x97, 0x30, 0x78, 0x61,
+	0x2e, 0x86, 0x13, 0xfc, 0x0a, 0xc1, 0xea, 0xc8, 0xf7, 0x2d, 0x31, 0xc5, 0x8f, 0x52, 0x61, 0xee,
+	0xc7, 0x5a, 0xc2, 0x88, 0x3c, 0x3c, 0x57, 0x82, 0xe2, 0xed, 0xe7, 0x8b, 0x52, 0x6d, 0xf0, 0x1b,
+	0x02, 0x48, 0xba, 0x4d, 0x0b, 0x22, 0x05, 0xed, 0x77, 0x11, 0x4d, 0x13, 0xad, 0xe9, 0x7b, 0xe7,
+	0xbb, 0xeb, 0x68, 0xca, 0x43, 0x2e, 0x52, 0xa7, 0xf4, 0xbe, 0x44, 0x00, 0x49, 0xab, 0x5a, 0x7a,
+	0xcf, 0x7c, 0x0e, 0x0b, 0xf5, 0x9a, 0x32, 0xf6, 0xae, 0x54, 0xc6, 0xa7, 0x55, 0xed, 0xed, 0xc3,
+	0xff, 0x07, 0x00, 0x6f, 0xf2, 0xb9, 0x3f, 0xc7, 0x0e, 0x00, 0x00,
 }
@@ -31,7 +31,6 @@ var RatePolicy_name = map[int32]string{
 	0: "DROP",
 	1: "MARK",
 }
-
 var RatePolicy_value = map[string]int32{
 	"DROP": 0,
 	"MARK": 1,
@@ -40,7 +39,6 @@ var RatePolicy_value = map[string]int32{
 func (x RatePolicy) String() string {
 	return proto.EnumName(RatePolicy_name, int32(x))
 }
-
 func (RatePolicy) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9610db3cccb08234, []int{0}
 }
@@ -329,7 +327,14 @@ type DeviceProfile struct {
 	// RF region name.
 	RfRegion string `protobuf:"bytes,19,opt,name=rf_region,json=rfRegion,proto3" json:"rf_region,omitempty"`
 	// End-Device uses 32bit FCnt (mandatory for LoRaWAN 1.0 End-Device).
-	Supports_32BitFCnt   bool     `protobuf:"varint,20,opt,name=supports_32bit_f_cnt,json=supports32BitFCnt,proto3" json:"supports_32bit_f_cnt,omitempty"`
+	Supports_32BitFCnt bool `protobuf:"varint,20,opt,name=supports_32bit_f_cnt,json=supports32BitFCnt,proto3" json:"supports_32bit_f_cnt,omitempty"`
+	// Payload codec.
+	// When left blank, the payload codec of the application is used.
+	PayloadCodec string `protobuf:"bytes,24,opt,name=payload_codec,json=payloadCodec,proto3" json:"payload_codec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,25,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string   `protobuf:"bytes,26,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -520,6 +525,27 @@ func (m *DeviceProfile) GetSupports_32BitFCnt() bool {
 	return false
 }
 
+func (m *DeviceProfile) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *DeviceProfile) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ServiceProfile)(nil), "api.ServiceProfile")
 	proto.RegisterType((*DeviceProfile)(nil), "api.DeviceProfile")
@@ -529,63 +555,66 @@ func init() {
 func init() { proto.RegisterFile("profiles.proto", fileDescriptor_9610db3cccb08234) }
 
 var fileDescriptor_9610db3cccb08234 = []byte{
-	// 917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
-	0x14, 0x9d, 0x9b, 0xd4, 0xb1, 0x19, 0x4b, 0x76, 0x98, 0xa4, 0x55, 0xf7, 0xe9, 0xa5, 0xc3, 0x66,
-	0x14, 0x58, 0xb6, 0x38, 0x18, 0x86, 0x3d, 0x36, 0x51, 0x1b, 0x64, 0x5b, 0x50, 0x83, 0x19, 0xd6,
-	0x47, 0xe2, 0x5a, 0xa4, 0x1d, 0xce, 0x92, 0xa8, 0x50, 0x94, 0x2d, 0xe7, 0x8f, 0x6e, 0x3f, 0x67,
-	0xe0, 0x95, 0xfc, 0xd1, 0x14, 0x7b, 0xdf, 0x9b, 0x75, 0xce, 0xb9, 0x3c, 0xbc, 0xbc, 0x3c, 0x34,
-	0xf1, 0x33, 0xa3, 0x27, 0x2a, 0x96, 0xf9, 0x69, 0x66, 0xb4, 0xd5, 0x74, 0x07, 0x32, 0x75, 0xf2,
-	0x77, 0x93, 0xf8, 0xb7, 0xd2, 0xcc, 0x55, 0x24, 0x47, 0x15, 0x4d, 0x7d, 0xf2, 0x44, 0x89, 0xa0,
-	0xd1, 0x6f, 0x0c, 0xda, 0xec, 0x89, 0x12, 0x94, 0x92, 0xdd, 0x14, 0x12, 0x19, 0x1c, 0x23, 0x82,
-	0xbf, 0xe9, 0x77, 0xa4, 0xab, 0xcd, 0x14, 0x52, 0xf5, 0x00, 0x56, 0xe9, 0x94, 0x2b, 0x11, 0x3c,
-	0xeb, 0x37, 0x06, 0x3b, 0xcc, 0xdf, 0x86, 0xaf, 0x43, 0xfa, 0x8a, 0x1c, 0xa4, 0xd2, 0x2e, 0xb4,
-	0x99, 0xf1, 0x5c, 0x9a, 0xb9, 0x34, 0x4e, 0xfa, 0x1c, 0xa5, 0xdd, 0x9a, 0xb8, 0x45, 0xfc, 0x3a,
-	0xa4, 0xcf, 0xc9, 0x5e, 0x11, 0x73, 0x03, 0x56, 0x06, 0x4f, 0xfa, 0x8d, 0x81, 0xc7, 0x9a, 0x45,
-	0xcc, 0xc0, 0x4a, 0xfa, 0x0d, 0xf1, 0x8b, 0x98, 0x8f, 0x8b, 0x68, 0x26, 0x2d, 0xcf, 0xd5, 0x83,
-	0x0c, 0x76, 0x90, 0xef, 0x14, 0xf1, 0x05, 0x82, 0xb7, 0xea, 0x41, 0xd2, 0x9f, 0x50, 0xe5, 0xca,
-	0x79, 0xa6, 0x63, 0x15, 0x2d, 0x83, 0xdd, 0x7e, 0x63, 0xe0, 0x0f, 0xbb, 0xa7, 0x90, 0xa9, 0x53,
-	0xb7, 0xd0, 0x08, 0x61, 0x57, 0xb6, 0xf9, 0x72, 0xae, 0xa2, 0x76, 0x7d, 0x5a, 0xb9, 0x8a, 0xb5,
-	0xab, 0xf8, 0xd0, 0xb5, 0x59, 0xb9, 0x8a, 0x47, 0xae, 0xe2, 0x43, 0xd7, 0xbd, 0xff, 0x70, 0x15,
-	0xdb, 0xae, 0xdf, 0x92, 0x2e, 0x08, 0xc1, 0xa7, 0x0b, 0x9e, 0x48, 0x0b, 0x02, 0x2c, 0x04, 0xad,
-	0x7e, 0x63, 0xd0, 0x62, 0x1e, 0x08, 0x71, 0xf5, 0xfe, 0x46, 0x5a, 0x08, 0xc1, 0x02, 0xfd, 0x9e,
-	0x1c, 0x0a, 0x39, 0xe7, 0xb9, 0x05, 0x5b, 0xe4, 0xdc, 0xc8, 0x7b, 0x3e, 0x31, 0xf2, 0x3e, 0x68,
-	0xe3, 0x4e, 0x7a, 0x42, 0xce, 0x6f, 0x91, 0x61, 0xf2, 0xfe, 0xad, 0x91, 0xf7, 0xf4, 0x17, 0xf2,
-	0xc2, 0xc8, 0x4c, 0x1b, 0xcb, 0xb7, 0xaa, 0xc6, 0x60, 0xad, 0x34, 0xcb, 0x80, 0xa0, 0xc1, 0xb3,
-	0x4a, 0x10, 0xae, 0x4a, 0x2f, 0x2a, 0x96, 0xfe, 0x4c, 0x82, 0x8f, 0x4b, 0x13, 0x30, 0x53, 0x95,
-	0x06, 0xfb, 0x58, 0x79, 0xfc, 0xa8, 0xf2, 0x06, 0x49, 0x7a, 0x4c, 0x9a, 0xc2, 0xf0, 0x44, 0xa5,
-	0x41, 0x07, 0x77, 0xf5, 0x54, 0x98, 0x9b, 0x0d, 0x0c, 0x65, 0xe0, 0xad, 0x61, 0x28, 0xe9, 0xd7,
-	0xa4, 0x13, 0xdd, 0x41, 0x9a, 0xca, 0x98, 0x27, 0x90, 0xcf, 0x02, 0xbf, 0xdf, 0x18, 0x74, 0xd8,
-	0x7e, 0x8d, 0xdd, 0x40, 0x3e, 0xa3, 0x5f, 0x10, 0x92, 0x19, 0x0e, 0x71, 0xac, 0x17, 0x52, 0x04,
-	0x5d, 0xf4, 0x6e, 0x67, 0xe6, 0x75, 0x05, 0x38, 0xfa, 0x6e, 0x43, 0xf7, 0x2a, 0xfa, 0x6e, 0x9b,
-	0x36, 0xb0, 0xa6, 0x0f, 0x2a, 0xda, 0xc0, 0x8a, 0xfe, 0x92, 0xec, 0xa7, 0x8b, 0x19, 0x9f, 0x4a,
-	0xcd, 0x63, 0x1d, 0x05, 0xb4, 0xe2, 0xd3, 0xc5, 0xec, 0x4a, 0xea, 0xdf, 0x75, 0xe4, 0xca, 0x2d,
-	0x98, 0xa9, 0xb4, 0x3c, 0x93, 0x26, 0x38, 0xc4, 0xad, 0xb7, 0x2b, 0x64, 0xf4, 0x86, 0xd1, 0x01,
-	0xe9, 0x25, 0x2a, 0x75, 0x73, 0x13, 0x6a, 0x2e, 0x4d, 0xae, 0xec, 0x32, 0x38, 0x42, 0x91, 0x9f,
-	0xa8, 0xf4, 0xea, 0x7d, 0xb8, 0x42, 0x4f, 0xfe, 0x69, 0x12, 0x2f, 0x94, 0xff, 0x8b, 0x60, 0x0d,
-	0x48, 0x2f, 0x2f, 0x32, 0x37, 0xbb, 0x9c, 0x47, 0x31, 0xe4, 0x39, 0x1f, 0x63, 0xc2, 0x5a, 0xcc,
-	0x5f, 0xe1, 0x97, 0x0e, 0xbe, 0x70, 0xd7, 0xb2, 0x16, 0x70, 0xab, 0x12, 0xa9, 0x0b, 0x5b, 0x47,
-	0xcd, 0x43, 0xf8, 0xe2, 0x8f, 0x0a, 0x74, 0x2b, 0x66, 0x2a, 0x9d, 0xf2, 0x3c, 0xd6, 0x78, 0x50,
-	0x4a, 0x0b, 0x4c, 0x9b, 0xc7, 0x7c, 0x87, 0xdf, 0xc6, 0xda, 0x8e, 0x10, 0xa5, 0x7d, 0xd2, 0xd9,
-	0x28, 0x85, 0xa9, 0x33, 0x46, 0x56, 0xaa, 0x90, 0xb9, 0x9c, 0x6d, 0x14, 0x78, 0xbb, 0xeb, 0x9c,
-	0xad, 0x34, 0x78, 0xb3, 0x3f, 0xee, 0x21, 0xc2, 0xa4, 0x3d, 0xee, 0xe1, 0x72, 0xd3, 0x43, 0xb4,
-	0xee, 0xa1, 0xb5, 0xd5, 0xc3, 0xe5, 0xaa, 0x87, 0xaf, 0xc8, 0x7e, 0x02, 0x11, 0xc7, 0x79, 0xe9,
-	0x14, 0x23, 0xd5, 0x66, 0x24, 0x81, 0xe8, 0xcf, 0x0a, 0xa1, 0xa7, 0xe4, 0xd0, 0xc8, 0x29, 0xcf,
-	0xc0, 0x40, 0xe2, 0xb2, 0x37, 0x57, 0x28, 0x24, 0x28, 0x3c, 0x30, 0x72, 0x3a, 0x42, 0x86, 0xd5,
-	0x04, 0xfd, 0x9c, 0x10, 0x53, 0x72, 0x21, 0x63, 0x58, 0xf2, 0x33, 0xcc, 0x8c, 0xc7, 0x5a, 0xa6,
-	0x0c, 0x1d, 0x70, 0x46, 0x5f, 0x12, 0xdf, 0xb1, 0x86, 0xeb, 0xc9, 0x24, 0x97, 0x96, 0x9f, 0xd5,
-	0x71, 0xd9, 0x37, 0x65, 0xc8, 0xde, 0x21, 0x76, 0x46, 0x4f, 0x88, 0xe7, 0x44, 0x60, 0x01, 0x5f,
-	0x94, 0x61, 0x9d, 0x1d, 0xa7, 0x01, 0x0b, 0xee, 0xfd, 0x18, 0xd2, 0x4f, 0x49, 0xdb, 0x94, 0x78,
-	0x50, 0x7c, 0x88, 0xf1, 0xf1, 0xd8, 0x9e, 0x29, 0xdd, 0x21, 0x0d, 0xe9, 0x8f, 0xe4, 0x68, 0x02,
-	0x91, 0xd5, 0x66, 0xc9, 0x33, 0x23, 0x9d, 0x8d, 0xd3, 0xe5, 0x41, 0xb7, 0xbf, 0x33, 0xf0, 0x18,
-	0xad, 0xb9, 0x11, 0x52, 0xae, 0x22, 0xa7, 0x2f, 0x48, 0x2b, 0x81, 0x92, 0x4b, 0x65, 0x32, 0xcc,
-	0x92, 0xc7, 0xf6, 0x12, 0x28, 0xdf, 0x5c, 0xb3, 0x91, 0x1b, 0x8c, 0xa3, 0x44, 0x61, 0x97, 0x3c,
-	0x5a, 0x46, 0xb1, 0xc4, 0x34, 0x79, 0xac, 0x93, 0x40, 0x19, 0x16, 0x76, 0x79, 0xe9, 0x30, 0xfa,
-	0x92, 0x78, 0xeb, 0xc1, 0xfc, 0xa5, 0x55, 0x5a, 0x47, 0xaa, 0xb3, 0x02, 0x7f, 0xd5, 0x2a, 0xa5,
-	0x9f, 0x91, 0xb6, 0x99, 0x70, 0x23, 0xa7, 0xee, 0x00, 0x0f, 0xf1, 0x00, 0x5b, 0x66, 0xc2, 0xf0,
-	0x9b, 0xfe, 0x40, 0x8e, 0xd6, 0x2b, 0x9c, 0x0f, 0xc7, 0xca, 0xf2, 0x09, 0x8f, 0x52, 0x8b, 0xb9,
-	0x6a, 0xb1, 0x83, 0x15, 0x77, 0x3e, 0xbc, 0x50, 0xf6, 0xed, 0x65, 0x6a, 0x5f, 0xf5, 0x09, 0xd9,
-	0x7a, 0x4a, 0x5b, 0x64, 0x37, 0x64, 0xef, 0x46, 0xbd, 0x4f, 0xdc, 0xaf, 0x9b, 0xd7, 0xec, 0xb7,
-	0x5e, 0x63, 0xdc, 0xc4, 0xbf, 0xb8, 0xf3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xd8, 0xd7,
-	0x9e, 0xf4, 0x06, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+	0x1b, 0xfe, 0xdc, 0xb4, 0xb1, 0xcd, 0x58, 0xb2, 0xc3, 0xa4, 0x2d, 0xdb, 0x6f, 0x3f, 0x5e, 0x3a,
+	0x6c, 0x46, 0x81, 0x65, 0x8b, 0xb3, 0x61, 0xd8, 0x61, 0x63, 0xa5, 0x41, 0xb6, 0x05, 0x35, 0xe8,
+	0x61, 0x3d, 0x24, 0x18, 0xf1, 0xb5, 0xc3, 0x59, 0x12, 0x15, 0x8a, 0x76, 0xec, 0xdc, 0xe8, 0xae,
+	0x61, 0x77, 0x31, 0xf0, 0x95, 0xfc, 0x93, 0x76, 0x3b, 0xdf, 0x99, 0xf5, 0xfc, 0xf0, 0xd1, 0x4b,
+	0xea, 0xa1, 0x49, 0x98, 0x5b, 0x33, 0xd6, 0x09, 0x14, 0xc7, 0xb9, 0x35, 0xce, 0xd0, 0x1d, 0x99,
+	0xeb, 0xa3, 0x3f, 0x77, 0x49, 0x38, 0x02, 0x3b, 0xd7, 0x31, 0x0c, 0x4b, 0x9a, 0x86, 0xe4, 0x91,
+	0x56, 0xac, 0xd6, 0xad, 0xf5, 0x9a, 0xfc, 0x91, 0x56, 0x94, 0x92, 0xc7, 0x99, 0x4c, 0x81, 0x3d,
+	0x45, 0x04, 0x7f, 0xd3, 0xaf, 0x49, 0xdb, 0xd8, 0x89, 0xcc, 0xf4, 0xbd, 0x74, 0xda, 0x64, 0x42,
+	0x2b, 0xf6, 0xac, 0x5b, 0xeb, 0xed, 0xf0, 0x70, 0x1b, 0xbe, 0x8c, 0xe8, 0x6b, 0xb2, 0x9f, 0x81,
+	0xbb, 0x33, 0x76, 0x2a, 0x0a, 0xb0, 0x73, 0xb0, 0x5e, 0xfa, 0x1c, 0xa5, 0xed, 0x8a, 0x18, 0x21,
+	0x7e, 0x19, 0xd1, 0xe7, 0xa4, 0x3e, 0x4b, 0x84, 0x95, 0x0e, 0xd8, 0xa3, 0x6e, 0xad, 0x17, 0xf0,
+	0xdd, 0x59, 0xc2, 0xa5, 0x03, 0xfa, 0x25, 0x09, 0x67, 0x89, 0xb8, 0x9e, 0xc5, 0x53, 0x70, 0xa2,
+	0xd0, 0xf7, 0xc0, 0x76, 0x90, 0x6f, 0xcd, 0x92, 0x33, 0x04, 0x47, 0xfa, 0x1e, 0xe8, 0x0f, 0x24,
+	0xac, 0xec, 0x22, 0x37, 0x89, 0x8e, 0x97, 0xec, 0x71, 0xb7, 0xd6, 0x0b, 0xfb, 0xed, 0x63, 0x99,
+	0xeb, 0x63, 0xbf, 0xd0, 0x10, 0x61, 0x6f, 0xdb, 0x3c, 0xf9, 0x54, 0x55, 0xa5, 0x3e, 0x29, 0x53,
+	0xd5, 0x3a, 0x55, 0x3d, 0x4c, 0xdd, 0x2d, 0x53, 0xd5, 0x07, 0xa9, 0xea, 0x61, 0x6a, 0xfd, 0x5f,
+	0x52, 0xd5, 0x76, 0xea, 0x57, 0xa4, 0x2d, 0x95, 0x12, 0x93, 0x3b, 0x91, 0x82, 0x93, 0x4a, 0x3a,
+	0xc9, 0x1a, 0xdd, 0x5a, 0xaf, 0xc1, 0x03, 0xa9, 0xd4, 0xc5, 0xfb, 0x2b, 0x70, 0x32, 0x92, 0x4e,
+	0xd2, 0x6f, 0xc8, 0x81, 0x82, 0xb9, 0x28, 0x9c, 0x74, 0xb3, 0x42, 0x58, 0xb8, 0x15, 0x63, 0x0b,
+	0xb7, 0xac, 0x89, 0x6f, 0xd2, 0x51, 0x30, 0x1f, 0x21, 0xc3, 0xe1, 0xf6, 0xad, 0x85, 0x5b, 0xfa,
+	0x13, 0x79, 0x61, 0x21, 0x37, 0xd6, 0x89, 0x2d, 0xd7, 0xb5, 0x74, 0x0e, 0xec, 0x92, 0x11, 0x0c,
+	0x78, 0x56, 0x0a, 0xa2, 0x95, 0xf5, 0xac, 0x64, 0xe9, 0x8f, 0x84, 0x7d, 0x6c, 0x4d, 0xa5, 0x9d,
+	0xe8, 0x8c, 0xed, 0xa1, 0xf3, 0xe9, 0x07, 0xce, 0x2b, 0x24, 0xe9, 0x53, 0xb2, 0xab, 0xac, 0x48,
+	0x75, 0xc6, 0x5a, 0xf8, 0x56, 0x4f, 0x94, 0xbd, 0xda, 0xc0, 0x72, 0xc1, 0x82, 0x35, 0x2c, 0x17,
+	0xf4, 0x0b, 0xd2, 0x8a, 0x6f, 0x64, 0x96, 0x41, 0x22, 0x52, 0x59, 0x4c, 0x59, 0xd8, 0xad, 0xf5,
+	0x5a, 0x7c, 0xaf, 0xc2, 0xae, 0x64, 0x31, 0xa5, 0x9f, 0x12, 0x92, 0x5b, 0x21, 0x93, 0xc4, 0xdc,
+	0x81, 0x62, 0x6d, 0xcc, 0x6e, 0xe6, 0xf6, 0x4d, 0x09, 0x78, 0xfa, 0x66, 0x43, 0x77, 0x4a, 0xfa,
+	0x66, 0x9b, 0xb6, 0x72, 0x4d, 0xef, 0x97, 0xb4, 0x95, 0x2b, 0xfa, 0x33, 0xb2, 0x97, 0xdd, 0x4d,
+	0xc5, 0x04, 0x8c, 0x48, 0x4c, 0xcc, 0x68, 0xc9, 0x67, 0x77, 0xd3, 0x0b, 0x30, 0xbf, 0x9a, 0xd8,
+	0xdb, 0x9d, 0xb4, 0x13, 0x70, 0x22, 0x07, 0xcb, 0x0e, 0xf0, 0xd5, 0x9b, 0x25, 0x32, 0x3c, 0xe7,
+	0xb4, 0x47, 0x3a, 0xa9, 0xce, 0xfc, 0xb9, 0x29, 0x3d, 0x07, 0x5b, 0x68, 0xb7, 0x64, 0x87, 0x28,
+	0x0a, 0x53, 0x9d, 0x5d, 0xbc, 0x8f, 0x56, 0xe8, 0xd1, 0x5f, 0x75, 0x12, 0x44, 0xf0, 0x9f, 0x28,
+	0x56, 0x8f, 0x74, 0x8a, 0x59, 0xee, 0xcf, 0xae, 0x10, 0x71, 0x22, 0x8b, 0x42, 0x5c, 0x63, 0xc3,
+	0x1a, 0x3c, 0x5c, 0xe1, 0x03, 0x0f, 0x9f, 0xf9, 0xcf, 0xb2, 0x12, 0x08, 0xa7, 0x53, 0x30, 0x33,
+	0x57, 0x55, 0x2d, 0x40, 0xf8, 0xec, 0xb7, 0x12, 0xf4, 0x2b, 0xe6, 0x3a, 0x9b, 0x88, 0x22, 0x31,
+	0xb8, 0x51, 0xda, 0x28, 0x6c, 0x5b, 0xc0, 0x43, 0x8f, 0x8f, 0x12, 0xe3, 0x86, 0x88, 0xd2, 0x2e,
+	0x69, 0x6d, 0x94, 0xca, 0x56, 0x1d, 0x23, 0x2b, 0x55, 0xc4, 0x7d, 0xcf, 0x36, 0x0a, 0xfc, 0xba,
+	0xab, 0x9e, 0xad, 0x34, 0xf8, 0x65, 0x7f, 0x3c, 0x43, 0xcc, 0xea, 0xff, 0x30, 0xc3, 0x60, 0x33,
+	0x43, 0xbc, 0x9e, 0xa1, 0xb1, 0x35, 0xc3, 0x60, 0x35, 0xc3, 0xe7, 0x64, 0x2f, 0x95, 0xb1, 0xc0,
+	0xf3, 0x32, 0x19, 0x56, 0xaa, 0xc9, 0x49, 0x2a, 0xe3, 0xdf, 0x4b, 0x84, 0x1e, 0x93, 0x03, 0x0b,
+	0x13, 0x91, 0x4b, 0x2b, 0x53, 0xdf, 0xbd, 0xb9, 0x46, 0x21, 0x41, 0xe1, 0xbe, 0x85, 0xc9, 0x10,
+	0x19, 0x5e, 0x11, 0xf4, 0x13, 0x42, 0xec, 0x42, 0x28, 0x48, 0xe4, 0x52, 0x9c, 0x60, 0x67, 0x02,
+	0xde, 0xb0, 0x8b, 0xc8, 0x03, 0x27, 0xf4, 0x15, 0x09, 0x3d, 0x6b, 0x85, 0x19, 0x8f, 0x0b, 0x70,
+	0xe2, 0xa4, 0xaa, 0xcb, 0x9e, 0x5d, 0x44, 0xfc, 0x1d, 0x62, 0x27, 0xf4, 0x88, 0x04, 0x5e, 0x24,
+	0x9d, 0xc4, 0x1b, 0xa5, 0xcf, 0x82, 0xb5, 0x46, 0x3a, 0xe9, 0xef, 0x8f, 0x3e, 0x7d, 0x49, 0x9a,
+	0x76, 0x81, 0x1b, 0x25, 0xfa, 0x58, 0x9f, 0x80, 0xd7, 0xed, 0xc2, 0x6f, 0x52, 0x9f, 0x7e, 0x47,
+	0x0e, 0xc7, 0x32, 0x76, 0xc6, 0x2e, 0x45, 0x6e, 0xc1, 0xc7, 0x78, 0x5d, 0xc1, 0xda, 0xdd, 0x9d,
+	0x5e, 0xc0, 0x69, 0xc5, 0x0d, 0x91, 0xf2, 0x8e, 0x82, 0xbe, 0x20, 0x8d, 0x54, 0x2e, 0x04, 0x68,
+	0x9b, 0x63, 0x97, 0x02, 0x5e, 0x4f, 0xe5, 0xe2, 0xfc, 0x92, 0x0f, 0xfd, 0xc1, 0x78, 0x4a, 0xcd,
+	0xdc, 0x52, 0xc4, 0xcb, 0x38, 0x01, 0x6c, 0x53, 0xc0, 0x5b, 0xa9, 0x5c, 0x44, 0x33, 0xb7, 0x1c,
+	0x78, 0x8c, 0xbe, 0x22, 0xc1, 0xfa, 0x60, 0xfe, 0x30, 0x3a, 0xab, 0x2a, 0xd5, 0x5a, 0x81, 0x3f,
+	0x1b, 0x9d, 0xd1, 0xff, 0x93, 0xa6, 0x1d, 0x0b, 0x0b, 0x13, 0xbf, 0x81, 0x07, 0xb8, 0x81, 0x0d,
+	0x3b, 0xe6, 0xf8, 0x4c, 0xbf, 0x25, 0x87, 0xeb, 0x15, 0x4e, 0xfb, 0xd7, 0xda, 0x89, 0xb1, 0x88,
+	0x33, 0x87, 0xbd, 0x6a, 0xf0, 0xfd, 0x15, 0x77, 0xda, 0x3f, 0xd3, 0xee, 0xed, 0x20, 0x73, 0x3e,
+	0x32, 0x97, 0xcb, 0xc4, 0x48, 0x25, 0x62, 0xa3, 0x20, 0x66, 0x0c, 0x57, 0x6c, 0x55, 0xe0, 0xc0,
+	0x63, 0xf4, 0x7b, 0xf2, 0x6c, 0x25, 0x82, 0xcc, 0xcb, 0xac, 0x28, 0x62, 0xab, 0x73, 0xc7, 0x5e,
+	0xa0, 0xfa, 0xb0, 0x62, 0xcf, 0x4b, 0x72, 0x84, 0xdc, 0xb6, 0x4b, 0xc1, 0x03, 0xd7, 0xcb, 0x07,
+	0xae, 0x08, 0xb6, 0x5c, 0xaf, 0xbb, 0x84, 0x6c, 0xdd, 0xed, 0x0d, 0xf2, 0x38, 0xe2, 0xef, 0x86,
+	0x9d, 0xff, 0xf9, 0x5f, 0x57, 0x6f, 0xf8, 0x2f, 0x9d, 0xda, 0xf5, 0x2e, 0xfe, 0xe7, 0x9e, 0xfe,
+	0x3d, 0x00, 0x71, 0xae, 0x73, 0xce, 0x85, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,867 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: scheduledDownlink.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ScheduledDownlink struct {
+	// ID (string formatted UUID).
+	// This will be generated automatically on create.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Device EUI (HEX encoded).
+	// Either the device EUI or the multicast-group ID must be set.
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Multicast-group ID (string formatted UUID).
+	// Either the device EUI or the multicast-group ID must be set.
+	MulticastGroupId string `protobuf:"bytes,3,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	// FPort used (must be > 0).
+	FPort uint32 `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Downlink is confirmed.
+	// This is not supported for multicast-groups.
+	Confirmed bool `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Base64 encoded data.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Not before timestamp.
+	// The (first) downlink will not be enqueued before this timestamp.
+	// When not set, the current time is used.
+	NotBefore *timestamp.Timestamp `protobuf:"bytes,7,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// Cron expression (minute, hour, day of month, month and day of week,
+	// in UTC) for a recurring downlink, e.g. "0 6 * * 1-5".
+	// This can not be used in combination with interval.
+	Cron string `protobuf:"bytes,8,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Interval of a recurring downlink (minimum one minute).
+	// This can not be used in combination with cron.
+	Interval             *duration.Duration `protobuf:"bytes,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ScheduledDownlink) Reset()         { *m = ScheduledDownlink{} }
+func (m *ScheduledDownlink) String() string { return proto.CompactTextString(m) }
+func (*ScheduledDownlink) ProtoMessage()    {}
+func (*ScheduledDownlink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{0}
+}
+func (m *ScheduledDownlink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduledDownlink.Unmarshal(m, b)
+}
+func (m *ScheduledDownlink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduledDownlink.Marshal(b, m, deterministic)
+}
+func (dst *ScheduledDownlink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledDownlink.Merge(dst, src)
+}
+func (m *ScheduledDownlink) XXX_Size() int {
+	return xxx_messageInfo_ScheduledDownlink.Size(m)
+}
+func (m *ScheduledDownlink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledDownlink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledDownlink proto.InternalMessageInfo
+
+func (m *ScheduledDownlink) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ScheduledDownlink) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ScheduledDownlink) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+func (m *ScheduledDownlink) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *ScheduledDownlink) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *ScheduledDownlink) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ScheduledDownlink) GetNotBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *ScheduledDownlink) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *ScheduledDownlink) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+type ScheduledDownlinkListItem struct {
+	// ID (string formatted UUID).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Multicast-group ID (string formatted UUID).
+	MulticastGroupId string `protobuf:"bytes,3,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	// FPort used.
+	FPort uint32 `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Cron expression.
+	Cron string `protobuf:"bytes,5,opt,name=cron,proto3" json:"cron,omitempty"`
+	// Interval.
+	Interval *duration.Duration `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Next run timestamp.
+	// This is not set when the downlink has been enqueued and is not
+	// recurring.
+	NextRunAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	// Last run timestamp.
+	LastRunAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	// Error of the last run.
+	LastError            string   `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduledDownlinkListItem) Reset()         { *m = ScheduledDownlinkListItem{} }
+func (m *ScheduledDownlinkListItem) String() string { return proto.CompactTextString(m) }
+func (*ScheduledDownlinkListItem) ProtoMessage()    {}
+func (*ScheduledDownlinkListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{1}
+}
+func (m *ScheduledDownlinkListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduledDownlinkListItem.Unmarshal(m, b)
+}
+func (m *ScheduledDownlinkListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduledDownlinkListItem.Marshal(b, m, deterministic)
+}
+func (dst *ScheduledDownlinkListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledDownlinkListItem.Merge(dst, src)
+}
+func (m *ScheduledDownlinkListItem) XXX_Size() int {
+	return xxx_messageInfo_ScheduledDownlinkListItem.Size(m)
+}
+func (m *ScheduledDownlinkListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledDownlinkListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledDownlinkListItem proto.InternalMessageInfo
+
+func (m *ScheduledDownlinkListItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ScheduledDownlinkListItem) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ScheduledDownlinkListItem) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+func (m *ScheduledDownlinkListItem) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *ScheduledDownlinkListItem) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *ScheduledDownlinkListItem) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *ScheduledDownlinkListItem) GetNextRunAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return nil
+}
+
+func (m *ScheduledDownlinkListItem) GetLastRunAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return nil
+}
+
+func (m *ScheduledDownlinkListItem) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type CreateScheduledDownlinkRequest struct {
+	// Scheduled downlink object to create.
+	ScheduledDownlink    *ScheduledDownlink `protobuf:"bytes,1,opt,name=scheduled_downlink,json=scheduledDownlink,proto3" json:"scheduled_downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateScheduledDownlinkRequest) Reset()         { *m = CreateScheduledDownlinkRequest{} }
+func (m *CreateScheduledDownlinkRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduledDownlinkRequest) ProtoMessage()    {}
+func (*CreateScheduledDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{2}
+}
+func (m *CreateScheduledDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScheduledDownlinkRequest.Unmarshal(m, b)
+}
+func (m *CreateScheduledDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScheduledDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateScheduledDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduledDownlinkRequest.Merge(dst, src)
+}
+func (m *CreateScheduledDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateScheduledDownlinkRequest.Size(m)
+}
+func (m *CreateScheduledDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduledDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScheduledDownlinkRequest proto.InternalMessageInfo
+
+func (m *CreateScheduledDownlinkRequest) GetScheduledDownlink() *ScheduledDownlink {
+	if m != nil {
+		return m.ScheduledDownlink
+	}
+	return nil
+}
+
+type CreateScheduledDownlinkResponse struct {
+	// ID of the created scheduled downlink (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateScheduledDownlinkResponse) Reset()         { *m = CreateScheduledDownlinkResponse{} }
+func (m *CreateScheduledDownlinkResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduledDownlinkResponse) ProtoMessage()    {}
+func (*CreateScheduledDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{3}
+}
+func (m *CreateScheduledDownlinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScheduledDownlinkResponse.Unmarshal(m, b)
+}
+func (m *CreateScheduledDownlinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScheduledDownlinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateScheduledDownlinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduledDownlinkResponse.Merge(dst, src)
+}
+func (m *CreateScheduledDownlinkResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateScheduledDownlinkResponse.Size(m)
+}
+func (m *CreateScheduledDownlinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduledDownlinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScheduledDownlinkResponse proto.InternalMessageInfo
+
+func (m *CreateScheduledDownlinkResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetScheduledDownlinkRequest struct {
+	// ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetScheduledDownlinkRequest) Reset()         { *m = GetScheduledDownlinkRequest{} }
+func (m *GetScheduledDownlinkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScheduledDownlinkRequest) ProtoMessage()    {}
+func (*GetScheduledDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{4}
+}
+func (m *GetScheduledDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScheduledDownlinkRequest.Unmarshal(m, b)
+}
+func (m *GetScheduledDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScheduledDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetScheduledDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScheduledDownlinkRequest.Merge(dst, src)
+}
+func (m *GetScheduledDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_GetScheduledDownlinkRequest.Size(m)
+}
+func (m *GetScheduledDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScheduledDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScheduledDownlinkRequest proto.InternalMessageInfo
+
+func (m *GetScheduledDownlinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetScheduledDownlinkResponse struct {
+	// Scheduled downlink object.
+	ScheduledDownlink *ScheduledDownlink `protobuf:"bytes,1,opt,name=scheduled_downlink,json=scheduledDownlink,proto3" json:"scheduled_downlink,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Next run timestamp.
+	// This is not set when the downlink has been enqueued and is not
+	// recurring.
+	NextRunAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	// Last run timestamp.
+	LastRunAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	// Error of the last run.
+	LastError            string   `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetScheduledDownlinkResponse) Reset()         { *m = GetScheduledDownlinkResponse{} }
+func (m *GetScheduledDownlinkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScheduledDownlinkResponse) ProtoMessage()    {}
+func (*GetScheduledDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{5}
+}
+func (m *GetScheduledDownlinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScheduledDownlinkResponse.Unmarshal(m, b)
+}
+func (m *GetScheduledDownlinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScheduledDownlinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetScheduledDownlinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScheduledDownlinkResponse.Merge(dst, src)
+}
+func (m *GetScheduledDownlinkResponse) XXX_Size() int {
+	return xxx_messageInfo_GetScheduledDownlinkResponse.Size(m)
+}
+func (m *GetScheduledDownlinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScheduledDownlinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScheduledDownlinkResponse proto.InternalMessageInfo
+
+func (m *GetScheduledDownlinkResponse) GetScheduledDownlink() *ScheduledDownlink {
+	if m != nil {
+		return m.ScheduledDownlink
+	}
+	return nil
+}
+
+func (m *GetScheduledDownlinkResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetScheduledDownlinkResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *GetScheduledDownlinkResponse) GetNextRunAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return nil
+}
+
+func (m *GetScheduledDownlinkResponse) GetLastRunAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return nil
+}
+
+func (m *GetScheduledDownlinkResponse) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type DeleteScheduledDownlinkRequest struct {
+	// ID (string formatted UUID).
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScheduledDownlinkRequest) Reset()         { *m = DeleteScheduledDownlinkRequest{} }
+func (m *DeleteScheduledDownlinkRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteScheduledDownlinkRequest) ProtoMessage()    {}
+func (*DeleteScheduledDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{6}
+}
+func (m *DeleteScheduledDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScheduledDownlinkRequest.Unmarshal(m, b)
+}
+func (m *DeleteScheduledDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScheduledDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteScheduledDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduledDownlinkRequest.Merge(dst, src)
+}
+func (m *DeleteScheduledDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteScheduledDownlinkRequest.Size(m)
+}
+func (m *DeleteScheduledDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduledDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScheduledDownlinkRequest proto.InternalMessageInfo
+
+func (m *DeleteScheduledDownlinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListScheduledDownlinkRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Device EUI (HEX encoded) to filter on.
+	// Either the device EUI or the multicast-group ID must be set.
+	DevEui string `protobuf:"bytes,3,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Multicast-group ID (string formatted UUID) to filter on.
+	// Either the device EUI or the multicast-group ID must be set.
+	MulticastGroupId     string   `protobuf:"bytes,4,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListScheduledDownlinkRequest) Reset()         { *m = ListScheduledDownlinkRequest{} }
+func (m *ListScheduledDownlinkRequest) String() string { return proto.CompactTextString(m) }
+func (*ListScheduledDownlinkRequest) ProtoMessage()    {}
+func (*ListScheduledDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{7}
+}
+func (m *ListScheduledDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListScheduledDownlinkRequest.Unmarshal(m, b)
+}
+func (m *ListScheduledDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListScheduledDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListScheduledDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListScheduledDownlinkRequest.Merge(dst, src)
+}
+func (m *ListScheduledDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_ListScheduledDownlinkRequest.Size(m)
+}
+func (m *ListScheduledDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListScheduledDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListScheduledDownlinkRequest proto.InternalMessageInfo
+
+func (m *ListScheduledDownlinkRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListScheduledDownlinkRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListScheduledDownlinkRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *ListScheduledDownlinkRequest) GetMulticastGroupId() string {
+	if m != nil {
+		return m.MulticastGroupId
+	}
+	return ""
+}
+
+type ListScheduledDownlinkResponse struct {
+	// Total number of scheduled downlinks.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Result-set.
+	Result               []*ScheduledDownlinkListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ListScheduledDownlinkResponse) Reset()         { *m = ListScheduledDownlinkResponse{} }
+func (m *ListScheduledDownlinkResponse) String() string { return proto.CompactTextString(m) }
+func (*ListScheduledDownlinkResponse) ProtoMessage()    {}
+func (*ListScheduledDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53131b868696365, []int{8}
+}
+func (m *ListScheduledDownlinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListScheduledDownlinkResponse.Unmarshal(m, b)
+}
+func (m *ListScheduledDownlinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListScheduledDownlinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListScheduledDownlinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListScheduledDownlinkResponse.Merge(dst, src)
+}
+func (m *ListScheduledDownlinkResponse) XXX_Size() int {
+	return xxx_messageInfo_ListScheduledDownlinkResponse.Size(m)
+}
+func (m *ListScheduledDownlinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListScheduledDownlinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListScheduledDownlinkResponse proto.InternalMessageInfo
+
+func (m *ListScheduledDownlinkResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListScheduledDownlinkResponse) GetResult() []*ScheduledDownlinkListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ScheduledDownlink)(nil), "api.ScheduledDownlink")
+	proto.RegisterType((*ScheduledDownlinkListItem)(nil), "api.ScheduledDownlinkListItem")
+	proto.RegisterType((*CreateScheduledDownlinkRequest)(nil), "api.CreateScheduledDownlinkRequest")
+	proto.RegisterType((*CreateScheduledDownlinkResponse)(nil), "api.CreateScheduledDownlinkResponse")
+	proto.RegisterType((*GetScheduledDownlinkRequest)(nil), "api.GetScheduledDownlinkRequest")
+	proto.RegisterType((*GetScheduledDownlinkResponse)(nil), "api.GetScheduledDownlinkResponse")
+	proto.RegisterType((*DeleteScheduledDownlinkRequest)(nil), "api.DeleteScheduledDownlinkRequest")
+	proto.RegisterType((*ListScheduledDownlinkRequest)(nil), "api.ListScheduledDownlinkRequest")
+	proto.RegisterType((*ListScheduledDownlinkResponse)(nil), "api.ListScheduledDownlinkResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ScheduledDownlinkServiceClient is the client API for ScheduledDownlinkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ScheduledDownlinkServiceClient interface {
+	// Create creates the given scheduled downlink.
+	Create(ctx context.Context, in *CreateScheduledDownlinkRequest, opts ...grpc.CallOption) (*CreateScheduledDownlinkResponse, error)
+	// Get returns the scheduled downlink matching the given ID.
+	Get(ctx context.Context, in *GetScheduledDownlinkRequest, opts ...grpc.CallOption) (*GetScheduledDownlinkResponse, error)
+	// Delete deletes the scheduled downlink matching the given ID.
+	Delete(ctx context.Context, in *DeleteScheduledDownlinkRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the scheduled downlinks of the given device or
+	// multicast-group.
+	List(ctx context.Context, in *ListScheduledDownlinkRequest, opts ...grpc.CallOption) (*ListScheduledDownlinkResponse, error)
+}
+
+type scheduledDownlinkServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewScheduledDownlinkServiceClient(cc *grpc.ClientConn) ScheduledDownlinkServiceClient {
+	return &scheduledDownlinkServiceClient{cc}
+}
+
+func (c *scheduledDownlinkServiceClient) Create(ctx context.Context, in *CreateScheduledDownlinkRequest, opts ...grpc.CallOption) (*CreateScheduledDownlinkResponse, error) {
+	out := new(CreateScheduledDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/api.ScheduledDownlinkService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledDownlinkServiceClient) Get(ctx context.Context, in *GetScheduledDownlinkRequest, opts ...grpc.CallOption) (*GetScheduledDownlinkResponse, error) {
+	out := new(GetScheduledDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/api.ScheduledDownlinkService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledDownlinkServiceClient) Delete(ctx context.Context, in *DeleteScheduledDownlinkRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ScheduledDownlinkService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduledDownlinkServiceClient) List(ctx context.Context, in *ListScheduledDownlinkRequest, opts ...grpc.CallOption) (*ListScheduledDownlinkResponse, error) {
+	out := new(ListScheduledDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/api.ScheduledDownlinkService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduledDownlinkServiceServer is the server API for ScheduledDownlinkService service.
+type ScheduledDownlinkServiceServer interface {
+	// Create creates the given scheduled downlink.
+	Create(context.Context, *CreateScheduledDownlinkRequest) (*CreateScheduledDownlinkResponse, error)
+	// Get returns the scheduled downlink matching the given ID.
+	Get(context.Context, *GetScheduledDownlinkRequest) (*GetScheduledDownlinkResponse, error)
+	// Delete deletes the scheduled downlink matching the given ID.
+	Delete(context.Context, *DeleteScheduledDownlinkRequest) (*empty.Empty, error)
+	// List lists the scheduled downlinks of the given device or
+	// multicast-group.
+	List(context.Context, *ListScheduledDownlinkRequest) (*ListScheduledDownlinkResponse, error)
+}
+
+func RegisterScheduledDownlinkServiceServer(s *grpc.Server, srv ScheduledDownlinkServiceServer) {
+	s.RegisterService(&_ScheduledDownlinkService_serviceDesc, srv)
+}
+
+func _ScheduledDownlinkService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledDownlinkServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledDownlinkService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledDownlinkServiceServer).Create(ctx, req.(*CreateScheduledDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledDownlinkService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledDownlinkServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledDownlinkService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledDownlinkServiceServer).Get(ctx, req.(*GetScheduledDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledDownlinkService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledDownlinkServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledDownlinkService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledDownlinkServiceServer).Delete(ctx, req.(*DeleteScheduledDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduledDownlinkService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduledDownlinkServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ScheduledDownlinkService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduledDownlinkServiceServer).List(ctx, req.(*ListScheduledDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ScheduledDownlinkService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.ScheduledDownlinkService",
+	HandlerType: (*ScheduledDownlinkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ScheduledDownlinkService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ScheduledDownlinkService_Get_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ScheduledDownlinkService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ScheduledDownlinkService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduledDownlink.proto",
+}
+
+func init() {
+	proto.RegisterFile("scheduledDownlink.proto", fileDescriptor_d53131b868696365)
+}
+
+var fileDescriptor_d53131b868696365 = []byte{
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xd3, 0x48,
+	0x14, 0x96, 0xed, 0xc4, 0x4d, 0x4e, 0x76, 0x57, 0xdb, 0xd1, 0x6e, 0xeb, 0xba, 0xf9, 0x5b, 0x77,
+	0x91, 0xa2, 0x8a, 0x26, 0x10, 0x04, 0x12, 0xbd, 0x2b, 0x4d, 0x54, 0x55, 0xe2, 0x02, 0xb9, 0x70,
+	0x6d, 0xb9, 0xf1, 0x49, 0x18, 0xe1, 0x78, 0xdc, 0xf1, 0x38, 0x04, 0x21, 0x6e, 0x78, 0x85, 0x3e,
+	0x0d, 0xcf, 0xc1, 0x2b, 0x20, 0x6e, 0xb8, 0xe3, 0x09, 0x90, 0xc7, 0x76, 0xa8, 0x92, 0xe6, 0x47,
+	0x80, 0xb8, 0xf3, 0xcc, 0xf9, 0xbe, 0xf3, 0xfb, 0x9d, 0x31, 0xec, 0x46, 0x83, 0x97, 0xe8, 0xc5,
+	0x3e, 0x7a, 0x3d, 0xf6, 0x3a, 0xf0, 0x69, 0xf0, 0xaa, 0x1d, 0x72, 0x26, 0x18, 0xd1, 0xdc, 0x90,
+	0x9a, 0xd5, 0x11, 0x63, 0x23, 0x1f, 0x3b, 0x6e, 0x48, 0x3b, 0x6e, 0x10, 0x30, 0xe1, 0x0a, 0xca,
+	0x82, 0x28, 0x85, 0x98, 0x8d, 0xcc, 0x2a, 0x4f, 0x97, 0xf1, 0xb0, 0x23, 0xe8, 0x18, 0x23, 0xe1,
+	0x8e, 0xc3, 0x0c, 0x50, 0x9f, 0x07, 0x78, 0x31, 0x97, 0x1e, 0x32, 0xfb, 0xfe, 0xbc, 0x1d, 0xc7,
+	0xa1, 0x78, 0x93, 0x1a, 0xad, 0x0f, 0x2a, 0x6c, 0x5f, 0xcc, 0x27, 0x47, 0xfe, 0x02, 0x95, 0x7a,
+	0x86, 0xd2, 0x54, 0x5a, 0x65, 0x5b, 0xa5, 0x1e, 0xd9, 0x85, 0x2d, 0x0f, 0x27, 0x0e, 0xc6, 0xd4,
+	0x50, 0xe5, 0xa5, 0xee, 0xe1, 0xa4, 0xff, 0xe2, 0x9c, 0xdc, 0x05, 0x32, 0x8e, 0x7d, 0x41, 0x07,
+	0x6e, 0x24, 0x9c, 0x11, 0x67, 0x71, 0xe8, 0x50, 0xcf, 0xd0, 0x24, 0xe6, 0xef, 0x99, 0xe5, 0x2c,
+	0x31, 0x9c, 0xf7, 0xc8, 0xbf, 0xa0, 0x0f, 0x9d, 0x90, 0x71, 0x61, 0x14, 0x9a, 0x4a, 0xeb, 0x4f,
+	0xbb, 0x38, 0x7c, 0xc6, 0xb8, 0x20, 0x55, 0x28, 0x0f, 0x58, 0x30, 0xa4, 0x7c, 0x8c, 0x9e, 0x51,
+	0x6c, 0x2a, 0xad, 0x92, 0xfd, 0xfd, 0x82, 0x10, 0x28, 0x78, 0xae, 0x70, 0x0d, 0xbd, 0xa9, 0xb4,
+	0xfe, 0xb0, 0xe5, 0x37, 0x79, 0x0c, 0x10, 0x30, 0xe1, 0x5c, 0xe2, 0x90, 0x71, 0x34, 0xb6, 0x9a,
+	0x4a, 0xab, 0xd2, 0x35, 0xdb, 0x69, 0x9d, 0xed, 0xbc, 0xce, 0xf6, 0xf3, 0xbc, 0x51, 0x76, 0x39,
+	0x60, 0xe2, 0x89, 0x04, 0x27, 0xee, 0x06, 0x9c, 0x05, 0x46, 0x49, 0xe6, 0x28, 0xbf, 0xc9, 0x43,
+	0x28, 0xd1, 0x40, 0x20, 0x9f, 0xb8, 0xbe, 0x51, 0x96, 0xce, 0xf6, 0x16, 0x9c, 0xf5, 0xb2, 0xa6,
+	0xda, 0x33, 0xa8, 0xf5, 0x45, 0x85, 0xbd, 0x85, 0xde, 0x3d, 0xa5, 0x91, 0x38, 0x17, 0x38, 0xfe,
+	0xcd, 0x3d, 0xcc, 0xcb, 0x2a, 0x2e, 0x29, 0x4b, 0xdf, 0xb8, 0x2c, 0x72, 0x0c, 0x95, 0x00, 0xa7,
+	0xc2, 0xe1, 0x71, 0xe0, 0xb8, 0x62, 0xa3, 0xee, 0xe2, 0x54, 0xd8, 0x71, 0x70, 0x22, 0x12, 0xae,
+	0xef, 0x46, 0x33, 0x6e, 0x69, 0x3d, 0x37, 0x81, 0xa7, 0xdc, 0x1a, 0x80, 0xe4, 0x22, 0xe7, 0x8c,
+	0xcb, 0x39, 0x94, 0x53, 0x73, 0x3f, 0xb9, 0xb0, 0x46, 0x50, 0x3f, 0xe5, 0xe8, 0x0a, 0x5c, 0x68,
+	0xb9, 0x8d, 0x57, 0x31, 0x46, 0x82, 0xf4, 0x81, 0xcc, 0xf6, 0xcc, 0xf1, 0x32, 0xa3, 0x9c, 0x40,
+	0xa5, 0xbb, 0xd3, 0x76, 0x43, 0xda, 0x5e, 0xa4, 0x6e, 0x2f, 0x6c, 0xa6, 0x75, 0x1f, 0x1a, 0x4b,
+	0x03, 0x45, 0x21, 0x0b, 0x22, 0x9c, 0x9f, 0xad, 0x75, 0x04, 0xfb, 0x67, 0x28, 0x96, 0x26, 0x36,
+	0x0f, 0xff, 0xaa, 0x42, 0xf5, 0x76, 0x7c, 0xe6, 0xff, 0xd7, 0x54, 0x92, 0xac, 0xc9, 0x40, 0x56,
+	0xe2, 0x25, 0xc3, 0x50, 0xd7, 0x0f, 0x23, 0x43, 0x9f, 0x88, 0x84, 0x1a, 0x87, 0x5e, 0x4e, 0xd5,
+	0xd6, 0x53, 0x33, 0x74, 0xaa, 0x81, 0x9b, 0xfa, 0x29, 0xfc, 0x84, 0x7e, 0x8a, 0x3f, 0xae, 0x1f,
+	0x7d, 0x5e, 0x3f, 0xf7, 0xa0, 0xde, 0x43, 0x1f, 0x05, 0x6e, 0x3c, 0xa6, 0x6b, 0x05, 0xaa, 0xc9,
+	0x3a, 0x2f, 0x25, 0xfc, 0x03, 0x45, 0x9f, 0x8e, 0xa9, 0x90, 0x1c, 0xcd, 0x4e, 0x0f, 0x64, 0x07,
+	0x74, 0x36, 0x1c, 0x46, 0x98, 0x76, 0x5c, 0xb3, 0xb3, 0xd3, 0xcd, 0x07, 0x40, 0xdb, 0xe0, 0x01,
+	0x28, 0xdc, 0xfe, 0x00, 0x58, 0x53, 0xa8, 0x2d, 0x49, 0x2a, 0x13, 0x4f, 0x03, 0x2a, 0x82, 0x09,
+	0xd7, 0x77, 0x06, 0x2c, 0x0e, 0xf2, 0xdc, 0x40, 0x5e, 0x9d, 0x26, 0x37, 0xe4, 0x11, 0xe8, 0x1c,
+	0xa3, 0xd8, 0x4f, 0x12, 0xd4, 0x5a, 0x95, 0x6e, 0xfd, 0x76, 0x45, 0xe5, 0x2f, 0x99, 0x9d, 0xa1,
+	0xbb, 0x9f, 0x35, 0x30, 0x16, 0x50, 0x17, 0xc8, 0x27, 0x74, 0x80, 0x64, 0x0a, 0x7a, 0xba, 0x35,
+	0xe4, 0x40, 0xba, 0x5b, 0xbd, 0xab, 0xe6, 0xff, 0xab, 0x41, 0x69, 0x29, 0xd6, 0xc1, 0xfb, 0x8f,
+	0x9f, 0xae, 0xd5, 0x9a, 0x65, 0xc8, 0x7f, 0xe3, 0x4c, 0xe0, 0x47, 0xf9, 0x4a, 0x44, 0xc7, 0xca,
+	0x21, 0xb9, 0x02, 0xed, 0x0c, 0x05, 0x69, 0x4a, 0x8f, 0x2b, 0xd6, 0xd0, 0xfc, 0x6f, 0x05, 0x22,
+	0x0b, 0x78, 0x47, 0x06, 0x6c, 0x90, 0xda, 0xb2, 0x80, 0x9d, 0xb7, 0xd4, 0x7b, 0x47, 0x7c, 0xd0,
+	0x53, 0x2d, 0x65, 0xc5, 0xae, 0x16, 0x96, 0xb9, 0xb3, 0x20, 0xe0, 0x7e, 0xf2, 0x0b, 0xce, 0xa3,
+	0x1d, 0xae, 0x89, 0xc6, 0xa0, 0x90, 0xcc, 0x82, 0xa4, 0xf9, 0xaf, 0x52, 0xa4, 0x69, 0xad, 0x82,
+	0x64, 0x35, 0x36, 0x65, 0x54, 0x93, 0x2c, 0x6d, 0xea, 0xa5, 0x2e, 0xf3, 0x7c, 0xf0, 0x6d, 0x00,
+	0x4c, 0xf6, 0x69, 0x23, 0xb7, 0x08, 0x00, 0x00,
+}
@@ -26,6 +26,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type EmailAlertType int32
+
+const (
+	// The device has not been seen for the configured device offline timeout.
+	EmailAlertType_DEVICE_OFFLINE EmailAlertType = 0
+	// The gateway has not been seen for the configured gateway offline timeout.
+	EmailAlertType_GATEWAY_OFFLINE EmailAlertType = 1
+	// Forwarding data to the integration(s) of an application failed.
+	EmailAlertType_INTEGRATION_FAILURE EmailAlertType = 2
+)
+
+var EmailAlertType_name = map[int32]string{
+	0: "DEVICE_OFFLINE",
+	1: "GATEWAY_OFFLINE",
+	2: "INTEGRATION_FAILURE",
+}
+var EmailAlertType_value = map[string]int32{
+	"DEVICE_OFFLINE":      0,
+	"GATEWAY_OFFLINE":     1,
+	"INTEGRATION_FAILURE": 2,
+}
+
+func (x EmailAlertType) String() string {
+	return proto.EnumName(EmailAlertType_name, int32(x))
+}
+func (EmailAlertType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{0}
+}
+
 type User struct {
 	// User ID.
 	// Will be set automatically on create.
@@ -703,6 +732,238 @@ func (m *UpdateUserPasswordRequest) GetPassword() string {
 	return ""
 }
 
+type EmailAlertSubscription struct {
+	// User ID.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userID,proto3" json:"user_id,omitempty"`
+	// Organization ID.
+	// The user must be a member of the organization (or a global admin).
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Alert type.
+	AlertType            EmailAlertType `protobuf:"varint,3,opt,name=alert_type,json=alertType,proto3,enum=api.EmailAlertType" json:"alert_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *EmailAlertSubscription) Reset()         { *m = EmailAlertSubscription{} }
+func (m *EmailAlertSubscription) String() string { return proto.CompactTextString(m) }
+func (*EmailAlertSubscription) ProtoMessage()    {}
+func (*EmailAlertSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{12}
+}
+func (m *EmailAlertSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmailAlertSubscription.Unmarshal(m, b)
+}
+func (m *EmailAlertSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmailAlertSubscription.Marshal(b, m, deterministic)
+}
+func (dst *EmailAlertSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmailAlertSubscription.Merge(dst, src)
+}
+func (m *EmailAlertSubscription) XXX_Size() int {
+	return xxx_messageInfo_EmailAlertSubscription.Size(m)
+}
+func (m *EmailAlertSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmailAlertSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmailAlertSubscription proto.InternalMessageInfo
+
+func (m *EmailAlertSubscription) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *EmailAlertSubscription) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *EmailAlertSubscription) GetAlertType() EmailAlertType {
+	if m != nil {
+		return m.AlertType
+	}
+	return EmailAlertType_DEVICE_OFFLINE
+}
+
+type ListEmailAlertSubscriptionsRequest struct {
+	// User ID.
+	UserId               int64    `protobuf:"varint,1,opt,name=user_id,json=userID,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEmailAlertSubscriptionsRequest) Reset()         { *m = ListEmailAlertSubscriptionsRequest{} }
+func (m *ListEmailAlertSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEmailAlertSubscriptionsRequest) ProtoMessage()    {}
+func (*ListEmailAlertSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{13}
+}
+func (m *ListEmailAlertSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEmailAlertSubscriptionsRequest.Unmarshal(m, b)
+}
+func (m *ListEmailAlertSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEmailAlertSubscriptionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListEmailAlertSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEmailAlertSubscriptionsRequest.Merge(dst, src)
+}
+func (m *ListEmailAlertSubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEmailAlertSubscriptionsRequest.Size(m)
+}
+func (m *ListEmailAlertSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEmailAlertSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEmailAlertSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *ListEmailAlertSubscriptionsRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+type ListEmailAlertSubscriptionsResponse struct {
+	// Result-set.
+	Result               []*EmailAlertSubscription `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListEmailAlertSubscriptionsResponse) Reset()         { *m = ListEmailAlertSubscriptionsResponse{} }
+func (m *ListEmailAlertSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEmailAlertSubscriptionsResponse) ProtoMessage()    {}
+func (*ListEmailAlertSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{14}
+}
+func (m *ListEmailAlertSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEmailAlertSubscriptionsResponse.Unmarshal(m, b)
+}
+func (m *ListEmailAlertSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEmailAlertSubscriptionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListEmailAlertSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEmailAlertSubscriptionsResponse.Merge(dst, src)
+}
+func (m *ListEmailAlertSubscriptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEmailAlertSubscriptionsResponse.Size(m)
+}
+func (m *ListEmailAlertSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEmailAlertSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEmailAlertSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *ListEmailAlertSubscriptionsResponse) GetResult() []*EmailAlertSubscription {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CreateEmailAlertSubscriptionRequest struct {
+	// Subscription object to create.
+	Subscription         *EmailAlertSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CreateEmailAlertSubscriptionRequest) Reset()         { *m = CreateEmailAlertSubscriptionRequest{} }
+func (m *CreateEmailAlertSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateEmailAlertSubscriptionRequest) ProtoMessage()    {}
+func (*CreateEmailAlertSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{15}
+}
+func (m *CreateEmailAlertSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateEmailAlertSubscriptionRequest.Unmarshal(m, b)
+}
+func (m *CreateEmailAlertSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateEmailAlertSubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateEmailAlertSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateEmailAlertSubscriptionRequest.Merge(dst, src)
+}
+func (m *CreateEmailAlertSubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateEmailAlertSubscriptionRequest.Size(m)
+}
+func (m *CreateEmailAlertSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateEmailAlertSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateEmailAlertSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CreateEmailAlertSubscriptionRequest) GetSubscription() *EmailAlertSubscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+type DeleteEmailAlertSubscriptionRequest struct {
+	// User ID.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userID,proto3" json:"user_id,omitempty"`
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Alert type.
+	AlertType            EmailAlertType `protobuf:"varint,3,opt,name=alert_type,json=alertType,proto3,enum=api.EmailAlertType" json:"alert_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeleteEmailAlertSubscriptionRequest) Reset()         { *m = DeleteEmailAlertSubscriptionRequest{} }
+func (m *DeleteEmailAlertSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteEmailAlertSubscriptionRequest) ProtoMessage()    {}
+func (*DeleteEmailAlertSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{16}
+}
+func (m *DeleteEmailAlertSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteEmailAlertSubscriptionRequest.Unmarshal(m, b)
+}
+func (m *DeleteEmailAlertSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteEmailAlertSubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteEmailAlertSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteEmailAlertSubscriptionRequest.Merge(dst, src)
+}
+func (m *DeleteEmailAlertSubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteEmailAlertSubscriptionRequest.Size(m)
+}
+func (m *DeleteEmailAlertSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteEmailAlertSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteEmailAlertSubscriptionRequest proto.InternalMessageInfo
+
+func (m *DeleteEmailAlertSubscriptionRequest) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *DeleteEmailAlertSubscriptionRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *DeleteEmailAlertSubscriptionRequest) GetAlertType() EmailAlertType {
+	if m != nil {
+		return m.AlertType
+	}
+	return EmailAlertType_DEVICE_OFFLINE
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "api.User")
 	proto.RegisterType((*UserListItem)(nil), "api.UserListItem")
@@ -716,6 +977,12 @@ func init() {
 	proto.RegisterType((*ListUserRequest)(nil), "api.ListUserRequest")
 	proto.RegisterType((*ListUserResponse)(nil), "api.ListUserResponse")
 	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "api.UpdateUserPasswordRequest")
+	proto.RegisterType((*EmailAlertSubscription)(nil), "api.EmailAlertSubscription")
+	proto.RegisterType((*ListEmailAlertSubscriptionsRequest)(nil), "api.ListEmailAlertSubscriptionsRequest")
+	proto.RegisterType((*ListEmailAlertSubscriptionsResponse)(nil), "api.ListEmailAlertSubscriptionsResponse")
+	proto.RegisterType((*CreateEmailAlertSubscriptionRequest)(nil), "api.CreateEmailAlertSubscriptionRequest")
+	proto.RegisterType((*DeleteEmailAlertSubscriptionRequest)(nil), "api.DeleteEmailAlertSubscriptionRequest")
+	proto.RegisterEnum("api.EmailAlertType", EmailAlertType_name, EmailAlertType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -742,6 +1009,15 @@ type UserServiceClient interface {
 	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// UpdatePassword updates a password.
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListEmailAlertSubscriptions lists the e-mail alert subscriptions of
+	// the given user.
+	ListEmailAlertSubscriptions(ctx context.Context, in *ListEmailAlertSubscriptionsRequest, opts ...grpc.CallOption) (*ListEmailAlertSubscriptionsResponse, error)
+	// CreateEmailAlertSubscription subscribes the user to the given e-mail
+	// alert type for the given organization.
+	CreateEmailAlertSubscription(ctx context.Context, in *CreateEmailAlertSubscriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteEmailAlertSubscription unsubscribes the user from the given
+	// e-mail alert type for the given organization.
+	DeleteEmailAlertSubscription(ctx context.Context, in *DeleteEmailAlertSubscriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type userServiceClient struct {
@@ -806,6 +1082,33 @@ func (c *userServiceClient) UpdatePassword(ctx context.Context, in *UpdateUserPa
 	return out, nil
 }
 
+func (c *userServiceClient) ListEmailAlertSubscriptions(ctx context.Context, in *ListEmailAlertSubscriptionsRequest, opts ...grpc.CallOption) (*ListEmailAlertSubscriptionsResponse, error) {
+	out := new(ListEmailAlertSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/api.UserService/ListEmailAlertSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateEmailAlertSubscription(ctx context.Context, in *CreateEmailAlertSubscriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.UserService/CreateEmailAlertSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteEmailAlertSubscription(ctx context.Context, in *DeleteEmailAlertSubscriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.UserService/DeleteEmailAlertSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 type UserServiceServer interface {
 	// Get user list.
@@ -820,6 +1123,15 @@ type UserServiceServer interface {
 	Delete(context.Context, *DeleteUserRequest) (*empty.Empty, error)
 	// UpdatePassword updates a password.
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*empty.Empty, error)
+	// ListEmailAlertSubscriptions lists the e-mail alert subscriptions of
+	// the given user.
+	ListEmailAlertSubscriptions(context.Context, *ListEmailAlertSubscriptionsRequest) (*ListEmailAlertSubscriptionsResponse, error)
+	// CreateEmailAlertSubscription subscribes the user to the given e-mail
+	// alert type for the given organization.
+	CreateEmailAlertSubscription(context.Context, *CreateEmailAlertSubscriptionRequest) (*empty.Empty, error)
+	// DeleteEmailAlertSubscription unsubscribes the user from the given
+	// e-mail alert type for the given organization.
+	DeleteEmailAlertSubscription(context.Context, *DeleteEmailAlertSubscriptionRequest) (*empty.Empty, error)
 }
 
 func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
@@ -934,6 +1246,60 @@ func _UserService_UpdatePassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListEmailAlertSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmailAlertSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListEmailAlertSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UserService/ListEmailAlertSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListEmailAlertSubscriptions(ctx, req.(*ListEmailAlertSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateEmailAlertSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmailAlertSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateEmailAlertSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UserService/CreateEmailAlertSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateEmailAlertSubscription(ctx, req.(*CreateEmailAlertSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteEmailAlertSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmailAlertSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteEmailAlertSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UserService/DeleteEmailAlertSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteEmailAlertSubscription(ctx, req.(*DeleteEmailAlertSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.UserService",
 	HandlerType: (*UserServiceServer)(nil),
@@ -962,6 +1328,18 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _UserService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "ListEmailAlertSubscriptions",
+			Handler:    _UserService_ListEmailAlertSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateEmailAlertSubscription",
+			Handler:    _UserService_CreateEmailAlertSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteEmailAlertSubscription",
+			Handler:    _UserService_DeleteEmailAlertSubscription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -970,53 +1348,71 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("user.proto", fileDescriptor_116e343673f7ffaf) }
 
 var fileDescriptor_116e343673f7ffaf = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x96, 0xe3, 0xc4, 0x4d, 0x26, 0xfd, 0x93, 0x66, 0xff, 0xb4, 0x75, 0xdd, 0xbf, 0x7f, 0x22,
-	0x83, 0x44, 0xe8, 0x21, 0x91, 0xc2, 0x89, 0x72, 0x8a, 0x5a, 0x54, 0x45, 0xaa, 0x44, 0x31, 0x2d,
-	0x88, 0x0b, 0xd1, 0x36, 0xde, 0x96, 0x95, 0x62, 0xaf, 0xf1, 0x6e, 0x8a, 0x00, 0xf5, 0xc2, 0x95,
-	0x23, 0xe2, 0x15, 0x78, 0x01, 0xc4, 0x93, 0xf0, 0x0a, 0x3c, 0x08, 0xda, 0xf5, 0x3a, 0x71, 0x1c,
-	0x95, 0x02, 0x37, 0x6e, 0x99, 0xd9, 0x99, 0x6f, 0xbe, 0xf9, 0xe6, 0x4b, 0x02, 0x30, 0xe5, 0x24,
-	0xee, 0x46, 0x31, 0x13, 0x0c, 0x99, 0x38, 0xa2, 0xce, 0x7f, 0x17, 0x8c, 0x5d, 0x4c, 0x48, 0x0f,
-	0x47, 0xb4, 0x87, 0xc3, 0x90, 0x09, 0x2c, 0x28, 0x0b, 0x79, 0x52, 0xe2, 0xb4, 0xf4, 0xab, 0x8a,
-	0xce, 0xa6, 0xe7, 0x3d, 0x41, 0x03, 0xc2, 0x05, 0x0e, 0x22, 0x5d, 0xb0, 0x9d, 0x2f, 0x20, 0x41,
-	0x24, 0xde, 0x24, 0x8f, 0xee, 0x57, 0x03, 0x8a, 0xa7, 0x9c, 0xc4, 0xa8, 0x06, 0x05, 0xea, 0xdb,
-	0x46, 0xdb, 0xe8, 0x98, 0x5e, 0x81, 0xfa, 0xc8, 0x81, 0xb2, 0xe4, 0x11, 0xe2, 0x80, 0xd8, 0x85,
-	0xb6, 0xd1, 0xa9, 0x78, 0xb3, 0x18, 0xb5, 0xa0, 0xca, 0x09, 0xe7, 0x94, 0x85, 0x23, 0x21, 0x26,
-	0xb6, 0xd9, 0x36, 0x3a, 0x25, 0x0f, 0x74, 0xea, 0xe4, 0xe4, 0x08, 0x6d, 0x41, 0x99, 0xf2, 0x11,
-	0xf6, 0x03, 0x1a, 0xda, 0xc5, 0xb6, 0xd1, 0x29, 0x7b, 0x2b, 0x94, 0x0f, 0x64, 0x88, 0xb6, 0xa1,
-	0x22, 0x9f, 0xc6, 0x82, 0x5e, 0x12, 0xbb, 0xa4, 0xde, 0xca, 0x94, 0x0f, 0x54, 0x8c, 0x9a, 0x50,
-	0x22, 0x01, 0xa6, 0x13, 0xdb, 0x52, 0x13, 0x93, 0x00, 0x21, 0x28, 0x86, 0x4c, 0x10, 0x7b, 0x45,
-	0x25, 0xd5, 0x67, 0xf7, 0x4b, 0x01, 0x56, 0x25, 0xef, 0x23, 0xca, 0xc5, 0x50, 0x90, 0xe0, 0x2f,
-	0xe3, 0x8f, 0xee, 0x03, 0x8c, 0x63, 0x82, 0x05, 0xf1, 0x47, 0x58, 0xd8, 0xe5, 0xb6, 0xd1, 0xa9,
-	0xf6, 0x9d, 0x6e, 0x72, 0xa9, 0x6e, 0x7a, 0xa9, 0xee, 0x49, 0x7a, 0x4a, 0xaf, 0xa2, 0xab, 0x07,
-	0x42, 0xb6, 0x4e, 0x23, 0x3f, 0x6d, 0xad, 0xdc, 0xdc, 0xaa, 0xab, 0x07, 0xc2, 0x7d, 0x0a, 0x6b,
-	0x52, 0xb4, 0x47, 0xf1, 0x05, 0x0e, 0xe9, 0x5b, 0x65, 0x23, 0x74, 0x07, 0xea, 0x2c, 0x13, 0x8f,
-	0x66, 0x2a, 0xd6, 0xb2, 0xe9, 0xe1, 0xc1, 0x82, 0x28, 0x85, 0x05, 0x51, 0xdc, 0x0f, 0x06, 0x34,
-	0xf6, 0x15, 0x41, 0x09, 0xef, 0x91, 0x57, 0x53, 0xc2, 0x05, 0xda, 0x81, 0xa2, 0x94, 0x5c, 0xc1,
-	0x55, 0xfb, 0x95, 0x2e, 0x8e, 0x68, 0x57, 0xbd, 0xab, 0xb4, 0xbc, 0x50, 0x84, 0x39, 0x7f, 0xcd,
-	0x62, 0x3f, 0xbd, 0x50, 0x1a, 0xa3, 0x07, 0xf0, 0x4f, 0x76, 0x3a, 0xb7, 0xcd, 0xb6, 0xd9, 0xa9,
-	0xf6, 0xd7, 0x67, 0x18, 0xd9, 0x15, 0xbc, 0xc5, 0x5a, 0xf7, 0x36, 0xa0, 0x2c, 0x19, 0x1e, 0xb1,
-	0x90, 0x93, 0xbc, 0x41, 0xdc, 0x36, 0xd4, 0x0e, 0x89, 0xc8, 0xf2, 0xcd, 0x57, 0x7c, 0x36, 0xa0,
-	0x3e, 0x2b, 0xd1, 0x28, 0x37, 0xec, 0xb4, 0x78, 0xd6, 0xc2, 0x9f, 0x9f, 0xd5, 0xfc, 0x9d, 0xb3,
-	0xf6, 0xa1, 0x71, 0xaa, 0x82, 0x5f, 0x57, 0xdf, 0xbd, 0x05, 0x8d, 0x03, 0x32, 0x21, 0x8b, 0x3d,
-	0x79, 0x05, 0x9e, 0x41, 0x5d, 0x7e, 0xc1, 0xb2, 0x25, 0x4d, 0x28, 0x4d, 0x68, 0x40, 0x85, 0xae,
-	0x4a, 0x02, 0xb4, 0x01, 0x16, 0x3b, 0x3f, 0xe7, 0x24, 0xd9, 0xd9, 0xf4, 0x74, 0x24, 0xf3, 0x9c,
-	0xe0, 0x78, 0xfc, 0x52, 0x2d, 0x54, 0xf1, 0x74, 0xe4, 0xbe, 0x80, 0xb5, 0x39, 0xb0, 0x96, 0xb6,
-	0x05, 0x55, 0xc1, 0x04, 0x9e, 0x8c, 0xc6, 0x6c, 0x1a, 0xa6, 0xf8, 0xa0, 0x52, 0xfb, 0x32, 0x83,
-	0xee, 0x82, 0x15, 0x13, 0x3e, 0x9d, 0xc8, 0x21, 0xd2, 0x0d, 0x8d, 0xd9, 0x4e, 0xe9, 0xaf, 0x80,
-	0xa7, 0x0b, 0xdc, 0x63, 0xd8, 0x9a, 0x2b, 0x72, 0xac, 0x5d, 0x95, 0xae, 0xb0, 0x09, 0x2b, 0x52,
-	0x82, 0xb9, 0xd3, 0x2d, 0x19, 0x0e, 0xfd, 0x9f, 0x39, 0xb2, 0xff, 0xa9, 0x08, 0x55, 0x09, 0xf6,
-	0x84, 0xc4, 0x97, 0x74, 0x4c, 0xd0, 0x21, 0x14, 0xe5, 0x54, 0xd4, 0x54, 0x24, 0x72, 0x2a, 0x39,
-	0xeb, 0xb9, 0x6c, 0xb2, 0xa2, 0x8b, 0xde, 0x7f, 0xfb, 0xfe, 0xb1, 0xb0, 0x8a, 0x40, 0xfd, 0x96,
-	0xcb, 0xa9, 0x1c, 0x0d, 0xc1, 0x3c, 0x24, 0x02, 0xfd, 0xab, 0x3a, 0x16, 0x1d, 0xe9, 0x34, 0x17,
-	0x93, 0x1a, 0x65, 0x53, 0xa1, 0x34, 0x50, 0x7d, 0x8e, 0xd2, 0x7b, 0x47, 0xfd, 0x2b, 0x74, 0x0c,
-	0x56, 0x62, 0x7c, 0xb4, 0xa1, 0x1a, 0x97, 0xbe, 0x92, 0xce, 0xe6, 0x52, 0x5e, 0x63, 0xae, 0x2b,
-	0xcc, 0xba, 0x9b, 0x61, 0xb6, 0x67, 0xec, 0xa2, 0xe7, 0x60, 0x25, 0x3a, 0x6a, 0xc4, 0x25, 0x9b,
-	0x39, 0x1b, 0x4b, 0x16, 0x7d, 0x28, 0xff, 0x5e, 0xdc, 0x96, 0x02, 0xdc, 0x72, 0x9a, 0x59, 0x92,
-	0xea, 0x9f, 0x8d, 0xfa, 0x57, 0x12, 0xfa, 0x31, 0x58, 0x89, 0x01, 0x35, 0xf4, 0x92, 0x1b, 0xaf,
-	0x85, 0xd6, 0xfb, 0xef, 0x2e, 0xed, 0x1f, 0x43, 0x2d, 0x21, 0x98, 0x5e, 0x1c, 0xfd, 0x9f, 0x63,
-	0x9d, 0xb3, 0xc2, 0xb5, 0x23, 0x3a, 0x6a, 0x84, 0xeb, 0xec, 0xe4, 0xd9, 0x8f, 0xa8, 0x7f, 0xd5,
-	0x4b, 0x4d, 0xb1, 0x67, 0xec, 0x9e, 0x59, 0xaa, 0xf3, 0xde, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x40, 0x20, 0xf3, 0xce, 0xb6, 0x07, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x49, 0x9b, 0x36, 0x2f, 0x4b, 0xd2, 0x4c, 0xd3, 0x36, 0x75, 0xbb, 0x34, 0x4c, 0x90,
+	0x36, 0x5b, 0x89, 0x44, 0x4a, 0x4f, 0x0b, 0x42, 0x10, 0x9a, 0x34, 0x44, 0xaa, 0xda, 0xe2, 0x4d,
+	0x77, 0xb5, 0x1c, 0x88, 0xdc, 0x78, 0x5a, 0x46, 0x4a, 0x6c, 0xe3, 0x99, 0x2c, 0x2a, 0x55, 0x2e,
+	0x5c, 0x91, 0xb8, 0x70, 0xdc, 0x3b, 0x07, 0xae, 0x88, 0x4f, 0xc2, 0x57, 0xe0, 0x73, 0x20, 0x34,
+	0xe3, 0x71, 0xe2, 0x38, 0x89, 0xb3, 0x70, 0x41, 0x9c, 0x9a, 0xf7, 0xe6, 0xcd, 0x6f, 0x7e, 0xef,
+	0xf7, 0xfe, 0xd4, 0x00, 0x23, 0x46, 0xbc, 0xaa, 0xeb, 0x39, 0xdc, 0x41, 0x49, 0xd3, 0xa5, 0xfa,
+	0xe1, 0x9d, 0xe3, 0xdc, 0x0d, 0x48, 0xcd, 0x74, 0x69, 0xcd, 0xb4, 0x6d, 0x87, 0x9b, 0x9c, 0x3a,
+	0x36, 0xf3, 0x43, 0xf4, 0x23, 0x75, 0x2a, 0xad, 0x9b, 0xd1, 0x6d, 0x8d, 0xd3, 0x21, 0x61, 0xdc,
+	0x1c, 0xba, 0x2a, 0xe0, 0x20, 0x1a, 0x40, 0x86, 0x2e, 0xbf, 0xf7, 0x0f, 0xf1, 0xef, 0x1a, 0xac,
+	0x5d, 0x33, 0xe2, 0xa1, 0x2c, 0x24, 0xa8, 0x55, 0xd4, 0x4a, 0x5a, 0x25, 0x69, 0x24, 0xa8, 0x85,
+	0x74, 0xd8, 0x14, 0x3c, 0x6c, 0x73, 0x48, 0x8a, 0x89, 0x92, 0x56, 0x49, 0x1b, 0x13, 0x1b, 0x1d,
+	0x41, 0x86, 0x11, 0xc6, 0xa8, 0x63, 0xf7, 0x38, 0x1f, 0x14, 0x93, 0x25, 0xad, 0xb2, 0x6e, 0x80,
+	0x72, 0x75, 0xbb, 0xe7, 0x68, 0x1f, 0x36, 0x29, 0xeb, 0x99, 0xd6, 0x90, 0xda, 0xc5, 0xb5, 0x92,
+	0x56, 0xd9, 0x34, 0x36, 0x28, 0x6b, 0x08, 0x13, 0x1d, 0x40, 0x5a, 0x1c, 0xf5, 0x39, 0x7d, 0x4d,
+	0x8a, 0xeb, 0xf2, 0x6c, 0x93, 0xb2, 0x86, 0xb4, 0x51, 0x01, 0xd6, 0xc9, 0xd0, 0xa4, 0x83, 0x62,
+	0x4a, 0xbe, 0xe8, 0x1b, 0x08, 0xc1, 0x9a, 0xed, 0x70, 0x52, 0xdc, 0x90, 0x4e, 0xf9, 0x1b, 0xff,
+	0x96, 0x80, 0x47, 0x82, 0xf7, 0x39, 0x65, 0xbc, 0xc3, 0xc9, 0xf0, 0x7f, 0xc6, 0x1f, 0x3d, 0x03,
+	0xe8, 0x7b, 0xc4, 0xe4, 0xc4, 0xea, 0x99, 0xbc, 0xb8, 0x59, 0xd2, 0x2a, 0x99, 0xba, 0x5e, 0xf5,
+	0x2b, 0x55, 0x0d, 0x2a, 0x55, 0xed, 0x06, 0xa5, 0x34, 0xd2, 0x2a, 0xba, 0xc1, 0xc5, 0xd5, 0x91,
+	0x6b, 0x05, 0x57, 0xd3, 0xab, 0xaf, 0xaa, 0xe8, 0x06, 0xc7, 0x2f, 0x60, 0x4b, 0x88, 0x76, 0xe9,
+	0xdd, 0x99, 0x36, 0xfd, 0x5e, 0xb6, 0x11, 0x7a, 0x02, 0x39, 0x27, 0x64, 0xf7, 0x26, 0x2a, 0x66,
+	0xc3, 0xee, 0x4e, 0x73, 0x46, 0x94, 0xc4, 0x8c, 0x28, 0xf8, 0x47, 0x0d, 0xf2, 0xa7, 0x92, 0xa0,
+	0x80, 0x37, 0xc8, 0xb7, 0x23, 0xc2, 0x38, 0x7a, 0x0c, 0x6b, 0x42, 0x72, 0x09, 0x97, 0xa9, 0xa7,
+	0xab, 0xa6, 0x4b, 0xab, 0xf2, 0x5c, 0xba, 0x45, 0x85, 0x5c, 0x93, 0xb1, 0xef, 0x1c, 0xcf, 0x0a,
+	0x2a, 0x14, 0xd8, 0xe8, 0x63, 0x78, 0x37, 0xfc, 0x3a, 0x2b, 0x26, 0x4b, 0xc9, 0x4a, 0xa6, 0xbe,
+	0x33, 0xc1, 0x08, 0xa7, 0x60, 0xcc, 0xc6, 0xe2, 0x0f, 0x00, 0x85, 0xc9, 0x30, 0xd7, 0xb1, 0x19,
+	0x89, 0x36, 0x08, 0x2e, 0x41, 0xb6, 0x4d, 0x78, 0x98, 0x6f, 0x34, 0xe2, 0x17, 0x0d, 0x72, 0x93,
+	0x10, 0x85, 0xb2, 0x22, 0xa7, 0xd9, 0xb2, 0x26, 0xfe, 0x7d, 0x59, 0x93, 0xff, 0xa4, 0xac, 0x75,
+	0xc8, 0x5f, 0x4b, 0xe3, 0xed, 0xd5, 0xc7, 0x65, 0xc8, 0x37, 0xc9, 0x80, 0x70, 0x12, 0xa7, 0xc0,
+	0x4b, 0xc8, 0x89, 0x01, 0x0b, 0x87, 0x14, 0x60, 0x7d, 0x40, 0x87, 0x94, 0xab, 0x28, 0xdf, 0x40,
+	0xbb, 0x90, 0x72, 0x6e, 0x6f, 0x19, 0xf1, 0x73, 0x4e, 0x1a, 0xca, 0x12, 0x7e, 0x46, 0x4c, 0xaf,
+	0xff, 0x8d, 0x4c, 0x28, 0x6d, 0x28, 0x0b, 0x7f, 0x0d, 0x5b, 0x53, 0x60, 0x25, 0xed, 0x11, 0x64,
+	0xb8, 0xc3, 0xcd, 0x41, 0xaf, 0xef, 0x8c, 0xec, 0x00, 0x1f, 0xa4, 0xeb, 0x54, 0x78, 0xd0, 0x53,
+	0x48, 0x79, 0x84, 0x8d, 0x06, 0xe2, 0x11, 0xd1, 0x0d, 0xf9, 0x49, 0x4e, 0xc1, 0x16, 0x30, 0x54,
+	0x00, 0xbe, 0x82, 0xfd, 0xa9, 0x22, 0x57, 0xaa, 0xab, 0x82, 0x14, 0xf6, 0x60, 0x43, 0x48, 0x30,
+	0xed, 0xf4, 0x94, 0x30, 0x3b, 0x56, 0x5c, 0x47, 0xe2, 0x9f, 0x34, 0xd8, 0x6d, 0x89, 0x71, 0x6e,
+	0x0c, 0x88, 0xc7, 0x9f, 0x8f, 0x6e, 0x58, 0xdf, 0xa3, 0xae, 0x9c, 0xa0, 0x25, 0x78, 0xcd, 0x45,
+	0xa3, 0x95, 0x58, 0x38, 0x5a, 0x75, 0x00, 0x53, 0xc0, 0xf6, 0xf8, 0xbd, 0x4b, 0xa4, 0x54, 0xd9,
+	0xfa, 0xb6, 0xcc, 0x6e, 0xfa, 0x64, 0xf7, 0xde, 0x25, 0x46, 0xda, 0x0c, 0x7e, 0xe2, 0x4f, 0x00,
+	0x8b, 0xb4, 0x17, 0x73, 0x62, 0x2b, 0x72, 0x6d, 0xe2, 0xaf, 0xa0, 0x1c, 0x7b, 0x5d, 0x15, 0xe5,
+	0x64, 0xa2, 0xb9, 0x26, 0x35, 0x3f, 0x88, 0xb0, 0x0a, 0xdf, 0x9a, 0xa8, 0x7f, 0x0b, 0x65, 0x7f,
+	0x00, 0x97, 0xc4, 0x29, 0x6e, 0x9f, 0xc2, 0x23, 0x16, 0x72, 0xab, 0x4e, 0x8d, 0x7d, 0x61, 0xe6,
+	0x02, 0x7e, 0xa3, 0x41, 0xd9, 0x6f, 0xe2, 0xf8, 0x87, 0xfe, 0x93, 0x02, 0x1d, 0x1b, 0x90, 0x9d,
+	0x3d, 0x44, 0x08, 0xb2, 0xcd, 0xd6, 0x8b, 0xce, 0x69, 0xab, 0x77, 0x79, 0x76, 0x76, 0xde, 0xb9,
+	0x68, 0x6d, 0xbd, 0x83, 0xb6, 0x21, 0xd7, 0x6e, 0x74, 0x5b, 0x2f, 0x1b, 0xaf, 0x26, 0x4e, 0x0d,
+	0xed, 0xc1, 0x76, 0xe7, 0xa2, 0xdb, 0x6a, 0x1b, 0x8d, 0x6e, 0xe7, 0xf2, 0xa2, 0x77, 0xd6, 0xe8,
+	0x9c, 0x5f, 0x1b, 0xad, 0xad, 0x44, 0xfd, 0xaf, 0x0d, 0xc8, 0x88, 0x96, 0x7e, 0x4e, 0xbc, 0xd7,
+	0xb4, 0x4f, 0x50, 0x1b, 0xd6, 0x44, 0x15, 0x51, 0x41, 0x72, 0x89, 0xcc, 0xaa, 0xbe, 0x13, 0xf1,
+	0xfa, 0x35, 0xc5, 0xe8, 0x87, 0x3f, 0xfe, 0xfc, 0x39, 0xf1, 0x08, 0x81, 0xfc, 0xa2, 0x10, 0x52,
+	0x30, 0xd4, 0x81, 0x64, 0x9b, 0x70, 0xe4, 0xe7, 0x34, 0xbb, 0x17, 0xf5, 0xc2, 0xac, 0x53, 0xa1,
+	0xec, 0x49, 0x94, 0x3c, 0xca, 0x4d, 0x51, 0x6a, 0x0f, 0xd4, 0x1a, 0xa3, 0x2b, 0x48, 0xf9, 0xd5,
+	0x47, 0xbb, 0xf2, 0xe2, 0xdc, 0x3f, 0x06, 0x7d, 0x6f, 0xce, 0xaf, 0x30, 0x77, 0x24, 0x66, 0x0e,
+	0x87, 0x98, 0x7d, 0xa4, 0x1d, 0xa3, 0x57, 0x90, 0xf2, 0xa7, 0x59, 0x21, 0xce, 0x2d, 0x3b, 0x7d,
+	0x77, 0x6e, 0x51, 0xb6, 0xc4, 0x47, 0x0e, 0x3e, 0x92, 0x80, 0xfb, 0x7a, 0x21, 0x4c, 0x52, 0xfc,
+	0xa9, 0x52, 0x6b, 0x2c, 0xa0, 0xbf, 0x84, 0x94, 0xdf, 0x41, 0x0a, 0x7a, 0x6e, 0x27, 0x2e, 0x85,
+	0x56, 0xf9, 0x1f, 0xcf, 0xe5, 0xef, 0x41, 0xd6, 0x27, 0x18, 0xec, 0x1d, 0xf4, 0x5e, 0x84, 0x75,
+	0x64, 0x21, 0x2d, 0x7d, 0xa2, 0x22, 0x9f, 0xc0, 0xfa, 0xe3, 0x28, 0xfb, 0x1e, 0xb5, 0xc6, 0xb5,
+	0x60, 0x35, 0x89, 0x34, 0xde, 0x68, 0x70, 0x10, 0x33, 0xce, 0xe8, 0xc9, 0xa4, 0x13, 0xe2, 0xf7,
+	0x85, 0x5e, 0x59, 0x1d, 0xa8, 0x6a, 0xf5, 0x54, 0x92, 0x2b, 0xa3, 0xf7, 0x17, 0x92, 0x93, 0x5f,
+	0x3e, 0x1f, 0xca, 0x61, 0x60, 0x82, 0xdd, 0x61, 0xdc, 0x42, 0x40, 0x95, 0x50, 0x43, 0xc4, 0x8e,
+	0xf2, 0x52, 0xa9, 0x9e, 0x49, 0x36, 0x27, 0xb8, 0x1a, 0x66, 0x13, 0x5e, 0x16, 0xd5, 0x85, 0xd4,
+	0x84, 0x76, 0xbf, 0x6a, 0x70, 0x18, 0xb7, 0x45, 0x14, 0xbb, 0xb7, 0x58, 0x34, 0x4b, 0xd9, 0x7d,
+	0x21, 0xd9, 0x7d, 0x7e, 0xfc, 0xd9, 0x4a, 0xad, 0x6a, 0x0f, 0x91, 0x85, 0x34, 0xae, 0x3d, 0x4c,
+	0x37, 0xcf, 0xf8, 0x26, 0x25, 0x91, 0x4f, 0xfe, 0x1e, 0x00, 0x0d, 0xf5, 0x21, 0xb6, 0x25, 0x0c,
+	0x00, 0x00,
 }
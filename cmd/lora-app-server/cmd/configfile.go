@@ -299,6 +299,154 @@ id="{{ .ApplicationServer.ID }}"
   # when set, existing users can't be re-assigned (to avoid exposure of all users to an organization admin)"
   disable_assign_existing_users={{ .ApplicationServer.ExternalAPI.DisableAssignExistingUsers }}
 
+  # E-mail settings.
+  #
+  # These settings are used for sending alerts (e.g. device offline,
+  # gateway offline or integration failures) to the users subscribed to
+  # these alerts and for account related e-mails (e.g. invitations and
+  # password resets).
+  [application_server.email]
+  # Enable sending e-mails.
+  enabled={{ .ApplicationServer.Email.Enabled }}
+
+  # SMTP server (host:port).
+  server="{{ .ApplicationServer.Email.Server }}"
+
+  # SMTP username (optional).
+  username="{{ .ApplicationServer.Email.Username }}"
+
+  # SMTP password (optional).
+  password="{{ .ApplicationServer.Email.Password }}"
+
+  # From address.
+  from="{{ .ApplicationServer.Email.From }}"
+
+  # TLS mode.
+  #
+  # Valid options are:
+  # * none     - Plain connection
+  # * starttls - Upgrade the connection to TLS using STARTTLS
+  # * tls      - Connect using TLS
+  tls_mode="{{ .ApplicationServer.Email.TLSMode }}"
+
+  # CA certificate file (optional).
+  #
+  # Use this when the certificate used by the SMTP server is not trusted
+  # by any CA certificate on the server (e.g. when self generated).
+  ca_cert="{{ .ApplicationServer.Email.CACert }}"
+
+  # Template directory (optional).
+  #
+  # Each of the built-in templates can be overridden by placing a
+  # <name>.tmpl file in this directory, defining both a "subject" and
+  # a "body" template. Templates that can be overridden are:
+  # device_offline, gateway_offline, integration_failure, invitation and
+  # password_reset.
+  template_dir="{{ .ApplicationServer.Email.TemplateDir }}"
+
+  # Base URL of the web-interface (e.g. https://example.com), used for
+  # generating links within the e-mails.
+  base_url="{{ .ApplicationServer.Email.BaseURL }}"
+
+  # Alert interval.
+  #
+  # The same alert (e.g. for the same device) will be sent at most once
+  # within this interval.
+  alert_interval="{{ .ApplicationServer.Email.AlertInterval }}"
+
+  # Device offline timeout.
+  #
+  # A device_offline alert is sent when a device has not been seen for this
+  # duration. Set this to 0 to disable device offline alerts.
+  device_offline_timeout="{{ .ApplicationServer.Email.DeviceOfflineTimeout }}"
+
+  # Gateway offline timeout.
+  #
+  # A gateway_offline alert is sent when a gateway has not been seen for
+  # this duration. A gateway is seen when it receives an uplink (or gateway
+  # ping). Set this to 0 to disable gateway offline alerts.
+  gateway_offline_timeout="{{ .ApplicationServer.Email.GatewayOfflineTimeout }}"
+
+  # Password reset TTL.
+  #
+  # The duration for which the password reset token (sent by e-mail) is
+  # valid.
+  password_reset_ttl="{{ .ApplicationServer.Email.PasswordResetTTL }}"
+
+
+  # Remote multicast setup settings.
+  #
+  # These settings are used when a multicast-group has a McKey configured.
+  # In this case LoRa App Server will configure the multicast-group on the
+  # devices, using the LoRaWAN Remote Multicast Setup specification.
+  [application_server.remote_multicast_setup]
+  # Sync interval.
+  #
+  # This defines the interval in which LoRa App Server will re-try
+  # (remote multicast setup) commands to which the device did not answer.
+  sync_interval="{{ .ApplicationServer.RemoteMulticastSetup.SyncInterval }}"
+
+  # Sync retries.
+  #
+  # This defines the max number of re-tries, after which the remote
+  # multicast setup of the device is set to the error state.
+  sync_retries={{ .ApplicationServer.RemoteMulticastSetup.SyncRetries }}
+
+  # Session timeout.
+  #
+  # This defines the maximum duration of the multicast session on the
+  # device. It is rounded up to the nearest 2^n seconds, with a max of
+  # 2^15 seconds.
+  session_timeout="{{ .ApplicationServer.RemoteMulticastSetup.SessionTimeout }}"
+
+  # Device event log settings.
+  #
+  # The device events (e.g. uplink, downlink, ack and error events) are
+  # stored in the database, so that they can be retrieved afterwards.
+  [application_server.device_event_log]
+  # Retention.
+  #
+  # This defines how long the device events are retained. This value can
+  # be overridden per organization. Set this to 0 to disable storing the
+  # device events of organizations without retention.
+  retention="{{ .ApplicationServer.DeviceEventLog.Retention }}"
+
+  # Cleanup interval.
+  #
+  # This defines the interval in which the expired device events are
+  # deleted.
+  cleanup_interval="{{ .ApplicationServer.DeviceEventLog.CleanupInterval }}"
+
+  # Store codec events.
+  #
+  # By default, only the codec events containing an error are stored. The
+  # codec events of successful executions are only published to the live
+  # device event log. Set this to true to store these too.
+  store_codec_events={{ .ApplicationServer.DeviceEventLog.StoreCodecEvents }}
+
+  # Device stats settings.
+  #
+  # The uplink statistics of each device are aggregated per hour and per
+  # day.
+  [application_server.device_stats]
+  # Hourly retention.
+  #
+  # This defines how long the hourly device stats are retained. Set this
+  # to 0 to retain the hourly device stats forever.
+  hourly_retention="{{ .ApplicationServer.DeviceStats.HourlyRetention }}"
+
+  # Daily retention.
+  #
+  # This defines how long the daily device stats are retained. Set this
+  # to 0 to retain the daily device stats forever.
+  daily_retention="{{ .ApplicationServer.DeviceStats.DailyRetention }}"
+
+  # Cleanup interval.
+  #
+  # This defines the interval in which the expired device stats are
+  # deleted.
+  cleanup_interval="{{ .ApplicationServer.DeviceStats.CleanupInterval }}"
+
 {{ if ne .ApplicationServer.Branding.Header  "" }}
   # Branding configuration.
   [application_server.branding]
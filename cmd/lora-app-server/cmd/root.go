@@ -56,6 +56,19 @@ func init() {
 	viper.SetDefault("application_server.integration.mqtt.location_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/location")
 	viper.SetDefault("application_server.integration.mqtt.clean_session", true)
 	viper.SetDefault("application_server.integration.enabled", []string{"mqtt"})
+	viper.SetDefault("application_server.email.server", "localhost:25")
+	viper.SetDefault("application_server.email.tls_mode", "none")
+	viper.SetDefault("application_server.email.alert_interval", time.Hour)
+	viper.SetDefault("application_server.email.device_offline_timeout", 24*time.Hour)
+	viper.SetDefault("application_server.email.gateway_offline_timeout", 10*time.Minute)
+	viper.SetDefault("application_server.email.password_reset_ttl", time.Hour)
+	viper.SetDefault("application_server.remote_multicast_setup.sync_interval", time.Minute)
+	viper.SetDefault("application_server.remote_multicast_setup.sync_retries", 3)
+	viper.SetDefault("application_server.remote_multicast_setup.session_timeout", time.Hour)
+	viper.SetDefault("application_server.device_event_log.retention", 7*24*time.Hour)
+	viper.SetDefault("application_server.device_event_log.cleanup_interval", time.Hour)
+	viper.SetDefault("application_server.device_stats.hourly_retention", 31*24*time.Hour)
+	viper.SetDefault("application_server.device_stats.cleanup_interval", time.Hour)
 
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(configCmd)
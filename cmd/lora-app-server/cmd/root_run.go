@@ -35,13 +35,21 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicefile"
+	"github.com/brocaar/lora-app-server/internal/devicegroup"
+	"github.com/brocaar/lora-app-server/internal/devicestats"
 	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/email"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/fuota"
 	"github.com/brocaar/lora-app-server/internal/gwping"
 	"github.com/brocaar/lora-app-server/internal/integration"
 	"github.com/brocaar/lora-app-server/internal/integration/application"
 	"github.com/brocaar/lora-app-server/internal/integration/multi"
 	"github.com/brocaar/lora-app-server/internal/migrations"
+	"github.com/brocaar/lora-app-server/internal/multicastsetup"
 	"github.com/brocaar/lora-app-server/internal/nsclient"
+	"github.com/brocaar/lora-app-server/internal/scheduler"
 	"github.com/brocaar/lora-app-server/internal/static"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/as"
@@ -63,9 +71,18 @@ func run(cmd *cobra.Command, args []string) error {
 		setJWTSecret,
 		setHashIterations,
 		setDisableAssignExistingUsers,
+		setupEmail,
 		handleDataDownPayloads,
 		startApplicationServerAPI,
 		startGatewayPing,
+		startFUOTADeploymentLoop,
+		startRemoteMulticastSetupLoop,
+		startDeviceEventCleanupLoop,
+		startDeviceStatsCleanupLoop,
+		startScheduledDownlinkLoop,
+		startDeviceGroupJobLoop,
+		startDeviceImportJobLoop,
+		startEmailOfflineAlertLoop,
 		startJoinServerAPI,
 		startClientAPI(ctx),
 	}
@@ -190,6 +207,21 @@ func setDisableAssignExistingUsers() error {
 	return nil
 }
 
+func setupEmail() error {
+	if err := email.Setup(); err != nil {
+		return errors.Wrap(err, "setup e-mail error")
+	}
+	return nil
+}
+
+func startEmailOfflineAlertLoop() error {
+	if !email.Enabled() {
+		return nil
+	}
+	go email.OfflineAlertLoop()
+	return nil
+}
+
 func handleDataDownPayloads() error {
 	go downlink.HandleDataDownPayloads()
 	return nil
@@ -217,6 +249,50 @@ func startGatewayPing() error {
 	return nil
 }
 
+func startFUOTADeploymentLoop() error {
+	go fuota.DeploymentLoop()
+
+	return nil
+}
+
+func startRemoteMulticastSetupLoop() error {
+	go multicastsetup.SyncRemoteMulticastSetupLoop()
+
+	return nil
+}
+
+func startDeviceEventCleanupLoop() error {
+	// this is also needed when the global retention is 0, as the retention
+	// can be set per organization
+	go eventlog.DeleteExpiredEventsLoop()
+
+	return nil
+}
+
+func startDeviceStatsCleanupLoop() error {
+	go devicestats.DeleteExpiredStatsLoop()
+
+	return nil
+}
+
+func startScheduledDownlinkLoop() error {
+	go scheduler.DownlinkLoop()
+
+	return nil
+}
+
+func startDeviceGroupJobLoop() error {
+	go devicegroup.JobLoop()
+
+	return nil
+}
+
+func startDeviceImportJobLoop() error {
+	go devicefile.ImportJobLoop()
+
+	return nil
+}
+
 func startJoinServerAPI() error {
 	log.WithFields(log.Fields{
 		"bind":     config.C.JoinServer.Bind,
@@ -299,6 +375,10 @@ func startClientAPI(ctx context.Context) func() error {
 		pb.RegisterServiceProfileServiceServer(clientAPIHandler, api.NewServiceProfileServiceAPI(validator))
 		pb.RegisterDeviceProfileServiceServer(clientAPIHandler, api.NewDeviceProfileServiceAPI(validator))
 		pb.RegisterMulticastGroupServiceServer(clientAPIHandler, api.NewMulticastGroupAPI(validator, config.C.PostgreSQL.DB, rpID, config.C.NetworkServer.Pool))
+		pb.RegisterCodecServiceServer(clientAPIHandler, api.NewCodecAPI(validator))
+		pb.RegisterFUOTADeploymentServiceServer(clientAPIHandler, api.NewFUOTADeploymentAPI(validator))
+		pb.RegisterScheduledDownlinkServiceServer(clientAPIHandler, api.NewScheduledDownlinkAPI(validator))
+		pb.RegisterDeviceGroupServiceServer(clientAPIHandler, api.NewDeviceGroupAPI(validator))
 
 		// setup the client http interface variable
 		// we need to start the gRPC service first, as it is used by the
@@ -492,6 +572,18 @@ func getJSONGateway(ctx context.Context) (http.Handler, error) {
 	if err := pb.RegisterMulticastGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register multicast-group handler error")
 	}
+	if err := pb.RegisterCodecServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register codec handler error")
+	}
+	if err := pb.RegisterFUOTADeploymentServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register fuota deployment handler error")
+	}
+	if err := pb.RegisterScheduledDownlinkServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register scheduled downlink handler error")
+	}
+	if err := pb.RegisterDeviceGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register device-group handler error")
+	}
 
 	return mux, nil
 }
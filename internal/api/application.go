@@ -1,13 +1,18 @@
 package api
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,6 +25,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/integration/http"
 	"github.com/brocaar/lora-app-server/internal/integration/influxdb"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // ApplicationAPI exports the Application related functions.
@@ -46,11 +52,20 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := codec.Validate(codec.Type(req.Application.PayloadCodec), req.Application.PayloadEncoderScript, req.Application.PayloadDecoderScript); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", err)
+	}
+
 	spID, err := uuid.FromString(req.Application.ServiceProfileId)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	codecID, codecVersion, err := getApplicationCodec(req.Application.OrganizationId, req.Application.CodecId, req.Application.CodecVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	app := storage.Application{
 		Name:                 req.Application.Name,
 		Description:          req.Application.Description,
@@ -59,12 +74,31 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		PayloadCodec:         codec.Type(req.Application.PayloadCodec),
 		PayloadEncoderScript: req.Application.PayloadEncoderScript,
 		PayloadDecoderScript: req.Application.PayloadDecoderScript,
+		CodecID:              codecID,
+		CodecVersion:         codecVersion,
+		SenMLOutput:          req.Application.SenmlOutput,
 	}
 
-	if err := storage.CreateApplication(config.C.PostgreSQL.DB, &app); err != nil {
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CreateApplication(tx, &app); err != nil {
+			return errToRPCError(err)
+		}
+
+		if _, err := storage.CreateApplicationPayloadCodecRevisionForApplication(tx, app, username); err != nil {
+			return errToRPCError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.CreateApplicationResponse{
 		Id: app.ID,
 	}, nil
@@ -92,9 +126,17 @@ func (a *ApplicationAPI) Get(ctx context.Context, req *pb.GetApplicationRequest)
 			PayloadCodec:         string(app.PayloadCodec),
 			PayloadEncoderScript: app.PayloadEncoderScript,
 			PayloadDecoderScript: app.PayloadDecoderScript,
+			SenmlOutput:          app.SenMLOutput,
 		},
 	}
 
+	if app.CodecID != nil {
+		resp.Application.CodecId = *app.CodecID
+	}
+	if app.CodecVersion != nil {
+		resp.Application.CodecVersion = *app.CodecVersion
+	}
+
 	return &resp, nil
 }
 
@@ -110,6 +152,10 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := codec.Validate(codec.Type(req.Application.PayloadCodec), req.Application.PayloadEncoderScript, req.Application.PayloadDecoderScript); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", err)
+	}
+
 	app, err := storage.GetApplication(config.C.PostgreSQL.DB, req.Application.Id)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -120,6 +166,25 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 		return nil, errToRPCError(err)
 	}
 
+	codecID, codecVersion, err := getApplicationCodec(app.OrganizationID, req.Application.CodecId, req.Application.CodecVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	codecChanged := app.PayloadCodec != codec.Type(req.Application.PayloadCodec) ||
+		app.PayloadEncoderScript != req.Application.PayloadEncoderScript ||
+		app.PayloadDecoderScript != req.Application.PayloadDecoderScript ||
+		!int64PtrEqual(app.CodecID, codecID) ||
+		!int64PtrEqual(app.CodecVersion, codecVersion)
+
+	oldEncoderScript := app.PayloadEncoderScript
+	oldDecoderScript := app.PayloadDecoderScript
+
 	// update the fields
 	app.Name = req.Application.Name
 	app.Description = req.Application.Description
@@ -127,12 +192,30 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 	app.PayloadCodec = codec.Type(req.Application.PayloadCodec)
 	app.PayloadEncoderScript = req.Application.PayloadEncoderScript
 	app.PayloadDecoderScript = req.Application.PayloadDecoderScript
+	app.CodecID = codecID
+	app.CodecVersion = codecVersion
+	app.SenMLOutput = req.Application.SenmlOutput
 
-	err = storage.UpdateApplication(config.C.PostgreSQL.DB, app)
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.UpdateApplication(tx, app); err != nil {
+			return errToRPCError(err)
+		}
+
+		if codecChanged {
+			if _, err := storage.CreateApplicationPayloadCodecRevisionForApplication(tx, app, username); err != nil {
+				return errToRPCError(err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, errToRPCError(err)
+		return nil, err
 	}
 
+	invalidateScriptCache(oldEncoderScript, app.PayloadEncoderScript)
+	invalidateScriptCache(oldDecoderScript, app.PayloadDecoderScript)
+
 	return &empty.Empty{}, nil
 }
 
@@ -240,6 +323,61 @@ func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationReques
 	return &resp, nil
 }
 
+// getApplicationCodec returns the shared codec ID and (pinned) codec
+// version to store for the application. It validates that the codec (and
+// version) exists and that the codec belongs to the given organization.
+func getApplicationCodec(organizationID, codecID, codecVersion int64) (*int64, *int64, error) {
+	if codecID == 0 {
+		if codecVersion != 0 {
+			return nil, nil, grpc.Errorf(codes.InvalidArgument, "codec version requires a codec")
+		}
+		return nil, nil, nil
+	}
+
+	c, err := storage.GetCodec(config.C.PostgreSQL.DB, codecID)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil, nil, grpc.Errorf(codes.InvalidArgument, "codec does not exist")
+		}
+		return nil, nil, errToRPCError(err)
+	}
+
+	if c.OrganizationID != organizationID {
+		return nil, nil, grpc.Errorf(codes.InvalidArgument, "codec must belong to the same organization as the application")
+	}
+
+	if codecVersion == 0 {
+		return &c.ID, nil, nil
+	}
+
+	v, err := storage.GetCodecVersion(config.C.PostgreSQL.DB, c.ID, codecVersion)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil, nil, grpc.Errorf(codes.InvalidArgument, "codec version does not exist")
+		}
+		return nil, nil, errToRPCError(err)
+	}
+
+	return &c.ID, &v.Version, nil
+}
+
+// int64PtrValue returns the value of the given pointer or 0 when nil.
+func int64PtrValue(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// int64PtrEqual returns true when both pointers are nil or point to the
+// same value.
+func int64PtrEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // CreateHTTPIntegration creates an HTTP application-integration.
 func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.CreateHTTPIntegrationRequest) (*empty.Empty, error) {
 	if in.Integration == nil {
@@ -562,3 +700,278 @@ func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegr
 
 	return &out, nil
 }
+
+// TestPayloadCodec runs the given payload codec against the given payload,
+// without storing the codec configuration.
+func (a *ApplicationAPI) TestPayloadCodec(ctx context.Context, in *pb.TestPayloadCodecRequest) (*pb.TestPayloadCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// the application ID is not set, so that the (one-off) scripts are not
+	// added to the compiled script cache used for the uplinks
+	codecPL := codec.NewPayload(codec.Type(in.PayloadCodec), uint8(in.FPort), in.PayloadEncoderScript, in.PayloadDecoderScript)
+	if codecPL == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "no or invalid codec given")
+	}
+
+	var out pb.TestPayloadCodecResponse
+	var start time.Time
+
+	switch in.Operation {
+	case pb.PayloadCodecOperation_DECODE:
+		data := in.Data
+		if in.DataHex != "" {
+			var err error
+			data, err = hex.DecodeString(in.DataHex)
+			if err != nil {
+				return nil, grpc.Errorf(codes.InvalidArgument, "decode hex error: %s", err)
+			}
+		}
+
+		md := codec.UplinkMetadata{
+			Time:      time.Now(),
+			RXInfo:    []codec.UplinkRXInfo{},
+			Variables: make(map[string]string),
+		}
+
+		if in.DevEui != "" {
+			var devEUI lorawan.EUI64
+			if err := devEUI.UnmarshalText([]byte(in.DevEui)); err != nil {
+				return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+			}
+
+			d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+			if d.ApplicationID != in.ApplicationId {
+				return nil, grpc.Errorf(codes.InvalidArgument, "device does not belong to the application")
+			}
+
+			md = uplinkMetadata(d, 0, md.Time, nil)
+		}
+
+		for k, v := range in.Variables {
+			md.Variables[k] = v
+		}
+		codec.SetUplinkMetadata(codecPL, md)
+
+		start = time.Now()
+		if err := codecPL.DecodeBytes(data); err != nil {
+			out.Error = err.Error()
+			break
+		}
+
+		b, err := json.Marshal(codecPL.Object())
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		out.JsonObject = string(b)
+	case pb.PayloadCodecOperation_ENCODE:
+		if err := json.Unmarshal([]byte(in.JsonObject), &codecPL); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "unmarshal json object error: %s", err)
+		}
+
+		start = time.Now()
+		b, err := codecPL.EncodeToBytes()
+		if err != nil {
+			out.Error = err.Error()
+			break
+		}
+		out.Data = b
+		out.DataHex = hex.EncodeToString(b)
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown operation: %s", in.Operation)
+	}
+
+	out.ExecutionTime = ptypes.DurationProto(time.Since(start))
+	out.Console = codec.GetConsoleOutput(codecPL)
+
+	return &out, nil
+}
+
+// ListPayloadCodecRevisions lists the payload codec revisions of the
+// application (newest first).
+func (a *ApplicationAPI) ListPayloadCodecRevisions(ctx context.Context, in *pb.ListPayloadCodecRevisionsRequest) (*pb.ListPayloadCodecRevisionsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetApplicationPayloadCodecRevisionCount(config.C.PostgreSQL.DB, in.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	revs, err := storage.GetApplicationPayloadCodecRevisions(config.C.PostgreSQL.DB, in.ApplicationId, int(in.Limit), int(in.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListPayloadCodecRevisionsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, rev := range revs {
+		item := pb.PayloadCodecRevisionListItem{
+			Revision:     uint32(rev.Revision),
+			Username:     rev.Username,
+			PayloadCodec: string(rev.PayloadCodec),
+			CodecId:      int64PtrValue(rev.CodecID),
+			CodecVersion: int64PtrValue(rev.CodecVersion),
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(rev.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// GetPayloadCodecRevision returns the given payload codec revision.
+func (a *ApplicationAPI) GetPayloadCodecRevision(ctx context.Context, in *pb.GetPayloadCodecRevisionRequest) (*pb.GetPayloadCodecRevisionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	rev, err := storage.GetApplicationPayloadCodecRevision(config.C.PostgreSQL.DB, in.ApplicationId, int(in.Revision))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetPayloadCodecRevisionResponse{
+		Revision: &pb.PayloadCodecRevision{
+			ApplicationId:        rev.ApplicationID,
+			Revision:             uint32(rev.Revision),
+			Username:             rev.Username,
+			PayloadCodec:         string(rev.PayloadCodec),
+			PayloadEncoderScript: rev.PayloadEncoderScript,
+			PayloadDecoderScript: rev.PayloadDecoderScript,
+			CodecId:              int64PtrValue(rev.CodecID),
+			CodecVersion:         int64PtrValue(rev.CodecVersion),
+		},
+	}
+
+	resp.Revision.CreatedAt, err = ptypes.TimestampProto(rev.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// DiffPayloadCodecRevisions returns the differences between two payload
+// codec revisions.
+func (a *ApplicationAPI) DiffPayloadCodecRevisions(ctx context.Context, in *pb.DiffPayloadCodecRevisionsRequest) (*pb.DiffPayloadCodecRevisionsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	fromRevision := in.FromRevision
+	if fromRevision == 0 && in.Revision > 0 {
+		fromRevision = in.Revision - 1
+	}
+
+	rev, err := storage.GetApplicationPayloadCodecRevision(config.C.PostgreSQL.DB, in.ApplicationId, int(in.Revision))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	// revision 0 represents the empty state before the first revision
+	var fromRev storage.ApplicationPayloadCodecRevision
+	if fromRevision != 0 {
+		fromRev, err = storage.GetApplicationPayloadCodecRevision(config.C.PostgreSQL.DB, in.ApplicationId, int(fromRevision))
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	fromName := fmt.Sprintf("revision %d", fromRevision)
+	toName := fmt.Sprintf("revision %d", in.Revision)
+
+	return &pb.DiffPayloadCodecRevisionsResponse{
+		FromRevision:             fromRevision,
+		Revision:                 in.Revision,
+		FromPayloadCodec:         string(fromRev.PayloadCodec),
+		PayloadCodec:             string(rev.PayloadCodec),
+		PayloadEncoderScriptDiff: unifiedDiff(fromName, toName, fromRev.PayloadEncoderScript, rev.PayloadEncoderScript),
+		PayloadDecoderScriptDiff: unifiedDiff(fromName, toName, fromRev.PayloadDecoderScript, rev.PayloadDecoderScript),
+	}, nil
+}
+
+// RollbackPayloadCodec restores the payload codec of the given revision.
+// This creates a new revision.
+func (a *ApplicationAPI) RollbackPayloadCodec(ctx context.Context, in *pb.RollbackPayloadCodecRequest) (*pb.RollbackPayloadCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.RollbackPayloadCodecResponse
+	var oldApp, newApp storage.Application
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		rev, err := storage.GetApplicationPayloadCodecRevision(tx, in.ApplicationId, int(in.Revision))
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		app, err := storage.GetApplication(tx, in.ApplicationId)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		// the shared codec (or version) of the revision might have been
+		// deleted in the meantime
+		codecID, codecVersion, err := getApplicationCodec(app.OrganizationID, int64PtrValue(rev.CodecID), int64PtrValue(rev.CodecVersion))
+		if err != nil {
+			return err
+		}
+
+		oldApp = app
+		app.PayloadCodec = rev.PayloadCodec
+		app.PayloadEncoderScript = rev.PayloadEncoderScript
+		app.PayloadDecoderScript = rev.PayloadDecoderScript
+		app.CodecID = codecID
+		app.CodecVersion = codecVersion
+		newApp = app
+
+		if err := storage.UpdateApplication(tx, app); err != nil {
+			return errToRPCError(err)
+		}
+
+		newRev, err := storage.CreateApplicationPayloadCodecRevisionForApplication(tx, app, username)
+		if err != nil {
+			return errToRPCError(err)
+		}
+		resp.Revision = uint32(newRev.Revision)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateScriptCache(oldApp.PayloadEncoderScript, newApp.PayloadEncoderScript)
+	invalidateScriptCache(oldApp.PayloadDecoderScript, newApp.PayloadDecoderScript)
+
+	return &resp, nil
+}
@@ -18,15 +18,26 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	clksync "github.com/brocaar/lora-app-server/internal/clocksync"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicestats"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/email"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/fuota"
+	"github.com/brocaar/lora-app-server/internal/gps"
 	"github.com/brocaar/lora-app-server/internal/gwping"
 	"github.com/brocaar/lora-app-server/internal/integration"
+	mcsetup "github.com/brocaar/lora-app-server/internal/multicastsetup"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
 	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/clocksync"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
 )
 
 // ApplicationServerAPI implements the as.ApplicationServerServer interface.
@@ -49,6 +60,7 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 	var appEUI, devEUI lorawan.EUI64
 	copy(appEUI[:], req.JoinEui)
 	copy(devEUI[:], req.DevEui)
+	now := time.Now()
 
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
 		d, err = storage.GetDevice(tx, devEUI, true, true)
@@ -56,8 +68,6 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 			grpc.Errorf(codes.Internal, "get device error: %s", err)
 		}
 
-		now := time.Now()
-
 		d.LastSeenAt = &now
 		err = storage.UpdateDevice(tx, &d, true)
 		if err != nil {
@@ -70,6 +80,20 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, err
 	}
 
+	// the device stats are updated in a separate transaction, so that an
+	// error does not fail the handling of the uplink
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		// lock the device, which makes it safe to update the device stats
+		if _, err := storage.GetDevice(tx, devEUI, true, true); err != nil {
+			return errors.Wrap(err, "get device error")
+		}
+
+		return devicestats.HandleUplink(tx, devEUI, now, req.FCnt, req.TxInfo, req.RxInfo)
+	})
+	if err != nil {
+		log.WithError(err).WithField("dev_eui", devEUI).Error("update device stats error")
+	}
+
 	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
 	if err != nil {
 		errStr := fmt.Sprintf("get application error: %s", err)
@@ -99,58 +123,55 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
 	}
 
-	var object interface{}
-	codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
-	if codecPL != nil {
-		if err := codecPL.DecodeBytes(b); err != nil {
+	// the fragmentation fPort is reserved for the fragmentation-session
+	// commands (FUOTA), these are not forwarded to the integrations
+	if uint8(req.FPort) == fragmentation.DefaultFPort {
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			return fuota.HandleUplinkCommand(tx, d.DevEUI, b)
+		})
+		if err != nil {
 			log.WithFields(log.Fields{
-				"codec":          app.PayloadCodec,
-				"application_id": app.ID,
-				"f_port":         req.FPort,
-				"f_cnt":          req.FCnt,
-				"dev_eui":        d.DevEUI,
-			}).WithError(err).Error("decode payload error")
-
-			errNotification := integration.ErrorNotification{
-				ApplicationID:   d.ApplicationID,
-				ApplicationName: app.Name,
-				DeviceName:      d.Name,
-				DevEUI:          d.DevEUI,
-				Type:            "CODEC",
-				Error:           err.Error(),
-				FCnt:            req.FCnt,
-			}
+				"dev_eui": d.DevEUI,
+				"f_cnt":   req.FCnt,
+			}).WithError(err).Error("handle fragmentation-session command error")
+		}
+		return &empty.Empty{}, nil
+	}
 
-			if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
-				Type:    eventlog.Error,
-				Payload: errNotification,
-			}); err != nil {
-				log.WithError(err).Error("log event for device error")
-			}
+	// the remote multicast setup fPort is reserved for the remote multicast
+	// setup commands, these are not forwarded to the integrations
+	if uint8(req.FPort) == multicastsetup.DefaultFPort {
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			return mcsetup.HandleUplinkCommand(tx, d.DevEUI, b)
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui": d.DevEUI,
+				"f_cnt":   req.FCnt,
+			}).WithError(err).Error("handle remote multicast setup command error")
+		}
+		return &empty.Empty{}, nil
+	}
 
-			if err := integration.Integration().SendErrorNotification(errNotification); err != nil {
-				log.WithError(err).Error("send error notification to integration error")
-			}
-		} else {
-			object = codecPL.Object()
+	// the clock synchronization fPort is reserved for the clock
+	// synchronization commands, these are not forwarded to the integrations
+	if uint8(req.FPort) == clocksync.DefaultFPort {
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			return clksync.HandleUplinkCommand(tx, d.DevEUI, getTimeSinceGPSEpoch(req.RxInfo, now), b)
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui": d.DevEUI,
+				"f_cnt":   req.FCnt,
+			}).WithError(err).Error("handle clock synchronization command error")
 		}
+		return &empty.Empty{}, nil
 	}
 
-	pl := integration.DataUpPayload{
-		ApplicationID:   app.ID,
-		ApplicationName: app.Name,
-		DeviceName:      d.Name,
-		DevEUI:          devEUI,
-		RXInfo:          []integration.RXInfo{},
-		TXInfo: integration.TXInfo{
-			Frequency: int(req.TxInfo.Frequency),
-			DR:        int(req.Dr),
-		},
-		ADR:    req.Adr,
-		FCnt:   req.FCnt,
-		FPort:  uint8(req.FPort),
-		Data:   b,
-		Object: object,
+	payloadCodec, encoderScript, decoderScript, err := storage.GetPayloadCodecForDevice(config.C.PostgreSQL.DB, d, app)
+	if err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("get payload codec error")
+		return nil, grpc.Errorf(codes.Internal, "get payload codec error: %s", err)
 	}
 
 	// collect gateway data of receiving gateways (e.g. gateway name)
@@ -165,6 +186,11 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.Internal, "get gateways for macs error: %s", err)
 	}
 
+	if err := storage.UpdateGatewaysLastSeenAt(config.C.PostgreSQL.DB, macs, now); err != nil {
+		log.WithError(err).Error("update gateways last-seen timestamp error")
+	}
+
+	rxInfoSet := []integration.RXInfo{}
 	for _, rxInfo := range req.RxInfo {
 		var mac lorawan.EUI64
 		copy(mac[:], rxInfo.GatewayId)
@@ -196,7 +222,115 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 			}
 		}
 
-		pl.RXInfo = append(pl.RXInfo, row)
+		rxInfoSet = append(rxInfoSet, row)
+	}
+
+	var object interface{}
+	codecPL := codec.NewPayload(payloadCodec, uint8(req.FPort), encoderScript, decoderScript)
+	if codecPL != nil {
+		codec.SetApplicationID(codecPL, app.ID)
+		codec.SetUplinkMetadata(codecPL, uplinkMetadata(d, req.FCnt, now, rxInfoSet))
+
+		start := time.Now()
+		err := codecPL.DecodeBytes(b)
+
+		downlink.LogCodecEvent(d, "decode", payloadCodec, uint8(req.FPort), codecPL, time.Since(start), err)
+		decodeErr := err
+
+		if err != nil {
+			log.WithFields(log.Fields{
+				"codec":          payloadCodec,
+				"application_id": app.ID,
+				"f_port":         req.FPort,
+				"f_cnt":          req.FCnt,
+				"dev_eui":        d.DevEUI,
+			}).WithError(err).Error("decode payload error")
+
+			errNotification := integration.ErrorNotification{
+				ApplicationID:   d.ApplicationID,
+				ApplicationName: app.Name,
+				DeviceName:      d.Name,
+				DevEUI:          d.DevEUI,
+				Tags:            d.TagsMap(),
+				Type:            "CODEC",
+				Error:           err.Error(),
+				FCnt:            req.FCnt,
+			}
+
+			if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+				Type:    eventlog.Error,
+				Payload: errNotification,
+			}); err != nil {
+				log.WithError(err).Error("log event for device error")
+			}
+
+			if err := integration.Integration().SendErrorNotification(errNotification); err != nil {
+				log.WithError(err).Error("send error notification to integration error")
+			}
+		} else if app.SenMLOutput {
+			object, err = codec.SenMLRecords(codecPL, codec.SenMLBaseName(d.DevEUI), now)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"application_id": app.ID,
+					"dev_eui":        d.DevEUI,
+				}).WithError(err).Error("convert object to senml records error")
+				object = nil
+			}
+		} else {
+			object = codecPL.Object()
+		}
+
+		// set the GPS location contained by the (decoded) payload as the
+		// latest device location and store it in the location history
+		if loc, ok := codec.GetGPSLocation(codecPL); ok && decodeErr == nil {
+			if err := storage.SetDeviceLocation(config.C.PostgreSQL.DB, &storage.DeviceLocation{
+				DevEUI:    d.DevEUI,
+				Latitude:  loc.Latitude,
+				Longitude: loc.Longitude,
+				Altitude:  loc.Altitude,
+				Source:    common.LocationSource_GPS.String(),
+			}); err != nil {
+				log.WithField("dev_eui", d.DevEUI).WithError(err).Error("set device location error")
+			}
+		}
+
+		if decodeErr == nil {
+			// store the decoded fields as last known values of the device
+			fields, err := codec.GetObjectFields(codecPL)
+			if err != nil {
+				log.WithField("dev_eui", d.DevEUI).WithError(err).Error("get decoded object fields error")
+			} else if len(fields) != 0 {
+				if err := storage.UpdateDeviceLastValues(config.C.PostgreSQL.DB, d.DevEUI, storage.NewDeviceValues(fields, time.Now())); err != nil {
+					log.WithField("dev_eui", d.DevEUI).WithError(err).Error("update device last values error")
+				}
+			}
+
+			// update the reported state of the device twin, this might enqueue
+			// the desired state when both states differ
+			if err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+				return downlink.HandleTwinUplink(tx, d, app, codecPL.Object())
+			}); err != nil {
+				log.WithField("dev_eui", d.DevEUI).WithError(err).Error("handle device twin uplink error")
+			}
+		}
+	}
+
+	pl := integration.DataUpPayload{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          devEUI,
+		Tags:            d.TagsMap(),
+		RXInfo:          rxInfoSet,
+		TXInfo: integration.TXInfo{
+			Frequency: int(req.TxInfo.Frequency),
+			DR:        int(req.Dr),
+		},
+		ADR:    req.Adr,
+		FCnt:   req.FCnt,
+		FPort:  uint8(req.FPort),
+		Data:   b,
+		Object: object,
 	}
 
 	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
@@ -210,6 +344,17 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 	err = integration.Integration().SendDataUp(pl)
 	if err != nil {
 		log.WithError(err).Error("send uplink data to integration error")
+
+		if err := email.SendAlertAsync(config.C.PostgreSQL.DB, app.OrganizationID, email.IntegrationFailure, fmt.Sprintf("%d", app.ID), email.IntegrationFailureData{
+			ApplicationID:   app.ID,
+			ApplicationName: app.Name,
+			DeviceName:      d.Name,
+			DevEUI:          d.DevEUI,
+			Error:           err.Error(),
+		}); err != nil {
+			log.WithError(err).Error("send integration failure alert error")
+		}
+
 		return nil, grpc.Errorf(codes.Internal, err.Error())
 	}
 
@@ -243,6 +388,7 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
 		DevEUI:          devEUI,
+		Tags:            d.TagsMap(),
 		Acknowledged:    req.Acknowledged,
 		FCnt:            req.FCnt,
 	}
@@ -291,6 +437,7 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
 		DevEUI:          devEUI,
+		Tags:            d.TagsMap(),
 		Type:            req.Type.String(),
 		Error:           req.Error,
 		FCnt:            req.FCnt,
@@ -320,6 +467,16 @@ func (a *ApplicationServerAPI) HandleProprietaryUplink(ctx context.Context, req
 		return nil, grpc.Errorf(codes.InvalidArgument, "tx_info must not be nil")
 	}
 
+	var macs []lorawan.EUI64
+	for _, rxInfo := range req.RxInfo {
+		var mac lorawan.EUI64
+		copy(mac[:], rxInfo.GatewayId)
+		macs = append(macs, mac)
+	}
+	if err := storage.UpdateGatewaysLastSeenAt(config.C.PostgreSQL.DB, macs, time.Now()); err != nil {
+		log.WithError(err).Error("update gateways last-seen timestamp error")
+	}
+
 	err := gwping.HandleReceivedPing(req)
 	if err != nil {
 		errStr := fmt.Sprintf("handle received ping error: %s", err)
@@ -378,6 +535,7 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 		ApplicationName:         app.Name,
 		DeviceName:              d.Name,
 		DevEUI:                  d.DevEUI,
+		Tags:                    d.TagsMap(),
 		Battery:                 int(req.Battery),
 		Margin:                  int(req.Margin),
 		ExternalPowerSource:     req.ExternalPowerSource,
@@ -418,12 +576,16 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 			return errToRPCError(errors.Wrap(err, "get device error"))
 		}
 
-		d.Latitude = &req.Location.Latitude
-		d.Longitude = &req.Location.Longitude
-		d.Altitude = &req.Location.Altitude
-
-		if err = storage.UpdateDevice(tx, &d, true); err != nil {
-			return errToRPCError(errors.Wrap(err, "update device error"))
+		err = storage.SetDeviceLocation(tx, &storage.DeviceLocation{
+			DevEUI:    d.DevEUI,
+			Latitude:  req.Location.Latitude,
+			Longitude: req.Location.Longitude,
+			Altitude:  req.Location.Altitude,
+			Source:    req.Location.Source.String(),
+			Accuracy:  int(req.Location.Accuracy),
+		})
+		if err != nil {
+			return errToRPCError(errors.Wrap(err, "set device location error"))
 		}
 
 		return nil
@@ -442,6 +604,7 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
 		DevEUI:          d.DevEUI,
+		Tags:            d.TagsMap(),
 		Location: integration.Location{
 			Latitude:  req.Location.Latitude,
 			Longitude: req.Location.Longitude,
@@ -537,6 +700,7 @@ func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DevEUI:          d.DevEUI,
+		Tags:            d.TagsMap(),
 		DeviceName:      d.Name,
 		DevAddr:         da.DevAddr,
 	}
@@ -589,3 +753,53 @@ func unwrapASKey(ke *common.KeyEnvelope) (lorawan.AES128Key, error) {
 
 	return key, fmt.Errorf("unknown kek label: %s", ke.KekLabel)
 }
+
+// uplinkMetadata returns the uplink metadata and device variables which are
+// exposed to the payload codec.
+// getTimeSinceGPSEpoch returns the time since GPS epoch at which the uplink
+// was received. The gateway (GPS) time is used when available, else the
+// given time is used.
+func getTimeSinceGPSEpoch(rxInfo []*gw.UplinkRXInfo, t time.Time) time.Duration {
+	for _, rx := range rxInfo {
+		if rx.TimeSinceGpsEpoch == nil {
+			continue
+		}
+		if d, err := ptypes.Duration(rx.TimeSinceGpsEpoch); err == nil {
+			return d
+		}
+	}
+
+	for _, rx := range rxInfo {
+		if rx.Time == nil {
+			continue
+		}
+		if ts, err := ptypes.Timestamp(rx.Time); err == nil {
+			return gps.Time(ts).TimeSinceGPSEpoch()
+		}
+	}
+
+	return gps.Time(t).TimeSinceGPSEpoch()
+}
+
+func uplinkMetadata(d storage.Device, fCnt uint32, ts time.Time, rxInfoSet []integration.RXInfo) codec.UplinkMetadata {
+	md := codec.UplinkMetadata{
+		DevEUI:     d.DevEUI,
+		DeviceName: d.Name,
+		FCnt:       fCnt,
+		Time:       ts,
+		RXInfo:     []codec.UplinkRXInfo{},
+		Variables:  d.VariablesMap(),
+	}
+
+	for _, rxInfo := range rxInfoSet {
+		md.RXInfo = append(md.RXInfo, codec.UplinkRXInfo{
+			GatewayID: rxInfo.GatewayID,
+			Name:      rxInfo.Name,
+			Time:      rxInfo.Time,
+			RSSI:      rxInfo.RSSI,
+			LoRaSNR:   rxInfo.LoRaSNR,
+		})
+	}
+
+	return md
+}
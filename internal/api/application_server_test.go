@@ -2,12 +2,14 @@ package api
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq/hstore"
 	"github.com/stretchr/testify/require"
 
 	"github.com/brocaar/lora-app-server/internal/codec"
@@ -75,6 +77,11 @@ func (ts *APITestSuite) TestApplicationServer() {
 		Name:            "test-node",
 		DevEUI:          [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
 		DeviceProfileID: dpID,
+		Tags: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"building": sql.NullString{String: "b-42", Valid: true},
+			},
+		},
 	}
 	assert.NoError(storage.CreateDevice(ts.DB(), &d))
 
@@ -115,6 +122,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 			ApplicationName: "test-app",
 			DeviceName:      "test-node",
 			DevEUI:          [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+			Tags:            map[string]string{"building": "b-42"},
 			Type:            "DATA_UP_FCNT",
 			Error:           "BOOM!",
 			FCnt:            123,
@@ -232,11 +240,17 @@ func (ts *APITestSuite) TestApplicationServer() {
 				assert.NoError(err)
 				assert.InDelta(time.Now().UnixNano(), d.LastSeenAt.UnixNano(), float64(time.Second))
 
+				stats, err := storage.GetLastDeviceStats(ts.DB(), d.DevEUI, storage.DeviceStatsIntervalHour, false)
+				assert.NoError(err)
+				assert.Equal(1, stats.RXPackets)
+				assert.Equal(-60, stats.RSSIMax)
+
 				assert.Equal(integration.DataUpPayload{
 					ApplicationID:   app.ID,
 					ApplicationName: "test-app",
 					DeviceName:      "test-node",
 					DevEUI:          d.DevEUI,
+					Tags:            map[string]string{"building": "b-42"},
 					RXInfo: []integration.RXInfo{
 						{
 							GatewayID: mac,
@@ -285,6 +299,110 @@ func (ts *APITestSuite) TestApplicationServer() {
 				assert.NoError(err)
 				assert.Equal(`{"fPort":3,"firstByte":67}`, string(b))
 			})
+
+			t.Run("JS codec with SenML output", func(t *testing.T) {
+				assert := require.New(t)
+
+				app.SenMLOutput = true
+				assert.NoError(storage.UpdateApplication(ts.DB(), app))
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				assert.NoError(err)
+
+				pl := <-h.SendDataUpChan
+				records, ok := pl.Object.([]codec.SenMLRecord)
+				assert.True(ok)
+				assert.Len(records, 2)
+				assert.Equal(codec.SenMLBaseName(d.DevEUI), records[0].BaseName)
+				assert.NotZero(records[0].BaseTime)
+				assert.Equal("fPort", records[0].Name)
+				assert.EqualValues(3, *records[0].Value)
+				assert.Equal("firstByte", records[1].Name)
+				assert.EqualValues(67, *records[1].Value)
+
+				app.SenMLOutput = false
+				assert.NoError(storage.UpdateApplication(ts.DB(), app))
+			})
+
+			t.Run("JS codec does not receive the secret variables", func(t *testing.T) {
+				assert := require.New(t)
+
+				d.Variables = hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"factor": sql.NullString{String: "3", Valid: true},
+					},
+				}
+				d.SecretVariables = hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"token": sql.NullString{String: "secret", Valid: true},
+					},
+				}
+				assert.NoError(storage.UpdateDevice(ts.DB(), &d, true))
+
+				app.PayloadDecoderScript = `function Decode(f, b, v) { return v; }`
+				assert.NoError(storage.UpdateApplication(ts.DB(), app))
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				assert.NoError(err)
+
+				pl := <-h.SendDataUpChan
+				b, err := json.Marshal(pl.Object)
+				assert.NoError(err)
+				assert.Equal(`{"factor":"3"}`, string(b))
+			})
+
+			t.Run("JS codec with GPS location", func(t *testing.T) {
+				assert := require.New(t)
+
+				app.PayloadDecoderScript = `
+					function Decode(fPort, bytes) {
+						return {
+							"latitude": 1.5,
+							"longitude": 2.5,
+							"altitude": 3.5
+						}
+					}
+				`
+				assert.NoError(storage.UpdateApplication(ts.DB(), app))
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				assert.NoError(err)
+				<-h.SendDataUpChan
+
+				d, err := storage.GetDevice(ts.DB(), d.DevEUI, false, true)
+				assert.NoError(err)
+				assert.Equal(1.5, *d.Latitude)
+				assert.Equal(2.5, *d.Longitude)
+				assert.Equal(3.5, *d.Altitude)
+
+				l, err := storage.GetLastDeviceLocation(ts.DB(), d.DevEUI)
+				assert.NoError(err)
+				assert.Equal(1.5, l.Latitude)
+				assert.Equal("GPS", l.Source)
+			})
+
+			t.Run("Device-profile JS codec", func(t *testing.T) {
+				assert := require.New(t)
+
+				dp.PayloadCodec = codec.CustomJSType
+				dp.PayloadDecoderScript = `
+					function Decode(fPort, bytes) {
+						return {
+							"secondByte": bytes[1]
+						}
+					}
+				`
+				assert.NoError(storage.UpdateDeviceProfile(ts.DB(), &dp))
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				assert.NoError(err)
+
+				pl := <-h.SendDataUpChan
+				assert.NotNil(pl.Object)
+				b, err := json.Marshal(pl.Object)
+				assert.NoError(err)
+				assert.Equal(`{"secondByte":216}`, string(b))
+			})
 		})
 	})
 
@@ -307,6 +425,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 					ApplicationName: app.Name,
 					DeviceName:      d.Name,
 					DevEUI:          d.DevEUI,
+					Tags:            map[string]string{"building": "b-42"},
 					Margin:          10,
 					Battery:         123,
 					BatteryLevel:    25.50,
@@ -324,6 +443,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 					ApplicationName:         app.Name,
 					DeviceName:              d.Name,
 					DevEUI:                  d.DevEUI,
+					Tags:                    map[string]string{"building": "b-42"},
 					Margin:                  10,
 					BatteryLevelUnavailable: true,
 				},
@@ -340,6 +460,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 					ApplicationName:     app.Name,
 					DeviceName:          d.Name,
 					DevEUI:              d.DevEUI,
+					Tags:                map[string]string{"building": "b-42"},
 					Margin:              10,
 					ExternalPowerSource: true,
 				},
@@ -379,6 +500,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 				Longitude: 2.123,
 				Altitude:  3.123,
 				Source:    common.LocationSource_GEO_RESOLVER,
+				Accuracy:  10,
 			},
 		})
 		assert.NoError(err)
@@ -388,6 +510,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 			ApplicationName: app.Name,
 			DeviceName:      d.Name,
 			DevEUI:          d.DevEUI,
+			Tags:            map[string]string{"building": "b-42"},
 			Location: integration.Location{
 				Latitude:  1.123,
 				Longitude: 2.123,
@@ -400,6 +523,12 @@ func (ts *APITestSuite) TestApplicationServer() {
 		assert.Equal(1.123, *d.Latitude)
 		assert.Equal(2.123, *d.Longitude)
 		assert.Equal(3.123, *d.Altitude)
+
+		l, err := storage.GetLastDeviceLocation(ts.DB(), d.DevEUI)
+		assert.NoError(err)
+		assert.Equal(1.123, l.Latitude)
+		assert.Equal("GEO_RESOLVER", l.Source)
+		assert.Equal(10, l.Accuracy)
 	})
 
 	ts.T().Run("HandleDownlinkACK", func(t *testing.T) {
@@ -415,6 +544,7 @@ func (ts *APITestSuite) TestApplicationServer() {
 			ApplicationName: app.Name,
 			DeviceName:      d.Name,
 			DevEUI:          d.DevEUI,
+			Tags:            map[string]string{"building": "b-42"},
 			Acknowledged:    true,
 			FCnt:            10,
 		}, <-h.SendACKNotificationChan)
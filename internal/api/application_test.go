@@ -1,9 +1,11 @@
 package api
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,9 +14,11 @@ import (
 	"golang.org/x/net/context"
 
 	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
 )
 
 func TestApplicationAPI(t *testing.T) {
@@ -55,6 +59,136 @@ func TestApplicationAPI(t *testing.T) {
 		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
 		So(err, ShouldBeNil)
 
+		Convey("When testing a payload codec", func() {
+			Convey("Then a HEX payload can be decoded", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId:        1,
+					Operation:            pb.PayloadCodecOperation_DECODE,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: `function Decode(fPort, bytes) { return {"fPort": fPort, "firstByte": bytes[0]}; }`,
+					FPort:                10,
+					DataHex:              "0102",
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.Error, ShouldEqual, "")
+				So(resp.JsonObject, ShouldEqual, `{"fPort":10,"firstByte":1}`)
+				So(resp.ExecutionTime, ShouldNotBeNil)
+			})
+
+			Convey("Then the given variables are passed to the decode function", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId:        1,
+					Operation:            pb.PayloadCodecOperation_DECODE,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: `function Decode(fPort, bytes, variables, uplink) { return {"value": bytes[0] * parseInt(variables.factor), "gateways": uplink.rxInfo.length}; }`,
+					FPort:                10,
+					DataHex:              "02",
+					Variables: map[string]string{
+						"factor": "3",
+					},
+				})
+				So(err, ShouldBeNil)
+				So(resp.Error, ShouldEqual, "")
+				So(resp.JsonObject, ShouldEqual, `{"gateways":0,"value":6}`)
+			})
+
+			Convey("Then an unknown device returns an error", func() {
+				_, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId:        1,
+					Operation:            pb.PayloadCodecOperation_DECODE,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: `function Decode(fPort, bytes) { return {}; }`,
+					DevEui:               "0102030405060708",
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+
+			Convey("Then the secret variables of the given device are not passed to the decode function", func() {
+				dp := storage.DeviceProfile{
+					Name:            "test-dp",
+					OrganizationID:  org.ID,
+					NetworkServerID: n.ID,
+				}
+				So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+				dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+				So(err, ShouldBeNil)
+
+				app := storage.Application{
+					OrganizationID:   org.ID,
+					Name:             "test-app",
+					ServiceProfileID: spID,
+				}
+				So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+				d := storage.Device{
+					DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					ApplicationID:   app.ID,
+					DeviceProfileID: dpID,
+					Name:            "test-device",
+					Variables: hstore.Hstore{
+						Map: map[string]sql.NullString{
+							"factor": sql.NullString{String: "3", Valid: true},
+						},
+					},
+					SecretVariables: hstore.Hstore{
+						Map: map[string]sql.NullString{
+							"token": sql.NullString{String: "secret", Valid: true},
+						},
+					},
+				}
+				So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId:        app.ID,
+					Operation:            pb.PayloadCodecOperation_DECODE,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: `function Decode(f, b, v) { return v; }`,
+					DevEui:               d.DevEUI.String(),
+					DataHex:              "01",
+				})
+				So(err, ShouldBeNil)
+				So(resp.Error, ShouldEqual, "")
+				So(resp.JsonObject, ShouldEqual, `{"factor":"3"}`)
+			})
+
+			Convey("Then a JSON object can be encoded", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId:        1,
+					Operation:            pb.PayloadCodecOperation_ENCODE,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadEncoderScript: `function Encode(fPort, obj) { return [fPort, obj.value]; }`,
+					FPort:                10,
+					JsonObject:           `{"value": 3}`,
+				})
+				So(err, ShouldBeNil)
+				So(resp.Error, ShouldEqual, "")
+				So(resp.Data, ShouldResemble, []byte{10, 3})
+				So(resp.DataHex, ShouldEqual, "0a03")
+			})
+
+			Convey("Then a script error is returned in the response", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId:        1,
+					Operation:            pb.PayloadCodecOperation_DECODE,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: `function Decode(fPort, bytes) { return foo; }`,
+					Data:                 []byte{1, 2},
+				})
+				So(err, ShouldBeNil)
+				So(resp.Error, ShouldNotEqual, "")
+				So(resp.JsonObject, ShouldEqual, "")
+			})
+
+			Convey("Then an invalid codec returns an error", func() {
+				_, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					ApplicationId: 1,
+					PayloadCodec:  "INVALID",
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+		})
+
 		Convey("When creating an application", func() {
 			createResp, err := api.Create(ctx, &pb.CreateApplicationRequest{
 				Application: &pb.Application{
@@ -155,6 +289,7 @@ func TestApplicationAPI(t *testing.T) {
 			})
 
 			Convey("When updating the application", func() {
+				validator.returnUsername = "testuser"
 				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
 					Application: &pb.Application{
 						Id:                   createResp.Id,
@@ -188,6 +323,141 @@ func TestApplicationAPI(t *testing.T) {
 						},
 					})
 				})
+
+				Convey("Then a payload codec revision has been created", func() {
+					revs, err := api.ListPayloadCodecRevisions(ctx, &pb.ListPayloadCodecRevisionsRequest{
+						ApplicationId: createResp.Id,
+						Limit:         10,
+					})
+					So(err, ShouldBeNil)
+					So(revs.TotalCount, ShouldEqual, 2)
+					So(revs.Result, ShouldHaveLength, 2)
+					So(revs.Result[0].Revision, ShouldEqual, 2)
+					So(revs.Result[0].Username, ShouldEqual, "testuser")
+					So(revs.Result[1].Revision, ShouldEqual, 1)
+
+					rev, err := api.GetPayloadCodecRevision(ctx, &pb.GetPayloadCodecRevisionRequest{
+						ApplicationId: createResp.Id,
+						Revision:      1,
+					})
+					So(err, ShouldBeNil)
+					So(rev.Revision.PayloadCodec, ShouldEqual, "CUSTOM_JS")
+					So(rev.Revision.PayloadEncoderScript, ShouldEqual, "Encode() {}")
+					So(rev.Revision.PayloadDecoderScript, ShouldEqual, "Decode() {}")
+				})
+
+				Convey("Then the diff with the previous revision can be requested", func() {
+					diff, err := api.DiffPayloadCodecRevisions(ctx, &pb.DiffPayloadCodecRevisionsRequest{
+						ApplicationId: createResp.Id,
+						Revision:      2,
+					})
+					So(err, ShouldBeNil)
+					So(diff.FromRevision, ShouldEqual, 1)
+					So(diff.PayloadDecoderScriptDiff, ShouldEqual, "--- revision 1\n+++ revision 2\n@@ -1,1 +1,1 @@\n-Decode() {}\n+Decode2() {}\n")
+				})
+
+				Convey("When rolling back to the first revision", func() {
+					resp, err := api.RollbackPayloadCodec(ctx, &pb.RollbackPayloadCodecRequest{
+						ApplicationId: createResp.Id,
+						Revision:      1,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Revision, ShouldEqual, 3)
+
+					Convey("Then the payload codec has been restored", func() {
+						app, err := api.Get(ctx, &pb.GetApplicationRequest{
+							Id: createResp.Id,
+						})
+						So(err, ShouldBeNil)
+						So(app.Application.Name, ShouldEqual, "test-app-updated")
+						So(app.Application.PayloadEncoderScript, ShouldEqual, "Encode() {}")
+						So(app.Application.PayloadDecoderScript, ShouldEqual, "Decode() {}")
+					})
+				})
+			})
+
+			Convey("When updating the application without changing the payload codec", func() {
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Application: &pb.Application{
+						Id:                   createResp.Id,
+						Name:                 "test-app-updated",
+						Description:          "An updated test description",
+						ServiceProfileId:     spID.String(),
+						PayloadCodec:         "CUSTOM_JS",
+						PayloadEncoderScript: "Encode() {}",
+						PayloadDecoderScript: "Decode() {}",
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then no payload codec revision has been created", func() {
+					revs, err := api.ListPayloadCodecRevisions(ctx, &pb.ListPayloadCodecRevisionsRequest{
+						ApplicationId: createResp.Id,
+						Limit:         10,
+					})
+					So(err, ShouldBeNil)
+					So(revs.TotalCount, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When updating the application to use a shared codec", func() {
+				c := storage.Codec{
+					OrganizationID: org.ID,
+					Name:           "test-codec",
+					PayloadCodec:   codec.CayenneLPPType,
+				}
+				So(storage.CreateCodec(config.C.PostgreSQL.DB, &c), ShouldBeNil)
+
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Application: &pb.Application{
+						Id:                   createResp.Id,
+						Name:                 "test-app",
+						ServiceProfileId:     spID.String(),
+						PayloadCodec:         "CUSTOM_JS",
+						PayloadEncoderScript: "Encode() {}",
+						PayloadDecoderScript: "Decode() {}",
+						CodecId:              c.ID,
+						CodecVersion:         1,
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then a payload codec revision containing the codec has been created", func() {
+					rev, err := api.GetPayloadCodecRevision(ctx, &pb.GetPayloadCodecRevisionRequest{
+						ApplicationId: createResp.Id,
+						Revision:      2,
+					})
+					So(err, ShouldBeNil)
+					So(rev.Revision.CodecId, ShouldEqual, c.ID)
+					So(rev.Revision.CodecVersion, ShouldEqual, 1)
+				})
+
+				Convey("When rolling back to the first revision", func() {
+					_, err := api.RollbackPayloadCodec(ctx, &pb.RollbackPayloadCodecRequest{
+						ApplicationId: createResp.Id,
+						Revision:      1,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the shared codec has been removed", func() {
+						app, err := api.Get(ctx, &pb.GetApplicationRequest{
+							Id: createResp.Id,
+						})
+						So(err, ShouldBeNil)
+						So(app.Application.CodecId, ShouldEqual, 0)
+						So(app.Application.CodecVersion, ShouldEqual, 0)
+					})
+
+					Convey("When rolling back to the revision using the shared codec after it has been deleted", func() {
+						So(storage.DeleteCodec(config.C.PostgreSQL.DB, c.ID), ShouldBeNil)
+
+						_, err := api.RollbackPayloadCodec(ctx, &pb.RollbackPayloadCodecRequest{
+							ApplicationId: createResp.Id,
+							Revision:      2,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+					})
+				})
 			})
 
 			Convey("When deleting the application", func() {
@@ -52,6 +52,71 @@ const userQuery = `
 		on sp.service_profile_id = mg.service_profile_id
 `
 
+// The following queries validate that the resource given by $2 belongs to
+// the organization of the user. These are used as sub-queries (instead of
+// joins in userQuery) to avoid multiplying the number of rows of userQuery.
+const (
+	codecOrganizationQuery = `exists (
+		select 1
+		from codec c
+		where
+			c.id = $2
+			and c.organization_id = o.id)`
+
+	fuotaDeploymentOrganizationQuery = `exists (
+		select 1
+		from fuota_deployment fd
+		inner join application fda
+			on fda.id = fd.application_id
+		where
+			fd.id = $2
+			and fda.organization_id = o.id)`
+
+	scheduledDownlinkOrganizationQuery = `exists (
+		select 1
+		from scheduled_downlink sdl
+		left join device sdld
+			on sdld.dev_eui = sdl.dev_eui
+		left join application sdla
+			on sdla.id = sdld.application_id
+		left join multicast_group sdlmg
+			on sdlmg.id = sdl.multicast_group_id
+		left join service_profile sdlsp
+			on sdlsp.service_profile_id = sdlmg.service_profile_id
+		where
+			sdl.id = $2
+			and (sdla.organization_id = o.id or sdlsp.organization_id = o.id))`
+
+	deviceGroupOrganizationQuery = `exists (
+		select 1
+		from device_group dg
+		inner join application dga
+			on dga.id = dg.application_id
+		where
+			dg.id = $2
+			and dga.organization_id = o.id)`
+
+	deviceGroupJobOrganizationQuery = `exists (
+		select 1
+		from device_group_job dgj
+		inner join device_group dg
+			on dg.id = dgj.device_group_id
+		inner join application dga
+			on dga.id = dg.application_id
+		where
+			dgj.id = $2
+			and dga.organization_id = o.id)`
+
+	deviceImportJobOrganizationQuery = `exists (
+		select 1
+		from device_import_job dij
+		inner join application dija
+			on dija.id = dij.application_id
+		where
+			dij.id = $2
+			and dija.organization_id = o.id)`
+)
+
 // ValidateActiveUser validates if the user in the JWT claim is active.
 func ValidateActiveUser() ValidatorFunc {
 	where := [][]string{
@@ -862,6 +927,220 @@ func ValidateMulticastGroupQueueAccess(flag Flag, multicastGroupID uuid.UUID) Va
 	}
 }
 
+// ValidateCodecsAccess validates if the client has access to the codecs.
+func ValidateCodecsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2"},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+	}
+}
+
+// ValidateCodecAccess validates if the client has access to the given codec.
+func ValidateCodecAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", codecOrganizationQuery},
+		}
+	case Update, Delete:
+		// global admin
+		// organization admin users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", codecOrganizationQuery},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateFUOTADeploymentsAccess validates if the client has access to the
+// FUOTA deployments of the given application.
+func ValidateFUOTADeploymentsAccess(flag Flag, applicationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+		}
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+	}
+}
+
+// ValidateFUOTADeploymentAccess validates if the client has access to the
+// given FUOTA deployment.
+func ValidateFUOTADeploymentAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", fuotaDeploymentOrganizationQuery},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateScheduledDownlinkAccess validates if the client has access to the
+// given scheduled downlink.
+func ValidateScheduledDownlinkAccess(flag Flag, id uuid.UUID) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read, Delete:
+		// global admin
+		// organization users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", scheduledDownlinkOrganizationQuery},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateDeviceGroupsAccess validates if the client has access to the
+// device-groups of the given application.
+func ValidateDeviceGroupsAccess(flag Flag, applicationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+		}
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+	}
+}
+
+// ValidateDeviceGroupAccess validates if the client has access to the
+// given device-group. As the jobs of a device-group modify its devices,
+// creating a job requires the Update flag.
+func ValidateDeviceGroupAccess(flag Flag, id uuid.UUID) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", deviceGroupOrganizationQuery},
+		}
+	case Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", deviceGroupOrganizationQuery},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateDeviceGroupJobAccess validates if the client has access to the
+// given device-group job.
+func ValidateDeviceGroupJobAccess(flag Flag, id uuid.UUID) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", deviceGroupJobOrganizationQuery},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateDeviceImportJobAccess validates if the client has access to the
+// given device import job.
+func ValidateDeviceImportJobAccess(flag Flag, id uuid.UUID) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization users
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", deviceImportJobOrganizationQuery},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
 func executeQuery(db sqlx.Queryer, query string, where [][]string, args ...interface{}) (bool, error) {
 	var ors []string
 	for _, ands := range where {
@@ -129,6 +129,26 @@ func TestValidators(t *testing.T) {
 		}
 	}
 
+	codecs := []storage.Codec{
+		{OrganizationID: organizations[0].ID, Name: "codec-1"},
+		{OrganizationID: organizations[1].ID, Name: "codec-2"},
+	}
+	for i := range codecs {
+		if err := storage.CreateCodec(db, &codecs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fuotaDeployments := []storage.FUOTADeployment{
+		{ApplicationID: applications[0].ID, Name: "fuota-1", Payload: []byte{1, 2, 3, 4}, FragSize: 2, Descriptor: []byte{0, 0, 0, 0}},
+		{ApplicationID: applications[1].ID, Name: "fuota-2", Payload: []byte{1, 2, 3, 4}, FragSize: 2, Descriptor: []byte{0, 0, 0, 0}},
+	}
+	for i := range fuotaDeployments {
+		if err := storage.CreateFUOTADeployment(db, &fuotaDeployments[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	devices := []storage.Device{
 		{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, Name: "test-1", ApplicationID: applications[0].ID, DeviceProfileID: deviceProfilesIDs[0]},
 		{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, Name: "test-2", ApplicationID: applications[1].ID, DeviceProfileID: deviceProfilesIDs[1]},
@@ -139,6 +159,47 @@ func TestValidators(t *testing.T) {
 		}
 	}
 
+	scheduledDownlinks := []storage.ScheduledDownlink{
+		{DevEUI: &devices[0].DevEUI, FPort: 10, Data: []byte{1, 2, 3}},
+		{DevEUI: &devices[1].DevEUI, FPort: 10, Data: []byte{1, 2, 3}},
+		{MulticastGroupID: &multicastGroupsIDs[0], FPort: 10, Data: []byte{1, 2, 3}},
+	}
+	for i := range scheduledDownlinks {
+		if err := storage.CreateScheduledDownlink(db, &scheduledDownlinks[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deviceGroups := []storage.DeviceGroup{
+		{ApplicationID: applications[0].ID, Name: "device-group-1"},
+		{ApplicationID: applications[1].ID, Name: "device-group-2"},
+	}
+	for i := range deviceGroups {
+		if err := storage.CreateDeviceGroup(db, &deviceGroups[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deviceGroupJobs := []storage.DeviceGroupJob{
+		{DeviceGroupID: deviceGroups[0].ID, Action: storage.DeviceGroupJobFlushQueue},
+		{DeviceGroupID: deviceGroups[1].ID, Action: storage.DeviceGroupJobFlushQueue},
+	}
+	for i := range deviceGroupJobs {
+		if err := storage.CreateDeviceGroupJob(db, &deviceGroupJobs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deviceImportJobs := []storage.DeviceImportJob{
+		{ApplicationID: applications[0].ID, Records: []byte("[]")},
+		{ApplicationID: applications[1].ID, Records: []byte("[]")},
+	}
+	for i := range deviceImportJobs {
+		if err := storage.CreateDeviceImportJob(db, &deviceImportJobs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	// cleanup once structs are in place
 	users := []struct {
 		ID       int64
@@ -1185,6 +1246,327 @@ func TestValidators(t *testing.T) {
 
 			runTests(tests, db)
 		})
+
+		Convey("When testing ValidateCodecsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID), ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID), ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateCodecsAccess(Create, organizations[0].ID), ValidateCodecsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateCodecAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID), ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID), ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update and delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization admin users can not read, update and delete codecs of other organizations",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[1].ID), ValidateCodecAccess(Update, codecs[1].ID), ValidateCodecAccess(Delete, codecs[1].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateCodecAccess(Read, codecs[0].ID), ValidateCodecAccess(Update, codecs[0].ID), ValidateCodecAccess(Delete, codecs[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateFUOTADeploymentsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(Create, applications[0].ID), ValidateFUOTADeploymentsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(Create, applications[0].ID), ValidateFUOTADeploymentsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(Create, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(Create, applications[0].ID), ValidateFUOTADeploymentsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateFUOTADeploymentAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(Read, fuotaDeployments[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(Read, fuotaDeployments[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read deployments of other organizations",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(Read, fuotaDeployments[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(Read, fuotaDeployments[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateScheduledDownlinkAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read and delete",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[1].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read and delete device and multicast-group downlinks",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[2].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read downlinks of other organizations",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read or delete",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateDeviceGroupsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDeviceGroupsAccess(Create, applications[0].ID), ValidateDeviceGroupsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDeviceGroupsAccess(Create, applications[0].ID), ValidateDeviceGroupsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateDeviceGroupsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateDeviceGroupsAccess(Create, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateDeviceGroupsAccess(Create, applications[0].ID), ValidateDeviceGroupsAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateDeviceGroupAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceGroupAccess(Read, deviceGroups[0].ID), ValidateDeviceGroupAccess(Update, deviceGroups[0].ID), ValidateDeviceGroupAccess(Delete, deviceGroups[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceGroupAccess(Read, deviceGroups[0].ID), ValidateDeviceGroupAccess(Update, deviceGroups[0].ID), ValidateDeviceGroupAccess(Delete, deviceGroups[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateDeviceGroupAccess(Read, deviceGroups[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update or delete",
+					Validators: []ValidatorFunc{ValidateDeviceGroupAccess(Update, deviceGroups[0].ID), ValidateDeviceGroupAccess(Delete, deviceGroups[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can not read device-groups of other organizations",
+					Validators: []ValidatorFunc{ValidateDeviceGroupAccess(Read, deviceGroups[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read, update or delete",
+					Validators: []ValidatorFunc{ValidateDeviceGroupAccess(Read, deviceGroups[0].ID), ValidateDeviceGroupAccess(Update, deviceGroups[0].ID), ValidateDeviceGroupAccess(Delete, deviceGroups[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateDeviceGroupJobAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateDeviceGroupJobAccess(Read, deviceGroupJobs[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateDeviceGroupJobAccess(Read, deviceGroupJobs[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read jobs of other organizations",
+					Validators: []ValidatorFunc{ValidateDeviceGroupJobAccess(Read, deviceGroupJobs[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceGroupJobAccess(Read, deviceGroupJobs[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateDeviceImportJobAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateDeviceImportJobAccess(Read, deviceImportJobs[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateDeviceImportJobAccess(Read, deviceImportJobs[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read jobs of other organizations",
+					Validators: []ValidatorFunc{ValidateDeviceImportJobAccess(Read, deviceImportJobs[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceImportJobAccess(Read, deviceImportJobs[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
 	})
 }
 
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// CodecAPI exports the (shared) codec related functions.
+type CodecAPI struct {
+	validator auth.Validator
+}
+
+// NewCodecAPI creates a new CodecAPI.
+func NewCodecAPI(validator auth.Validator) *CodecAPI {
+	return &CodecAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given codec.
+func (a *CodecAPI) Create(ctx context.Context, req *pb.CreateCodecRequest) (*pb.CreateCodecResponse, error) {
+	if req.Codec == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "codec expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecsAccess(auth.Create, req.Codec.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := codec.Validate(codec.Type(req.Codec.PayloadCodec), req.Codec.PayloadEncoderScript, req.Codec.PayloadDecoderScript); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", err)
+	}
+
+	c := storage.Codec{
+		OrganizationID:       req.Codec.OrganizationId,
+		Name:                 req.Codec.Name,
+		Description:          req.Codec.Description,
+		PayloadCodec:         codec.Type(req.Codec.PayloadCodec),
+		PayloadEncoderScript: req.Codec.PayloadEncoderScript,
+		PayloadDecoderScript: req.Codec.PayloadDecoderScript,
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return storage.CreateCodec(tx, &c)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateCodecResponse{
+		Id: c.ID,
+	}, nil
+}
+
+// Get returns the codec matching the given id.
+func (a *CodecAPI) Get(ctx context.Context, req *pb.GetCodecRequest) (*pb.GetCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c, err := storage.GetCodec(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetCodecResponse{
+		Codec: &pb.Codec{
+			Id:                   c.ID,
+			OrganizationId:       c.OrganizationID,
+			Name:                 c.Name,
+			Description:          c.Description,
+			Version:              c.Version,
+			PayloadCodec:         string(c.PayloadCodec),
+			PayloadEncoderScript: c.PayloadEncoderScript,
+			PayloadDecoderScript: c.PayloadDecoderScript,
+		},
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates the given codec.
+func (a *CodecAPI) Update(ctx context.Context, req *pb.UpdateCodecRequest) (*empty.Empty, error) {
+	if req.Codec == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "codec expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Update, req.Codec.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := codec.Validate(codec.Type(req.Codec.PayloadCodec), req.Codec.PayloadEncoderScript, req.Codec.PayloadDecoderScript); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", err)
+	}
+
+	c := storage.Codec{
+		ID:                   req.Codec.Id,
+		Name:                 req.Codec.Name,
+		Description:          req.Codec.Description,
+		PayloadCodec:         codec.Type(req.Codec.PayloadCodec),
+		PayloadEncoderScript: req.Codec.PayloadEncoderScript,
+		PayloadDecoderScript: req.Codec.PayloadDecoderScript,
+	}
+
+	current, err := storage.GetCodec(config.C.PostgreSQL.DB, c.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return storage.UpdateCodec(tx, &c)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	invalidateScriptCache(current.PayloadEncoderScript, c.PayloadEncoderScript)
+	invalidateScriptCache(current.PayloadDecoderScript, c.PayloadDecoderScript)
+
+	return &empty.Empty{}, nil
+}
+
+// Delete deletes the codec matching the given id.
+func (a *CodecAPI) Delete(ctx context.Context, req *pb.DeleteCodecRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Delete, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteCodec(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the codecs of the given organization.
+func (a *CodecAPI) List(ctx context.Context, req *pb.ListCodecRequest) (*pb.ListCodecResponse, error) {
+	if req.OrganizationId == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "organization_id must be given")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecsAccess(auth.List, req.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetCodecCountForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	codecs, err := storage.GetCodecsForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListCodecResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, c := range codecs {
+		resp.Result = append(resp.Result, &pb.CodecListItem{
+			Id:               c.ID,
+			OrganizationId:   c.OrganizationID,
+			Name:             c.Name,
+			Description:      c.Description,
+			Version:          c.Version,
+			PayloadCodec:     string(c.PayloadCodec),
+			ApplicationCount: int64(c.ApplicationCount),
+		})
+	}
+
+	return &resp, nil
+}
+
+// GetVersion returns the given version of the codec.
+func (a *CodecAPI) GetVersion(ctx context.Context, req *pb.GetCodecVersionRequest) (*pb.GetCodecVersionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Read, req.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	v, err := storage.GetCodecVersion(config.C.PostgreSQL.DB, req.CodecId, req.Version)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetCodecVersionResponse{
+		CodecVersion: &pb.CodecVersion{
+			CodecId:              v.CodecID,
+			Version:              v.Version,
+			PayloadCodec:         string(v.PayloadCodec),
+			PayloadEncoderScript: v.PayloadEncoderScript,
+			PayloadDecoderScript: v.PayloadDecoderScript,
+		},
+	}
+
+	resp.CodecVersion.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// ListVersions lists the versions of the given codec.
+func (a *CodecAPI) ListVersions(ctx context.Context, req *pb.ListCodecVersionsRequest) (*pb.ListCodecVersionsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateCodecAccess(auth.Read, req.CodecId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetCodecVersionCount(config.C.PostgreSQL.DB, req.CodecId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	versions, err := storage.GetCodecVersions(config.C.PostgreSQL.DB, req.CodecId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListCodecVersionsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, v := range versions {
+		item := pb.CodecVersionListItem{
+			Version:      v.Version,
+			PayloadCodec: string(v.PayloadCodec),
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// invalidateScriptCache removes the old codec script from the codec script
+// cache in case it has been changed. This must be called after the update
+// has been committed, as a concurrent execution could otherwise cache the
+// old script again.
+func invalidateScriptCache(oldScript, newScript string) {
+	if oldScript != newScript {
+		codec.InvalidateScriptCache(oldScript)
+	}
+}
@@ -0,0 +1,260 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestCodecAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database and api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewCodecAPI(validator)
+		appAPI := NewApplicationAPI(validator)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		org2 := storage.Organization{
+			Name: "test-org-2",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org2), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		Convey("Then Create with an invalid codec configuration returns an error", func() {
+			_, err := api.Create(ctx, &pb.CreateCodecRequest{
+				Codec: &pb.Codec{
+					OrganizationId:       org.ID,
+					Name:                 "test-codec",
+					PayloadCodec:         "BINARY_SCHEMA",
+					PayloadDecoderScript: "{",
+				},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("When creating a codec", func() {
+			createReq := pb.CreateCodecRequest{
+				Codec: &pb.Codec{
+					OrganizationId:       org.ID,
+					Name:                 "test-codec",
+					Description:          "test codec",
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadEncoderScript: "function Encode(fPort, obj) { return []; }",
+					PayloadDecoderScript: "function Decode(fPort, bytes) { return {}; }",
+				},
+			}
+			createResp, err := api.Create(ctx, &createReq)
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			So(createResp.Id, ShouldBeGreaterThan, 0)
+
+			Convey("Then Get returns the codec", func() {
+				resp, err := api.Get(ctx, &pb.GetCodecRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				createReq.Codec.Id = createResp.Id
+				createReq.Codec.Version = 1
+				So(resp.Codec, ShouldResemble, createReq.Codec)
+				So(resp.CreatedAt, ShouldNotBeNil)
+				So(resp.UpdatedAt, ShouldNotBeNil)
+			})
+
+			Convey("Then Update updates the codec and increments the version", func() {
+				updateReq := pb.UpdateCodecRequest{
+					Codec: &pb.Codec{
+						Id:           createResp.Id,
+						Name:         "test-codec-updated",
+						Description:  "updated test codec",
+						PayloadCodec: "CAYENNE_LPP",
+					},
+				}
+				_, err := api.Update(ctx, &updateReq)
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				resp, err := api.Get(ctx, &pb.GetCodecRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+
+				updateReq.Codec.OrganizationId = org.ID
+				updateReq.Codec.Version = 2
+				So(resp.Codec, ShouldResemble, updateReq.Codec)
+
+				Convey("Then ListVersions returns both versions", func() {
+					resp, err := api.ListVersions(ctx, &pb.ListCodecVersionsRequest{
+						CodecId: createResp.Id,
+						Limit:   10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 2)
+					So(resp.Result, ShouldHaveLength, 2)
+					So(resp.Result[0].Version, ShouldEqual, 2)
+					So(resp.Result[0].PayloadCodec, ShouldEqual, "CAYENNE_LPP")
+					So(resp.Result[1].Version, ShouldEqual, 1)
+					So(resp.Result[1].PayloadCodec, ShouldEqual, "CUSTOM_JS")
+				})
+
+				Convey("Then GetVersion returns the previous version", func() {
+					resp, err := api.GetVersion(ctx, &pb.GetCodecVersionRequest{
+						CodecId: createResp.Id,
+						Version: 1,
+					})
+					So(err, ShouldBeNil)
+					So(resp.CodecVersion.PayloadCodec, ShouldEqual, "CUSTOM_JS")
+					So(resp.CodecVersion.PayloadEncoderScript, ShouldEqual, createReq.Codec.PayloadEncoderScript)
+					So(resp.CodecVersion.PayloadDecoderScript, ShouldEqual, createReq.Codec.PayloadDecoderScript)
+				})
+
+				Convey("Then an application can be pinned to the previous version", func() {
+					appResp, err := appAPI.Create(ctx, &pb.CreateApplicationRequest{
+						Application: &pb.Application{
+							OrganizationId:   org.ID,
+							Name:             "test-app",
+							ServiceProfileId: spID.String(),
+							CodecId:          createResp.Id,
+							CodecVersion:     1,
+						},
+					})
+					So(err, ShouldBeNil)
+
+					resp, err := appAPI.Get(ctx, &pb.GetApplicationRequest{
+						Id: appResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Application.CodecId, ShouldEqual, createResp.Id)
+					So(resp.Application.CodecVersion, ShouldEqual, 1)
+				})
+
+				Convey("Then an application can not be pinned to a non-existing version", func() {
+					_, err := appAPI.Create(ctx, &pb.CreateApplicationRequest{
+						Application: &pb.Application{
+							OrganizationId:   org.ID,
+							Name:             "test-app",
+							ServiceProfileId: spID.String(),
+							CodecId:          createResp.Id,
+							CodecVersion:     3,
+						},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("Then List returns the codec", func() {
+				resp, err := api.List(ctx, &pb.ListCodecRequest{
+					OrganizationId: org.ID,
+					Limit:          10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Name, ShouldEqual, "test-codec")
+				So(resp.Result[0].Version, ShouldEqual, 1)
+				So(resp.Result[0].ApplicationCount, ShouldEqual, 0)
+			})
+
+			Convey("Then an application of an other organization can not use the codec", func() {
+				_, err := appAPI.Create(ctx, &pb.CreateApplicationRequest{
+					Application: &pb.Application{
+						OrganizationId:   org2.ID,
+						Name:             "test-app",
+						ServiceProfileId: spID.String(),
+						CodecId:          createResp.Id,
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
+			Convey("When creating an application using the codec", func() {
+				appResp, err := appAPI.Create(ctx, &pb.CreateApplicationRequest{
+					Application: &pb.Application{
+						OrganizationId:   org.ID,
+						Name:             "test-app",
+						ServiceProfileId: spID.String(),
+						CodecId:          createResp.Id,
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the application references the codec", func() {
+					resp, err := appAPI.Get(ctx, &pb.GetApplicationRequest{
+						Id: appResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Application.CodecId, ShouldEqual, createResp.Id)
+
+					listResp, err := api.List(ctx, &pb.ListCodecRequest{
+						OrganizationId: org.ID,
+						Limit:          10,
+					})
+					So(err, ShouldBeNil)
+					So(listResp.Result, ShouldHaveLength, 1)
+					So(listResp.Result[0].ApplicationCount, ShouldEqual, 1)
+				})
+
+				Convey("Then the codec can not be deleted", func() {
+					_, err := api.Delete(ctx, &pb.DeleteCodecRequest{
+						Id: createResp.Id,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+			})
+
+			Convey("Then Delete deletes the codec", func() {
+				_, err := api.Delete(ctx, &pb.DeleteCodecRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				_, err = api.Get(ctx, &pb.GetCodecRequest{
+					Id: createResp.Id,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+		})
+	})
+}
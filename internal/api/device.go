@@ -1,13 +1,19 @@
 package api
 
 import (
+	"bytes"
+	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -16,7 +22,11 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/clocksync"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicefile"
+	"github.com/brocaar/lora-app-server/internal/devicestats"
+	"github.com/brocaar/lora-app-server/internal/downlink"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/common"
@@ -71,6 +81,27 @@ func (a *DeviceAPI) Create(ctx context.Context, req *pb.CreateDeviceRequest) (*e
 		Description:       req.Device.Description,
 		SkipFCntCheck:     req.Device.SkipFCntCheck,
 		ReferenceAltitude: req.Device.ReferenceAltitude,
+		Variables: hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		},
+		SecretVariables: hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		},
+		Tags: hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		},
+	}
+
+	for k, v := range req.Device.Variables {
+		d.Variables.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+
+	for k, v := range req.Device.SecretVariables {
+		d.SecretVariables.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+
+	for k, v := range req.Device.Tags {
+		d.Tags.Map[k] = sql.NullString{String: v, Valid: true}
 	}
 
 	// as this also performs a remote call to create the node on the
@@ -111,12 +142,34 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 			DeviceProfileId:   d.DeviceProfileID.String(),
 			SkipFCntCheck:     d.SkipFCntCheck,
 			ReferenceAltitude: d.ReferenceAltitude,
+			Variables:         make(map[string]string),
+			SecretVariables:   make(map[string]string),
+			Tags:              make(map[string]string),
 		},
 
 		DeviceStatusBattery: 256,
 		DeviceStatusMargin:  256,
 	}
 
+	for k, v := range d.Variables.Map {
+		if v.Valid {
+			resp.Device.Variables[k] = v.String
+		}
+	}
+
+	// the values of the secret variables are never returned
+	for k, v := range d.SecretVariables.Map {
+		if v.Valid {
+			resp.Device.SecretVariables[k] = ""
+		}
+	}
+
+	for k, v := range d.Tags.Map {
+		if v.Valid {
+			resp.Device.Tags[k] = v.String
+		}
+	}
+
 	if d.DeviceStatusBattery != nil {
 		resp.DeviceStatusBattery = uint32(*d.DeviceStatusBattery)
 	}
@@ -137,6 +190,22 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 			Altitude:  *d.Altitude,
 			Source:    common.LocationSource_GEO_RESOLVER,
 		}
+
+		// locations stored before the location history was introduced
+		// were always resolved by the geolocation-server
+		l, err := storage.GetLastDeviceLocation(config.C.PostgreSQL.DB, d.DevEUI)
+		if err != nil && err != storage.ErrDoesNotExist {
+			return nil, errToRPCError(err)
+		}
+		if err == nil {
+			resp.Location.Source = common.LocationSource(common.LocationSource_value[l.Source])
+			resp.Location.Accuracy = uint32(l.Accuracy)
+		}
+	}
+
+	resp.LastValues, err = deviceValuesToProto(d.LastValues)
+	if err != nil {
+		return nil, errToRPCError(err)
 	}
 
 	return &resp, nil
@@ -168,6 +237,13 @@ func (a *DeviceAPI) List(ctx context.Context, req *pb.ListDeviceRequest) (*pb.Li
 		}
 	}
 
+	if req.DeviceGroupId != "" {
+		filters.DeviceGroupID, err = uuid.FromString(req.DeviceGroupId)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
 	if filters.ApplicationID != 0 {
 		idFilter = true
 
@@ -191,6 +267,17 @@ func (a *DeviceAPI) List(ctx context.Context, req *pb.ListDeviceRequest) (*pb.Li
 		}
 	}
 
+	if filters.DeviceGroupID != uuid.Nil {
+		idFilter = true
+
+		// validate that the client has access to the given device-group
+		if err := a.validator.Validate(ctx,
+			auth.ValidateDeviceGroupAccess(auth.Read, filters.DeviceGroupID),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+	}
+
 	if filters.ServiceProfileID != uuid.Nil {
 		idFilter = true
 
@@ -258,6 +345,35 @@ func (a *DeviceAPI) Update(ctx context.Context, req *pb.UpdateDeviceRequest) (*e
 		d.Description = req.Device.Description
 		d.SkipFCntCheck = req.Device.SkipFCntCheck
 		d.ReferenceAltitude = req.Device.ReferenceAltitude
+		secretVariables := d.SecretVariables
+
+		d.Variables = hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		}
+		d.SecretVariables = hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		}
+		d.Tags = hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		}
+
+		for k, v := range req.Device.Variables {
+			d.Variables.Map[k] = sql.NullString{String: v, Valid: true}
+		}
+
+		// as Get does not return the values of the secret variables, an
+		// empty value keeps the current value
+		for k, v := range req.Device.SecretVariables {
+			if current, ok := secretVariables.Map[k]; ok && v == "" {
+				d.SecretVariables.Map[k] = current
+				continue
+			}
+			d.SecretVariables.Map[k] = sql.NullString{String: v, Valid: true}
+		}
+
+		for k, v := range req.Device.Tags {
+			d.Tags.Map[k] = sql.NullString{String: v, Valid: true}
+		}
 
 		if err := storage.UpdateDevice(tx, &d, false); err != nil {
 			return errToRPCError(err)
@@ -310,6 +426,14 @@ func (a *DeviceAPI) CreateKeys(ctx context.Context, req *pb.CreateDeviceKeysRequ
 		}
 	}
 
+	// genAppKey is only used for LoRaWAN 1.0 (remote multicast setup)
+	var genAppKey lorawan.AES128Key
+	if req.DeviceKeys.GenAppKey != "" {
+		if err := genAppKey.UnmarshalText([]byte(req.DeviceKeys.GenAppKey)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	var nwkKey lorawan.AES128Key
 	if err := nwkKey.UnmarshalText([]byte(req.DeviceKeys.NwkKey)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
@@ -327,9 +451,10 @@ func (a *DeviceAPI) CreateKeys(ctx context.Context, req *pb.CreateDeviceKeysRequ
 	}
 
 	err := storage.CreateDeviceKeys(config.C.PostgreSQL.DB, &storage.DeviceKeys{
-		DevEUI: eui,
-		NwkKey: nwkKey,
-		AppKey: appKey,
+		DevEUI:    eui,
+		NwkKey:    nwkKey,
+		AppKey:    appKey,
+		GenAppKey: genAppKey,
 	})
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -358,9 +483,10 @@ func (a *DeviceAPI) GetKeys(ctx context.Context, req *pb.GetDeviceKeysRequest) (
 
 	return &pb.GetDeviceKeysResponse{
 		DeviceKeys: &pb.DeviceKeys{
-			DevEui: eui.String(),
-			AppKey: dk.AppKey.String(),
-			NwkKey: dk.NwkKey.String(),
+			DevEui:    eui.String(),
+			AppKey:    dk.AppKey.String(),
+			NwkKey:    dk.NwkKey.String(),
+			GenAppKey: dk.GenAppKey.String(),
 		},
 	}, nil
 }
@@ -379,6 +505,14 @@ func (a *DeviceAPI) UpdateKeys(ctx context.Context, req *pb.UpdateDeviceKeysRequ
 		}
 	}
 
+	// genAppKey is only used for LoRaWAN 1.0 (remote multicast setup)
+	var genAppKey lorawan.AES128Key
+	if req.DeviceKeys.GenAppKey != "" {
+		if err := genAppKey.UnmarshalText([]byte(req.DeviceKeys.GenAppKey)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	var nwkKey lorawan.AES128Key
 	if err := nwkKey.UnmarshalText([]byte(req.DeviceKeys.NwkKey)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
@@ -401,6 +535,7 @@ func (a *DeviceAPI) UpdateKeys(ctx context.Context, req *pb.UpdateDeviceKeysRequ
 	}
 	dk.NwkKey = nwkKey
 	dk.AppKey = appKey
+	dk.GenAppKey = genAppKey
 
 	err = storage.UpdateDeviceKeys(config.C.PostgreSQL.DB, &dk)
 	if err != nil {
@@ -735,6 +870,249 @@ func (a *DeviceAPI) StreamEventLogs(req *pb.StreamDeviceEventLogsRequest, srv pb
 	return nil
 }
 
+// ListEvents returns the persisted events of the given DevEUI.
+func (a *DeviceAPI) ListEvents(ctx context.Context, req *pb.ListDeviceEventsRequest) (*pb.ListDeviceEventsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.DeviceEventFilters{
+		DevEUI: devEUI,
+		Type:   req.Type,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+
+	if req.Start != nil {
+		start, err := ptypes.Timestamp(req.Start)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start: %s", err)
+		}
+		filters.Start = &start
+	}
+
+	if req.End != nil {
+		end, err := ptypes.Timestamp(req.End)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end: %s", err)
+		}
+		filters.End = &end
+	}
+
+	count, err := storage.GetDeviceEventCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	events, err := storage.GetDeviceEvents(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDeviceEventsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, e := range events {
+		item := pb.DeviceEvent{
+			Id:          e.ID,
+			Type:        e.Type,
+			PayloadJson: string(e.Payload),
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(e.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// GetStats returns the link and traffic statistics of the given DevEUI.
+func (a *DeviceAPI) GetStats(ctx context.Context, req *pb.GetDeviceStatsRequest) (*pb.GetDeviceStatsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var interval storage.DeviceStatsInterval
+	for _, i := range storage.DeviceStatsIntervals {
+		if string(i) == strings.ToUpper(req.Interval) {
+			interval = i
+		}
+	}
+	if interval == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad interval: %s", req.Interval)
+	}
+
+	start, err := ptypes.Timestamp(req.StartTimestamp)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+	}
+
+	end, err := ptypes.Timestamp(req.EndTimestamp)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+	}
+
+	stats, err := storage.GetDeviceStats(config.C.PostgreSQL.DB, devEUI, interval, start, end)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	result := make([]*pb.DeviceStats, len(stats))
+	for i, stat := range stats {
+		result[i] = &pb.DeviceStats{
+			RxPackets:      uint32(stat.RXPackets),
+			LostPackets:    uint32(stat.LostPackets),
+			RssiMax:        int32(stat.RSSIMax),
+			SnrMax:         stat.SNRMax,
+			RxPacketsPerSf: make(map[uint32]uint32),
+			GatewayCount:   uint32(len(stat.RXPacketsPerGateway.Map)),
+		}
+
+		if stat.RXPackets != 0 {
+			result[i].RssiAvg = float64(stat.RSSISum) / float64(stat.RXPackets)
+			result[i].SnrAvg = stat.SNRSum / float64(stat.RXPackets)
+		}
+
+		for sf, count := range devicestats.HstoreCounts(stat.RXPacketsPerSF) {
+			sfInt, err := strconv.Atoi(sf)
+			if err != nil {
+				continue
+			}
+			result[i].RxPacketsPerSf[uint32(sfInt)] = uint32(count)
+		}
+
+		result[i].Timestamp, err = ptypes.TimestampProto(stat.Timestamp)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &pb.GetDeviceStatsResponse{
+		Result: result,
+	}, nil
+}
+
+// ListLocations returns the location history of the given DevEUI.
+func (a *DeviceAPI) ListLocations(ctx context.Context, req *pb.ListDeviceLocationsRequest) (*pb.ListDeviceLocationsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.DeviceLocationFilters{
+		DevEUI: devEUI,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+
+	if req.StartTimestamp != nil {
+		start, err := ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+		}
+		filters.Start = &start
+	}
+
+	if req.EndTimestamp != nil {
+		end, err := ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+		}
+		filters.End = &end
+	}
+
+	count, err := storage.GetDeviceLocationCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	locations, err := storage.GetDeviceLocations(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDeviceLocationsResponse{
+		TotalCount: int64(count),
+	}
+	for _, l := range locations {
+		item := pb.DeviceLocation{
+			Location: &common.Location{
+				Latitude:  l.Latitude,
+				Longitude: l.Longitude,
+				Altitude:  l.Altitude,
+				Source:    common.LocationSource(common.LocationSource_value[l.Source]),
+				Accuracy:  uint32(l.Accuracy),
+			},
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(l.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	if req.GeoJson {
+		b, err := deviceLocationsToGeoJSON(devEUI, locations)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		resp.GeoJson = string(b)
+	}
+
+	return &resp, nil
+}
+
+// SetLocation sets the (manual) location of the given DevEUI.
+func (a *DeviceAPI) SetLocation(ctx context.Context, req *pb.SetDeviceLocationRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.SetDeviceLocation(config.C.PostgreSQL.DB, &storage.DeviceLocation{
+		DevEUI:    devEUI,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Altitude:  req.Altitude,
+		Source:    common.LocationSource_CONFIG.String(),
+		Accuracy:  int(req.Accuracy),
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
 func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAddrRequest) (*pb.GetRandomDevAddrResponse, error) {
 	var devEUI lorawan.EUI64
@@ -766,6 +1144,389 @@ func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAd
 	}, nil
 }
 
+// GetClockSync returns the clock synchronization state of the device.
+func (a *DeviceAPI) GetClockSync(ctx context.Context, req *pb.GetDeviceClockSyncRequest) (*pb.GetDeviceClockSyncResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	dcs, err := storage.GetDeviceClockSync(config.C.PostgreSQL.DB, devEUI, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceClockSyncResponse{
+		DeviceClockSync: &pb.DeviceClockSync{
+			DevEui:         devEUI.String(),
+			TimeCorrection: int32(dcs.TimeCorrection),
+			ClockDrift:     dcs.ClockDrift,
+			Periodicity:    -1,
+		},
+	}
+
+	if dcs.LastSyncAt != nil {
+		resp.DeviceClockSync.LastSyncAt, err = ptypes.TimestampProto(*dcs.LastSyncAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	if dcs.Periodicity != nil {
+		resp.DeviceClockSync.Periodicity = int32(*dcs.Periodicity)
+	}
+
+	return &resp, nil
+}
+
+// SetClockSyncPeriodicity requests the device to synchronize its clock
+// every 128 * 2^period seconds.
+func (a *DeviceAPI) SetClockSyncPeriodicity(ctx context.Context, req *pb.SetDeviceClockSyncPeriodicityRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if req.Period > 15 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "period must be between 0 and 15")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := clocksync.SetPeriodicity(tx, devEUI, int(req.Period)); err != nil {
+			return errToRPCError(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ForceClockResync requests the device to re-synchronize its clock.
+func (a *DeviceAPI) ForceClockResync(ctx context.Context, req *pb.ForceDeviceClockResyncRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if req.NbTransmissions < 1 || req.NbTransmissions > 7 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "nb_transmissions must be between 1 and 7")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := clocksync.ForceResync(config.C.PostgreSQL.DB, devEUI, int(req.NbTransmissions)); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// deviceImportJobStatus maps the device import job status to its API
+// representation.
+var deviceImportJobStatus = map[storage.DeviceImportJobStatus]pb.DeviceImportJobStatus{
+	storage.DeviceImportJobPending:   pb.DeviceImportJobStatus_IMPORT_PENDING,
+	storage.DeviceImportJobRunning:   pb.DeviceImportJobStatus_IMPORT_RUNNING,
+	storage.DeviceImportJobCompleted: pb.DeviceImportJobStatus_IMPORT_COMPLETED,
+}
+
+// Import creates the devices from the given file.
+func (a *DeviceAPI) Import(ctx context.Context, req *pb.ImportDevicesRequest) (*pb.ImportDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodesAccess(req.ApplicationId, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, req.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	records, rowErrors, err := devicefile.Read(devicefile.Format(req.Format), req.Data)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "read file error: %s", err)
+	}
+	if len(records) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "file does not contain any devices")
+	}
+
+	rowErrors, err = devicefile.Validate(config.C.PostgreSQL.DB, app, records, rowErrors)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ImportDevicesResponse
+	for _, rowErr := range rowErrors {
+		resp.Errors = append(resp.Errors, &pb.ImportDevicesError{
+			Row:    uint32(rowErr.Row),
+			DevEui: rowErr.DevEUI.String(),
+			Error:  rowErr.Err.Error(),
+		})
+	}
+
+	if len(rowErrors) != 0 || req.DryRun {
+		return &resp, nil
+	}
+
+	j, err := devicefile.CreateImportJob(config.C.PostgreSQL.DB, app, records)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp.JobId = j.ID.String()
+
+	return &resp, nil
+}
+
+// GetImportJob returns the progress and errors of the given device import
+// job.
+func (a *DeviceAPI) GetImportJob(ctx context.Context, req *pb.GetDeviceImportJobRequest) (*pb.GetDeviceImportJobResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceImportJobAccess(auth.Read, id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	j, err := storage.GetDeviceImportJob(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	rowErrors, err := storage.GetDeviceImportJobErrors(config.C.PostgreSQL.DB, id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceImportJobResponse{
+		Job: &pb.DeviceImportJob{
+			Id:             j.ID.String(),
+			ApplicationId:  j.ApplicationID,
+			Status:         deviceImportJobStatus[j.Status],
+			TotalCount:     uint32(j.TotalCount),
+			ProcessedCount: uint32(j.ProcessedCount),
+			ImportedCount:  uint32(j.ImportedCount),
+		},
+	}
+
+	resp.Job.CreatedAt, err = ptypes.TimestampProto(j.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.Job.UpdatedAt, err = ptypes.TimestampProto(j.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	if j.CompletedAt != nil {
+		resp.Job.CompletedAt, err = ptypes.TimestampProto(*j.CompletedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	for _, rowErr := range rowErrors {
+		resp.Errors = append(resp.Errors, &pb.ImportDevicesError{
+			Row:    uint32(rowErr.Row),
+			DevEui: rowErr.DevEUI.String(),
+			Error:  rowErr.Error,
+		})
+	}
+
+	return &resp, nil
+}
+
+// Export returns the devices of the given application in the requested
+// file format.
+func (a *DeviceAPI) Export(ctx context.Context, req *pb.ExportDevicesRequest) (*pb.ExportDevicesResponse, error) {
+	// the export contains the device keys, therefore it requires the same
+	// permissions as creating devices
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodesAccess(req.ApplicationId, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	records, err := devicefile.Export(config.C.PostgreSQL.DB, req.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var b bytes.Buffer
+	if err := devicefile.Write(devicefile.Format(req.Format), &b, records); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.ExportDevicesResponse{
+		Data: b.Bytes(),
+	}, nil
+}
+
+// GetTwin returns the device twin for the given DevEUI.
+func (a *DeviceAPI) GetTwin(ctx context.Context, req *pb.GetDeviceTwinRequest) (*pb.GetDeviceTwinResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	t, err := storage.GetDeviceTwin(config.C.PostgreSQL.DB, devEUI, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	desired, err := json.Marshal(t.Desired)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	reported, err := json.Marshal(t.Reported)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	delta, err := json.Marshal(downlink.TwinDelta(t.Desired, t.Reported))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceTwinResponse{
+		Twin: &pb.DeviceTwin{
+			DevEui:       t.DevEUI.String(),
+			FPort:        uint32(t.FPort),
+			DesiredJson:  string(desired),
+			ReportedJson: string(reported),
+		},
+		DeltaJson: string(delta),
+	}
+
+	if t.ReportedAt != nil {
+		resp.ReportedAt, err = ptypes.TimestampProto(*t.ReportedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	if t.SyncAt != nil {
+		resp.SyncAt, err = ptypes.TimestampProto(*t.SyncAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// UpdateTwin updates the desired state of the device twin.
+func (a *DeviceAPI) UpdateTwin(ctx context.Context, req *pb.UpdateDeviceTwinRequest) (*empty.Empty, error) {
+	if req.Twin == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "twin must not be nil")
+	}
+
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.Twin.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+	}
+
+	// updating the desired state can result in a downlink, therefore it
+	// requires the same permissions as enqueueing a downlink
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceQueueAccess(devEUI, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var desired storage.DeviceTwinState
+	if req.Twin.DesiredJson != "" {
+		if err := json.Unmarshal([]byte(req.Twin.DesiredJson), &desired); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "desired_json: %s", err)
+		}
+	}
+
+	if req.Twin.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "f_port: %s", storage.ErrDeviceTwinInvalidFPort)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		d, err := storage.GetDevice(tx, devEUI, true, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		app, err := storage.GetApplication(tx, d.ApplicationID)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if _, err := downlink.UpdateTwinDesired(tx, d, app, uint8(req.Twin.FPort), desired); err != nil {
+			return errToRPCError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Move moves the device to the given application.
+func (a *DeviceAPI) Move(ctx context.Context, req *pb.MoveDeviceRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+	}
+
+	var dpID uuid.UUID
+	if req.DeviceProfileId != "" {
+		var err error
+		dpID, err = uuid.FromString(req.DeviceProfileId)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "device_profile_id: %s", err)
+		}
+	}
+
+	// moving a device requires the permission to update the device and
+	// to create devices within the target application
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodesAccess(req.ApplicationId, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// as this also performs a remote call to update the device on the
+	// network-server, wrap it in a transaction
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return storage.MoveDevice(tx, devEUI, req.ApplicationId, dpID)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb.ListDeviceResponse, error) {
 	resp := pb.ListDeviceResponse{
 		TotalCount: int64(count),
@@ -781,6 +1542,13 @@ func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb
 			DeviceStatusBattery:             256,
 			DeviceStatusMargin:              256,
 			DeviceStatusExternalPowerSource: device.DeviceStatusExternalPower,
+			Tags:                            make(map[string]string),
+		}
+
+		for k, v := range device.Tags.Map {
+			if v.Valid {
+				item.Tags[k] = v.String
+			}
 		}
 
 		if !device.DeviceStatusExternalPower && device.DeviceStatusBattery == nil {
@@ -811,11 +1579,79 @@ func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb
 			}
 		}
 
+		var err error
+		item.LastValues, err = deviceValuesToProto(device.LastValues)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
 		resp.Result = append(resp.Result, &item)
 	}
 	return &resp, nil
 }
 
+func deviceValuesToProto(values storage.DeviceValues) (map[string]*pb.DeviceValue, error) {
+	out := make(map[string]*pb.DeviceValue)
+	for k, v := range values {
+		b, err := json.Marshal(v.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal json error")
+		}
+
+		receivedAt, err := ptypes.TimestampProto(v.ReceivedAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "timestamp proto error")
+		}
+
+		out[k] = &pb.DeviceValue{
+			ValueJson:  string(b),
+			ReceivedAt: receivedAt,
+		}
+	}
+	return out, nil
+}
+
+// deviceLocationsToGeoJSON returns the given locations as GeoJSON
+// LineString feature. The timestamps of the positions are stored in the
+// feature properties.
+func deviceLocationsToGeoJSON(devEUI lorawan.EUI64, locations []storage.DeviceLocation) ([]byte, error) {
+	type geometry struct {
+		Type        string       `json:"type"`
+		Coordinates [][3]float64 `json:"coordinates"`
+	}
+
+	type feature struct {
+		Type       string                 `json:"type"`
+		Geometry   geometry               `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	}
+
+	coordinates := make([][3]float64, 0, len(locations))
+	timestamps := make([]time.Time, 0, len(locations))
+	for _, l := range locations {
+		// GeoJSON positions are ordered as longitude, latitude, altitude
+		coordinates = append(coordinates, [3]float64{l.Longitude, l.Latitude, l.Altitude})
+		timestamps = append(timestamps, l.CreatedAt)
+	}
+
+	b, err := json.Marshal(feature{
+		Type: "Feature",
+		Geometry: geometry{
+			Type:        "LineString",
+			Coordinates: coordinates,
+		},
+		Properties: map[string]interface{}{
+			"devEUI":     devEUI,
+			"timestamps": timestamps,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	return b, nil
+}
+
 func convertUplinkAndDownlinkFrames(up *gw.UplinkFrameSet, down *gw.DownlinkFrame, decodeMACCommands bool) (*pb.UplinkFrameLog, *pb.DownlinkFrameLog, error) {
 	var phy lorawan.PHYPayload
 
@@ -0,0 +1,594 @@
+package api
+
+import (
+	"database/sql"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// deviceGroupJobStatus maps the device-group job status to its API
+// representation.
+var deviceGroupJobStatus = map[storage.DeviceGroupJobStatus]pb.DeviceGroupJobStatus{
+	storage.DeviceGroupJobPending:   pb.DeviceGroupJobStatus_JOB_PENDING,
+	storage.DeviceGroupJobRunning:   pb.DeviceGroupJobStatus_JOB_RUNNING,
+	storage.DeviceGroupJobCompleted: pb.DeviceGroupJobStatus_JOB_COMPLETED,
+}
+
+// DeviceGroupAPI exports the device-group related functions.
+type DeviceGroupAPI struct {
+	validator auth.Validator
+}
+
+// NewDeviceGroupAPI creates a new DeviceGroupAPI.
+func NewDeviceGroupAPI(validator auth.Validator) *DeviceGroupAPI {
+	return &DeviceGroupAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given device-group.
+func (a *DeviceGroupAPI) Create(ctx context.Context, req *pb.CreateDeviceGroupRequest) (*pb.CreateDeviceGroupResponse, error) {
+	if req.DeviceGroup == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_group expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupsAccess(auth.Create, req.DeviceGroup.ApplicationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g := storage.DeviceGroup{
+		ApplicationID: req.DeviceGroup.ApplicationId,
+	}
+	if err := deviceGroupFromPB(req.DeviceGroup, &g); err != nil {
+		return nil, err
+	}
+
+	if g.FilterDeviceProfileID != nil {
+		if err := validateDeviceProfileForApplication(g.ApplicationID, *g.FilterDeviceProfileID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := storage.CreateDeviceGroup(config.C.PostgreSQL.DB, &g); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateDeviceGroupResponse{
+		Id: g.ID.String(),
+	}, nil
+}
+
+// Get returns the device-group matching the given id.
+func (a *DeviceGroupAPI) Get(ctx context.Context, req *pb.GetDeviceGroupRequest) (*pb.GetDeviceGroupResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Read, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := storage.GetDeviceGroup(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetDeviceCount(config.C.PostgreSQL.DB, storage.DeviceFilters{
+		DeviceGroupID: id,
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceGroupResponse{
+		DeviceGroup: &pb.DeviceGroup{
+			Id:            g.ID.String(),
+			ApplicationId: g.ApplicationID,
+			Name:          g.Name,
+			Description:   g.Description,
+			Dynamic:       g.Dynamic,
+			FilterTags:    make(map[string]string),
+		},
+		DeviceCount: uint32(count),
+	}
+
+	for k, v := range g.FilterTags.Map {
+		if v.Valid {
+			resp.DeviceGroup.FilterTags[k] = v.String
+		}
+	}
+	if g.FilterDeviceProfileID != nil {
+		resp.DeviceGroup.FilterDeviceProfileId = g.FilterDeviceProfileID.String()
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(g.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(g.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// Update updates the given device-group.
+func (a *DeviceGroupAPI) Update(ctx context.Context, req *pb.UpdateDeviceGroupRequest) (*empty.Empty, error) {
+	if req.DeviceGroup == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_group expected")
+	}
+
+	id, err := uuid.FromString(req.DeviceGroup.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Update, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		g, err := storage.GetDeviceGroup(tx, id, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if err := deviceGroupFromPB(req.DeviceGroup, &g); err != nil {
+			return err
+		}
+
+		if g.FilterDeviceProfileID != nil {
+			if err := validateDeviceProfileForApplication(g.ApplicationID, *g.FilterDeviceProfileID); err != nil {
+				return err
+			}
+		}
+
+		if err := storage.UpdateDeviceGroup(tx, &g); err != nil {
+			return errToRPCError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Delete deletes the device-group matching the given id.
+func (a *DeviceGroupAPI) Delete(ctx context.Context, req *pb.DeleteDeviceGroupRequest) (*empty.Empty, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Delete, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDeviceGroup(config.C.PostgreSQL.DB, id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the device-groups of the given application.
+func (a *DeviceGroupAPI) List(ctx context.Context, req *pb.ListDeviceGroupRequest) (*pb.ListDeviceGroupResponse, error) {
+	if req.ApplicationId == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "application_id must be given")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupsAccess(auth.List, req.ApplicationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.DeviceGroupFilters{
+		ApplicationID: req.ApplicationId,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+
+	if req.DevEui != "" {
+		if err := filters.DevEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+		}
+	}
+
+	count, err := storage.GetDeviceGroupCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	groups, err := storage.GetDeviceGroups(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDeviceGroupResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, g := range groups {
+		resp.Result = append(resp.Result, &pb.DeviceGroupListItem{
+			Id:          g.ID.String(),
+			Name:        g.Name,
+			Description: g.Description,
+			Dynamic:     g.Dynamic,
+		})
+	}
+
+	return &resp, nil
+}
+
+// AddDevice adds the given device to the (static) device-group.
+func (a *DeviceGroupAPI) AddDevice(ctx context.Context, req *pb.AddDeviceToDeviceGroupRequest) (*empty.Empty, error) {
+	id, err := uuid.FromString(req.DeviceGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_group_id: %s", err)
+	}
+
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Update, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := storage.GetDeviceGroup(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if g.Dynamic {
+		return nil, errToRPCError(storage.ErrDeviceGroupNotStatic)
+	}
+
+	// validate that the device is under the same application as the
+	// device-group
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if d.ApplicationID != g.ApplicationID {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "application of device != application of device-group")
+	}
+
+	if err := storage.AddDeviceToDeviceGroup(config.C.PostgreSQL.DB, id, devEUI); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// RemoveDevice removes the given device from the (static) device-group.
+func (a *DeviceGroupAPI) RemoveDevice(ctx context.Context, req *pb.RemoveDeviceFromDeviceGroupRequest) (*empty.Empty, error) {
+	id, err := uuid.FromString(req.DeviceGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_group_id: %s", err)
+	}
+
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Update, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := storage.GetDeviceGroup(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if g.Dynamic {
+		return nil, errToRPCError(storage.ErrDeviceGroupNotStatic)
+	}
+
+	if err := storage.RemoveDeviceFromDeviceGroup(config.C.PostgreSQL.DB, id, devEUI); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// CreateJob creates a job executing the given action for each device of
+// the device-group.
+func (a *DeviceGroupAPI) CreateJob(ctx context.Context, req *pb.CreateDeviceGroupJobRequest) (*pb.CreateDeviceGroupJobResponse, error) {
+	if req.Job == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "job expected")
+	}
+
+	id, err := uuid.FromString(req.Job.DeviceGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_group_id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Update, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.Job.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "f_port: %s", storage.ErrDeviceGroupJobInvalidFPort)
+	}
+
+	g, err := storage.GetDeviceGroup(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	j := storage.DeviceGroupJob{
+		DeviceGroupID: id,
+		Action:        storage.DeviceGroupJobAction(req.Job.Action.String()),
+	}
+
+	switch req.Job.Action {
+	case pb.DeviceGroupJobAction_ENQUEUE_DOWNLINK:
+		j.FPort = uint8(req.Job.FPort)
+		j.Confirmed = req.Job.Confirmed
+		j.Data = req.Job.Data
+	case pb.DeviceGroupJobAction_CHANGE_DEVICE_PROFILE:
+		if req.Job.DeviceProfileId != "" {
+			dpID, err := uuid.FromString(req.Job.DeviceProfileId)
+			if err != nil {
+				return nil, grpc.Errorf(codes.InvalidArgument, "device_profile_id: %s", err)
+			}
+
+			if err := validateDeviceProfileForApplication(g.ApplicationID, dpID); err != nil {
+				return nil, err
+			}
+
+			j.DeviceProfileID = &dpID
+		}
+	case pb.DeviceGroupJobAction_ADD_TO_MULTICAST_GROUP:
+		if req.Job.MulticastGroupId != "" {
+			mgID, err := uuid.FromString(req.Job.MulticastGroupId)
+			if err != nil {
+				return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+			}
+
+			// validate that the multicast-group is under the same
+			// service-profile as the application
+			app, err := storage.GetApplication(config.C.PostgreSQL.DB, g.ApplicationID)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			mg, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, mgID, false, true)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			if app.ServiceProfileID != mg.ServiceProfileID {
+				return nil, grpc.Errorf(codes.FailedPrecondition, "service-profile of application != service-profile of multicast-group")
+			}
+
+			j.MulticastGroupID = &mgID
+		}
+	}
+
+	if err := storage.CreateDeviceGroupJob(config.C.PostgreSQL.DB, &j); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateDeviceGroupJobResponse{
+		Id: j.ID.String(),
+	}, nil
+}
+
+// GetJob returns the device-group job matching the given id.
+func (a *DeviceGroupAPI) GetJob(ctx context.Context, req *pb.GetDeviceGroupJobRequest) (*pb.GetDeviceGroupJobResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupJobAccess(auth.Read, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	j, err := storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceGroupJobResponse{
+		Job: &pb.DeviceGroupJob{
+			Id:            j.ID.String(),
+			DeviceGroupId: j.DeviceGroupID.String(),
+			Action:        pb.DeviceGroupJobAction(pb.DeviceGroupJobAction_value[string(j.Action)]),
+			FPort:         uint32(j.FPort),
+			Confirmed:     j.Confirmed,
+			Data:          j.Data,
+		},
+	}
+
+	if j.DeviceProfileID != nil {
+		resp.Job.DeviceProfileId = j.DeviceProfileID.String()
+	}
+	if j.MulticastGroupID != nil {
+		resp.Job.MulticastGroupId = j.MulticastGroupID.String()
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(j.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(j.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.Progress, err = deviceGroupJobProgress(j)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// ListJobs lists the jobs of the given device-group.
+func (a *DeviceGroupAPI) ListJobs(ctx context.Context, req *pb.ListDeviceGroupJobRequest) (*pb.ListDeviceGroupJobResponse, error) {
+	id, err := uuid.FromString(req.DeviceGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_group_id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceGroupAccess(auth.Read, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.DeviceGroupJobFilters{
+		DeviceGroupID: id,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+
+	count, err := storage.GetDeviceGroupJobCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	jobs, err := storage.GetDeviceGroupJobs(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDeviceGroupJobResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, j := range jobs {
+		item := pb.DeviceGroupJobListItem{
+			Id:     j.ID.String(),
+			Action: pb.DeviceGroupJobAction(pb.DeviceGroupJobAction_value[string(j.Action)]),
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(j.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		item.Progress, err = deviceGroupJobProgress(j)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// deviceGroupFromPB sets the (updatable) fields of the given device-group
+// from the given API object.
+func deviceGroupFromPB(in *pb.DeviceGroup, g *storage.DeviceGroup) error {
+	g.Name = in.Name
+	g.Description = in.Description
+	g.Dynamic = in.Dynamic
+	g.FilterTags = hstore.Hstore{
+		Map: make(map[string]sql.NullString),
+	}
+	g.FilterDeviceProfileID = nil
+
+	if !g.Dynamic {
+		return nil
+	}
+
+	for k, v := range in.FilterTags {
+		g.FilterTags.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+
+	if in.FilterDeviceProfileId != "" {
+		dpID, err := uuid.FromString(in.FilterDeviceProfileId)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "filter_device_profile_id: %s", err)
+		}
+		g.FilterDeviceProfileID = &dpID
+	}
+
+	return nil
+}
+
+// validateDeviceProfileForApplication validates that the given
+// device-profile can be used by the devices of the given application.
+func validateDeviceProfileForApplication(applicationID int64, dpID uuid.UUID) error {
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, applicationID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	sp, err := storage.GetServiceProfile(config.C.PostgreSQL.DB, app.ServiceProfileID, true)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	dp, err := storage.GetLocalDeviceProfile(config.C.PostgreSQL.DB, dpID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	if dp.OrganizationID != sp.OrganizationID || dp.NetworkServerID != sp.NetworkServerID {
+		return grpc.Errorf(codes.FailedPrecondition, "organization and network-server of device-profile != organization and network-server of application")
+	}
+
+	return nil
+}
+
+func deviceGroupJobProgress(j storage.DeviceGroupJob) (*pb.DeviceGroupJobProgress, error) {
+	p := pb.DeviceGroupJobProgress{
+		Status:         deviceGroupJobStatus[j.Status],
+		TotalCount:     uint32(j.TotalCount),
+		ProcessedCount: uint32(j.ProcessedCount),
+		ErrorCount:     uint32(j.ErrorCount),
+		LastError:      j.LastError,
+	}
+
+	if j.CompletedAt != nil {
+		var err error
+		p.CompletedAt, err = ptypes.TimestampProto(*j.CompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &p, nil
+}
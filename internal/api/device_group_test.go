@@ -0,0 +1,304 @@
+package api
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDeviceGroupAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database and api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewDeviceGroupAPI(validator)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		devices := []storage.Device{
+			{
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            "test-device-1",
+				Tags: hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"floor": {String: "1", Valid: true},
+					},
+				},
+			},
+			{
+				DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            "test-device-2",
+				Tags: hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"floor": {String: "2", Valid: true},
+					},
+				},
+			},
+		}
+		for i := range devices {
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &devices[i]), ShouldBeNil)
+		}
+
+		Convey("When creating a static device-group", func() {
+			createResp, err := api.Create(ctx, &pb.CreateDeviceGroupRequest{
+				DeviceGroup: &pb.DeviceGroup{
+					ApplicationId: app.ID,
+					Name:          "static",
+					Description:   "static group",
+				},
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then AddDevice adds the device to the group", func() {
+				_, err := api.AddDevice(ctx, &pb.AddDeviceToDeviceGroupRequest{
+					DeviceGroupId: createResp.Id,
+					DevEui:        devices[0].DevEUI.String(),
+				})
+				So(err, ShouldBeNil)
+
+				resp, err := api.Get(ctx, &pb.GetDeviceGroupRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(resp.DeviceGroup.Name, ShouldEqual, "static")
+				So(resp.DeviceGroup.Dynamic, ShouldBeFalse)
+				So(resp.DeviceCount, ShouldEqual, 1)
+
+				Convey("Then List filtered on DevEUI returns the group", func() {
+					resp, err := api.List(ctx, &pb.ListDeviceGroupRequest{
+						ApplicationId: app.ID,
+						DevEui:        devices[0].DevEUI.String(),
+						Limit:         10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].Id, ShouldEqual, createResp.Id)
+				})
+
+				Convey("Then RemoveDevice removes the device from the group", func() {
+					_, err := api.RemoveDevice(ctx, &pb.RemoveDeviceFromDeviceGroupRequest{
+						DeviceGroupId: createResp.Id,
+						DevEui:        devices[0].DevEUI.String(),
+					})
+					So(err, ShouldBeNil)
+
+					resp, err := api.Get(ctx, &pb.GetDeviceGroupRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.DeviceCount, ShouldEqual, 0)
+				})
+			})
+
+			Convey("Then AddDevice for a device of an other application returns an error", func() {
+				app2 := storage.Application{
+					OrganizationID:   org.ID,
+					Name:             "test-app-2",
+					ServiceProfileID: spID,
+				}
+				So(storage.CreateApplication(config.C.PostgreSQL.DB, &app2), ShouldBeNil)
+
+				d := storage.Device{
+					DevEUI:          lorawan.EUI64{3, 2, 3, 4, 5, 6, 7, 8},
+					ApplicationID:   app2.ID,
+					DeviceProfileID: dpID,
+					Name:            "test-device-3",
+				}
+				So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+				_, err := api.AddDevice(ctx, &pb.AddDeviceToDeviceGroupRequest{
+					DeviceGroupId: createResp.Id,
+					DevEui:        d.DevEUI.String(),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("Then Update updates the group", func() {
+				_, err := api.Update(ctx, &pb.UpdateDeviceGroupRequest{
+					DeviceGroup: &pb.DeviceGroup{
+						Id:         createResp.Id,
+						Name:       "dynamic",
+						Dynamic:    true,
+						FilterTags: map[string]string{"floor": "2"},
+					},
+				})
+				So(err, ShouldBeNil)
+
+				resp, err := api.Get(ctx, &pb.GetDeviceGroupRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(resp.DeviceGroup.Name, ShouldEqual, "dynamic")
+				So(resp.DeviceGroup.ApplicationId, ShouldEqual, app.ID)
+				So(resp.DeviceGroup.Dynamic, ShouldBeTrue)
+				So(resp.DeviceGroup.FilterTags, ShouldResemble, map[string]string{"floor": "2"})
+				So(resp.DeviceCount, ShouldEqual, 1)
+			})
+
+			Convey("Then Delete deletes the group", func() {
+				_, err := api.Delete(ctx, &pb.DeleteDeviceGroupRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+
+				_, err = api.Get(ctx, &pb.GetDeviceGroupRequest{
+					Id: createResp.Id,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+		})
+
+		Convey("When creating a dynamic device-group", func() {
+			createResp, err := api.Create(ctx, &pb.CreateDeviceGroupRequest{
+				DeviceGroup: &pb.DeviceGroup{
+					ApplicationId:         app.ID,
+					Name:                  "dynamic",
+					Dynamic:               true,
+					FilterTags:            map[string]string{"floor": "1"},
+					FilterDeviceProfileId: dpID.String(),
+				},
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the group contains the matching devices", func() {
+				resp, err := api.Get(ctx, &pb.GetDeviceGroupRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(resp.DeviceGroup.FilterDeviceProfileId, ShouldEqual, dpID.String())
+				So(resp.DeviceCount, ShouldEqual, 1)
+			})
+
+			Convey("Then AddDevice returns an error", func() {
+				_, err := api.AddDevice(ctx, &pb.AddDeviceToDeviceGroupRequest{
+					DeviceGroupId: createResp.Id,
+					DevEui:        devices[1].DevEUI.String(),
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("Then CreateJob with an invalid fPort returns an error", func() {
+				_, err := api.CreateJob(ctx, &pb.CreateDeviceGroupJobRequest{
+					Job: &pb.DeviceGroupJob{
+						DeviceGroupId: createResp.Id,
+						Action:        pb.DeviceGroupJobAction_ENQUEUE_DOWNLINK,
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
+			Convey("Then CreateJob without device-profile returns an error", func() {
+				_, err := api.CreateJob(ctx, &pb.CreateDeviceGroupJobRequest{
+					Job: &pb.DeviceGroupJob{
+						DeviceGroupId: createResp.Id,
+						Action:        pb.DeviceGroupJobAction_CHANGE_DEVICE_PROFILE,
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
+			Convey("When creating a job", func() {
+				jobResp, err := api.CreateJob(ctx, &pb.CreateDeviceGroupJobRequest{
+					Job: &pb.DeviceGroupJob{
+						DeviceGroupId: createResp.Id,
+						Action:        pb.DeviceGroupJobAction_ENQUEUE_DOWNLINK,
+						FPort:         10,
+						Data:          []byte{1, 2, 3},
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then GetJob returns the pending job", func() {
+					resp, err := api.GetJob(ctx, &pb.GetDeviceGroupJobRequest{
+						Id: jobResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Job, ShouldResemble, &pb.DeviceGroupJob{
+						Id:            jobResp.Id,
+						DeviceGroupId: createResp.Id,
+						Action:        pb.DeviceGroupJobAction_ENQUEUE_DOWNLINK,
+						FPort:         10,
+						Data:          []byte{1, 2, 3},
+					})
+					So(resp.Progress.Status, ShouldEqual, pb.DeviceGroupJobStatus_JOB_PENDING)
+					So(resp.Progress.TotalCount, ShouldEqual, 1)
+					So(resp.Progress.ProcessedCount, ShouldEqual, 0)
+				})
+
+				Convey("Then ListJobs returns the job", func() {
+					resp, err := api.ListJobs(ctx, &pb.ListDeviceGroupJobRequest{
+						DeviceGroupId: createResp.Id,
+						Limit:         10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].Id, ShouldEqual, jobResp.Id)
+					So(resp.Result[0].Progress.TotalCount, ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
@@ -42,10 +43,17 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := codec.Validate(codec.Type(req.DeviceProfile.PayloadCodec), req.DeviceProfile.PayloadEncoderScript, req.DeviceProfile.PayloadDecoderScript); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", err)
+	}
+
 	dp := storage.DeviceProfile{
-		OrganizationID:  req.DeviceProfile.OrganizationId,
-		NetworkServerID: req.DeviceProfile.NetworkServerId,
-		Name:            req.DeviceProfile.Name,
+		OrganizationID:       req.DeviceProfile.OrganizationId,
+		NetworkServerID:      req.DeviceProfile.NetworkServerId,
+		Name:                 req.DeviceProfile.Name,
+		PayloadCodec:         codec.Type(req.DeviceProfile.PayloadCodec),
+		PayloadEncoderScript: req.DeviceProfile.PayloadEncoderScript,
+		PayloadDecoderScript: req.DeviceProfile.PayloadDecoderScript,
 		DeviceProfile: ns.DeviceProfile{
 			SupportsClassB:     req.DeviceProfile.SupportsClassB,
 			ClassBTimeout:      req.DeviceProfile.ClassBTimeout,
@@ -108,29 +116,32 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 
 	resp := pb.GetDeviceProfileResponse{
 		DeviceProfile: &pb.DeviceProfile{
-			Id:                 dpID.String(),
-			Name:               dp.Name,
-			OrganizationId:     dp.OrganizationID,
-			NetworkServerId:    dp.NetworkServerID,
-			SupportsClassB:     dp.DeviceProfile.SupportsClassB,
-			ClassBTimeout:      dp.DeviceProfile.ClassBTimeout,
-			PingSlotPeriod:     dp.DeviceProfile.PingSlotPeriod,
-			PingSlotDr:         dp.DeviceProfile.PingSlotDr,
-			PingSlotFreq:       dp.DeviceProfile.PingSlotFreq,
-			SupportsClassC:     dp.DeviceProfile.SupportsClassC,
-			ClassCTimeout:      dp.DeviceProfile.ClassCTimeout,
-			MacVersion:         dp.DeviceProfile.MacVersion,
-			RegParamsRevision:  dp.DeviceProfile.RegParamsRevision,
-			RxDelay_1:          dp.DeviceProfile.RxDelay_1,
-			RxDrOffset_1:       dp.DeviceProfile.RxDrOffset_1,
-			RxDatarate_2:       dp.DeviceProfile.RxDatarate_2,
-			RxFreq_2:           dp.DeviceProfile.RxFreq_2,
-			MaxEirp:            dp.DeviceProfile.MaxEirp,
-			MaxDutyCycle:       dp.DeviceProfile.MaxDutyCycle,
-			SupportsJoin:       dp.DeviceProfile.SupportsJoin,
-			RfRegion:           dp.DeviceProfile.RfRegion,
-			Supports_32BitFCnt: dp.DeviceProfile.Supports_32BitFCnt,
-			FactoryPresetFreqs: dp.DeviceProfile.FactoryPresetFreqs,
+			Id:                   dpID.String(),
+			Name:                 dp.Name,
+			OrganizationId:       dp.OrganizationID,
+			NetworkServerId:      dp.NetworkServerID,
+			SupportsClassB:       dp.DeviceProfile.SupportsClassB,
+			ClassBTimeout:        dp.DeviceProfile.ClassBTimeout,
+			PingSlotPeriod:       dp.DeviceProfile.PingSlotPeriod,
+			PingSlotDr:           dp.DeviceProfile.PingSlotDr,
+			PingSlotFreq:         dp.DeviceProfile.PingSlotFreq,
+			SupportsClassC:       dp.DeviceProfile.SupportsClassC,
+			ClassCTimeout:        dp.DeviceProfile.ClassCTimeout,
+			MacVersion:           dp.DeviceProfile.MacVersion,
+			RegParamsRevision:    dp.DeviceProfile.RegParamsRevision,
+			RxDelay_1:            dp.DeviceProfile.RxDelay_1,
+			RxDrOffset_1:         dp.DeviceProfile.RxDrOffset_1,
+			RxDatarate_2:         dp.DeviceProfile.RxDatarate_2,
+			RxFreq_2:             dp.DeviceProfile.RxFreq_2,
+			MaxEirp:              dp.DeviceProfile.MaxEirp,
+			MaxDutyCycle:         dp.DeviceProfile.MaxDutyCycle,
+			SupportsJoin:         dp.DeviceProfile.SupportsJoin,
+			RfRegion:             dp.DeviceProfile.RfRegion,
+			Supports_32BitFCnt:   dp.DeviceProfile.Supports_32BitFCnt,
+			FactoryPresetFreqs:   dp.DeviceProfile.FactoryPresetFreqs,
+			PayloadCodec:         string(dp.PayloadCodec),
+			PayloadEncoderScript: dp.PayloadEncoderScript,
+			PayloadDecoderScript: dp.PayloadDecoderScript,
 		},
 	}
 
@@ -163,12 +174,22 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, dpID)
+	if err := codec.Validate(codec.Type(req.DeviceProfile.PayloadCodec), req.DeviceProfile.PayloadEncoderScript, req.DeviceProfile.PayloadDecoderScript); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", err)
+	}
+
+	dp, err := storage.GetLocalDeviceProfile(config.C.PostgreSQL.DB, dpID)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	oldEncoderScript := dp.PayloadEncoderScript
+	oldDecoderScript := dp.PayloadDecoderScript
+
 	dp.Name = req.DeviceProfile.Name
+	dp.PayloadCodec = codec.Type(req.DeviceProfile.PayloadCodec)
+	dp.PayloadEncoderScript = req.DeviceProfile.PayloadEncoderScript
+	dp.PayloadDecoderScript = req.DeviceProfile.PayloadDecoderScript
 	dp.DeviceProfile = ns.DeviceProfile{
 		Id:                 dpID.Bytes(),
 		SupportsClassB:     req.DeviceProfile.SupportsClassB,
@@ -201,6 +222,9 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 		return nil, errToRPCError(err)
 	}
 
+	invalidateScriptCache(oldEncoderScript, dp.PayloadEncoderScript)
+	invalidateScriptCache(oldDecoderScript, dp.PayloadDecoderScript)
+
 	return &empty.Empty{}, nil
 }
 
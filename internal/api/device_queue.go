@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
@@ -68,18 +69,27 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 				return errToRPCError(err)
 			}
 
-			// get codec payload configured for the application
-			codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.DeviceQueueItem.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
+			payloadCodec, encoderScript, decoderScript, err := storage.GetPayloadCodecForDevice(config.C.PostgreSQL.DB, dev, app)
+			if err != nil {
+				return errToRPCError(err)
+			}
+
+			// get codec payload configured for the device-profile or application
+			codecPL := codec.NewPayload(payloadCodec, uint8(req.DeviceQueueItem.FPort), encoderScript, decoderScript)
 			if codecPL == nil {
-				return grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
+				return grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for device-profile or application")
 			}
+			codec.SetApplicationID(codecPL, app.ID)
 
 			err = json.Unmarshal([]byte(req.DeviceQueueItem.JsonObject), &codecPL)
 			if err != nil {
 				return errToRPCError(err)
 			}
 
+			start := time.Now()
 			req.DeviceQueueItem.Data, err = codecPL.EncodeToBytes()
+
+			downlink.LogCodecEvent(dev, "encode", payloadCodec, uint8(req.DeviceQueueItem.FPort), codecPL, time.Since(start), err)
 			if err != nil {
 				return errToRPCError(err)
 			}
@@ -1,11 +1,13 @@
 package api
 
 import (
+	"encoding/json"
 	"net"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -13,10 +15,13 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicefile"
+	"github.com/brocaar/lora-app-server/internal/devicestats"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
 )
@@ -121,6 +126,187 @@ func TestNodeAPI(t *testing.T) {
 			})
 		})
 
+		Convey("When importing devices with invalid rows", func() {
+			data := "dev_eui,name,device_profile_id,dev_addr,app_s_key,nwk_s_enc_key\n" +
+				"0102030405060708,device-1," + dpID.String() + ",01020304,01020304050607080102030405060708,08070605040302010807060504030201\n" +
+				"0102030405060708,device-2," + dpID.String() + ",01020305,01020304050607080102030405060708,08070605040302010807060504030201\n" +
+				"0102030405060709,device-3," + dpID.String() + ",,,\n"
+
+			resp, err := api.Import(ctx, &pb.ImportDevicesRequest{
+				ApplicationId: app.ID,
+				Format:        pb.DeviceFileFormat_CSV,
+				Data:          []byte(data),
+			})
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then the errors are returned per row and no import job is created", func() {
+				So(resp.JobId, ShouldEqual, "")
+				So(resp.Errors, ShouldHaveLength, 2)
+				So(resp.Errors[0].Row, ShouldEqual, 2)
+				So(resp.Errors[0].DevEui, ShouldEqual, "0102030405060708")
+				So(resp.Errors[1].Row, ShouldEqual, 3)
+				So(resp.Errors[1].DevEui, ShouldEqual, "0102030405060709")
+
+				count, err := storage.GetDeviceCount(config.C.PostgreSQL.DB, storage.DeviceFilters{ApplicationID: app.ID})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When importing devices (ABP)", func() {
+			data := "dev_eui,name,device_profile_id,dev_addr,app_s_key,nwk_s_enc_key,f_cnt_up\n" +
+				"0102030405060708,device-1," + dpID.String() + ",01020304,01020304050607080102030405060708,08070605040302010807060504030201,10\n" +
+				"0102030405060709,," + dpID.String() + ",01020305,01020304050607080102030405060709,08070605040302010807060504030202,0\n"
+
+			resp, err := api.Import(ctx, &pb.ImportDevicesRequest{
+				ApplicationId: app.ID,
+				Format:        pb.DeviceFileFormat_CSV,
+				Data:          []byte(data),
+			})
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			So(resp.Errors, ShouldHaveLength, 0)
+			So(resp.JobId, ShouldNotEqual, "")
+
+			Convey("Then GetImportJob returns the pending job", func() {
+				jobResp, err := api.GetImportJob(ctx, &pb.GetDeviceImportJobRequest{
+					Id: resp.JobId,
+				})
+				So(err, ShouldBeNil)
+				So(jobResp.Job.Status, ShouldEqual, pb.DeviceImportJobStatus_IMPORT_PENDING)
+				So(jobResp.Job.TotalCount, ShouldEqual, 2)
+				So(jobResp.Job.ProcessedCount, ShouldEqual, 0)
+			})
+
+			Convey("When a device has been created before the job is processed", func() {
+				So(storage.CreateDevice(config.C.PostgreSQL.DB, &storage.Device{
+					DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 9},
+					ApplicationID:   app.ID,
+					DeviceProfileID: dpID,
+					Name:            "test-device",
+				}), ShouldBeNil)
+				<-nsClient.CreateDeviceChan
+
+				So(devicefile.ProcessImportJobs(), ShouldBeNil)
+
+				Convey("Then the other device has been imported and the error is returned per row", func() {
+					jobResp, err := api.GetImportJob(ctx, &pb.GetDeviceImportJobRequest{
+						Id: resp.JobId,
+					})
+					So(err, ShouldBeNil)
+					So(jobResp.Job.Status, ShouldEqual, pb.DeviceImportJobStatus_IMPORT_COMPLETED)
+					So(jobResp.Job.ProcessedCount, ShouldEqual, 2)
+					So(jobResp.Job.ImportedCount, ShouldEqual, 1)
+					So(jobResp.Job.CompletedAt, ShouldNotBeNil)
+					So(jobResp.Errors, ShouldHaveLength, 1)
+					So(jobResp.Errors[0].Row, ShouldEqual, 2)
+					So(jobResp.Errors[0].DevEui, ShouldEqual, "0102030405060709")
+
+					_, err = storage.GetDevice(config.C.PostgreSQL.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, false, true)
+					So(err, ShouldBeNil)
+				})
+
+				Convey("Then the existing device has not been removed from the network-server", func() {
+					So(nsClient.DeleteDeviceChan, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("When importing and processing devices (ABP)", func() {
+			data := "dev_eui,name,device_profile_id,dev_addr,app_s_key,nwk_s_enc_key,f_cnt_up\n" +
+				"0102030405060708,device-1," + dpID.String() + ",01020304,01020304050607080102030405060708,08070605040302010807060504030201,10\n" +
+				"0102030405060709,," + dpID.String() + ",01020305,01020304050607080102030405060709,08070605040302010807060504030202,0\n"
+
+			resp, err := api.Import(ctx, &pb.ImportDevicesRequest{
+				ApplicationId: app.ID,
+				Format:        pb.DeviceFileFormat_CSV,
+				Data:          []byte(data),
+			})
+			So(err, ShouldBeNil)
+			So(resp.Errors, ShouldHaveLength, 0)
+			So(devicefile.ProcessImportJobs(), ShouldBeNil)
+
+			Convey("Then the import job has completed", func() {
+				jobResp, err := api.GetImportJob(ctx, &pb.GetDeviceImportJobRequest{
+					Id: resp.JobId,
+				})
+				So(err, ShouldBeNil)
+				So(jobResp.Job.Status, ShouldEqual, pb.DeviceImportJobStatus_IMPORT_COMPLETED)
+				So(jobResp.Job.ImportedCount, ShouldEqual, 2)
+				So(jobResp.Errors, ShouldHaveLength, 0)
+			})
+
+			Convey("Then the devices have been created", func() {
+				d, err := storage.GetDevice(config.C.PostgreSQL.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, false, true)
+				So(err, ShouldBeNil)
+				So(d.Name, ShouldEqual, "device-1")
+
+				d, err = storage.GetDevice(config.C.PostgreSQL.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 9}, false, true)
+				So(err, ShouldBeNil)
+				So(d.Name, ShouldEqual, "0102030405060709")
+			})
+
+			Convey("Then the devices have been activated", func() {
+				So(nsClient.ActivateDeviceChan, ShouldHaveLength, 2)
+				So(<-nsClient.ActivateDeviceChan, ShouldResemble, ns.ActivateDeviceRequest{
+					DeviceActivation: &ns.DeviceActivation{
+						DevEui:      []uint8{1, 2, 3, 4, 5, 6, 7, 8},
+						DevAddr:     []uint8{1, 2, 3, 4},
+						NwkSEncKey:  []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						SNwkSIntKey: []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						FNwkSIntKey: []uint8{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						FCntUp:      10,
+					},
+				})
+
+				da, err := storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+				So(err, ShouldBeNil)
+				So(da.DevAddr, ShouldEqual, lorawan.DevAddr{1, 2, 3, 4})
+			})
+
+			Convey("Then importing the same devices again returns an error per row", func() {
+				resp, err := api.Import(ctx, &pb.ImportDevicesRequest{
+					ApplicationId: app.ID,
+					Format:        pb.DeviceFileFormat_CSV,
+					Data:          []byte(data),
+					DryRun:        true,
+				})
+				So(err, ShouldBeNil)
+				So(resp.Errors, ShouldHaveLength, 2)
+				So(resp.Errors[0].Error, ShouldEqual, "device already exists")
+			})
+
+			Convey("When exporting the devices", func() {
+				nsClient.GetDeviceActivationResponse = ns.GetDeviceActivationResponse{
+					DeviceActivation: &ns.DeviceActivation{
+						NwkSEncKey:  []byte{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						SNwkSIntKey: []byte{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						FNwkSIntKey: []byte{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						FCntUp:      10,
+					},
+				}
+
+				resp, err := api.Export(ctx, &pb.ExportDevicesRequest{
+					ApplicationId: app.ID,
+					Format:        pb.DeviceFileFormat_JSON,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the file contains the devices and their activation", func() {
+					var records []map[string]interface{}
+					So(json.Unmarshal(resp.Data, &records), ShouldBeNil)
+					So(records, ShouldHaveLength, 2)
+					So(records[0]["devEUI"], ShouldEqual, "0102030405060709")
+					So(records[1]["devEUI"], ShouldEqual, "0102030405060708")
+					So(records[1]["devAddr"], ShouldEqual, "01020304")
+					So(records[1]["appSKey"], ShouldEqual, "01020304050607080102030405060708")
+					So(records[1]["fCntUp"], ShouldEqual, float64(10))
+				})
+			})
+		})
+
 		Convey("When creating a device", func() {
 			createReq := pb.CreateDeviceRequest{
 				Device: &pb.Device{
@@ -131,6 +317,15 @@ func TestNodeAPI(t *testing.T) {
 					DeviceProfileId:   dpID.String(),
 					SkipFCntCheck:     true,
 					ReferenceAltitude: 5.6,
+					Variables: map[string]string{
+						"calibration": "1.5",
+					},
+					Tags: map[string]string{
+						"building": "b-42",
+					},
+					SecretVariables: map[string]string{
+						"api_token": "secret",
+					},
 				},
 			}
 
@@ -150,6 +345,9 @@ func TestNodeAPI(t *testing.T) {
 				})
 				So(err, ShouldBeNil)
 				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				// the values of the secret variables are not returned
+				createReq.Device.SecretVariables["api_token"] = ""
 				So(d.Device, ShouldResemble, createReq.Device)
 				So(d.LastSeenAt, ShouldBeNil)
 				So(d.DeviceStatusBattery, ShouldEqual, 256)
@@ -266,6 +464,21 @@ func TestNodeAPI(t *testing.T) {
 						So(devices.Result, ShouldHaveLength, 1)
 					})
 				})
+
+				Convey("When searching on a tag value", func() {
+					Convey("Then it returns the device including its tags", func() {
+						devices, err := api.List(ctx, &pb.ListDeviceRequest{
+							Limit:         10,
+							Offset:        0,
+							ApplicationId: app.ID,
+							Search:        "b-42",
+						})
+						So(err, ShouldBeNil)
+						So(devices.TotalCount, ShouldEqual, 1)
+						So(devices.Result, ShouldHaveLength, 1)
+						So(devices.Result[0].Tags, ShouldResemble, map[string]string{"building": "b-42"})
+					})
+				})
 			})
 
 			Convey("When updating the device", func() {
@@ -278,6 +491,18 @@ func TestNodeAPI(t *testing.T) {
 						DeviceProfileId:   dpID.String(),
 						SkipFCntCheck:     true,
 						ReferenceAltitude: 6.7,
+						Variables: map[string]string{
+							"calibration": "2.5",
+							"offset":      "10",
+						},
+						Tags: map[string]string{
+							"building": "b-43",
+							"floor":    "3",
+						},
+						SecretVariables: map[string]string{
+							"api_token": "",
+							"password":  "secret2",
+						},
 					},
 				}
 
@@ -295,8 +520,21 @@ func TestNodeAPI(t *testing.T) {
 						DevEui: "0807060504030201",
 					})
 					So(err, ShouldBeNil)
+
+					updateReq.Device.SecretVariables["password"] = ""
 					So(d.Device, ShouldResemble, updateReq.Device)
 				})
+
+				Convey("Then the secret variable without value kept its value", func() {
+					d, err := storage.GetDevice(config.C.PostgreSQL.DB, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, false, true)
+					So(err, ShouldBeNil)
+					So(d.VariablesMap(), ShouldResemble, map[string]string{
+						"calibration": "2.5",
+						"offset":      "10",
+						"api_token":   "secret",
+						"password":    "secret2",
+					})
+				})
 			})
 
 			Convey("After deleting the device", func() {
@@ -317,6 +555,374 @@ func TestNodeAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given an application within an other organization", func() {
+				org2 := storage.Organization{
+					Name: "test-org-2",
+				}
+				So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org2), ShouldBeNil)
+
+				sp2 := storage.ServiceProfile{
+					Name:            "test-sp-2",
+					OrganizationID:  org2.ID,
+					NetworkServerID: n.ID,
+				}
+				So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp2), ShouldBeNil)
+				sp2ID, err := uuid.FromBytes(sp2.ServiceProfile.Id)
+				So(err, ShouldBeNil)
+
+				app2 := storage.Application{
+					OrganizationID:   org2.ID,
+					Name:             "test-app-2",
+					ServiceProfileID: sp2ID,
+				}
+				So(storage.CreateApplication(config.C.PostgreSQL.DB, &app2), ShouldBeNil)
+
+				dp2 := storage.DeviceProfile{
+					Name:            "test-dp-2",
+					OrganizationID:  org2.ID,
+					NetworkServerID: n.ID,
+				}
+				So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp2), ShouldBeNil)
+				dp2ID, err := uuid.FromBytes(dp2.DeviceProfile.Id)
+				So(err, ShouldBeNil)
+
+				Convey("Then Move without device-profile of the organization returns an error", func() {
+					_, err := api.Move(ctx, &pb.MoveDeviceRequest{
+						DevEui:        "0807060504030201",
+						ApplicationId: app2.ID,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+
+				Convey("When moving the device to the application", func() {
+					_, err := api.Move(ctx, &pb.MoveDeviceRequest{
+						DevEui:          "0807060504030201",
+						ApplicationId:   app2.ID,
+						DeviceProfileId: dp2ID.String(),
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the device has been updated on the network-server", func() {
+						nsReq := <-nsClient.UpdateDeviceChan
+						So(nsReq.Device.ServiceProfileId, ShouldResemble, sp2ID.Bytes())
+						So(nsReq.Device.DeviceProfileId, ShouldResemble, dp2ID.Bytes())
+					})
+
+					Convey("Then the device belongs to the application", func() {
+						d, err := api.Get(ctx, &pb.GetDeviceRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(d.Device.ApplicationId, ShouldEqual, app2.ID)
+						So(d.Device.DeviceProfileId, ShouldEqual, dp2ID.String())
+					})
+				})
+			})
+
+			Convey("Then GetClockSync returns not found when the device did not synchronize its clock", func() {
+				_, err := api.GetClockSync(ctx, &pb.GetDeviceClockSyncRequest{
+					DevEui: "0807060504030201",
+				})
+				So(err, ShouldNotBeNil)
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+
+			Convey("Given the device has last known values", func() {
+				So(storage.UpdateDeviceLastValues(db, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, storage.NewDeviceValues(map[string]interface{}{
+					"temperature": 21.5,
+				}, time.Now())), ShouldBeNil)
+
+				Convey("Then Get returns the last known values", func() {
+					resp, err := api.Get(ctx, &pb.GetDeviceRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.LastValues, ShouldHaveLength, 1)
+					So(resp.LastValues["temperature"].ValueJson, ShouldEqual, "21.5")
+					So(resp.LastValues["temperature"].ReceivedAt, ShouldNotBeNil)
+				})
+			})
+
+			Convey("Given the device synchronized its clock", func() {
+				lastSyncAt := time.Now()
+				periodicity := 4
+				So(storage.CreateDeviceClockSync(db, &storage.DeviceClockSync{
+					DevEUI:         lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					LastSyncAt:     &lastSyncAt,
+					TimeCorrection: -3,
+					ClockDrift:     12.5,
+					Periodicity:    &periodicity,
+				}), ShouldBeNil)
+
+				Convey("Then GetClockSync returns the clock synchronization state", func() {
+					resp, err := api.GetClockSync(ctx, &pb.GetDeviceClockSyncRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.DeviceClockSync.DevEui, ShouldEqual, "0807060504030201")
+					So(resp.DeviceClockSync.LastSyncAt, ShouldNotBeNil)
+					So(resp.DeviceClockSync.TimeCorrection, ShouldEqual, -3)
+					So(resp.DeviceClockSync.ClockDrift, ShouldEqual, 12.5)
+					So(resp.DeviceClockSync.Periodicity, ShouldEqual, 4)
+				})
+			})
+
+			Convey("Then SetClockSyncPeriodicity validates the period", func() {
+				_, err := api.SetClockSyncPeriodicity(ctx, &pb.SetDeviceClockSyncPeriodicityRequest{
+					DevEui: "0807060504030201",
+					Period: 16,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
+			Convey("Then ForceClockResync validates the number of transmissions", func() {
+				_, err := api.ForceClockResync(ctx, &pb.ForceDeviceClockResyncRequest{
+					DevEui:          "0807060504030201",
+					NbTransmissions: 0,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
+			Convey("Given the device sent uplinks", func() {
+				now := time.Now()
+				txInfo := &gw.UplinkTXInfo{
+					ModulationInfo: &gw.UplinkTXInfo_LoraModulationInfo{
+						LoraModulationInfo: &gw.LoRaModulationInfo{
+							SpreadingFactor: 7,
+						},
+					},
+				}
+				for _, fCnt := range []uint32{1, 3} {
+					So(devicestats.HandleUplink(db, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, now, fCnt, txInfo, []*gw.UplinkRXInfo{
+						{GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8}, Rssi: -80, LoraSnr: 5},
+					}), ShouldBeNil)
+				}
+
+				Convey("Then GetStats validates the interval", func() {
+					_, err := api.GetStats(ctx, &pb.GetDeviceStatsRequest{
+						DevEui:   "0807060504030201",
+						Interval: "week",
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+
+				Convey("Then GetStats returns the stats", func() {
+					start, _ := ptypes.TimestampProto(now.Add(-48 * time.Hour))
+					end, _ := ptypes.TimestampProto(now.Add(time.Hour))
+
+					for _, interval := range []string{"hour", "DAY"} {
+						resp, err := api.GetStats(ctx, &pb.GetDeviceStatsRequest{
+							DevEui:         "0807060504030201",
+							Interval:       interval,
+							StartTimestamp: start,
+							EndTimestamp:   end,
+						})
+						So(err, ShouldBeNil)
+						So(resp.Result, ShouldHaveLength, 1)
+						So(resp.Result[0].RxPackets, ShouldEqual, 2)
+						So(resp.Result[0].LostPackets, ShouldEqual, 1)
+						So(resp.Result[0].RssiMax, ShouldEqual, -80)
+						So(resp.Result[0].RssiAvg, ShouldEqual, -80)
+						So(resp.Result[0].SnrAvg, ShouldEqual, 5)
+						So(resp.Result[0].RxPacketsPerSf, ShouldResemble, map[uint32]uint32{7: 2})
+						So(resp.Result[0].GatewayCount, ShouldEqual, 1)
+					}
+				})
+			})
+
+			Convey("Given the device has a location history", func() {
+				for i, source := range []string{"GEO_RESOLVER", "GPS"} {
+					So(storage.CreateDeviceLocation(db, &storage.DeviceLocation{
+						DevEUI:    lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+						Latitude:  1.1 + float64(i),
+						Longitude: 2.2 + float64(i),
+						Altitude:  3.3,
+						Source:    source,
+						Accuracy:  10,
+					}), ShouldBeNil)
+				}
+
+				Convey("Then ListLocations returns the track", func() {
+					resp, err := api.ListLocations(ctx, &pb.ListDeviceLocationsRequest{
+						DevEui:  "0807060504030201",
+						GeoJson: true,
+						Limit:   10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 2)
+					So(resp.Result, ShouldHaveLength, 2)
+					So(resp.Result[0].CreatedAt, ShouldNotBeNil)
+					So(resp.Result[0].Location, ShouldResemble, &common.Location{
+						Latitude:  1.1,
+						Longitude: 2.2,
+						Altitude:  3.3,
+						Source:    common.LocationSource_GEO_RESOLVER,
+						Accuracy:  10,
+					})
+					So(resp.Result[1].Location.Source, ShouldEqual, common.LocationSource_GPS)
+
+					var geoJSON struct {
+						Type     string `json:"type"`
+						Geometry struct {
+							Type        string       `json:"type"`
+							Coordinates [][3]float64 `json:"coordinates"`
+						} `json:"geometry"`
+					}
+					So(json.Unmarshal([]byte(resp.GeoJson), &geoJSON), ShouldBeNil)
+					So(geoJSON.Type, ShouldEqual, "Feature")
+					So(geoJSON.Geometry.Type, ShouldEqual, "LineString")
+					So(geoJSON.Geometry.Coordinates, ShouldResemble, [][3]float64{
+						{2.2, 1.1, 3.3},
+						{3.2, 2.1, 3.3},
+					})
+				})
+
+				Convey("Then ListLocations filters on time-range", func() {
+					end, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+
+					resp, err := api.ListLocations(ctx, &pb.ListDeviceLocationsRequest{
+						DevEui:       "0807060504030201",
+						EndTimestamp: end,
+						Limit:        10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 0)
+					So(resp.Result, ShouldHaveLength, 0)
+					So(resp.GeoJson, ShouldEqual, "")
+				})
+
+				Convey("Then ListLocations paginates the track", func() {
+					resp, err := api.ListLocations(ctx, &pb.ListDeviceLocationsRequest{
+						DevEui: "0807060504030201",
+						Limit:  1,
+						Offset: 1,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 2)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].Location.Source, ShouldEqual, common.LocationSource_GPS)
+				})
+
+				Convey("When setting the location of the device", func() {
+					_, err := api.SetLocation(ctx, &pb.SetDeviceLocationRequest{
+						DevEui:    "0807060504030201",
+						Latitude:  4.4,
+						Longitude: 5.5,
+						Altitude:  6.6,
+						Accuracy:  5,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then Get returns the manual location", func() {
+						d, err := api.Get(ctx, &pb.GetDeviceRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(d.Location, ShouldResemble, &common.Location{
+							Latitude:  4.4,
+							Longitude: 5.5,
+							Altitude:  6.6,
+							Source:    common.LocationSource_CONFIG,
+							Accuracy:  5,
+						})
+					})
+
+					Convey("Then the location is stored in the location history", func() {
+						resp, err := api.ListLocations(ctx, &pb.ListDeviceLocationsRequest{
+							DevEui: "0807060504030201",
+							Limit:  10,
+						})
+						So(err, ShouldBeNil)
+						So(resp.TotalCount, ShouldEqual, 3)
+						So(resp.Result[2].Location.Source, ShouldEqual, common.LocationSource_CONFIG)
+					})
+				})
+			})
+
+			Convey("Given the device has a twin with reported state", func() {
+				So(storage.CreateDeviceTwin(db, &storage.DeviceTwin{
+					DevEUI:   lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					Desired:  storage.DeviceTwinState{},
+					Reported: storage.DeviceTwinState{"interval": 60.0, "temperature": 21.5},
+				}), ShouldBeNil)
+
+				Convey("Then UpdateTwin with an invalid fPort returns an error", func() {
+					_, err := api.UpdateTwin(ctx, &pb.UpdateDeviceTwinRequest{
+						Twin: &pb.DeviceTwin{
+							DevEui:      "0807060504030201",
+							DesiredJson: `{"interval": 60}`,
+						},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+
+				Convey("When updating the desired state to the reported state", func() {
+					_, err := api.UpdateTwin(ctx, &pb.UpdateDeviceTwinRequest{
+						Twin: &pb.DeviceTwin{
+							DevEui:      "0807060504030201",
+							FPort:       10,
+							DesiredJson: `{"interval": 60}`,
+						},
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then GetTwin returns the twin without delta", func() {
+						resp, err := api.GetTwin(ctx, &pb.GetDeviceTwinRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(resp.Twin, ShouldResemble, &pb.DeviceTwin{
+							DevEui:       "0807060504030201",
+							FPort:        10,
+							DesiredJson:  `{"interval":60}`,
+							ReportedJson: `{"interval":60,"temperature":21.5}`,
+						})
+						So(resp.DeltaJson, ShouldEqual, "{}")
+						So(resp.SyncAt, ShouldBeNil)
+					})
+				})
+			})
+
+			Convey("Given the device has persisted events", func() {
+				for _, typ := range []string{eventlog.Uplink, eventlog.Error, eventlog.Uplink} {
+					So(storage.CreateDeviceEvent(db, &storage.DeviceEvent{
+						DevEUI:  lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+						Type:    typ,
+						Payload: json.RawMessage(`{"foo":"bar"}`),
+					}), ShouldBeNil)
+				}
+
+				Convey("Then ListEvents returns the events", func() {
+					resp, err := api.ListEvents(ctx, &pb.ListDeviceEventsRequest{
+						DevEui: "0807060504030201",
+						Limit:  2,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 3)
+					So(resp.Result, ShouldHaveLength, 2)
+					So(resp.Result[0].Type, ShouldEqual, eventlog.Uplink)
+					So(resp.Result[0].PayloadJson, ShouldEqual, `{"foo": "bar"}`)
+					So(resp.Result[0].CreatedAt, ShouldNotBeNil)
+				})
+
+				Convey("Then ListEvents can filter on type and time-range", func() {
+					start, err := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+					So(err, ShouldBeNil)
+
+					resp, err := api.ListEvents(ctx, &pb.ListDeviceEventsRequest{
+						DevEui: "0807060504030201",
+						Type:   eventlog.Error,
+						Start:  start,
+						Limit:  10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].Type, ShouldEqual, eventlog.Error)
+				})
+			})
+
 			Convey("Then CreateKeys creates device-keys", func() {
 				createReq := pb.CreateDeviceKeysRequest{
 					DeviceKeys: &pb.DeviceKeys{
@@ -334,17 +940,19 @@ func TestNodeAPI(t *testing.T) {
 					})
 					So(err, ShouldBeNil)
 					So(dk.DeviceKeys, ShouldResemble, &pb.DeviceKeys{
-						DevEui: "0807060504030201",
-						NwkKey: "01020304050607080807060504030201",
-						AppKey: "00000000000000000000000000000000",
+						DevEui:    "0807060504030201",
+						NwkKey:    "01020304050607080807060504030201",
+						AppKey:    "00000000000000000000000000000000",
+						GenAppKey: "00000000000000000000000000000000",
 					})
 				})
 
 				Convey("Then UpdateKeys updates the device-keys", func() {
 					updateReq := pb.UpdateDeviceKeysRequest{
 						DeviceKeys: &pb.DeviceKeys{
-							DevEui: "0807060504030201",
-							NwkKey: "08070605040302010102030405060708",
+							DevEui:    "0807060504030201",
+							NwkKey:    "08070605040302010102030405060708",
+							GenAppKey: "01020304050607080102030405060708",
 						},
 					}
 
@@ -356,9 +964,10 @@ func TestNodeAPI(t *testing.T) {
 					})
 					So(err, ShouldBeNil)
 					So(dk.DeviceKeys, ShouldResemble, &pb.DeviceKeys{
-						DevEui: "0807060504030201",
-						NwkKey: "08070605040302010102030405060708",
-						AppKey: "00000000000000000000000000000000",
+						DevEui:    "0807060504030201",
+						NwkKey:    "08070605040302010102030405060708",
+						AppKey:    "00000000000000000000000000000000",
+						GenAppKey: "01020304050607080102030405060708",
 					})
 				})
 
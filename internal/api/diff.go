@@ -0,0 +1,173 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContextLines defines the number of unchanged lines shown around each
+// change.
+const diffContextLines = 3
+
+type diffOp struct {
+	kind byte // ' ' (equal), '-' (delete) or '+' (insert)
+	text string
+	a, b int // line index in a and b before this operation
+}
+
+// unifiedDiff returns the line-based differences between a and b in the
+// unified diff format. An empty string is returned when a and b are equal.
+func unifiedDiff(fromName, toName, a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		// extend the hunk as long as the next change is within the
+		// context of the previous change
+		start := i - diffContextLines
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for {
+			j := end + 1
+			for j < len(ops) && ops[j].kind == ' ' {
+				j++
+			}
+			if j < len(ops) && j-end-1 <= 2*diffContextLines {
+				end = j
+				continue
+			}
+			break
+		}
+		stop := end + diffContextLines + 1
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		var aLen, bLen int
+		for _, op := range ops[start:stop] {
+			if op.kind != '+' {
+				aLen++
+			}
+			if op.kind != '-' {
+				bLen++
+			}
+		}
+		aStart, bStart := ops[start].a+1, ops[start].b+1
+		if aLen == 0 {
+			aStart--
+		}
+		if bLen == 0 {
+			bStart--
+		}
+
+		fmt.Fprintf(&buf, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+		for _, op := range ops[start:stop] {
+			buf.WriteByte(op.kind)
+			buf.WriteString(op.text)
+			buf.WriteByte('\n')
+		}
+
+		i = stop
+	}
+
+	return buf.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the shortest edit script to transform a into b, using
+// the Myers diff algorithm.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+	// find the length of the shortest edit script
+	var d int
+outer:
+	for d = 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				break outer
+			}
+		}
+	}
+
+	// backtrack the edit script
+	var ops []diffOp
+	x, y := n, m
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, diffOp{kind: ' ', text: a[x], a: x, b: y})
+		}
+
+		if x == prevX {
+			y--
+			ops = append(ops, diffOp{kind: '+', text: b[y], a: x, b: y})
+		} else {
+			x--
+			ops = append(ops, diffOp{kind: '-', text: a[x], a: x, b: y})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		ops = append(ops, diffOp{kind: ' ', text: a[x], a: x, b: y})
+	}
+
+	// reverse, as the operations were added while backtracking
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
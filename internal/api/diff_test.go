@@ -0,0 +1,59 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name: "equal",
+			a:    "a\nb\n",
+			b:    "a\nb\n",
+		},
+		{
+			name:     "from empty",
+			a:        "",
+			b:        "a\nb",
+			expected: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name:     "to empty",
+			a:        "a\nb",
+			b:        "",
+			expected: "--- a\n+++ b\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		{
+			name:     "changed line",
+			a:        "1\n2\n3\n4\n5\n6\n7\n8\n9\n10",
+			b:        "1\n2\n3\n4\n5\nsix\n7\n8\n9\n10",
+			expected: "--- a\n+++ b\n@@ -3,7 +3,7 @@\n 3\n 4\n 5\n-6\n+six\n 7\n 8\n 9\n",
+		},
+		{
+			name:     "changes in separate hunks",
+			a:        "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12",
+			b:        "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13",
+			expected: "--- a\n+++ b\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -10,3 +10,4 @@\n 10\n 11\n 12\n+13\n",
+		},
+		{
+			name:     "changes within the same hunk",
+			a:        "1\n2\n3\n4\n5\n6\n7\n8",
+			b:        "one\n2\n3\n4\n5\n6\n7\neight",
+			expected: "--- a\n+++ b\n@@ -1,8 +1,8 @@\n-1\n+one\n 2\n 3\n 4\n 5\n 6\n 7\n-8\n+eight\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.expected, unifiedDiff("a", "b", test.a, test.b))
+		})
+	}
+}
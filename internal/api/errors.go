@@ -10,21 +10,46 @@ import (
 )
 
 var errToCode = map[error]codes.Code{
-	storage.ErrAlreadyExists:                   codes.AlreadyExists,
-	storage.ErrDoesNotExist:                    codes.NotFound,
-	storage.ErrUsedByOtherObjects:              codes.FailedPrecondition,
-	storage.ErrApplicationInvalidName:          codes.InvalidArgument,
-	storage.ErrNodeInvalidName:                 codes.InvalidArgument,
-	storage.ErrNodeMaxRXDelay:                  codes.InvalidArgument,
-	storage.ErrCFListTooManyChannels:           codes.InvalidArgument,
-	storage.ErrUserInvalidUsername:             codes.InvalidArgument,
-	storage.ErrUserPasswordLength:              codes.InvalidArgument,
-	storage.ErrInvalidUsernameOrPassword:       codes.Unauthenticated,
-	storage.ErrInvalidEmail:                    codes.InvalidArgument,
-	storage.ErrInvalidGatewayDiscoveryInterval: codes.InvalidArgument,
-	storage.ErrDeviceProfileInvalidName:        codes.InvalidArgument,
-	http.ErrInvalidHeaderName:                  codes.InvalidArgument,
-	influxdb.ErrInvalidPrecision:               codes.InvalidArgument,
+	storage.ErrAlreadyExists:                           codes.AlreadyExists,
+	storage.ErrDoesNotExist:                            codes.NotFound,
+	storage.ErrUsedByOtherObjects:                      codes.FailedPrecondition,
+	storage.ErrApplicationInvalidName:                  codes.InvalidArgument,
+	storage.ErrNodeInvalidName:                         codes.InvalidArgument,
+	storage.ErrNodeMaxRXDelay:                          codes.InvalidArgument,
+	storage.ErrCFListTooManyChannels:                   codes.InvalidArgument,
+	storage.ErrUserInvalidUsername:                     codes.InvalidArgument,
+	storage.ErrUserPasswordLength:                      codes.InvalidArgument,
+	storage.ErrInvalidUsernameOrPassword:               codes.Unauthenticated,
+	storage.ErrInvalidEmail:                            codes.InvalidArgument,
+	storage.ErrInvalidGatewayDiscoveryInterval:         codes.InvalidArgument,
+	storage.ErrDeviceProfileInvalidName:                codes.InvalidArgument,
+	storage.ErrCodecInvalidName:                        codes.InvalidArgument,
+	storage.ErrApplicationInvalidCodecVersion:          codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidName:              codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidPayload:           codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidFragSize:          codes.InvalidArgument,
+	storage.ErrFUOTADeploymentTooManyFragments:         codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidSessionParameters: codes.InvalidArgument,
+	storage.ErrRemoteMulticastSetupNoFreeMcGroupID:     codes.FailedPrecondition,
+	storage.ErrScheduledDownlinkInvalidTarget:          codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidFPort:           codes.InvalidArgument,
+	storage.ErrScheduledDownlinkConfirmedMulticast:     codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidRecurrence:      codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidInterval:        codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidCron:            codes.InvalidArgument,
+	storage.ErrDeviceTwinInvalidFPort:                  codes.InvalidArgument,
+	storage.ErrDeviceGroupInvalidName:                  codes.InvalidArgument,
+	storage.ErrDeviceGroupNotStatic:                    codes.FailedPrecondition,
+	storage.ErrDeviceGroupJobInvalidAction:             codes.InvalidArgument,
+	storage.ErrDeviceGroupJobInvalidFPort:              codes.InvalidArgument,
+	storage.ErrDeviceGroupJobDeviceProfileRequired:     codes.InvalidArgument,
+	storage.ErrDeviceGroupJobMulticastGroupRequired:    codes.InvalidArgument,
+	storage.ErrDeviceMoveInvalidNetworkServer:          codes.FailedPrecondition,
+	storage.ErrDeviceMoveInvalidDeviceProfile:          codes.FailedPrecondition,
+	storage.ErrEmailAlertInvalidType:                   codes.InvalidArgument,
+	storage.ErrPasswordResetInvalidToken:               codes.InvalidArgument,
+	http.ErrInvalidHeaderName:                          codes.InvalidArgument,
+	influxdb.ErrInvalidPrecision:                       codes.InvalidArgument,
 }
 
 func errToRPCError(err error) error {
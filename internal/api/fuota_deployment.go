@@ -0,0 +1,298 @@
+package api
+
+import (
+	"encoding/hex"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// FUOTADeploymentAPI exports the FUOTA deployment related functions.
+type FUOTADeploymentAPI struct {
+	validator auth.Validator
+}
+
+// NewFUOTADeploymentAPI creates a new FUOTADeploymentAPI.
+func NewFUOTADeploymentAPI(validator auth.Validator) *FUOTADeploymentAPI {
+	return &FUOTADeploymentAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given FUOTA deployment.
+func (a *FUOTADeploymentAPI) Create(ctx context.Context, req *pb.CreateFUOTADeploymentRequest) (*pb.CreateFUOTADeploymentResponse, error) {
+	if req.FuotaDeployment == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fuota_deployment expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentsAccess(auth.Create, req.FuotaDeployment.ApplicationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	descriptor, err := hex.DecodeString(req.FuotaDeployment.Descriptor_)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "descriptor: %s", err)
+	}
+
+	d := storage.FUOTADeployment{
+		ApplicationID: req.FuotaDeployment.ApplicationId,
+		Name:          req.FuotaDeployment.Name,
+		Payload:       req.FuotaDeployment.Payload,
+		FragSize:      int(req.FuotaDeployment.FragSize),
+		Redundancy:    int(req.FuotaDeployment.Redundancy),
+		FragIndex:     int(req.FuotaDeployment.FragIndex),
+		McGroupID:     int(req.FuotaDeployment.McGroupId),
+		BlockAckDelay: int(req.FuotaDeployment.BlockAckDelay),
+		Descriptor:    descriptor,
+		Timeout:       int(req.FuotaDeployment.Timeout),
+	}
+
+	var devEUIs []lorawan.EUI64
+	for _, s := range req.DevEuis {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(s)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "dev_euis: %s", err)
+		}
+		devEUIs = append(devEUIs, devEUI)
+	}
+
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if req.FuotaDeployment.MulticastGroupId != "" {
+		mgID, err := uuid.FromString(req.FuotaDeployment.MulticastGroupId)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+		}
+		d.MulticastGroupID = &mgID
+
+		// validate that the multicast-group is under the same service-profile
+		// as the application
+		mg, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, mgID, false, true)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		if app.ServiceProfileID != mg.ServiceProfileID {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "service-profile of application != service-profile of multicast-group")
+		}
+
+		if len(devEUIs) == 0 {
+			count, err := storage.GetDeviceCountForMulticastGroup(config.C.PostgreSQL.DB, mgID)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			devices, err := storage.GetDevicesForMulticastGroup(config.C.PostgreSQL.DB, mgID, count, 0)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			for _, device := range devices {
+				devEUIs = append(devEUIs, device.DevEUI)
+			}
+		}
+	}
+
+	if len(devEUIs) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "at least one device must be given")
+	}
+
+	// validate that all devices belong to the application
+	for _, devEUI := range devEUIs {
+		device, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		if device.ApplicationID != app.ID {
+			return nil, grpc.Errorf(codes.InvalidArgument, "device %s does not belong to application %d", devEUI, app.ID)
+		}
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CreateFUOTADeployment(tx, &d); err != nil {
+			return errToRPCError(err)
+		}
+
+		for _, devEUI := range devEUIs {
+			if err := storage.CreateFUOTADeploymentDevice(tx, &storage.FUOTADeploymentDevice{
+				FUOTADeploymentID: d.ID,
+				DevEUI:            devEUI,
+			}); err != nil {
+				return errToRPCError(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateFUOTADeploymentResponse{
+		Id: d.ID,
+	}, nil
+}
+
+// Get returns the FUOTA deployment matching the given id.
+func (a *FUOTADeploymentAPI) Get(ctx context.Context, req *pb.GetFUOTADeploymentRequest) (*pb.GetFUOTADeploymentResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	d, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, req.Id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetFUOTADeploymentResponse{
+		FuotaDeployment: &pb.FUOTADeployment{
+			Id:            d.ID,
+			ApplicationId: d.ApplicationID,
+			Name:          d.Name,
+			FragSize:      uint32(d.FragSize),
+			Redundancy:    uint32(d.Redundancy),
+			FragIndex:     uint32(d.FragIndex),
+			McGroupId:     uint32(d.McGroupID),
+			BlockAckDelay: uint32(d.BlockAckDelay),
+			Descriptor_:   hex.EncodeToString(d.Descriptor),
+			Timeout:       uint32(d.Timeout),
+			State:         string(d.State),
+		},
+	}
+
+	if d.MulticastGroupID != nil {
+		resp.FuotaDeployment.MulticastGroupId = d.MulticastGroupID.String()
+	}
+
+	resp.FuotaDeployment.NextStepAfter, err = ptypes.TimestampProto(d.NextStepAfter)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.CreatedAt, err = ptypes.TimestampProto(d.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(d.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// List lists the FUOTA deployments of the given application.
+func (a *FUOTADeploymentAPI) List(ctx context.Context, req *pb.ListFUOTADeploymentRequest) (*pb.ListFUOTADeploymentResponse, error) {
+	if req.ApplicationId == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "application_id must be given")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentsAccess(auth.List, req.ApplicationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetFUOTADeploymentCountForApplicationID(config.C.PostgreSQL.DB, req.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetFUOTADeploymentsForApplicationID(config.C.PostgreSQL.DB, req.ApplicationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListFUOTADeploymentResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, item := range items {
+		pbItem := pb.FUOTADeploymentListItem{
+			Id:    item.ID,
+			Name:  item.Name,
+			State: string(item.State),
+		}
+
+		pbItem.CreatedAt, err = ptypes.TimestampProto(item.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		pbItem.UpdatedAt, err = ptypes.TimestampProto(item.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		pbItem.NextStepAfter, err = ptypes.TimestampProto(item.NextStepAfter)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &pbItem)
+	}
+
+	return &resp, nil
+}
+
+// ListDeploymentDevices lists the devices of the given FUOTA deployment.
+func (a *FUOTADeploymentAPI) ListDeploymentDevices(ctx context.Context, req *pb.ListFUOTADeploymentDevicesRequest) (*pb.ListFUOTADeploymentDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentAccess(auth.Read, req.FuotaDeploymentId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetFUOTADeploymentDeviceCount(config.C.PostgreSQL.DB, req.FuotaDeploymentId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetFUOTADeploymentDeviceListItems(config.C.PostgreSQL.DB, req.FuotaDeploymentId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListFUOTADeploymentDevicesResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, item := range items {
+		pbItem := pb.FUOTADeploymentDeviceListItem{
+			DevEui:         item.DevEUI.String(),
+			DeviceName:     item.DeviceName,
+			State:          string(item.State),
+			ErrorMessage:   item.ErrorMessage,
+			NbFragReceived: uint32(item.NbFragReceived),
+			MissingFrag:    uint32(item.MissingFrag),
+		}
+
+		pbItem.CreatedAt, err = ptypes.TimestampProto(item.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		pbItem.UpdatedAt, err = ptypes.TimestampProto(item.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &pbItem)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestFUOTADeploymentAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database and api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewFUOTADeploymentAPI(validator)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		apps := []storage.Application{
+			{OrganizationID: org.ID, Name: "test-app", ServiceProfileID: spID},
+			{OrganizationID: org.ID, Name: "test-app-2", ServiceProfileID: spID},
+		}
+		for i := range apps {
+			So(storage.CreateApplication(config.C.PostgreSQL.DB, &apps[i]), ShouldBeNil)
+		}
+
+		devices := []storage.Device{
+			{DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, ApplicationID: apps[0].ID, DeviceProfileID: dpID, Name: "device-1"},
+			{DevEUI: lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8}, ApplicationID: apps[1].ID, DeviceProfileID: dpID, Name: "device-2"},
+		}
+		for i := range devices {
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &devices[i]), ShouldBeNil)
+		}
+
+		createReq := pb.CreateFUOTADeploymentRequest{
+			FuotaDeployment: &pb.FUOTADeployment{
+				ApplicationId: apps[0].ID,
+				Name:          "test-deployment",
+				Payload:       []byte{1, 2, 3, 4, 5},
+				FragSize:      2,
+				Redundancy:    1,
+				FragIndex:     1,
+				BlockAckDelay: 2,
+				Descriptor_:   "01020304",
+				Timeout:       60,
+			},
+			DevEuis: []string{devices[0].DevEUI.String()},
+		}
+
+		Convey("Then Create with a device of an other application returns an error", func() {
+			createReq.DevEuis = []string{devices[1].DevEUI.String()}
+			_, err := api.Create(ctx, &createReq)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then Create without devices returns an error", func() {
+			createReq.DevEuis = nil
+			_, err := api.Create(ctx, &createReq)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then Create with an invalid fragment size returns an error", func() {
+			createReq.FuotaDeployment.FragSize = 0
+			_, err := api.Create(ctx, &createReq)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("When creating a FUOTA deployment", func() {
+			createResp, err := api.Create(ctx, &createReq)
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			So(createResp.Id, ShouldBeGreaterThan, 0)
+
+			Convey("Then Get returns the FUOTA deployment", func() {
+				resp, err := api.Get(ctx, &pb.GetFUOTADeploymentRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.FuotaDeployment.NextStepAfter, ShouldNotBeNil)
+				So(resp.CreatedAt, ShouldNotBeNil)
+				So(resp.UpdatedAt, ShouldNotBeNil)
+
+				createReq.FuotaDeployment.Id = createResp.Id
+				createReq.FuotaDeployment.Payload = nil
+				createReq.FuotaDeployment.State = string(storage.FUOTADeploymentFragSessionSetup)
+				createReq.FuotaDeployment.NextStepAfter = resp.FuotaDeployment.NextStepAfter
+				So(resp.FuotaDeployment, ShouldResemble, createReq.FuotaDeployment)
+			})
+
+			Convey("Then List returns the FUOTA deployment", func() {
+				resp, err := api.List(ctx, &pb.ListFUOTADeploymentRequest{
+					ApplicationId: apps[0].ID,
+					Limit:         10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Id, ShouldEqual, createResp.Id)
+				So(resp.Result[0].Name, ShouldEqual, "test-deployment")
+				So(resp.Result[0].State, ShouldEqual, string(storage.FUOTADeploymentFragSessionSetup))
+			})
+
+			Convey("Then ListDeploymentDevices returns the devices", func() {
+				resp, err := api.ListDeploymentDevices(ctx, &pb.ListFUOTADeploymentDevicesRequest{
+					FuotaDeploymentId: createResp.Id,
+					Limit:             10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].DevEui, ShouldEqual, devices[0].DevEUI.String())
+				So(resp.Result[0].DeviceName, ShouldEqual, "device-1")
+				So(resp.Result[0].State, ShouldEqual, string(storage.FUOTADeploymentDevicePending))
+			})
+		})
+	})
+}
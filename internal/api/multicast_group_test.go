@@ -13,6 +13,7 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/multicastsetup"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/ns"
@@ -443,4 +444,98 @@ func (ts *APITestSuite) TestMulticastGroup() {
 			assert.Equal(codes.NotFound, grpc.Code(err))
 		})
 	})
+
+	ts.T().Run("Create with mc_key", func(t *testing.T) {
+		assert := require.New(t)
+
+		createReq := pb.CreateMulticastGroupRequest{
+			MulticastGroup: &pb.MulticastGroup{
+				Name:             "test-mg-remote-setup",
+				McAddr:           "01020304",
+				McKey:            "01020304050607080102030405060708",
+				GroupType:        pb.MulticastGroupType_CLASS_C,
+				Dr:               5,
+				Frequency:        868100000,
+				ServiceProfileId: spID.String(),
+			},
+		}
+
+		createResp, err := api.Create(context.Background(), &createReq)
+		assert.NoError(err)
+
+		nsCreateReq := <-nsClient.CreateMulticastGroupChan
+		nsClient.GetMulticastGroupResponse = ns.GetMulticastGroupResponse{
+			MulticastGroup: nsCreateReq.MulticastGroup,
+		}
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			mcKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+			mcAddr := lorawan.DevAddr{1, 2, 3, 4}
+			mcNwkSKey, err := multicastsetup.GetMcNwkSKey(mcKey, mcAddr)
+			assert.NoError(err)
+			mcAppSKey, err := multicastsetup.GetMcAppSKey(mcKey, mcAddr)
+			assert.NoError(err)
+
+			getResp, err := api.Get(context.Background(), &pb.GetMulticastGroupRequest{
+				Id: createResp.Id,
+			})
+			assert.NoError(err)
+			assert.Equal(createReq.MulticastGroup.McKey, getResp.MulticastGroup.McKey)
+			assert.Equal(mcNwkSKey.String(), getResp.MulticastGroup.McNwkSKey)
+			assert.Equal(mcAppSKey.String(), getResp.MulticastGroup.McAppSKey)
+		})
+
+		t.Run("Add device", func(t *testing.T) {
+			assert := require.New(t)
+
+			d := storage.Device{
+				DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            "test-device-remote-setup",
+			}
+			assert.NoError(storage.CreateDevice(ts.DB(), &d))
+
+			_, err := api.AddDevice(context.Background(), &pb.AddDeviceToMulticastGroupRequest{
+				DevEui:           d.DevEUI.String(),
+				MulticastGroupId: createResp.Id,
+			})
+			assert.NoError(err)
+
+			t.Run("ListRemoteMulticastSetup", func(t *testing.T) {
+				assert := require.New(t)
+
+				resp, err := api.ListRemoteMulticastSetup(context.Background(), &pb.ListRemoteMulticastSetupRequest{
+					MulticastGroupId: createResp.Id,
+					Limit:            10,
+				})
+				assert.NoError(err)
+				assert.EqualValues(1, resp.TotalCount)
+				assert.Len(resp.Result, 1)
+				assert.Equal(d.DevEUI.String(), resp.Result[0].DevEui)
+				assert.Equal(d.Name, resp.Result[0].DeviceName)
+				assert.EqualValues(0, resp.Result[0].McGroupId)
+				assert.Equal(pb.RemoteMulticastSetupState_MC_GROUP_SETUP, resp.Result[0].State)
+			})
+
+			t.Run("Remove device", func(t *testing.T) {
+				assert := require.New(t)
+
+				_, err := api.RemoveDevice(context.Background(), &pb.RemoveDeviceFromMulticastGroupRequest{
+					DevEui:           d.DevEUI.String(),
+					MulticastGroupId: createResp.Id,
+				})
+				assert.NoError(err)
+
+				resp, err := api.ListRemoteMulticastSetup(context.Background(), &pb.ListRemoteMulticastSetupRequest{
+					MulticastGroupId: createResp.Id,
+					Limit:            10,
+				})
+				assert.NoError(err)
+				assert.EqualValues(0, resp.TotalCount)
+			})
+		})
+	})
 }
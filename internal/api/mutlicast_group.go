@@ -5,6 +5,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -13,6 +14,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/common"
 	"github.com/brocaar/lora-app-server/internal/multicast"
+	"github.com/brocaar/lora-app-server/internal/multicastsetup"
 	"github.com/brocaar/lora-app-server/internal/nsclient"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/ns"
@@ -63,13 +65,15 @@ func (a *MulticastGroupAPI) Create(ctx context.Context, req *pb.CreateMulticastG
 		return nil, grpc.Errorf(codes.InvalidArgument, "mc_app_s_key: %s", err)
 	}
 
-	var mcNwkSKey lorawan.AES128Key
-	if err = mcNwkSKey.UnmarshalText([]byte(req.MulticastGroup.McNwkSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "mc_net_s_key: %s", err)
+	mcKey, mcNwkSKey, mcAppSKey, err := getMulticastGroupKeys(req.MulticastGroup, mcAddr)
+	if err != nil {
+		return nil, err
 	}
 
 	mg := storage.MulticastGroup{
 		Name:             req.MulticastGroup.Name,
+		MCAppSKey:        mcAppSKey,
+		MCKey:            mcKey,
 		ServiceProfileID: spID,
 		MulticastGroup: ns.MulticastGroup{
 			McAddr:           mcAddr[:],
@@ -84,10 +88,6 @@ func (a *MulticastGroupAPI) Create(ctx context.Context, req *pb.CreateMulticastG
 		},
 	}
 
-	if err = mg.MCAppSKey.UnmarshalText([]byte(req.MulticastGroup.McAppSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "mc_app_s_key: %s", err)
-	}
-
 	if err = storage.Transaction(a.db, func(tx sqlx.Ext) error {
 		if err := storage.CreateMulticastGroup(tx, &mg); err != nil {
 			return errToRPCError(err)
@@ -144,6 +144,10 @@ func (a *MulticastGroupAPI) Get(ctx context.Context, req *pb.GetMulticastGroupRe
 		},
 	}
 
+	if mg.MCKey != (lorawan.AES128Key{}) {
+		out.MulticastGroup.McKey = mg.MCKey.String()
+	}
+
 	out.CreatedAt, err = ptypes.TimestampProto(mg.CreatedAt)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -183,12 +187,14 @@ func (a *MulticastGroupAPI) Update(ctx context.Context, req *pb.UpdateMulticastG
 		return nil, grpc.Errorf(codes.InvalidArgument, "mc_app_s_key: %s", err)
 	}
 
-	var mcNwkSKey lorawan.AES128Key
-	if err = mcNwkSKey.UnmarshalText([]byte(req.MulticastGroup.McNwkSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "mc_net_s_key: %s", err)
+	mcKey, mcNwkSKey, mcAppSKey, err := getMulticastGroupKeys(req.MulticastGroup, mcAddr)
+	if err != nil {
+		return nil, err
 	}
 
 	mg.Name = req.MulticastGroup.Name
+	mg.MCAppSKey = mcAppSKey
+	mg.MCKey = mcKey
 	mg.MulticastGroup = ns.MulticastGroup{
 		Id:               mg.MulticastGroup.Id,
 		McAddr:           mcAddr[:],
@@ -202,10 +208,6 @@ func (a *MulticastGroupAPI) Update(ctx context.Context, req *pb.UpdateMulticastG
 		RoutingProfileId: mg.MulticastGroup.RoutingProfileId,
 	}
 
-	if err = mg.MCAppSKey.UnmarshalText([]byte(req.MulticastGroup.McAppSKey)); err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, "mc_app_s_key: %s", err)
-	}
-
 	if err = storage.Transaction(a.db, func(tx sqlx.Ext) error {
 		if err := storage.UpdateMulticastGroup(tx, &mg); err != nil {
 			return errToRPCError(err)
@@ -368,6 +370,17 @@ func (a *MulticastGroupAPI) AddDevice(ctx context.Context, req *pb.AddDeviceToMu
 		if err := storage.AddDeviceToMulticastGroup(tx, mgID, devEUI); err != nil {
 			return errToRPCError(err)
 		}
+
+		// configure the multicast-group on the device using the remote
+		// multicast setup
+		if mg.MCKey != (lorawan.AES128Key{}) {
+			if err := storage.CreateRemoteMulticastSetup(tx, &storage.RemoteMulticastSetup{
+				DevEUI:           devEUI,
+				MulticastGroupID: mgID,
+			}); err != nil {
+				return errToRPCError(err)
+			}
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -397,6 +410,12 @@ func (a *MulticastGroupAPI) RemoveDevice(ctx context.Context, req *pb.RemoveDevi
 		if err := storage.RemoveDeviceFromMulticastGroup(tx, mgID, devEUI); err != nil {
 			return errToRPCError(err)
 		}
+
+		// remove the multicast-group from the device, in case it was
+		// configured using the remote multicast setup
+		if err := multicastsetup.DeleteRemoteMulticastSetup(tx, devEUI, mgID); err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+			return errToRPCError(err)
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -530,3 +549,88 @@ func (a *MulticastGroupAPI) ListQueue(ctx context.Context, req *pb.ListMulticast
 
 	return &resp, nil
 }
+
+// ListRemoteMulticastSetup lists the remote multicast setup state of the
+// devices within the multicast-group.
+func (a *MulticastGroupAPI) ListRemoteMulticastSetup(ctx context.Context, req *pb.ListRemoteMulticastSetupRequest) (*pb.ListRemoteMulticastSetupResponse, error) {
+	mgID, err := uuid.FromString(req.MulticastGroupId)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+	}
+
+	if err = a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(auth.Read, mgID)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetRemoteMulticastSetupCount(a.db, mgID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetRemoteMulticastSetupListItems(a.db, mgID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out := pb.ListRemoteMulticastSetupResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, item := range items {
+		updatedAt, err := ptypes.TimestampProto(item.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		out.Result = append(out.Result, &pb.RemoteMulticastSetupListItem{
+			DevEui:       item.DevEUI.String(),
+			DeviceName:   item.DeviceName,
+			McGroupId:    uint32(item.McGroupID),
+			State:        pb.RemoteMulticastSetupState(pb.RemoteMulticastSetupState_value[string(item.State)]),
+			ErrorMessage: item.ErrorMessage,
+			RetryCount:   uint32(item.RetryCount),
+			UpdatedAt:    updatedAt,
+		})
+	}
+
+	return &out, nil
+}
+
+// getMulticastGroupKeys returns the McKey and the multicast session keys of
+// the given multicast-group. When the McKey is set, the session keys are
+// derived from the McKey and McAddr.
+func getMulticastGroupKeys(mg *pb.MulticastGroup, mcAddr lorawan.DevAddr) (lorawan.AES128Key, lorawan.AES128Key, lorawan.AES128Key, error) {
+	var mcKey, mcNwkSKey, mcAppSKey lorawan.AES128Key
+
+	if mg.McKey != "" {
+		if err := mcKey.UnmarshalText([]byte(mg.McKey)); err != nil {
+			return mcKey, mcNwkSKey, mcAppSKey, grpc.Errorf(codes.InvalidArgument, "mc_key: %s", err)
+		}
+	}
+
+	if mcKey == (lorawan.AES128Key{}) {
+		if err := mcNwkSKey.UnmarshalText([]byte(mg.McNwkSKey)); err != nil {
+			return mcKey, mcNwkSKey, mcAppSKey, grpc.Errorf(codes.InvalidArgument, "mc_net_s_key: %s", err)
+		}
+
+		if err := mcAppSKey.UnmarshalText([]byte(mg.McAppSKey)); err != nil {
+			return mcKey, mcNwkSKey, mcAppSKey, grpc.Errorf(codes.InvalidArgument, "mc_app_s_key: %s", err)
+		}
+
+		return mcKey, mcNwkSKey, mcAppSKey, nil
+	}
+
+	var err error
+	mcNwkSKey, err = multicastsetup.GetMcNwkSKey(mcKey, mcAddr)
+	if err != nil {
+		return mcKey, mcNwkSKey, mcAppSKey, errToRPCError(err)
+	}
+
+	mcAppSKey, err = multicastsetup.GetMcAppSKey(mcKey, mcAddr)
+	if err != nil {
+		return mcKey, mcNwkSKey, mcAppSKey, errToRPCError(err)
+	}
+
+	return mcKey, mcNwkSKey, mcAppSKey, nil
+}
@@ -38,9 +38,10 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 	}
 
 	org := storage.Organization{
-		Name:            req.Organization.Name,
-		DisplayName:     req.Organization.DisplayName,
-		CanHaveGateways: req.Organization.CanHaveGateways,
+		Name:                     req.Organization.Name,
+		DisplayName:              req.Organization.DisplayName,
+		CanHaveGateways:          req.Organization.CanHaveGateways,
+		DeviceEventRetentionDays: int(req.Organization.DeviceEventRetentionDays),
 	}
 
 	err := storage.CreateOrganization(config.C.PostgreSQL.DB, &org)
@@ -67,10 +68,11 @@ func (a *OrganizationAPI) Get(ctx context.Context, req *pb.GetOrganizationReques
 
 	resp := pb.GetOrganizationResponse{
 		Organization: &pb.Organization{
-			Id:              org.ID,
-			Name:            org.Name,
-			DisplayName:     org.DisplayName,
-			CanHaveGateways: org.CanHaveGateways,
+			Id:                       org.ID,
+			Name:                     org.Name,
+			DisplayName:              org.DisplayName,
+			CanHaveGateways:          org.CanHaveGateways,
+			DeviceEventRetentionDays: uint32(org.DeviceEventRetentionDays),
 		},
 	}
 
@@ -178,6 +180,7 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 	org.DisplayName = req.Organization.DisplayName
 	if isAdmin {
 		org.CanHaveGateways = req.Organization.CanHaveGateways
+		org.DeviceEventRetentionDays = int(req.Organization.DeviceEventRetentionDays)
 	}
 
 	err = storage.UpdateOrganization(config.C.PostgreSQL.DB, &org)
@@ -45,9 +45,10 @@ func TestOrganizationAPI(t *testing.T) {
 			validator.returnIsAdmin = true
 			createReq := pb.CreateOrganizationRequest{
 				Organization: &pb.Organization{
-					Name:            "orgName",
-					DisplayName:     "Display Name",
-					CanHaveGateways: true,
+					Name:                     "orgName",
+					DisplayName:              "Display Name",
+					CanHaveGateways:          true,
+					DeviceEventRetentionDays: 30,
 				},
 			}
 			createResp, err := api.Create(ctx, &createReq)
@@ -0,0 +1,285 @@
+package api
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// ScheduledDownlinkAPI exports the scheduled downlink related functions.
+type ScheduledDownlinkAPI struct {
+	validator auth.Validator
+}
+
+// NewScheduledDownlinkAPI creates a new ScheduledDownlinkAPI.
+func NewScheduledDownlinkAPI(validator auth.Validator) *ScheduledDownlinkAPI {
+	return &ScheduledDownlinkAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given scheduled downlink.
+func (a *ScheduledDownlinkAPI) Create(ctx context.Context, req *pb.CreateScheduledDownlinkRequest) (*pb.CreateScheduledDownlinkResponse, error) {
+	if req.ScheduledDownlink == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "scheduled_downlink expected")
+	}
+
+	devEUI, mgID, err := scheduledDownlinkTarget(req.ScheduledDownlink.DevEui, req.ScheduledDownlink.MulticastGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	d := storage.ScheduledDownlink{
+		FPort:     uint8(req.ScheduledDownlink.FPort),
+		Confirmed: req.ScheduledDownlink.Confirmed,
+		Data:      req.ScheduledDownlink.Data,
+		Cron:      req.ScheduledDownlink.Cron,
+	}
+
+	if devEUI != nil {
+		if err := a.validator.Validate(ctx,
+			auth.ValidateDeviceQueueAccess(*devEUI, auth.Create),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+		d.DevEUI = devEUI
+	} else {
+		if err := a.validator.Validate(ctx,
+			auth.ValidateMulticastGroupQueueAccess(auth.Create, *mgID),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+		d.MulticastGroupID = mgID
+	}
+
+	if req.ScheduledDownlink.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "f_port: %s", storage.ErrScheduledDownlinkInvalidFPort)
+	}
+
+	if req.ScheduledDownlink.Interval != nil {
+		d.Interval, err = ptypes.Duration(req.ScheduledDownlink.Interval)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "interval: %s", err)
+		}
+	}
+
+	notBefore := time.Now()
+	if req.ScheduledDownlink.NotBefore != nil {
+		notBefore, err = ptypes.Timestamp(req.ScheduledDownlink.NotBefore)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "not_before: %s", err)
+		}
+	}
+
+	// a cron schedule runs at the first match after the not before
+	// timestamp, other downlinks run (for the first time) at the not before
+	// timestamp
+	if d.Cron != "" {
+		d.NextRunAt = d.NextRunAfter(notBefore)
+	} else {
+		d.NextRunAt = &notBefore
+	}
+
+	if err := storage.CreateScheduledDownlink(config.C.PostgreSQL.DB, &d); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateScheduledDownlinkResponse{
+		Id: d.ID.String(),
+	}, nil
+}
+
+// Get returns the scheduled downlink matching the given id.
+func (a *ScheduledDownlinkAPI) Get(ctx context.Context, req *pb.GetScheduledDownlinkRequest) (*pb.GetScheduledDownlinkResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinkAccess(auth.Read, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	d, err := storage.GetScheduledDownlink(config.C.PostgreSQL.DB, id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetScheduledDownlinkResponse{
+		ScheduledDownlink: &pb.ScheduledDownlink{
+			Id:        d.ID.String(),
+			FPort:     uint32(d.FPort),
+			Confirmed: d.Confirmed,
+			Data:      d.Data,
+			Cron:      d.Cron,
+		},
+		LastError: d.LastError,
+	}
+
+	if d.DevEUI != nil {
+		resp.ScheduledDownlink.DevEui = d.DevEUI.String()
+	}
+	if d.MulticastGroupID != nil {
+		resp.ScheduledDownlink.MulticastGroupId = d.MulticastGroupID.String()
+	}
+	if d.Interval != 0 {
+		resp.ScheduledDownlink.Interval = ptypes.DurationProto(d.Interval)
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(d.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(d.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	if d.NextRunAt != nil {
+		resp.NextRunAt, err = ptypes.TimestampProto(*d.NextRunAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+	if d.LastRunAt != nil {
+		resp.LastRunAt, err = ptypes.TimestampProto(*d.LastRunAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// Delete deletes the scheduled downlink matching the given id.
+func (a *ScheduledDownlinkAPI) Delete(ctx context.Context, req *pb.DeleteScheduledDownlinkRequest) (*empty.Empty, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "id: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinkAccess(auth.Delete, id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteScheduledDownlink(config.C.PostgreSQL.DB, id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// List lists the scheduled downlinks of the given device or multicast-group.
+func (a *ScheduledDownlinkAPI) List(ctx context.Context, req *pb.ListScheduledDownlinkRequest) (*pb.ListScheduledDownlinkResponse, error) {
+	devEUI, mgID, err := scheduledDownlinkTarget(req.DevEui, req.MulticastGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := storage.ScheduledDownlinkFilters{
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+
+	if devEUI != nil {
+		if err := a.validator.Validate(ctx,
+			auth.ValidateDeviceQueueAccess(*devEUI, auth.List),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+		filters.DevEUI = *devEUI
+	} else {
+		if err := a.validator.Validate(ctx,
+			auth.ValidateMulticastGroupQueueAccess(auth.List, *mgID),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+		filters.MulticastGroupID = *mgID
+	}
+
+	count, err := storage.GetScheduledDownlinkCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetScheduledDownlinks(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListScheduledDownlinkResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, item := range items {
+		pbItem := pb.ScheduledDownlinkListItem{
+			Id:        item.ID.String(),
+			FPort:     uint32(item.FPort),
+			Cron:      item.Cron,
+			LastError: item.LastError,
+		}
+
+		if item.DevEUI != nil {
+			pbItem.DevEui = item.DevEUI.String()
+		}
+		if item.MulticastGroupID != nil {
+			pbItem.MulticastGroupId = item.MulticastGroupID.String()
+		}
+		if item.Interval != 0 {
+			pbItem.Interval = ptypes.DurationProto(item.Interval)
+		}
+		if item.NextRunAt != nil {
+			pbItem.NextRunAt, err = ptypes.TimestampProto(*item.NextRunAt)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+		}
+		if item.LastRunAt != nil {
+			pbItem.LastRunAt, err = ptypes.TimestampProto(*item.LastRunAt)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+		}
+
+		resp.Result = append(resp.Result, &pbItem)
+	}
+
+	return &resp, nil
+}
+
+// scheduledDownlinkTarget returns the device EUI or multicast-group ID
+// of the given (string formatted) identifiers. Exactly one of both must be
+// set.
+func scheduledDownlinkTarget(devEUIStr, mgIDStr string) (*lorawan.EUI64, *uuid.UUID, error) {
+	if (devEUIStr == "") == (mgIDStr == "") {
+		return nil, nil, grpc.Errorf(codes.InvalidArgument, "either dev_eui or multicast_group_id must be given")
+	}
+
+	if devEUIStr != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(devEUIStr)); err != nil {
+			return nil, nil, grpc.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+		}
+		return &devEUI, nil, nil
+	}
+
+	mgID, err := uuid.FromString(mgIDStr)
+	if err != nil {
+		return nil, nil, grpc.Errorf(codes.InvalidArgument, "multicast_group_id: %s", err)
+	}
+	return nil, &mgID, nil
+}
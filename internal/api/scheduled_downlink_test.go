@@ -0,0 +1,211 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestScheduledDownlinkAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database and api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewScheduledDownlinkAPI(validator)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device",
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+
+		mg := storage.MulticastGroup{
+			Name:             "test-mg",
+			ServiceProfileID: spID,
+		}
+		So(storage.CreateMulticastGroup(config.C.PostgreSQL.DB, &mg), ShouldBeNil)
+		mgID, err := uuid.FromBytes(mg.MulticastGroup.Id)
+		So(err, ShouldBeNil)
+
+		notBefore, err := ptypes.TimestampProto(time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC))
+		So(err, ShouldBeNil)
+
+		createReq := pb.CreateScheduledDownlinkRequest{
+			ScheduledDownlink: &pb.ScheduledDownlink{
+				DevEui:    device.DevEUI.String(),
+				FPort:     10,
+				Confirmed: true,
+				Data:      []byte{1, 2, 3},
+				NotBefore: notBefore,
+				Interval:  ptypes.DurationProto(time.Hour),
+			},
+		}
+
+		Convey("Then Create without device or multicast-group returns an error", func() {
+			createReq.ScheduledDownlink.DevEui = ""
+			_, err := api.Create(ctx, &createReq)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then Create with cron and interval returns an error", func() {
+			createReq.ScheduledDownlink.Cron = "0 6 * * *"
+			_, err := api.Create(ctx, &createReq)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then Create with a confirmed multicast downlink returns an error", func() {
+			createReq.ScheduledDownlink.DevEui = ""
+			createReq.ScheduledDownlink.MulticastGroupId = mgID.String()
+			_, err := api.Create(ctx, &createReq)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("When creating a cron scheduled multicast downlink", func() {
+			createReq.ScheduledDownlink.DevEui = ""
+			createReq.ScheduledDownlink.MulticastGroupId = mgID.String()
+			createReq.ScheduledDownlink.Confirmed = false
+			createReq.ScheduledDownlink.Interval = nil
+			createReq.ScheduledDownlink.Cron = "30 6 * * *"
+
+			createResp, err := api.Create(ctx, &createReq)
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then the next run is the first cron match after the not before timestamp", func() {
+				resp, err := api.Get(ctx, &pb.GetScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+
+				nextRunAt, err := ptypes.Timestamp(resp.NextRunAt)
+				So(err, ShouldBeNil)
+				So(nextRunAt.Equal(time.Date(2030, 1, 2, 6, 30, 0, 0, time.UTC)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When creating a scheduled device downlink", func() {
+			createResp, err := api.Create(ctx, &createReq)
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			So(createResp.Id, ShouldNotEqual, "")
+
+			Convey("Then Get returns the scheduled downlink", func() {
+				resp, err := api.Get(ctx, &pb.GetScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.CreatedAt, ShouldNotBeNil)
+				So(resp.UpdatedAt, ShouldNotBeNil)
+				So(resp.NextRunAt, ShouldResemble, notBefore)
+				So(resp.LastRunAt, ShouldBeNil)
+
+				createReq.ScheduledDownlink.Id = createResp.Id
+				createReq.ScheduledDownlink.NotBefore = nil
+				So(resp.ScheduledDownlink, ShouldResemble, createReq.ScheduledDownlink)
+			})
+
+			Convey("Then List returns the scheduled downlink", func() {
+				resp, err := api.List(ctx, &pb.ListScheduledDownlinkRequest{
+					DevEui: device.DevEUI.String(),
+					Limit:  10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0], ShouldResemble, &pb.ScheduledDownlinkListItem{
+					Id:        createResp.Id,
+					DevEui:    device.DevEUI.String(),
+					FPort:     10,
+					Interval:  ptypes.DurationProto(time.Hour),
+					NextRunAt: notBefore,
+				})
+			})
+
+			Convey("Then List for the multicast-group returns no items", func() {
+				resp, err := api.List(ctx, &pb.ListScheduledDownlinkRequest{
+					MulticastGroupId: mgID.String(),
+					Limit:            10,
+				})
+				So(err, ShouldBeNil)
+				So(resp.TotalCount, ShouldEqual, 0)
+				So(resp.Result, ShouldHaveLength, 0)
+			})
+
+			Convey("Then Delete deletes the scheduled downlink", func() {
+				_, err := api.Delete(ctx, &pb.DeleteScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				_, err = api.Get(ctx, &pb.GetScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+		})
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -11,6 +13,7 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/email"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
@@ -92,6 +95,16 @@ func (a *UserAPI) Create(ctx context.Context, req *pb.CreateUserRequest) (*pb.Cr
 		return nil, errToRPCError(err)
 	}
 
+	if email.Enabled() && user.IsActive {
+		go func(user storage.User) {
+			if err := email.Send([]string{user.Email}, email.Invitation, email.InvitationData{
+				Username: user.Username,
+			}); err != nil {
+				log.WithError(err).WithField("user_id", user.ID).Error("send invitation e-mail error")
+			}
+		}(user)
+	}
+
 	return &pb.CreateUserResponse{Id: userID}, nil
 }
 
@@ -235,6 +248,111 @@ func (a *UserAPI) UpdatePassword(ctx context.Context, req *pb.UpdateUserPassword
 	return &empty.Empty{}, nil
 }
 
+// emailAlertTypes maps the API e-mail alert types to the stored alert
+// types.
+var emailAlertTypes = map[pb.EmailAlertType]string{
+	pb.EmailAlertType_DEVICE_OFFLINE:      storage.EmailAlertDeviceOffline,
+	pb.EmailAlertType_GATEWAY_OFFLINE:     storage.EmailAlertGatewayOffline,
+	pb.EmailAlertType_INTEGRATION_FAILURE: storage.EmailAlertIntegrationFailure,
+}
+
+// ListEmailAlertSubscriptions lists the e-mail alert subscriptions of the
+// given user.
+func (a *UserAPI) ListEmailAlertSubscriptions(ctx context.Context, req *pb.ListEmailAlertSubscriptionsRequest) (*pb.ListEmailAlertSubscriptionsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.UserId, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	subs, err := storage.GetEmailAlertSubscriptionsForUser(config.C.PostgreSQL.DB, req.UserId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ListEmailAlertSubscriptionsResponse
+	for _, s := range subs {
+		for t, alertType := range emailAlertTypes {
+			if alertType != s.AlertType {
+				continue
+			}
+
+			resp.Result = append(resp.Result, &pb.EmailAlertSubscription{
+				UserId:         s.UserID,
+				OrganizationId: s.OrganizationID,
+				AlertType:      t,
+			})
+		}
+	}
+
+	return &resp, nil
+}
+
+// CreateEmailAlertSubscription subscribes the user to the given e-mail
+// alert type for the given organization.
+func (a *UserAPI) CreateEmailAlertSubscription(ctx context.Context, req *pb.CreateEmailAlertSubscriptionRequest) (*empty.Empty, error) {
+	if req.Subscription == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "subscription must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.Subscription.UserId, auth.UpdateProfile)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	alertType, ok := emailAlertTypes[req.Subscription.AlertType]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "alert_type: %s", storage.ErrEmailAlertInvalidType)
+	}
+
+	// alerts are only sent to organization users and global admin users
+	user, err := storage.GetUser(config.C.PostgreSQL.DB, req.Subscription.UserId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if !user.IsAdmin {
+		_, err := storage.GetOrganizationUser(config.C.PostgreSQL.DB, req.Subscription.OrganizationId, user.ID)
+		if err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				return nil, grpc.Errorf(codes.FailedPrecondition, "user is not a member of the organization")
+			}
+			return nil, errToRPCError(err)
+		}
+	}
+
+	err = storage.CreateEmailAlertSubscription(config.C.PostgreSQL.DB, &storage.EmailAlertSubscription{
+		UserID:         user.ID,
+		OrganizationID: req.Subscription.OrganizationId,
+		AlertType:      alertType,
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteEmailAlertSubscription unsubscribes the user from the given e-mail
+// alert type for the given organization.
+func (a *UserAPI) DeleteEmailAlertSubscription(ctx context.Context, req *pb.DeleteEmailAlertSubscriptionRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.UserId, auth.UpdateProfile)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	alertType, ok := emailAlertTypes[req.AlertType]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "alert_type: %s", storage.ErrEmailAlertInvalidType)
+	}
+
+	err := storage.DeleteEmailAlertSubscription(config.C.PostgreSQL.DB, req.UserId, req.OrganizationId, alertType)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // NewInternalUserAPI creates a new InternalUserAPI.
 func NewInternalUserAPI(validator auth.Validator) *InternalUserAPI {
 	return &InternalUserAPI{
@@ -314,6 +432,56 @@ func (a *InternalUserAPI) Profile(ctx context.Context, req *empty.Empty) (*pb.Pr
 	return &resp, nil
 }
 
+// RequestPasswordReset sends a password reset e-mail to the user matching
+// the given username. To not disclose which users exist, no error is
+// returned when the user does not exist or does not have an e-mail address.
+func (a *InternalUserAPI) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*empty.Empty, error) {
+	if !email.Enabled() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "%s", email.ErrNotEnabled)
+	}
+
+	user, err := storage.GetUserByUsername(config.C.PostgreSQL.DB, req.Username)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return &empty.Empty{}, nil
+		}
+		return nil, errToRPCError(err)
+	}
+
+	if !user.IsActive || user.Email == "" {
+		return &empty.Empty{}, nil
+	}
+
+	token, err := storage.CreatePasswordResetToken(config.C.PostgreSQL.DB, user.ID, config.C.ApplicationServer.Email.PasswordResetTTL)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	go func(user storage.User) {
+		if err := email.Send([]string{user.Email}, email.PasswordReset, email.PasswordResetData{
+			Username: user.Username,
+			Token:    token,
+		}); err != nil {
+			log.WithError(err).WithField("user_id", user.ID).Error("send password reset e-mail error")
+		}
+	}(user)
+
+	return &empty.Empty{}, nil
+}
+
+// ResetPassword sets the password of the user using the password reset
+// token.
+func (a *InternalUserAPI) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*empty.Empty, error) {
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return storage.ResetPassword(tx, req.Token, req.Password)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // Branding returns UI branding.
 func (a *InternalUserAPI) Branding(ctx context.Context, req *empty.Empty) (*pb.BrandingResponse, error) {
 	resp := pb.BrandingResponse{
@@ -2,11 +2,14 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/brocaar/loraserver/api/ns"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
@@ -63,6 +66,107 @@ func TestUserAPI(t *testing.T) {
 			So(users, ShouldHaveLength, 1)
 			So(users[0].UserID, ShouldEqual, createResp.Id)
 			So(users[0].IsAdmin, ShouldBeTrue)
+
+			Convey("When subscribing the user to an e-mail alert of the organization", func() {
+				_, err := api.CreateEmailAlertSubscription(ctx, &pb.CreateEmailAlertSubscriptionRequest{
+					Subscription: &pb.EmailAlertSubscription{
+						UserId:         createResp.Id,
+						OrganizationId: org.ID,
+						AlertType:      pb.EmailAlertType_GATEWAY_OFFLINE,
+					},
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then ListEmailAlertSubscriptions returns the subscription", func() {
+					resp, err := api.ListEmailAlertSubscriptions(ctx, &pb.ListEmailAlertSubscriptionsRequest{
+						UserId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldResemble, []*pb.EmailAlertSubscription{
+						{
+							UserId:         createResp.Id,
+							OrganizationId: org.ID,
+							AlertType:      pb.EmailAlertType_GATEWAY_OFFLINE,
+						},
+					})
+				})
+
+				Convey("Then DeleteEmailAlertSubscription deletes the subscription", func() {
+					_, err := api.DeleteEmailAlertSubscription(ctx, &pb.DeleteEmailAlertSubscriptionRequest{
+						UserId:         createResp.Id,
+						OrganizationId: org.ID,
+						AlertType:      pb.EmailAlertType_GATEWAY_OFFLINE,
+					})
+					So(err, ShouldBeNil)
+
+					resp, err := api.ListEmailAlertSubscriptions(ctx, &pb.ListEmailAlertSubscriptionsRequest{
+						UserId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("Then subscribing the user to an e-mail alert of an other organization returns an error", func() {
+				org2 := storage.Organization{
+					Name: "test-org-2",
+				}
+				So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org2), ShouldBeNil)
+
+				_, err := api.CreateEmailAlertSubscription(ctx, &pb.CreateEmailAlertSubscriptionRequest{
+					Subscription: &pb.EmailAlertSubscription{
+						UserId:         createResp.Id,
+						OrganizationId: org2.ID,
+						AlertType:      pb.EmailAlertType_DEVICE_OFFLINE,
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("Then RequestPasswordReset returns an error when e-mail is not enabled", func() {
+				_, err := apiInternal.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{
+					Username: "testuser",
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("Given a password reset token", func() {
+				token, err := storage.CreatePasswordResetToken(config.C.PostgreSQL.DB, createResp.Id, time.Hour)
+				So(err, ShouldBeNil)
+
+				Convey("Then ResetPassword with an invalid token returns an error", func() {
+					_, err := apiInternal.ResetPassword(ctx, &pb.ResetPasswordRequest{
+						Token:    "foo",
+						Password: "newpasswd",
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+
+				Convey("When resetting the password", func() {
+					_, err := apiInternal.ResetPassword(ctx, &pb.ResetPasswordRequest{
+						Token:    token,
+						Password: "newpasswd",
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the user can log in with the new password", func() {
+						_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+							Username: "testuser",
+							Password: "newpasswd",
+						})
+						So(err, ShouldBeNil)
+					})
+
+					Convey("Then the token can not be used again", func() {
+						_, err := apiInternal.ResetPassword(ctx, &pb.ResetPasswordRequest{
+							Token:    token,
+							Password: "otherpasswd",
+						})
+						So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+					})
+				})
+			})
 		})
 
 		Convey("When creating an user", func() {
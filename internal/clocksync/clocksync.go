@@ -0,0 +1,212 @@
+// Package clocksync implements the LoRaWAN Application Layer Clock
+// Synchronization (TS003).
+package clocksync
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/gps"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/clocksync"
+)
+
+// HandleUplinkCommand handles the given clock synchronization commands,
+// received on the clock synchronization fPort. The timeSinceGPSEpoch must
+// contain the (GPS) time at which the uplink was received.
+func HandleUplinkCommand(db sqlx.Ext, devEUI lorawan.EUI64, timeSinceGPSEpoch time.Duration, b []byte) error {
+	var cmds clocksync.Commands
+	if err := cmds.UnmarshalBinary(true, b); err != nil {
+		return errors.Wrap(err, "unmarshal commands error")
+	}
+
+	for _, cmd := range cmds {
+		var err error
+		switch pl := cmd.Payload.(type) {
+		case *clocksync.AppTimeReqPayload:
+			err = handleAppTimeReq(db, devEUI, timeSinceGPSEpoch, pl)
+		case *clocksync.DeviceAppTimePeriodicityAnsPayload:
+			err = handleDeviceAppTimePeriodicityAns(db, devEUI, pl)
+		case *clocksync.PackageVersionAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":            devEUI,
+				"package_identifier": pl.PackageIdentifier,
+				"package_version":    pl.PackageVersion,
+			}).Info("clocksync: PackageVersionAns received")
+		default:
+			log.WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"cid":     cmd.CID,
+			}).Warning("clocksync: unexpected clock synchronization command")
+		}
+		if err != nil {
+			return errors.Wrapf(err, "handle cid %d error", cmd.CID)
+		}
+	}
+
+	return nil
+}
+
+// SetPeriodicity sends the DeviceAppTimePeriodicityReq to the device. The
+// device will send an AppTimeReq every 128 * 2^period seconds.
+func SetPeriodicity(db sqlx.Ext, devEUI lorawan.EUI64, period int) error {
+	dcs, err := getOrCreateDeviceClockSync(db, devEUI)
+	if err != nil {
+		return err
+	}
+
+	cmd := clocksync.Command{
+		CID: clocksync.DeviceAppTimePeriodicityReq,
+		Payload: &clocksync.DeviceAppTimePeriodicityReqPayload{
+			Periodicity: clocksync.DeviceAppTimePeriodicityReqPayloadPeriodicity{
+				Period: uint8(period),
+			},
+		},
+	}
+
+	if err := enqueueCommand(db, devEUI, cmd); err != nil {
+		return err
+	}
+
+	dcs.RequestedPeriodicity = &period
+	if err := storage.UpdateDeviceClockSync(db, &dcs); err != nil {
+		return errors.Wrap(err, "update device clock sync error")
+	}
+
+	return nil
+}
+
+// ForceResync sends the ForceDeviceResyncReq to the device. The device will
+// (re)send the AppTimeReq nbTransmissions times.
+func ForceResync(db sqlx.Ext, devEUI lorawan.EUI64, nbTransmissions int) error {
+	cmd := clocksync.Command{
+		CID: clocksync.ForceDeviceResyncReq,
+		Payload: &clocksync.ForceDeviceResyncReqPayload{
+			ForceConf: clocksync.ForceDeviceResyncReqPayloadForceConf{
+				NbTransmissions: uint8(nbTransmissions),
+			},
+		},
+	}
+
+	return enqueueCommand(db, devEUI, cmd)
+}
+
+func handleAppTimeReq(db sqlx.Ext, devEUI lorawan.EUI64, timeSinceGPSEpoch time.Duration, pl *clocksync.AppTimeReqPayload) error {
+	dcs, err := getOrCreateDeviceClockSync(db, devEUI)
+	if err != nil {
+		return err
+	}
+
+	correction := getTimeCorrection(timeSinceGPSEpoch, pl.DeviceTime)
+	syncAt := time.Time(gps.NewFromTimeSinceGPSEpoch(timeSinceGPSEpoch))
+
+	// the drift can only be measured when the device has been synchronized
+	// before, assuming it applied the previous time correction
+	if dcs.LastSyncAt != nil {
+		dcs.ClockDrift = getClockDrift(correction, syncAt.Sub(*dcs.LastSyncAt))
+	}
+
+	dcs.LastSyncAt = &syncAt
+	dcs.TimeCorrection = int(correction)
+
+	if err := storage.UpdateDeviceClockSync(db, &dcs); err != nil {
+		return errors.Wrap(err, "update device clock sync error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":         devEUI,
+		"time_correction": correction,
+		"clock_drift":     dcs.ClockDrift,
+	}).Info("clocksync: AppTimeReq received")
+
+	if correction == 0 && !pl.Param.AnsRequired {
+		return nil
+	}
+
+	cmd := clocksync.Command{
+		CID: clocksync.AppTimeAns,
+		Payload: &clocksync.AppTimeAnsPayload{
+			TimeCorrection: correction,
+			Param: clocksync.AppTimeAnsPayloadParam{
+				TokenAns: pl.Param.TokenReq,
+			},
+		},
+	}
+
+	return enqueueCommand(db, devEUI, cmd)
+}
+
+func handleDeviceAppTimePeriodicityAns(db sqlx.Ext, devEUI lorawan.EUI64, pl *clocksync.DeviceAppTimePeriodicityAnsPayload) error {
+	dcs, err := getOrCreateDeviceClockSync(db, devEUI)
+	if err != nil {
+		return err
+	}
+
+	if pl.Status.NotSupported {
+		log.WithField("dev_eui", devEUI).Warning("clocksync: device does not support the requested periodicity")
+		dcs.Periodicity = nil
+	} else {
+		dcs.Periodicity = dcs.RequestedPeriodicity
+	}
+
+	if err := storage.UpdateDeviceClockSync(db, &dcs); err != nil {
+		return errors.Wrap(err, "update device clock sync error")
+	}
+
+	return nil
+}
+
+// getOrCreateDeviceClockSync returns the (locked) device clock sync for the
+// given DevEUI. It is created when it does not yet exist.
+func getOrCreateDeviceClockSync(db sqlx.Ext, devEUI lorawan.EUI64) (storage.DeviceClockSync, error) {
+	dcs, err := storage.GetDeviceClockSync(db, devEUI, true)
+	if err == nil {
+		return dcs, nil
+	}
+	if errors.Cause(err) != storage.ErrDoesNotExist {
+		return dcs, errors.Wrap(err, "get device clock sync error")
+	}
+
+	dcs = storage.DeviceClockSync{
+		DevEUI: devEUI,
+	}
+	if err := storage.CreateDeviceClockSync(db, &dcs); err != nil {
+		return dcs, errors.Wrap(err, "create device clock sync error")
+	}
+
+	return dcs, nil
+}
+
+func enqueueCommand(db sqlx.Ext, devEUI lorawan.EUI64, cmd clocksync.Command) error {
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	if _, err := downlink.EnqueueDownlinkPayload(db, devEUI, false, clocksync.DefaultFPort, b); err != nil {
+		return errors.Wrap(err, "enqueue downlink payload error")
+	}
+
+	return nil
+}
+
+// getTimeCorrection returns the time correction (in seconds) given the
+// time since GPS epoch at which the uplink was received and the device
+// time (seconds since GPS epoch, modulo 2^32) as reported by the device.
+func getTimeCorrection(timeSinceGPSEpoch time.Duration, deviceTime uint32) int32 {
+	return int32(uint32(timeSinceGPSEpoch/time.Second) - deviceTime)
+}
+
+// getClockDrift returns the clock drift (in ppm) given the time correction
+// and the duration since the previous synchronization.
+func getClockDrift(correction int32, sinceLastSync time.Duration) float64 {
+	if sinceLastSync <= 0 {
+		return 0
+	}
+	return float64(correction) / sinceLastSync.Seconds() * 1e6
+}
@@ -0,0 +1,53 @@
+package clocksync
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetTimeCorrection(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			TimeSinceGPSEpoch time.Duration
+			DeviceTime        uint32
+			TimeCorrection    int32
+		}{
+			{1000 * time.Second, 1000, 0},
+			{1000*time.Second + 500*time.Millisecond, 1000, 0},
+			{1000 * time.Second, 990, 10},
+			{1000 * time.Second, 1010, -10},
+			// the device time wraps around at 2^32
+			{(1 << 32) * time.Second, 4294967290, 6},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s / %d [%d]", test.TimeSinceGPSEpoch, test.DeviceTime, i), func() {
+				So(getTimeCorrection(test.TimeSinceGPSEpoch, test.DeviceTime), ShouldEqual, test.TimeCorrection)
+			})
+		}
+	})
+}
+
+func TestGetClockDrift(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			TimeCorrection int32
+			SinceLastSync  time.Duration
+			ClockDrift     float64
+		}{
+			{0, time.Hour, 0},
+			{1, 0, 0},
+			{10, 100000 * time.Second, 100},
+			{-10, 100000 * time.Second, -100},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %d / %s [%d]", test.TimeCorrection, test.SinceLastSync, i), func() {
+				So(getClockDrift(test.TimeCorrection, test.SinceLastSync), ShouldEqual, test.ClockDrift)
+			})
+		}
+	})
+}
@@ -0,0 +1,380 @@
+package codec
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+func init() {
+	gob.Register(BinarySchema{})
+}
+
+// Schema endianness options.
+const (
+	SchemaBigEndian    = "big"
+	SchemaLittleEndian = "little"
+)
+
+// Schema defines a binary payload schema.
+type Schema struct {
+	// Variants contains the payload layouts. The first variant matching the
+	// fPort of the payload is used.
+	Variants []SchemaVariant `json:"variants"`
+}
+
+// SchemaVariant defines a payload layout.
+type SchemaVariant struct {
+	// FPorts contains the fPorts to which this variant applies. When empty,
+	// the variant applies to all fPorts.
+	FPorts []int `json:"fPorts,omitempty"`
+
+	// Fields contains the fields of the payload.
+	Fields []SchemaField `json:"fields"`
+}
+
+// SchemaField defines a single field within the payload.
+type SchemaField struct {
+	// Name of the field (used as key in the decoded object).
+	Name string `json:"name"`
+
+	// Offset of the field in bytes.
+	Offset int `json:"offset"`
+
+	// Length of the field in bytes (1 - 8).
+	Length int `json:"length"`
+
+	// Signed indicates that the value is signed (two's complement).
+	Signed bool `json:"signed,omitempty"`
+
+	// Endianness of the field (big or little, defaults to big).
+	Endianness string `json:"endianness,omitempty"`
+
+	// BitOffset and BitLength define a bitfield within the field. The
+	// bit-offset is counted from the least significant bit. When BitLength
+	// is 0, all bits of the field are used.
+	BitOffset int `json:"bitOffset,omitempty"`
+	BitLength int `json:"bitLength,omitempty"`
+
+	// Scale and ValueOffset are applied to the raw value, e.g.
+	// value = raw * scale + valueOffset. When Scale is not set, 1 is used.
+	Scale       float64 `json:"scale,omitempty"`
+	ValueOffset float64 `json:"valueOffset,omitempty"`
+
+	// Condition defines an optional condition which must be met for the
+	// field to be present.
+	Condition *SchemaCondition `json:"condition,omitempty"`
+}
+
+// SchemaCondition defines a condition on the value of a previous field.
+type SchemaCondition struct {
+	// Field is the name of the (previous) field.
+	Field string `json:"field"`
+
+	// Equals contains the value to which the raw (unscaled) value of the
+	// field must be equal.
+	Equals float64 `json:"equals"`
+}
+
+// schemas caches the parsed schemas, so that these are not parsed on every
+// DecodeBytes or EncodeToBytes call.
+var schemas = newParseCache(func(src string) (interface{}, error) {
+	return ParseSchema(src)
+})
+
+// getSchema returns the parsed schema for the given (JSON encoded) schema
+// source from the cache.
+func getSchema(s string) (Schema, error) {
+	v, err := schemas.get(s)
+	if err != nil {
+		return Schema{}, err
+	}
+	return v.(Schema), nil
+}
+
+// ParseSchema parses and validates the given (JSON encoded) schema.
+func ParseSchema(s string) (Schema, error) {
+	var schema Schema
+	if err := json.Unmarshal([]byte(s), &schema); err != nil {
+		return schema, errors.Wrap(err, "unmarshal schema error")
+	}
+
+	if err := schema.Validate(); err != nil {
+		return schema, err
+	}
+
+	return schema, nil
+}
+
+// Validate validates the schema.
+func (s Schema) Validate() error {
+	if len(s.Variants) == 0 {
+		return errors.New("schema must contain at least one variant")
+	}
+
+	for i, v := range s.Variants {
+		fields := make(map[string]struct{})
+
+		for _, f := range v.Fields {
+			if f.Name == "" {
+				return fmt.Errorf("variant %d: field name must not be empty", i)
+			}
+			if _, ok := fields[f.Name]; ok {
+				return fmt.Errorf("variant %d: duplicate field name: %s", i, f.Name)
+			}
+			if f.Offset < 0 {
+				return fmt.Errorf("variant %d: field %s: offset must not be negative", i, f.Name)
+			}
+			if f.Length < 1 || f.Length > 8 {
+				return fmt.Errorf("variant %d: field %s: length must be between 1 and 8", i, f.Name)
+			}
+			if f.Endianness != "" && f.Endianness != SchemaBigEndian && f.Endianness != SchemaLittleEndian {
+				return fmt.Errorf("variant %d: field %s: invalid endianness: %s", i, f.Name, f.Endianness)
+			}
+			if f.BitOffset < 0 || f.BitLength < 0 || f.BitOffset+f.BitLength > f.Length*8 {
+				return fmt.Errorf("variant %d: field %s: bitfield exceeds field length", i, f.Name)
+			}
+			if f.Condition != nil {
+				if _, ok := fields[f.Condition.Field]; !ok {
+					return fmt.Errorf("variant %d: field %s: condition must refer to a previous field", i, f.Name)
+				}
+			}
+
+			fields[f.Name] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// variant returns the variant for the given fPort.
+func (s Schema) variant(fPort uint8) (SchemaVariant, error) {
+	for _, v := range s.Variants {
+		if len(v.FPorts) == 0 {
+			return v, nil
+		}
+
+		for _, p := range v.FPorts {
+			if p == int(fPort) {
+				return v, nil
+			}
+		}
+	}
+
+	return SchemaVariant{}, fmt.Errorf("no schema variant for fPort %d", fPort)
+}
+
+// BinarySchema is a codec which decodes and encodes the payload according
+// to a declarative schema.
+type BinarySchema struct {
+	fPort  uint8
+	schema string
+	Data   map[string]interface{}
+}
+
+// NewBinarySchema creates a new binary schema codec.
+func NewBinarySchema(fPort uint8, schema string) *BinarySchema {
+	return &BinarySchema{
+		fPort:  fPort,
+		schema: schema,
+	}
+}
+
+// Object returns the object data.
+func (b BinarySchema) Object() interface{} {
+	return b.Data
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b BinarySchema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Data)
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (b *BinarySchema) UnmarshalJSON(text []byte) error {
+	return json.Unmarshal(text, &b.Data)
+}
+
+// DecodeBytes decodes the payload from a slice of bytes.
+func (b *BinarySchema) DecodeBytes(data []byte) error {
+	schema, err := getSchema(b.schema)
+	if err != nil {
+		return errors.Wrap(err, "parse schema error")
+	}
+
+	v, err := schema.variant(b.fPort)
+	if err != nil {
+		return err
+	}
+
+	b.Data = make(map[string]interface{})
+	raw := make(map[string]float64)
+
+	for _, f := range v.Fields {
+		if f.Condition != nil {
+			if val, ok := raw[f.Condition.Field]; !ok || val != f.Condition.Equals {
+				continue
+			}
+		}
+
+		if f.Offset+f.Length > len(data) {
+			return fmt.Errorf("field %s: payload too short", f.Name)
+		}
+
+		u := readUint(data[f.Offset:f.Offset+f.Length], f.Endianness == SchemaLittleEndian)
+		bits := f.Length * 8
+		if f.BitLength != 0 {
+			u = (u >> uint(f.BitOffset)) & (1<<uint(f.BitLength) - 1)
+			bits = f.BitLength
+		}
+
+		var val float64
+		if f.Signed {
+			// sign-extend the value
+			shift := uint(64 - bits)
+			val = float64(int64(u<<shift) >> shift)
+		} else {
+			val = float64(u)
+		}
+		raw[f.Name] = val
+
+		if f.Scale == 0 && f.ValueOffset == 0 {
+			if f.Signed {
+				b.Data[f.Name] = int64(val)
+			} else {
+				b.Data[f.Name] = u
+			}
+			continue
+		}
+
+		scale := f.Scale
+		if scale == 0 {
+			scale = 1
+		}
+		b.Data[f.Name] = val*scale + f.ValueOffset
+	}
+
+	return nil
+}
+
+// EncodeToBytes encodes the payload to a slice of bytes.
+func (b BinarySchema) EncodeToBytes() ([]byte, error) {
+	schema, err := getSchema(b.schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse schema error")
+	}
+
+	v, err := schema.variant(b.fPort)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	raw := make(map[string]float64)
+
+	for _, f := range v.Fields {
+		if f.Condition != nil {
+			if val, ok := raw[f.Condition.Field]; !ok || val != f.Condition.Equals {
+				continue
+			}
+		}
+
+		obj, ok := b.Data[f.Name]
+		if !ok {
+			return nil, fmt.Errorf("field %s: value missing", f.Name)
+		}
+
+		val, err := toFloat64(obj)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %s", f.Name, err)
+		}
+
+		scale := f.Scale
+		if scale == 0 {
+			scale = 1
+		}
+		val = math.Round((val - f.ValueOffset) / scale)
+		raw[f.Name] = val
+
+		bits := f.Length * 8
+		if f.BitLength != 0 {
+			bits = f.BitLength
+		}
+
+		var min, max float64
+		if f.Signed {
+			min = -math.Pow(2, float64(bits-1))
+			max = math.Pow(2, float64(bits-1)) - 1
+		} else {
+			max = math.Pow(2, float64(bits)) - 1
+		}
+		if val < min || val > max {
+			return nil, fmt.Errorf("field %s: value out of range", f.Name)
+		}
+
+		u := uint64(int64(val))
+		if bits < 64 {
+			u = u & (1<<uint(bits) - 1)
+		}
+		if f.BitLength != 0 {
+			u = u << uint(f.BitOffset)
+		}
+
+		if l := f.Offset + f.Length; l > len(out) {
+			out = append(out, make([]byte, l-len(out))...)
+		}
+
+		// bitfields can share the same bytes, therefore the value is merged
+		// with the current bytes
+		littleEndian := f.Endianness == SchemaLittleEndian
+		u = u | readUint(out[f.Offset:f.Offset+f.Length], littleEndian)
+		writeUint(out[f.Offset:f.Offset+f.Length], u, littleEndian)
+	}
+
+	return out, nil
+}
+
+func readUint(b []byte, littleEndian bool) uint64 {
+	var out uint64
+	for i := range b {
+		if littleEndian {
+			out |= uint64(b[i]) << uint(8*i)
+		} else {
+			out = out<<8 | uint64(b[i])
+		}
+	}
+	return out
+}
+
+func writeUint(b []byte, v uint64, littleEndian bool) {
+	for i := range b {
+		if littleEndian {
+			b[i] = byte(v >> uint(8*i))
+		} else {
+			b[len(b)-1-i] = byte(v >> uint(8*i))
+		}
+	}
+}
+
+func toFloat64(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("value must be a number, got: %T", v)
+	}
+}
@@ -0,0 +1,263 @@
+package codec
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBinarySchema(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name          string
+			Schema        string
+			FPort         uint8
+			Bytes         []byte
+			ExpectedJSON  string
+			ExpectedError string
+		}{
+			{
+				Name: "unsigned, signed and scaled fields",
+				Schema: `{"variants": [{"fields": [
+					{"name": "counter", "offset": 0, "length": 2},
+					{"name": "temperature", "offset": 2, "length": 2, "signed": true, "scale": 0.1},
+					{"name": "offset", "offset": 4, "length": 1, "signed": true}
+				]}]}`,
+				FPort:        1,
+				Bytes:        []byte{1, 2, 255, 156, 254},
+				ExpectedJSON: `{"counter":258,"offset":-2,"temperature":-10}`,
+			},
+			{
+				Name: "little endian with value offset",
+				Schema: `{"variants": [{"fields": [
+					{"name": "pressure", "offset": 0, "length": 2, "endianness": "little", "valueOffset": 800}
+				]}]}`,
+				FPort:        1,
+				Bytes:        []byte{220, 0},
+				ExpectedJSON: `{"pressure":1020}`,
+			},
+			{
+				Name: "bitfields",
+				Schema: `{"variants": [{"fields": [
+					{"name": "alarm", "offset": 0, "length": 1, "bitOffset": 7, "bitLength": 1},
+					{"name": "mode", "offset": 0, "length": 1, "bitOffset": 4, "bitLength": 3},
+					{"name": "level", "offset": 0, "length": 1, "bitOffset": 0, "bitLength": 4, "signed": true}
+				]}]}`,
+				FPort:        1,
+				Bytes:        []byte{0xbf},
+				ExpectedJSON: `{"alarm":1,"level":-1,"mode":3}`,
+			},
+			{
+				Name: "fPort variants",
+				Schema: `{"variants": [
+					{"fPorts": [1], "fields": [{"name": "a", "offset": 0, "length": 1}]},
+					{"fPorts": [2, 3], "fields": [{"name": "b", "offset": 0, "length": 1}]}
+				]}`,
+				FPort:        3,
+				Bytes:        []byte{5},
+				ExpectedJSON: `{"b":5}`,
+			},
+			{
+				Name: "condition met",
+				Schema: `{"variants": [{"fields": [
+					{"name": "type", "offset": 0, "length": 1},
+					{"name": "temperature", "offset": 1, "length": 2, "scale": 0.01, "condition": {"field": "type", "equals": 1}},
+					{"name": "humidity", "offset": 1, "length": 1, "condition": {"field": "type", "equals": 2}}
+				]}]}`,
+				FPort:        1,
+				Bytes:        []byte{1, 9, 196},
+				ExpectedJSON: `{"temperature":25,"type":1}`,
+			},
+			{
+				Name: "condition not met",
+				Schema: `{"variants": [{"fields": [
+					{"name": "type", "offset": 0, "length": 1},
+					{"name": "temperature", "offset": 1, "length": 2, "scale": 0.01, "condition": {"field": "type", "equals": 1}},
+					{"name": "humidity", "offset": 1, "length": 1, "condition": {"field": "type", "equals": 2}}
+				]}]}`,
+				FPort:        1,
+				Bytes:        []byte{2, 80},
+				ExpectedJSON: `{"humidity":80,"type":2}`,
+			},
+			{
+				Name: "no variant for fPort",
+				Schema: `{"variants": [
+					{"fPorts": [1], "fields": [{"name": "a", "offset": 0, "length": 1}]}
+				]}`,
+				FPort:         2,
+				Bytes:         []byte{5},
+				ExpectedError: "no schema variant for fPort 2",
+			},
+			{
+				Name: "payload too short",
+				Schema: `{"variants": [{"fields": [
+					{"name": "a", "offset": 0, "length": 4}
+				]}]}`,
+				FPort:         1,
+				Bytes:         []byte{1, 2},
+				ExpectedError: "field a: payload too short",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				Convey("Decoding", func() {
+					codec := NewBinarySchema(test.FPort, test.Schema)
+					err := codec.DecodeBytes(test.Bytes)
+					if test.ExpectedError != "" {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldEqual, test.ExpectedError)
+						return
+					}
+					So(err, ShouldBeNil)
+
+					b, err := json.Marshal(codec)
+					So(err, ShouldBeNil)
+					So(string(b), ShouldEqual, test.ExpectedJSON)
+				})
+
+				if test.ExpectedError != "" {
+					return
+				}
+
+				Convey("Encoding", func() {
+					codec := NewBinarySchema(test.FPort, test.Schema)
+					So(json.Unmarshal([]byte(test.ExpectedJSON), &codec), ShouldBeNil)
+
+					b, err := codec.EncodeToBytes()
+					So(err, ShouldBeNil)
+					So(b, ShouldResemble, test.Bytes)
+				})
+			})
+		}
+	})
+}
+
+func TestBinarySchemaEncodeErrors(t *testing.T) {
+	Convey("Given a schema", t, func() {
+		schema := `{"variants": [{"fields": [
+			{"name": "a", "offset": 0, "length": 1, "signed": true}
+		]}]}`
+
+		Convey("Then a missing value returns an error", func() {
+			codec := NewBinarySchema(1, schema)
+			codec.Data = map[string]interface{}{}
+			_, err := codec.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "field a: value missing")
+		})
+
+		Convey("Then an out of range value returns an error", func() {
+			codec := NewBinarySchema(1, schema)
+			codec.Data = map[string]interface{}{"a": 128}
+			_, err := codec.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "field a: value out of range")
+		})
+
+		Convey("Then a non-numeric value returns an error", func() {
+			codec := NewBinarySchema(1, schema)
+			codec.Data = map[string]interface{}{"a": "foo"}
+			_, err := codec.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestBinarySchemaCache(t *testing.T) {
+	Convey("Given a schema", t, func() {
+		schema := `{"variants": [{"fields": [{"name": "cached", "offset": 0, "length": 1}]}]}`
+
+		Convey("When decoding a payload", func() {
+			codec := NewBinarySchema(1, schema)
+			So(codec.DecodeBytes([]byte{1}), ShouldBeNil)
+
+			Convey("Then the parsed schema has been cached", func() {
+				s1, err := schemas.get(schema)
+				So(err, ShouldBeNil)
+				s2, err := schemas.get(schema)
+				So(err, ShouldBeNil)
+				So(&s1.(Schema).Variants[0], ShouldEqual, &s2.(Schema).Variants[0])
+			})
+		})
+
+		Convey("Then an invalid schema is not cached", func() {
+			codec := NewBinarySchema(1, `{"variants": []}`)
+			So(codec.DecodeBytes([]byte{1}), ShouldNotBeNil)
+
+			schemas.Lock()
+			defer schemas.Unlock()
+			So(schemas.items, ShouldNotContainKey, sha256.Sum256([]byte(`{"variants": []}`)))
+		})
+	})
+}
+
+func TestValidateSchema(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name          string
+			Schema        string
+			ExpectedError string
+		}{
+			{
+				Name:   "valid schema",
+				Schema: `{"variants": [{"fields": [{"name": "a", "offset": 0, "length": 1}]}]}`,
+			},
+			{
+				Name:          "invalid json",
+				Schema:        `{"variants": `,
+				ExpectedError: "unmarshal schema error: unexpected end of JSON input",
+			},
+			{
+				Name:          "no variants",
+				Schema:        `{"variants": []}`,
+				ExpectedError: "schema must contain at least one variant",
+			},
+			{
+				Name:          "empty field name",
+				Schema:        `{"variants": [{"fields": [{"offset": 0, "length": 1}]}]}`,
+				ExpectedError: "variant 0: field name must not be empty",
+			},
+			{
+				Name:          "duplicate field name",
+				Schema:        `{"variants": [{"fields": [{"name": "a", "offset": 0, "length": 1}, {"name": "a", "offset": 1, "length": 1}]}]}`,
+				ExpectedError: "variant 0: duplicate field name: a",
+			},
+			{
+				Name:          "invalid length",
+				Schema:        `{"variants": [{"fields": [{"name": "a", "offset": 0, "length": 9}]}]}`,
+				ExpectedError: "variant 0: field a: length must be between 1 and 8",
+			},
+			{
+				Name:          "invalid endianness",
+				Schema:        `{"variants": [{"fields": [{"name": "a", "offset": 0, "length": 2, "endianness": "middle"}]}]}`,
+				ExpectedError: "variant 0: field a: invalid endianness: middle",
+			},
+			{
+				Name:          "bitfield exceeds length",
+				Schema:        `{"variants": [{"fields": [{"name": "a", "offset": 0, "length": 1, "bitOffset": 4, "bitLength": 5}]}]}`,
+				ExpectedError: "variant 0: field a: bitfield exceeds field length",
+			},
+			{
+				Name:          "condition on unknown field",
+				Schema:        `{"variants": [{"fields": [{"name": "a", "offset": 0, "length": 1, "condition": {"field": "b", "equals": 1}}]}]}`,
+				ExpectedError: "variant 0: field a: condition must refer to a previous field",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				err := Validate(BinarySchemaType, "", test.Schema)
+				if test.ExpectedError != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.ExpectedError)
+					return
+				}
+				So(err, ShouldBeNil)
+			})
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/gob"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/pkg/errors"
 )
@@ -20,16 +21,60 @@ const (
 	lppDigitalOutput     byte = 1
 	lppAnalogInput       byte = 2
 	lppAnalogOutput      byte = 3
+	lppGenericSensor     byte = 100
 	lppIlluminanceSensor byte = 101
 	lppPresenseSensor    byte = 102
 	lppTemperatureSensor byte = 103
 	lppHumiditySensor    byte = 104
 	lppAccelerometer     byte = 113
 	lppBarometer         byte = 115
+	lppVoltage           byte = 116
+	lppCurrent           byte = 117
+	lppFrequency         byte = 118
+	lppPercentage        byte = 120
+	lppAltitude          byte = 121
+	lppConcentration     byte = 125
+	lppPower             byte = 128
+	lppDistance          byte = 130
+	lppEnergy            byte = 131
+	lppDirection         byte = 132
+	lppUnixTime          byte = 133
 	lppGyrometer         byte = 134
+	lppColour            byte = 135
 	lppGPSLocation       byte = 136
+	lppSwitch            byte = 142
 )
 
+// lppDataSize holds the data size (in bytes) for each CayenneLPP type.
+var lppDataSize = map[byte]int{
+	lppDigitalInput:      1,
+	lppDigitalOutput:     1,
+	lppAnalogInput:       2,
+	lppAnalogOutput:      2,
+	lppGenericSensor:     4,
+	lppIlluminanceSensor: 2,
+	lppPresenseSensor:    1,
+	lppTemperatureSensor: 2,
+	lppHumiditySensor:    1,
+	lppAccelerometer:     6,
+	lppBarometer:         2,
+	lppVoltage:           2,
+	lppCurrent:           2,
+	lppFrequency:         4,
+	lppPercentage:        1,
+	lppAltitude:          2,
+	lppConcentration:     2,
+	lppPower:             2,
+	lppDistance:          4,
+	lppEnergy:            4,
+	lppDirection:         2,
+	lppUnixTime:          4,
+	lppGyrometer:         6,
+	lppColour:            3,
+	lppGPSLocation:       9,
+	lppSwitch:            1,
+}
+
 // Accelerometer defines the accelerometer data.
 type Accelerometer struct {
 	X float64 `json:"x"`
@@ -44,6 +89,13 @@ type Gyrometer struct {
 	Z float64 `json:"z"`
 }
 
+// Colour defines the colour data.
+type Colour struct {
+	R uint8 `json:"r"`
+	G uint8 `json:"g"`
+	B uint8 `json:"b"`
+}
+
 // GPSLocation defines the GPS location data.
 type GPSLocation struct {
 	Latitude  float64 `json:"latitude"`
@@ -51,20 +103,73 @@ type GPSLocation struct {
 	Altitude  float64 `json:"altitude"`
 }
 
+// lppSenMLUnits holds the SenML unit for each CayenneLPP field (by its JSON
+// name). Fields without (registered) SenML unit are omitted.
+var lppSenMLUnits = map[string]string{
+	"illuminanceSensor": "lx",
+	"temperatureSensor": "Cel",
+	"humiditySensor":    "%RH",
+	"barometer":         "hPa",
+	"voltage":           "V",
+	"current":           "A",
+	"frequency":         "Hz",
+	"percentage":        "%",
+	"altitude":          "m",
+	"concentration":     "ppm",
+	"power":             "W",
+	"distance":          "m",
+	"energy":            "kWh",
+	"unixTime":          "s",
+}
+
+// lppSenMLGPSUnits holds the SenML unit for each GPSLocation field.
+var lppSenMLGPSUnits = map[string]string{
+	"latitude":  "lat",
+	"longitude": "lon",
+	"altitude":  "m",
+}
+
 // CayenneLPP defines the Cayenne LPP data structure.
 type CayenneLPP struct {
 	DigitalInput      map[byte]uint8         `json:"digitalInput,omitempty" influxdb:"digital_input"`
 	DigitalOutput     map[byte]uint8         `json:"digitalOutput,omitempty" influxdb:"digital_output"`
 	AnalogInput       map[byte]float64       `json:"analogInput,omitempty" influxdb:"analog_input"`
 	AnalogOutput      map[byte]float64       `json:"analogOutput,omitempty" influxdb:"analog_output"`
+	GenericSensor     map[byte]uint32        `json:"genericSensor,omitempty" influxdb:"generic_sensor"`
 	IlluminanceSensor map[byte]uint16        `json:"illuminanceSensor,omitempty" influxdb:"illuminance_sensor"`
 	PresenceSensor    map[byte]uint8         `json:"presenceSensor,omitempty" influxdb:"presence_sensor"`
 	TemperatureSensor map[byte]float64       `json:"temperatureSensor,omitempty" influxdb:"temperature_sensor"`
 	HumiditySensor    map[byte]float64       `json:"humiditySensor,omitempty" influxdb:"humidity_sensor"`
 	Accelerometer     map[byte]Accelerometer `json:"accelerometer,omitempty" influxdb:"accelerometer"`
 	Barometer         map[byte]float64       `json:"barometer,omitempty" influxdb:"barometer"`
+	Voltage           map[byte]float64       `json:"voltage,omitempty" influxdb:"voltage"`
+	Current           map[byte]float64       `json:"current,omitempty" influxdb:"current"`
+	Frequency         map[byte]uint32        `json:"frequency,omitempty" influxdb:"frequency"`
+	Percentage        map[byte]uint8         `json:"percentage,omitempty" influxdb:"percentage"`
+	Altitude          map[byte]int16         `json:"altitude,omitempty" influxdb:"altitude"`
+	Concentration     map[byte]uint16        `json:"concentration,omitempty" influxdb:"concentration"`
+	Power             map[byte]uint16        `json:"power,omitempty" influxdb:"power"`
+	Distance          map[byte]float64       `json:"distance,omitempty" influxdb:"distance"`
+	Energy            map[byte]float64       `json:"energy,omitempty" influxdb:"energy"`
+	Direction         map[byte]uint16        `json:"direction,omitempty" influxdb:"direction"`
+	UnixTime          map[byte]uint32        `json:"unixTime,omitempty" influxdb:"unix_time"`
 	Gyrometer         map[byte]Gyrometer     `json:"gyrometer,omitempty" influxdb:"gyrometer"`
+	Colour            map[byte]Colour        `json:"colour,omitempty" influxdb:"colour"`
 	GPSLocation       map[byte]GPSLocation   `json:"gpsLocation,omitempty" influxdb:"gps_location"`
+	Switch            map[byte]uint8         `json:"switch,omitempty" influxdb:"switch"`
+
+	// packed indicates that the packed variant of Cayenne LPP is used.
+	// In this variant, the data channel is omitted and is implied by the
+	// position of the value within the payload.
+	packed bool
+}
+
+// NewCayenneLPPPacked returns a new CayenneLPP codec for the packed
+// Cayenne LPP variant.
+func NewCayenneLPPPacked() *CayenneLPP {
+	return &CayenneLPP{
+		packed: true,
+	}
 }
 
 // Object returns the CayenneLPP data object.
@@ -72,14 +177,53 @@ func (c CayenneLPP) Object() interface{} {
 	return c
 }
 
+// Location returns the GPS location with the lowest channel, in case
+// the decoded payload contains one or multiple GPS locations.
+func (c CayenneLPP) Location() (GPSLocation, bool) {
+	var loc GPSLocation
+	var found bool
+	var channel byte
+
+	for k, v := range c.GPSLocation {
+		if !found || k < channel {
+			loc = v
+			channel = k
+			found = true
+		}
+	}
+
+	return loc, found
+}
+
+// senMLUnit returns the SenML unit for the value at the given path
+// (e.g. temperatureSensor/3).
+func (c CayenneLPP) senMLUnit(path []string) string {
+	if len(path) == 3 && path[0] == "gpsLocation" {
+		return lppSenMLGPSUnits[path[2]]
+	}
+	if len(path) == 2 {
+		return lppSenMLUnits[path[0]]
+	}
+	return ""
+}
+
 // DecodeBytes decodes the payload from a slice of bytes.
 func (c *CayenneLPP) DecodeBytes(data []byte) error {
 	var err error
 	buf := make([]byte, 2)
 	r := bytes.NewReader(data)
 
-	for {
-		_, err = io.ReadFull(r, buf)
+	for i := 0; ; i++ {
+		if c.packed {
+			// the channel is implied by the position of the value
+			if i > math.MaxUint8 {
+				return errors.New("packed payload exceeds max. number of channels")
+			}
+			buf[0] = uint8(i)
+			_, err = io.ReadFull(r, buf[1:])
+		} else {
+			_, err = io.ReadFull(r, buf)
+		}
 		if err != nil {
 			if err == io.EOF {
 				break
@@ -96,6 +240,8 @@ func (c *CayenneLPP) DecodeBytes(data []byte) error {
 			err = lppAnalogInputDecode(buf[0], r, c)
 		case lppAnalogOutput:
 			err = lppAnalogOutputDecode(buf[0], r, c)
+		case lppGenericSensor:
+			err = lppGenericSensorDecode(buf[0], r, c)
 		case lppIlluminanceSensor:
 			err = lppIlluminanceSensorDecode(buf[0], r, c)
 		case lppPresenseSensor:
@@ -108,10 +254,36 @@ func (c *CayenneLPP) DecodeBytes(data []byte) error {
 			err = lppAccelerometerDecode(buf[0], r, c)
 		case lppBarometer:
 			err = lppBarometerDecode(buf[0], r, c)
+		case lppVoltage:
+			err = lppVoltageDecode(buf[0], r, c)
+		case lppCurrent:
+			err = lppCurrentDecode(buf[0], r, c)
+		case lppFrequency:
+			err = lppFrequencyDecode(buf[0], r, c)
+		case lppPercentage:
+			err = lppPercentageDecode(buf[0], r, c)
+		case lppAltitude:
+			err = lppAltitudeDecode(buf[0], r, c)
+		case lppConcentration:
+			err = lppConcentrationDecode(buf[0], r, c)
+		case lppPower:
+			err = lppPowerDecode(buf[0], r, c)
+		case lppDistance:
+			err = lppDistanceDecode(buf[0], r, c)
+		case lppEnergy:
+			err = lppEnergyDecode(buf[0], r, c)
+		case lppDirection:
+			err = lppDirectionDecode(buf[0], r, c)
+		case lppUnixTime:
+			err = lppUnixTimeDecode(buf[0], r, c)
 		case lppGyrometer:
 			err = lppGyrometerDecode(buf[0], r, c)
+		case lppColour:
+			err = lppColourDecode(buf[0], r, c)
 		case lppGPSLocation:
 			err = lppGPSLocationDecode(buf[0], r, c)
+		case lppSwitch:
+			err = lppSwitchDecode(buf[0], r, c)
 		default:
 			return fmt.Errorf("invalid data type: %d", buf[1])
 		}
@@ -148,6 +320,11 @@ func (c CayenneLPP) EncodeToBytes() ([]byte, error) {
 			return nil, err
 		}
 	}
+	for k, v := range c.GenericSensor {
+		if err := lppGenericSensorEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
 	for k, v := range c.IlluminanceSensor {
 		if err := lppIlluminanceSensorEncode(k, w, v); err != nil {
 			return nil, err
@@ -178,20 +355,127 @@ func (c CayenneLPP) EncodeToBytes() ([]byte, error) {
 			return nil, err
 		}
 	}
+	for k, v := range c.Voltage {
+		if err := lppVoltageEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Current {
+		if err := lppCurrentEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Frequency {
+		if err := lppFrequencyEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Percentage {
+		if err := lppPercentageEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Altitude {
+		if err := lppAltitudeEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Concentration {
+		if err := lppConcentrationEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Power {
+		if err := lppPowerEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Distance {
+		if err := lppDistanceEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Energy {
+		if err := lppEnergyEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Direction {
+		if err := lppDirectionEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.UnixTime {
+		if err := lppUnixTimeEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
 	for k, v := range c.Gyrometer {
 		if err := lppGyrometerEncode(k, w, v); err != nil {
 			return nil, err
 		}
 	}
+	for k, v := range c.Colour {
+		if err := lppColourEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
 	for k, v := range c.GPSLocation {
 		if err := lppGPSLocationEncode(k, w, v); err != nil {
 			return nil, err
 		}
 	}
+	for k, v := range c.Switch {
+		if err := lppSwitchEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.packed {
+		return lppPack(w.Bytes())
+	}
 
 	return w.Bytes(), nil
 }
 
+// lppPack converts the given (dynamic) Cayenne LPP payload into the packed
+// variant. As the channel is implied by the position of the value, the
+// channels must be unique and consecutive, starting at 0.
+func lppPack(b []byte) ([]byte, error) {
+	values := make(map[uint8][]byte)
+
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, errors.New("invalid payload length")
+		}
+
+		size, ok := lppDataSize[b[1]]
+		if !ok {
+			return nil, fmt.Errorf("invalid data type: %d", b[1])
+		}
+		if len(b) < size+2 {
+			return nil, errors.New("invalid payload length")
+		}
+
+		if _, ok := values[b[0]]; ok {
+			return nil, fmt.Errorf("packed payload can't contain multiple values for channel %d", b[0])
+		}
+		values[b[0]] = b[1 : size+2]
+		b = b[size+2:]
+	}
+
+	var out []byte
+	for i := 0; i < len(values); i++ {
+		v, ok := values[uint8(i)]
+		if !ok {
+			return nil, fmt.Errorf("packed payload requires consecutive channels, channel %d is missing", i)
+		}
+		out = append(out, v...)
+	}
+
+	return out, nil
+}
+
 func lppDigitalInputDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
 	var b uint8
 	if err := binary.Read(r, binary.BigEndian, &b); err != nil {
@@ -478,3 +762,323 @@ func lppGPSLocationEncode(channel uint8, w io.Writer, data GPSLocation) error {
 	}
 	return nil
 }
+
+func lppGenericSensorDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.GenericSensor == nil {
+		out.GenericSensor = make(map[uint8]uint32)
+	}
+	out.GenericSensor[channel] = v
+	return nil
+}
+
+func lppGenericSensorEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppGenericSensor})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppVoltageDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Voltage == nil {
+		out.Voltage = make(map[uint8]float64)
+	}
+	out.Voltage[channel] = float64(v) / 100
+	return nil
+}
+
+func lppVoltageEncode(channel uint8, w io.Writer, data float64) error {
+	v, err := lppScaleUint16(data, 100)
+	if err != nil {
+		return errors.Wrap(err, "voltage error")
+	}
+	w.Write([]byte{channel, lppVoltage})
+	if err := binary.Write(w, binary.BigEndian, v); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppCurrentDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Current == nil {
+		out.Current = make(map[uint8]float64)
+	}
+	out.Current[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppCurrentEncode(channel uint8, w io.Writer, data float64) error {
+	v, err := lppScaleUint16(data, 1000)
+	if err != nil {
+		return errors.Wrap(err, "current error")
+	}
+	w.Write([]byte{channel, lppCurrent})
+	if err := binary.Write(w, binary.BigEndian, v); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppFrequencyDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Frequency == nil {
+		out.Frequency = make(map[uint8]uint32)
+	}
+	out.Frequency[channel] = v
+	return nil
+}
+
+func lppFrequencyEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppFrequency})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppPercentageDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint8
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint8 error")
+	}
+	if out.Percentage == nil {
+		out.Percentage = make(map[uint8]uint8)
+	}
+	out.Percentage[channel] = v
+	return nil
+}
+
+func lppPercentageEncode(channel uint8, w io.Writer, data uint8) error {
+	w.Write([]byte{channel, lppPercentage})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint8 error")
+	}
+	return nil
+}
+
+func lppAltitudeDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v int16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read int16 error")
+	}
+	if out.Altitude == nil {
+		out.Altitude = make(map[uint8]int16)
+	}
+	out.Altitude[channel] = v
+	return nil
+}
+
+func lppAltitudeEncode(channel uint8, w io.Writer, data int16) error {
+	w.Write([]byte{channel, lppAltitude})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write int16 error")
+	}
+	return nil
+}
+
+func lppConcentrationDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Concentration == nil {
+		out.Concentration = make(map[uint8]uint16)
+	}
+	out.Concentration[channel] = v
+	return nil
+}
+
+func lppConcentrationEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppConcentration})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppPowerDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Power == nil {
+		out.Power = make(map[uint8]uint16)
+	}
+	out.Power[channel] = v
+	return nil
+}
+
+func lppPowerEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppPower})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppDistanceDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Distance == nil {
+		out.Distance = make(map[uint8]float64)
+	}
+	out.Distance[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppDistanceEncode(channel uint8, w io.Writer, data float64) error {
+	v, err := lppScaleUint32(data, 1000)
+	if err != nil {
+		return errors.Wrap(err, "distance error")
+	}
+	w.Write([]byte{channel, lppDistance})
+	if err := binary.Write(w, binary.BigEndian, v); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppEnergyDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Energy == nil {
+		out.Energy = make(map[uint8]float64)
+	}
+	out.Energy[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppEnergyEncode(channel uint8, w io.Writer, data float64) error {
+	v, err := lppScaleUint32(data, 1000)
+	if err != nil {
+		return errors.Wrap(err, "energy error")
+	}
+	w.Write([]byte{channel, lppEnergy})
+	if err := binary.Write(w, binary.BigEndian, v); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppDirectionDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Direction == nil {
+		out.Direction = make(map[uint8]uint16)
+	}
+	out.Direction[channel] = v
+	return nil
+}
+
+func lppDirectionEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppDirection})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppUnixTimeDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.UnixTime == nil {
+		out.UnixTime = make(map[uint8]uint32)
+	}
+	out.UnixTime[channel] = v
+	return nil
+}
+
+func lppUnixTimeEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppUnixTime})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppColourDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return errors.Wrap(err, "read error")
+	}
+	if out.Colour == nil {
+		out.Colour = make(map[uint8]Colour)
+	}
+	out.Colour[channel] = Colour{
+		R: buf[0],
+		G: buf[1],
+		B: buf[2],
+	}
+	return nil
+}
+
+func lppColourEncode(channel uint8, w io.Writer, data Colour) error {
+	w.Write([]byte{channel, lppColour})
+	if _, err := w.Write([]byte{data.R, data.G, data.B}); err != nil {
+		return errors.Wrap(err, "write error")
+	}
+	return nil
+}
+
+func lppSwitchDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint8
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint8 error")
+	}
+	if out.Switch == nil {
+		out.Switch = make(map[uint8]uint8)
+	}
+	out.Switch[channel] = v
+	return nil
+}
+
+func lppSwitchEncode(channel uint8, w io.Writer, data uint8) error {
+	w.Write([]byte{channel, lppSwitch})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint8 error")
+	}
+	return nil
+}
+
+// lppScaleUint16 multiplies the given value by the given factor and returns
+// it as uint16. An error is returned when the result does not fit.
+func lppScaleUint16(data, factor float64) (uint16, error) {
+	v := math.Round(data * factor)
+	if v < 0 || v > math.MaxUint16 {
+		return 0, fmt.Errorf("value %v out of range", data)
+	}
+	return uint16(v), nil
+}
+
+// lppScaleUint32 multiplies the given value by the given factor and returns
+// it as uint32. An error is returned when the result does not fit.
+func lppScaleUint32(data, factor float64) (uint32, error) {
+	v := math.Round(data * factor)
+	if v < 0 || v > math.MaxUint32 {
+		return 0, fmt.Errorf("value %v out of range", data)
+	}
+	return uint32(v), nil
+}
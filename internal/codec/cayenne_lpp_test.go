@@ -133,6 +133,134 @@ func TestCayenneLPPDecode(t *testing.T) {
 					},
 				},
 			},
+			{
+				Name:  "generic sensor",
+				Bytes: []byte{1, 100, 0, 1, 226, 64},
+				Struct: CayenneLPP{
+					GenericSensor: map[byte]uint32{
+						1: 123456,
+					},
+				},
+			},
+			{
+				Name:  "voltage",
+				Bytes: []byte{1, 116, 1, 74},
+				Struct: CayenneLPP{
+					Voltage: map[byte]float64{
+						1: 3.3,
+					},
+				},
+			},
+			{
+				Name:  "current",
+				Bytes: []byte{1, 117, 4, 210},
+				Struct: CayenneLPP{
+					Current: map[byte]float64{
+						1: 1.234,
+					},
+				},
+			},
+			{
+				Name:  "frequency",
+				Bytes: []byte{1, 118, 0, 0, 195, 80},
+				Struct: CayenneLPP{
+					Frequency: map[byte]uint32{
+						1: 50000,
+					},
+				},
+			},
+			{
+				Name:  "percentage",
+				Bytes: []byte{1, 120, 75},
+				Struct: CayenneLPP{
+					Percentage: map[byte]uint8{
+						1: 75,
+					},
+				},
+			},
+			{
+				Name:  "altitude",
+				Bytes: []byte{1, 121, 255, 156, 2, 121, 3, 232},
+				Struct: CayenneLPP{
+					Altitude: map[byte]int16{
+						1: -100,
+						2: 1000,
+					},
+				},
+			},
+			{
+				Name:  "concentration",
+				Bytes: []byte{1, 125, 1, 144},
+				Struct: CayenneLPP{
+					Concentration: map[byte]uint16{
+						1: 400,
+					},
+				},
+			},
+			{
+				Name:  "power",
+				Bytes: []byte{1, 128, 0, 60},
+				Struct: CayenneLPP{
+					Power: map[byte]uint16{
+						1: 60,
+					},
+				},
+			},
+			{
+				Name:  "distance",
+				Bytes: []byte{1, 130, 0, 0, 48, 57},
+				Struct: CayenneLPP{
+					Distance: map[byte]float64{
+						1: 12.345,
+					},
+				},
+			},
+			{
+				Name:  "energy",
+				Bytes: []byte{1, 131, 0, 1, 226, 64},
+				Struct: CayenneLPP{
+					Energy: map[byte]float64{
+						1: 123.456,
+					},
+				},
+			},
+			{
+				Name:  "direction",
+				Bytes: []byte{1, 132, 1, 14},
+				Struct: CayenneLPP{
+					Direction: map[byte]uint16{
+						1: 270,
+					},
+				},
+			},
+			{
+				Name:  "unix time",
+				Bytes: []byte{1, 133, 92, 43, 162, 128},
+				Struct: CayenneLPP{
+					UnixTime: map[byte]uint32{
+						1: 1546363520,
+					},
+				},
+			},
+			{
+				Name:  "colour",
+				Bytes: []byte{1, 135, 255, 128, 0},
+				Struct: CayenneLPP{
+					Colour: map[byte]Colour{
+						1: {R: 255, G: 128, B: 0},
+					},
+				},
+			},
+			{
+				Name:  "switch",
+				Bytes: []byte{1, 142, 1, 2, 142, 0},
+				Struct: CayenneLPP{
+					Switch: map[byte]uint8{
+						1: 1,
+						2: 0,
+					},
+				},
+			},
 		}
 
 		for i, test := range tests {
@@ -154,3 +282,98 @@ func TestCayenneLPPDecode(t *testing.T) {
 		}
 	})
 }
+
+func TestCayenneLPPEncodeOutOfRange(t *testing.T) {
+	Convey("Given a set of CayenneLPP values out of range", t, func() {
+		tests := []CayenneLPP{
+			{Voltage: map[byte]float64{1: 655.36}},
+			{Voltage: map[byte]float64{1: -1}},
+			{Current: map[byte]float64{1: 65.536}},
+			{Distance: map[byte]float64{1: 4294967.296}},
+			{Energy: map[byte]float64{1: -0.1}},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Then encoding returns an error for test %d", i), func() {
+				_, err := test.EncodeToBytes()
+				So(err, ShouldNotBeNil)
+			})
+		}
+
+		Convey("Then the max. value can be encoded", func() {
+			lpp := CayenneLPP{Voltage: map[byte]float64{1: 655.35}}
+			b, err := lpp.EncodeToBytes()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{1, 116, 255, 255})
+		})
+	})
+}
+
+func TestCayenneLPPPacked(t *testing.T) {
+	Convey("Given a packed CayenneLPP codec", t, func() {
+		Convey("Then the channel is implied by the position of the value", func() {
+			lpp := NewCayenneLPPPacked()
+			So(lpp.DecodeBytes([]byte{103, 1, 16, 104, 160, 116, 1, 74}), ShouldBeNil)
+			So(lpp.TemperatureSensor, ShouldResemble, map[byte]float64{0: 27.2})
+			So(lpp.HumiditySensor, ShouldResemble, map[byte]float64{1: 80})
+			So(lpp.Voltage, ShouldResemble, map[byte]float64{2: 3.3})
+		})
+
+		Convey("Then encoding orders the values by channel", func() {
+			lpp := NewCayenneLPPPacked()
+			lpp.TemperatureSensor = map[byte]float64{0: 27.2}
+			lpp.HumiditySensor = map[byte]float64{1: 80}
+			lpp.Voltage = map[byte]float64{2: 3.3}
+
+			b, err := lpp.EncodeToBytes()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{103, 1, 16, 104, 160, 116, 1, 74})
+		})
+
+		Convey("Then encoding non-consecutive channels returns an error", func() {
+			lpp := NewCayenneLPPPacked()
+			lpp.TemperatureSensor = map[byte]float64{0: 27.2}
+			lpp.HumiditySensor = map[byte]float64{2: 80}
+
+			_, err := lpp.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then encoding multiple values for the same channel returns an error", func() {
+			lpp := NewCayenneLPPPacked()
+			lpp.TemperatureSensor = map[byte]float64{0: 27.2}
+			lpp.HumiditySensor = map[byte]float64{0: 80}
+
+			_, err := lpp.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestCayenneLPPLocation(t *testing.T) {
+	Convey("Given a CayenneLPP payload without GPS location", t, func() {
+		lpp := CayenneLPP{
+			TemperatureSensor: map[byte]float64{3: 27.2},
+		}
+
+		Convey("Then GetGPSLocation returns false", func() {
+			_, ok := GetGPSLocation(&lpp)
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a CayenneLPP payload with multiple GPS locations", t, func() {
+		lpp := CayenneLPP{
+			GPSLocation: map[byte]GPSLocation{
+				5: {Latitude: 52.3741, Longitude: 4.9144, Altitude: 10},
+				2: {Latitude: 42.3519, Longitude: -87.9094, Altitude: 10},
+			},
+		}
+
+		Convey("Then GetGPSLocation returns the location with the lowest channel", func() {
+			loc, ok := GetGPSLocation(&lpp)
+			So(ok, ShouldBeTrue)
+			So(loc, ShouldResemble, lpp.GPSLocation[2])
+		})
+	})
+}
@@ -1,12 +1,24 @@
 package codec
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
 // Type defines the codec type.
 type Type string
 
 // Available codec types.
 const (
-	CayenneLPPType Type = "CAYENNE_LPP"
-	CustomJSType   Type = "CUSTOM_JS"
+	CayenneLPPType       Type = "CAYENNE_LPP"
+	CayenneLPPPackedType Type = "CAYENNE_LPP_PACKED"
+	CustomJSType         Type = "CUSTOM_JS"
+	BinarySchemaType     Type = "BINARY_SCHEMA"
+	ProtobufType         Type = "PROTOBUF"
 )
 
 // Payload defines a codec payload.
@@ -16,14 +28,172 @@ type Payload interface {
 	Object() interface{}
 }
 
+// GetConsoleOutput returns the console output of the last DecodeBytes or
+// EncodeToBytes call, in case the given codec supports this.
+func GetConsoleOutput(pl Payload) []string {
+	if c, ok := pl.(interface {
+		ConsoleOutput() []string
+	}); ok {
+		return c.ConsoleOutput()
+	}
+	return nil
+}
+
+// GetGPSLocation returns the GPS location contained by the last decoded
+// payload. Codecs with a fixed payload format (e.g. Cayenne LPP) implement
+// this by providing a Location method. For the other codecs, the decoded
+// object must contain the location as top-level latitude and longitude
+// fields (and optionally an altitude field), e.g.
+// {"latitude": 52.37, "longitude": 4.89, "altitude": 10}.
+func GetGPSLocation(pl Payload) (GPSLocation, bool) {
+	if c, ok := pl.(interface {
+		Location() (GPSLocation, bool)
+	}); ok {
+		return c.Location()
+	}
+	return objectLocation(pl.Object())
+}
+
+// objectLocation returns the GPS location from the top-level latitude,
+// longitude and altitude fields of the given decoded object. It returns
+// false when the latitude or longitude is missing, not numeric or out of
+// range.
+func objectLocation(obj interface{}) (GPSLocation, bool) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return GPSLocation{}, false
+	}
+
+	var loc struct {
+		Latitude  *float64 `json:"latitude"`
+		Longitude *float64 `json:"longitude"`
+		Altitude  float64  `json:"altitude"`
+	}
+	if err := json.Unmarshal(b, &loc); err != nil {
+		return GPSLocation{}, false
+	}
+
+	if loc.Latitude == nil || loc.Longitude == nil {
+		return GPSLocation{}, false
+	}
+	if *loc.Latitude < -90 || *loc.Latitude > 90 || *loc.Longitude < -180 || *loc.Longitude > 180 {
+		return GPSLocation{}, false
+	}
+
+	return GPSLocation{
+		Latitude:  *loc.Latitude,
+		Longitude: *loc.Longitude,
+		Altitude:  loc.Altitude,
+	}, true
+}
+
+// GetObjectFields returns the fields of the last decoded object by field
+// path. Nested objects are flattened, using a dot to separate the keys,
+// e.g. the Cayenne LPP object {"temperatureSensor": {"3": 21.5}} results in
+// the field "temperatureSensor.3". Arrays are returned as a single field.
+// Nil is returned when the decoded object is not a JSON object.
+func GetObjectFields(pl Payload) (map[string]interface{}, error) {
+	b, err := json.Marshal(pl.Object())
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, nil
+	}
+
+	fields := make(map[string]interface{})
+	flattenObject(fields, "", obj)
+	return fields, nil
+}
+
+// flattenObject adds the fields of the given object to fields, prefixing
+// the keys with the given prefix.
+func flattenObject(fields map[string]interface{}, prefix string, obj map[string]interface{}) {
+	for k, v := range obj {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenObject(fields, k, nested)
+		} else {
+			fields[k] = v
+		}
+	}
+}
+
+// UplinkMetadata contains the uplink metadata and device variables which are
+// exposed to the decode function of codecs supporting this.
+type UplinkMetadata struct {
+	DevEUI     lorawan.EUI64     `json:"devEUI"`
+	DeviceName string            `json:"deviceName"`
+	FCnt       uint32            `json:"fCnt"`
+	Time       time.Time         `json:"time"`
+	RXInfo     []UplinkRXInfo    `json:"rxInfo"`
+	Variables  map[string]string `json:"-"`
+}
+
+// UplinkRXInfo contains the RX metadata of a receiving gateway.
+type UplinkRXInfo struct {
+	GatewayID lorawan.EUI64 `json:"gatewayID"`
+	Name      string        `json:"name"`
+	Time      *time.Time    `json:"time,omitempty"`
+	RSSI      int           `json:"rssi"`
+	LoRaSNR   float64       `json:"loRaSNR"`
+}
+
+// SetUplinkMetadata sets the uplink metadata used by the next DecodeBytes
+// call, in case the given codec supports this.
+func SetUplinkMetadata(pl Payload, md UplinkMetadata) {
+	if c, ok := pl.(interface {
+		SetUplinkMetadata(UplinkMetadata)
+	}); ok {
+		c.SetUplinkMetadata(md)
+	}
+}
+
+// SetApplicationID sets the ID of the application for which the payload is
+// decoded or encoded, in case the given codec supports this.
+func SetApplicationID(pl Payload, id int64) {
+	if c, ok := pl.(interface {
+		SetApplicationID(int64)
+	}); ok {
+		c.SetApplicationID(id)
+	}
+}
+
 // NewPayload returns a new codec payload. In case of an unknown Type, nil is
-// returned.
+// returned. For the BinarySchemaType, the decodeScript must contain the
+// (JSON encoded) schema, for the ProtobufType the (JSON encoded)
+// ProtobufConfig.
 func NewPayload(t Type, fPort uint8, encodeScript, decodeScript string) Payload {
 	switch t {
 	case CayenneLPPType:
 		return &CayenneLPP{}
+	case CayenneLPPPackedType:
+		return NewCayenneLPPPacked()
 	case CustomJSType:
 		return NewCustomJS(fPort, encodeScript, decodeScript)
+	case BinarySchemaType:
+		return NewBinarySchema(fPort, decodeScript)
+	case ProtobufType:
+		return NewProtobuf(fPort, decodeScript)
+	default:
+		return nil
+	}
+}
+
+// Validate validates the given codec configuration.
+func Validate(t Type, encodeScript, decodeScript string) error {
+	switch t {
+	case BinarySchemaType:
+		_, err := ParseSchema(decodeScript)
+		return err
+	case ProtobufType:
+		_, err := ParseProtobufConfig(decodeScript)
+		return err
 	default:
 		return nil
 	}
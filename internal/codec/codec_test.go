@@ -0,0 +1,167 @@
+package codec
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetGPSLocation(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name             string
+			Script           string
+			ExpectedLocation GPSLocation
+			ExpectedOK       bool
+		}{
+			{
+				Name: "top-level latitude, longitude and altitude",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"latitude": 52.3741, "longitude": 4.9144, "altitude": 10, "temperature": 21.5};
+					}
+				`,
+				ExpectedLocation: GPSLocation{Latitude: 52.3741, Longitude: 4.9144, Altitude: 10},
+				ExpectedOK:       true,
+			},
+			{
+				Name: "without altitude",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"latitude": -33.8688, "longitude": 151.2093};
+					}
+				`,
+				ExpectedLocation: GPSLocation{Latitude: -33.8688, Longitude: 151.2093},
+				ExpectedOK:       true,
+			},
+			{
+				Name: "without longitude",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"latitude": 52.3741};
+					}
+				`,
+			},
+			{
+				Name: "nested location",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"gps": {"latitude": 52.3741, "longitude": 4.9144}};
+					}
+				`,
+			},
+			{
+				Name: "non-numeric latitude",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"latitude": "52.3741", "longitude": 4.9144};
+					}
+				`,
+			},
+			{
+				Name: "latitude out of range",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"latitude": 91, "longitude": 4.9144};
+					}
+				`,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				codec := NewCustomJS(1, "", test.Script)
+				So(codec.DecodeBytes([]byte{1}), ShouldBeNil)
+
+				loc, ok := GetGPSLocation(codec)
+				So(ok, ShouldEqual, test.ExpectedOK)
+				So(loc, ShouldResemble, test.ExpectedLocation)
+			})
+		}
+	})
+}
+
+func TestGetObjectFields(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name           string
+			Script         string
+			ExpectedFields map[string]interface{}
+		}{
+			{
+				Name: "top-level fields",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"temperature": 21.5, "door": "open"};
+					}
+				`,
+				ExpectedFields: map[string]interface{}{
+					"temperature": 21.5,
+					"door":        "open",
+				},
+			},
+			{
+				Name: "nested fields",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"temperatureSensor": {"3": 21.5, "5": 22.0}, "gps": {"location": {"latitude": 52.3741}}};
+					}
+				`,
+				ExpectedFields: map[string]interface{}{
+					"temperatureSensor.3":   21.5,
+					"temperatureSensor.5":   22.0,
+					"gps.location.latitude": 52.3741,
+				},
+			},
+			{
+				Name: "array",
+				Script: `
+					function Decode(fPort, bytes) {
+						return {"values": [1, 2]};
+					}
+				`,
+				ExpectedFields: map[string]interface{}{
+					"values": []interface{}{1.0, 2.0},
+				},
+			},
+			{
+				Name: "not an object",
+				Script: `
+					function Decode(fPort, bytes) {
+						return [1, 2];
+					}
+				`,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				codec := NewCustomJS(1, "", test.Script)
+				So(codec.DecodeBytes([]byte{1}), ShouldBeNil)
+
+				fields, err := GetObjectFields(codec)
+				So(err, ShouldBeNil)
+				So(fields, ShouldResemble, test.ExpectedFields)
+			})
+		}
+	})
+}
+
+func TestGetObjectFieldsCayenneLPP(t *testing.T) {
+	Convey("Given two decoded Cayenne LPP payloads with different channels", t, func() {
+		var lpp1, lpp2 CayenneLPP
+		So(lpp1.DecodeBytes([]byte{3, 103, 1, 16}), ShouldBeNil)
+		So(lpp2.DecodeBytes([]byte{5, 103, 0, 255}), ShouldBeNil)
+
+		Convey("Then both result in a field for each channel", func() {
+			fields1, err := GetObjectFields(&lpp1)
+			So(err, ShouldBeNil)
+			So(fields1, ShouldResemble, map[string]interface{}{"temperatureSensor.3": 27.2})
+
+			fields2, err := GetObjectFields(&lpp2)
+			So(err, ShouldBeNil)
+			So(fields2, ShouldResemble, map[string]interface{}{"temperatureSensor.5": 25.5})
+		})
+	})
+}
@@ -21,10 +21,13 @@ var CodecMaxExecTime = 10 * time.Millisecond
 
 // CustomJS is a scriptable JS codec.
 type CustomJS struct {
-	fPort        uint8
-	encodeScript string
-	decodeScript string
-	Data         interface{}
+	fPort         uint8
+	applicationID int64
+	encodeScript  string
+	decodeScript  string
+	console       []string
+	metadata      *UplinkMetadata
+	Data          interface{}
 }
 
 // NewCustomJS creates a new custom JS codec.
@@ -41,6 +44,24 @@ func (c CustomJS) Object() interface{} {
 	return c.Data
 }
 
+// ConsoleOutput returns the console output (console.log) of the last
+// DecodeBytes or EncodeToBytes call.
+func (c CustomJS) ConsoleOutput() []string {
+	return c.console
+}
+
+// SetUplinkMetadata sets the uplink metadata and device variables which are
+// passed to the Decode function.
+func (c *CustomJS) SetUplinkMetadata(md UplinkMetadata) {
+	c.metadata = &md
+}
+
+// SetApplicationID sets the ID of the application to which the scripts
+// belong. Compiled scripts are cached per application.
+func (c *CustomJS) SetApplicationID(id int64) {
+	c.applicationID = id
+}
+
 // MarshalJSON implements json.Marshaler.
 func (c CustomJS) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.Data)
@@ -51,83 +72,76 @@ func (c *CustomJS) UnmarshalJSON(text []byte) error {
 	return json.Unmarshal(text, &c.Data)
 }
 
-// DecodeBytes decodes the payload from a slice of bytes.
-func (c *CustomJS) DecodeBytes(data []byte) (err error) {
-	defer func() {
-		if caught := recover(); caught != nil {
-			err = fmt.Errorf("%s", caught)
+// DecodeBytes decodes the payload from a slice of bytes. Besides the fPort
+// and bytes, the Decode function is called with the device variables and
+// uplink metadata (both empty objects when not set).
+func (c *CustomJS) DecodeBytes(data []byte) error {
+	variables, metadata, err := c.uplinkMetadataArgs()
+	if err != nil {
+		return err
+	}
+
+	c.console, err = executeScript(c.applicationID, c.decodeScript, "Decode", func(val otto.Value) error {
+		if !val.IsObject() {
+			return errors.New("function must return object")
 		}
-	}()
 
-	script := c.decodeScript + "\n\nDecode(fPort, bytes);\n"
+		var err error
+		c.Data, err = val.Export()
+		if err != nil {
+			return errors.Wrap(err, "export error")
+		}
 
-	vm := otto.New()
-	vm.Interrupt = make(chan func(), 1)
-	vm.SetStackDepthLimit(32)
-	vm.Set("bytes", data)
-	vm.Set("fPort", c.fPort)
+		return nil
+	}, c.fPort, data, variables, metadata)
+	return err
+}
 
-	go func() {
-		time.Sleep(CodecMaxExecTime)
-		vm.Interrupt <- func() {
-			panic(errors.New("execution timeout"))
-		}
-	}()
+// uplinkMetadataArgs returns the device variables and uplink metadata as
+// plain maps, so that the JS object keys match the JSON field names.
+func (c *CustomJS) uplinkMetadataArgs() (map[string]interface{}, map[string]interface{}, error) {
+	variables := make(map[string]interface{})
+	metadata := make(map[string]interface{})
 
-	var val otto.Value
-	val, err = vm.Run(script)
-	if err != nil {
-		return errors.Wrap(err, "js vm error")
+	if c.metadata == nil {
+		return variables, metadata, nil
 	}
 
-	if !val.IsObject() {
-		return errors.New("function must return object")
+	for k, v := range c.metadata.Variables {
+		variables[k] = v
 	}
 
-	c.Data, err = val.Export()
+	b, err := json.Marshal(c.metadata)
 	if err != nil {
-		return errors.Wrap(err, "export error")
+		return nil, nil, errors.Wrap(err, "marshal uplink metadata error")
+	}
+	if err := json.Unmarshal(b, &metadata); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal uplink metadata error")
 	}
 
-	return nil
+	return variables, metadata, nil
 }
 
 // EncodeToBytes encodes the payload to a slice of bytes.
-func (c CustomJS) EncodeToBytes() (b []byte, err error) {
-	defer func() {
-		if caught := recover(); caught != nil {
-			err = fmt.Errorf("%s", caught)
-		}
-	}()
-
-	script := c.encodeScript + "\n\nEncode(fPort, obj);\n"
-
-	vm := otto.New()
-	vm.Interrupt = make(chan func(), 1)
-	vm.SetStackDepthLimit(32)
-	vm.Set("obj", c.Data)
-	vm.Set("fPort", c.fPort)
+func (c *CustomJS) EncodeToBytes() ([]byte, error) {
+	var out interface{}
+	var err error
 
-	go func() {
-		time.Sleep(CodecMaxExecTime)
-		vm.Interrupt <- func() {
-			panic(errors.New("execution timeout"))
+	c.console, err = executeScript(c.applicationID, c.encodeScript, "Encode", func(val otto.Value) error {
+		if !val.IsObject() {
+			return errors.New("function must return an array")
 		}
-	}()
 
-	var val otto.Value
-	val, err = vm.Run(script)
-	if err != nil {
-		return nil, errors.Wrap(err, "js vm error")
-	}
-	if !val.IsObject() {
-		return nil, errors.New("function must return an array")
-	}
+		var err error
+		out, err = val.Export()
+		if err != nil {
+			return errors.Wrap(err, "export error")
+		}
 
-	var out interface{}
-	out, err = val.Export()
+		return nil
+	}, c.fPort, c.Data)
 	if err != nil {
-		return nil, errors.Wrap(err, "export error")
+		return nil, err
 	}
 
 	return interfaceToByteSlice(out)
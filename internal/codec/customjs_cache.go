@@ -0,0 +1,324 @@
+package codec
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+)
+
+// CodecScriptCacheSize holds the max. number of compiled (custom) codec
+// scripts that are kept in the cache.
+var CodecScriptCacheSize = 1000
+
+// CodecVMPoolSize holds the max. number of idle VMs that are kept per
+// compiled (custom) codec script, for re-use by the next executions. The
+// total number of idle VMs is bounded by CodecScriptCacheSize times
+// CodecVMPoolSize.
+var CodecVMPoolSize = 4
+
+// CodecMaxConsoleLines holds the max. number of console.log lines captured
+// per (custom) codec execution.
+var CodecMaxConsoleLines = 100
+
+// maxConsoleLineLength holds the max. length of a captured console.log line.
+const maxConsoleLineLength = 1024
+
+var errExecTimeout = errors.New("execution timeout")
+
+var scripts = newScriptCache()
+
+// globalNamesScript returns the names of the global variables and functions.
+var globalNamesScript = mustCompile("Object.getOwnPropertyNames(this)")
+
+// InvalidateScriptCache removes the given scripts from the compiled script
+// cache, for all applications. This must be called after a script has been
+// updated or removed, so that the compiled program can be garbage-collected.
+func InvalidateScriptCache(scriptSources ...string) {
+	for _, src := range scriptSources {
+		scripts.remove(src)
+	}
+}
+
+// scriptKey identifies a compiled script. Compiled scripts are not shared
+// between applications.
+type scriptKey struct {
+	applicationID int64
+	src           [sha256.Size]byte
+}
+
+// compiledScript holds a compiled script and the idle VMs which have been
+// used to execute this script.
+type compiledScript struct {
+	key    scriptKey
+	script *otto.Script
+	vms    chan *jsVM
+}
+
+// getVM returns an idle VM or a new VM when there is no idle VM.
+func (cs *compiledScript) getVM() *jsVM {
+	select {
+	case vm := <-cs.vms:
+		return vm
+	default:
+		return newJSVM()
+	}
+}
+
+// putVM returns the given VM to the pool of idle VMs. The VM is discarded
+// when the pool is full.
+func (cs *compiledScript) putVM(vm *jsVM) {
+	select {
+	case cs.vms <- vm:
+	default:
+	}
+}
+
+// jsVM wraps a JS VM and captures the console output of the execution.
+type jsVM struct {
+	*otto.Otto
+	console []string
+
+	// builtins holds the names of the globals of a new VM, all other
+	// globals are defined by the executed script.
+	builtins map[string]struct{}
+}
+
+func newJSVM() *jsVM {
+	vm := jsVM{
+		Otto: otto.New(),
+	}
+	vm.Interrupt = make(chan func(), 1)
+	vm.SetStackDepthLimit(32)
+	vm.Set("console", map[string]interface{}{
+		"log": vm.consoleLog,
+	})
+
+	vm.builtins = make(map[string]struct{})
+	for _, name := range vm.globalNames() {
+		vm.builtins[name] = struct{}{}
+	}
+
+	return &vm
+}
+
+// globalNames returns the names of the globals of the VM.
+func (vm *jsVM) globalNames() []string {
+	val, err := vm.Run(globalNamesScript)
+	if err != nil {
+		return nil
+	}
+
+	exp, err := val.Export()
+	if err != nil {
+		return nil
+	}
+
+	names, _ := exp.([]string)
+	return names
+}
+
+// reset sets the globals defined by the previously executed script to
+// undefined, so that no state is shared with the previous execution.
+// Note that changes made by the script to the builtin objects (e.g. the
+// Object prototype) are not reverted, for this reason VMs are only re-used
+// for the same compiled script (of the same application).
+func (vm *jsVM) reset() {
+	for _, name := range vm.globalNames() {
+		if _, ok := vm.builtins[name]; !ok {
+			vm.Set(name, otto.UndefinedValue())
+		}
+	}
+	vm.console = nil
+}
+
+// consoleLog implements console.log.
+func (vm *jsVM) consoleLog(call otto.FunctionCall) otto.Value {
+	if len(vm.console) >= CodecMaxConsoleLines {
+		return otto.UndefinedValue()
+	}
+
+	var args []string
+	for _, arg := range call.ArgumentList {
+		str := arg.String()
+		if arg.IsObject() {
+			if v, err := call.Otto.Call("JSON.stringify", nil, arg); err == nil {
+				str = v.String()
+			}
+		}
+		args = append(args, str)
+	}
+
+	line := strings.Join(args, " ")
+	if len(line) > maxConsoleLineLength {
+		line = line[:maxConsoleLineLength]
+	}
+	vm.console = append(vm.console, line)
+
+	return otto.UndefinedValue()
+}
+
+// scriptCache implements a LRU cache of compiled scripts, keyed by the
+// application ID and the SHA256 hash of the script source. Besides the
+// compiled program, each cached script holds a bounded pool of VMs which
+// are reset before each execution.
+type scriptCache struct {
+	sync.Mutex
+	ll    *list.List
+	items map[scriptKey]*list.Element
+}
+
+func newScriptCache() *scriptCache {
+	return &scriptCache{
+		ll:    list.New(),
+		items: make(map[scriptKey]*list.Element),
+	}
+}
+
+// get returns the compiled script for the given application ID and source.
+// In case the script is not yet in the cache, it will be compiled and added
+// to the cache.
+func (s *scriptCache) get(applicationID int64, src string) (*compiledScript, error) {
+	key := scriptKey{
+		applicationID: applicationID,
+		src:           sha256.Sum256([]byte(src)),
+	}
+
+	s.Lock()
+	if el, ok := s.items[key]; ok {
+		s.ll.MoveToFront(el)
+		s.Unlock()
+		return el.Value.(*compiledScript), nil
+	}
+	s.Unlock()
+
+	// compile outside the lock, in the worst case the same script is
+	// compiled concurrently and one of the results is discarded
+	script, err := otto.New().Compile("", src)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := compiledScript{
+		key:    key,
+		script: script,
+		vms:    make(chan *jsVM, CodecVMPoolSize),
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if el, ok := s.items[key]; ok {
+		s.ll.MoveToFront(el)
+		return el.Value.(*compiledScript), nil
+	}
+
+	s.items[key] = s.ll.PushFront(&cs)
+	for s.ll.Len() > CodecScriptCacheSize {
+		el := s.ll.Back()
+		s.ll.Remove(el)
+		delete(s.items, el.Value.(*compiledScript).key)
+	}
+
+	return &cs, nil
+}
+
+// remove removes the given script source from the cache, for all
+// applications.
+func (s *scriptCache) remove(src string) {
+	hash := sha256.Sum256([]byte(src))
+
+	s.Lock()
+	defer s.Unlock()
+
+	for el := s.ll.Front(); el != nil; {
+		next := el.Next()
+		if key := el.Value.(*compiledScript).key; key.src == hash {
+			s.ll.Remove(el)
+			delete(s.items, key)
+		}
+		el = next
+	}
+}
+
+// executeScript calls the function fn, defined by the given script source,
+// with the given arguments. The compiled script is cached per application
+// and executed using a VM of the pool of the compiled script. Scripts
+// without application ID (e.g. scripts run by the codec test API) are not
+// cached and are executed in a new VM. The handler function is called with
+// the return value of fn. The execution is interrupted when it exceeds
+// CodecMaxExecTime. It returns the captured console output, also in case of
+// an error.
+func executeScript(applicationID int64, src, fn string, handler func(otto.Value) error, args ...interface{}) (console []string, err error) {
+	var cs *compiledScript
+	var vm *jsVM
+
+	if applicationID == 0 {
+		script, err := otto.New().Compile("", src)
+		if err != nil {
+			return nil, errors.Wrap(err, "js vm error")
+		}
+		cs = &compiledScript{script: script}
+		vm = newJSVM()
+	} else {
+		cs, err = scripts.get(applicationID, src)
+		if err != nil {
+			return nil, errors.Wrap(err, "js vm error")
+		}
+		vm = cs.getVM()
+	}
+
+	timer := time.AfterFunc(CodecMaxExecTime, func() {
+		vm.Interrupt <- func() {
+			panic(errExecTimeout)
+		}
+	})
+
+	err = func() (err error) {
+		defer func() {
+			if caught := recover(); caught != nil {
+				err = fmt.Errorf("%s", caught)
+			}
+		}()
+
+		// the script is (re-)run to (re-)initialize its globals, this is
+		// part of the execution as the script might not return
+		vm.reset()
+		if _, err := vm.Run(cs.script); err != nil {
+			return errors.Wrap(err, "js vm error")
+		}
+
+		val, err := vm.Call(fn, nil, args...)
+		if err != nil {
+			return errors.Wrap(err, "js vm error")
+		}
+
+		return handler(val)
+	}()
+
+	// the console output is only referenced by the returned slice
+	console = vm.console
+	vm.console = nil
+
+	// the VM is discarded in case of an error or when the timer fired, as
+	// the (pending) interrupt could affect the next execution
+	if timer.Stop() && err == nil && cs.vms != nil {
+		cs.putVM(vm)
+	}
+
+	return console, err
+}
+
+// mustCompile compiles the given script source or panics.
+func mustCompile(src string) *otto.Script {
+	script, err := otto.New().Compile("", src)
+	if err != nil {
+		panic(err)
+	}
+	return script
+}
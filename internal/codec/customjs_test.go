@@ -1,11 +1,17 @@
 package codec
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
 )
 
 func TestCustomJSDecode(t *testing.T) {
@@ -175,3 +181,351 @@ func TestCustomEncodeJS(t *testing.T) {
 		}
 	})
 }
+
+func TestCustomJSScriptCache(t *testing.T) {
+	Convey("Given a decoder script", t, func() {
+		script := `
+			function Decode(fPort, bytes) {
+				return {"fPort": fPort};
+			}
+		`
+		InvalidateScriptCache(script)
+
+		Convey("When decoding a payload", func() {
+			js := NewCustomJS(10, "", script)
+			js.SetApplicationID(1)
+			So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+
+			Convey("Then the compiled script has been cached for the application", func() {
+				cs, err := scripts.get(1, script)
+				So(err, ShouldBeNil)
+
+				Convey("When decoding again, the compiled script is re-used", func() {
+					js := NewCustomJS(20, "", script)
+					js.SetApplicationID(1)
+					So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+					So(js.Data.(map[string]interface{})["fPort"], ShouldEqual, 20)
+
+					cs2, err := scripts.get(1, script)
+					So(err, ShouldBeNil)
+					So(cs2, ShouldEqual, cs)
+				})
+
+				Convey("Then an other application does not share the compiled script", func() {
+					cs2, err := scripts.get(2, script)
+					So(err, ShouldBeNil)
+					So(cs2, ShouldNotEqual, cs)
+				})
+
+				Convey("Then a script without application ID is not cached", func() {
+					InvalidateScriptCache(script)
+
+					js := NewCustomJS(10, "", script)
+					So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+
+					scripts.Lock()
+					defer scripts.Unlock()
+					_, ok := scripts.items[scriptKey{src: sha256.Sum256([]byte(script))}]
+					So(ok, ShouldBeFalse)
+				})
+
+				Convey("When invalidating the script", func() {
+					InvalidateScriptCache(script)
+
+					Convey("Then a new compiled script is returned", func() {
+						cs2, err := scripts.get(1, script)
+						So(err, ShouldBeNil)
+						So(cs2, ShouldNotEqual, cs)
+					})
+				})
+			})
+		})
+
+		Convey("When the execution times out", func() {
+			script := `
+				function Decode(fPort, bytes) {
+					if (fPort == 1) {
+						while(true) {}
+					}
+					return {};
+				}
+			`
+			InvalidateScriptCache(script)
+
+			js := NewCustomJS(1, "", script)
+			js.SetApplicationID(1)
+			So(js.DecodeBytes([]byte{1}), ShouldNotBeNil)
+
+			Convey("Then the VM is not returned to the pool", func() {
+				cs, err := scripts.get(1, script)
+				So(err, ShouldBeNil)
+				So(cs.vms, ShouldHaveLength, 0)
+			})
+
+			Convey("Then the next execution succeeds", func() {
+				js := NewCustomJS(2, "", script)
+				js.SetApplicationID(1)
+				So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a decoder script storing state in a global variable", t, func() {
+		script := `
+			var last;
+
+			function Decode(fPort, bytes) {
+				var prev = last;
+				last = bytes[0];
+				return {"prev": prev};
+			}
+		`
+
+		Convey("Then the global state does not leak between executions", func() {
+			js := NewCustomJS(1, "", script)
+			So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+			So(js.Data.(map[string]interface{})["prev"], ShouldBeNil)
+
+			js = NewCustomJS(1, "", script)
+			So(js.DecodeBytes([]byte{2}), ShouldBeNil)
+			So(js.Data.(map[string]interface{})["prev"], ShouldBeNil)
+		})
+
+		Convey("Then the global state does not leak between executions using a pooled VM", func() {
+			InvalidateScriptCache(script)
+
+			js := NewCustomJS(1, "", script)
+			js.SetApplicationID(1)
+			So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+			So(js.Data.(map[string]interface{})["prev"], ShouldBeNil)
+
+			cs, err := scripts.get(1, script)
+			So(err, ShouldBeNil)
+			So(cs.vms, ShouldHaveLength, 1)
+
+			js = NewCustomJS(1, "", script)
+			js.SetApplicationID(1)
+			So(js.DecodeBytes([]byte{2}), ShouldBeNil)
+			So(js.Data.(map[string]interface{})["prev"], ShouldBeNil)
+			So(cs.vms, ShouldHaveLength, 1)
+		})
+	})
+}
+
+func TestCustomJSConcurrent(t *testing.T) {
+	Convey("Given a decoder script using console.log and a global variable", t, func() {
+		script := `
+			var count = 0;
+
+			function Decode(fPort, bytes) {
+				count++;
+				console.log("fPort:", fPort);
+				return {"fPort": fPort, "count": count};
+			}
+		`
+
+		// the concurrent executions (especially with -race) could exceed the
+		// default max. execution time
+		maxExecTime := CodecMaxExecTime
+		CodecMaxExecTime = time.Second
+		Reset(func() {
+			CodecMaxExecTime = maxExecTime
+		})
+
+		Convey("When decoding concurrently for multiple applications", func() {
+			var wg sync.WaitGroup
+			errs := make(chan error, 50)
+
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					js := NewCustomJS(uint8(i), "", script)
+					js.SetApplicationID(int64(i % 5))
+					if err := js.DecodeBytes([]byte{1}); err != nil {
+						errs <- err
+						return
+					}
+
+					obj := js.Data.(map[string]interface{})
+					if fmt.Sprint(obj["fPort"]) != fmt.Sprint(i) {
+						errs <- fmt.Errorf("expected fPort %d, got: %v", i, obj["fPort"])
+					}
+					if fmt.Sprint(obj["count"]) != "1" {
+						errs <- fmt.Errorf("expected count 1, got: %v", obj["count"])
+					}
+					if out := js.ConsoleOutput(); len(out) != 1 || out[0] != fmt.Sprintf("fPort: %d", i) {
+						errs <- fmt.Errorf("unexpected console output: %v", out)
+					}
+				}(i)
+			}
+
+			wg.Wait()
+			close(errs)
+
+			Convey("Then each execution returns its own result and console output", func() {
+				for err := range errs {
+					So(err, ShouldBeNil)
+				}
+			})
+
+			Convey("Then the number of pooled VMs is bounded", func() {
+				for id := int64(1); id < 5; id++ {
+					cs, err := scripts.get(id, script)
+					So(err, ShouldBeNil)
+					So(len(cs.vms), ShouldBeGreaterThan, 0)
+					So(len(cs.vms), ShouldBeLessThanOrEqualTo, CodecVMPoolSize)
+				}
+			})
+		})
+	})
+}
+
+func TestCustomJSConsole(t *testing.T) {
+	Convey("Given a decoder and encoder script using console.log", t, func() {
+		decodeScript := `
+			function Decode(fPort, bytes) {
+				console.log("fPort:", fPort);
+				console.log({"length": bytes.length});
+				if (fPort == 2) {
+					throw "invalid fPort";
+				}
+				return {};
+			}
+		`
+		encodeScript := `
+			function Encode(fPort, obj) {
+				for (var i = 0; i < 200; i++) {
+					console.log(i);
+				}
+				return [];
+			}
+		`
+
+		Convey("Then the console output is captured when decoding", func() {
+			js := NewCustomJS(1, encodeScript, decodeScript)
+			So(js.DecodeBytes([]byte{1, 2}), ShouldBeNil)
+			So(js.ConsoleOutput(), ShouldResemble, []string{"fPort: 1", `{"length":2}`})
+
+			Convey("Then the console output is reset on the next execution", func() {
+				js := NewCustomJS(1, encodeScript, decodeScript)
+				So(js.DecodeBytes([]byte{1}), ShouldBeNil)
+				So(GetConsoleOutput(js), ShouldResemble, []string{"fPort: 1", `{"length":1}`})
+			})
+		})
+
+		Convey("Then the console output is captured in case of an error", func() {
+			js := NewCustomJS(2, encodeScript, decodeScript)
+			So(js.DecodeBytes([]byte{1}), ShouldNotBeNil)
+			So(js.ConsoleOutput(), ShouldResemble, []string{"fPort: 2", `{"length":1}`})
+		})
+
+		Convey("Then the number of captured lines is limited when encoding", func() {
+			js := NewCustomJS(1, encodeScript, decodeScript)
+			So(js.UnmarshalJSON([]byte(`{}`)), ShouldBeNil)
+			_, err := js.EncodeToBytes()
+			So(err, ShouldBeNil)
+			So(js.ConsoleOutput(), ShouldHaveLength, CodecMaxConsoleLines)
+			So(js.ConsoleOutput()[0], ShouldEqual, "0")
+		})
+
+		Convey("Then codecs without console support return no output", func() {
+			So(GetConsoleOutput(&CayenneLPP{}), ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomJSUplinkMetadata(t *testing.T) {
+	Convey("Given a decoder script using the variables and uplink metadata", t, func() {
+		decodeScript := `
+			function Decode(fPort, bytes, variables, uplink) {
+				return {
+					"temperature": bytes[0] * parseFloat(variables.calibration || "1"),
+					"devEUI": uplink.devEUI,
+					"deviceName": uplink.deviceName,
+					"fCnt": uplink.fCnt,
+					"time": uplink.time,
+					"gateways": uplink.rxInfo ? uplink.rxInfo.length : 0,
+					"rssi": uplink.rxInfo ? uplink.rxInfo[0].rssi : null,
+					"loRaSNR": uplink.rxInfo ? uplink.rxInfo[0].loRaSNR : null
+				};
+			}
+		`
+
+		Convey("When the uplink metadata is set", func() {
+			js := NewCustomJS(1, "", decodeScript)
+			SetUplinkMetadata(js, UplinkMetadata{
+				DevEUI:     lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				DeviceName: "test-device",
+				FCnt:       10,
+				Time:       time.Date(2018, 10, 1, 12, 30, 0, 0, time.UTC),
+				RXInfo: []UplinkRXInfo{
+					{
+						GatewayID: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+						RSSI:      -60,
+						LoRaSNR:   5.5,
+					},
+				},
+				Variables: map[string]string{
+					"calibration": "1.5",
+				},
+			})
+
+			Convey("Then the variables and metadata are passed to Decode", func() {
+				So(js.DecodeBytes([]byte{20}), ShouldBeNil)
+				b, err := json.Marshal(js)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"devEUI":"0102030405060708","deviceName":"test-device","fCnt":10,"gateways":1,"loRaSNR":5.5,"rssi":-60,"temperature":30,"time":"2018-10-01T12:30:00Z"}`)
+			})
+		})
+
+		Convey("When no uplink metadata is set", func() {
+			js := NewCustomJS(1, "", decodeScript)
+
+			Convey("Then empty objects are passed to Decode", func() {
+				So(js.DecodeBytes([]byte{20}), ShouldBeNil)
+				b, err := json.Marshal(js)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"gateways":0,"loRaSNR":null,"rssi":null,"temperature":20}`)
+			})
+		})
+	})
+}
+
+var benchmarkDecodeScript = `
+	function Decode(fPort, bytes) {
+		var temp = (bytes[0] << 8 | bytes[1]) / 10;
+		var humidity = bytes[2] / 2;
+		return {
+			"temperature": temp,
+			"humidity": humidity,
+			"battery": bytes[3]
+		};
+	}
+`
+
+func BenchmarkCustomJSDecode(b *testing.B) {
+	payload := []byte{1, 16, 160, 99}
+
+	b.Run("Pooled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			js := NewCustomJS(1, "", benchmarkDecodeScript)
+			js.SetApplicationID(1)
+			if err := js.DecodeBytes(payload); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			InvalidateScriptCache(benchmarkDecodeScript)
+			js := NewCustomJS(1, "", benchmarkDecodeScript)
+			js.SetApplicationID(1)
+			if err := js.DecodeBytes(payload); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
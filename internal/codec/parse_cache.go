@@ -0,0 +1,73 @@
+package codec
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+)
+
+// CodecParseCacheSize holds the max. number of parsed codec configurations
+// (e.g. binary schemas) that are kept in the cache (per codec type).
+var CodecParseCacheSize = 1000
+
+// parseCache implements a LRU cache of parsed codec configurations, keyed
+// by the SHA256 hash of the configuration source. The cached values are
+// shared and must not be modified.
+type parseCache struct {
+	sync.Mutex
+	parse func(src string) (interface{}, error)
+	ll    *list.List
+	items map[[sha256.Size]byte]*list.Element
+}
+
+type parseCacheItem struct {
+	key   [sha256.Size]byte
+	value interface{}
+}
+
+func newParseCache(parse func(src string) (interface{}, error)) *parseCache {
+	return &parseCache{
+		parse: parse,
+		ll:    list.New(),
+		items: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// get returns the parsed configuration for the given source. In case the
+// source is not yet in the cache, it will be parsed and added to the cache.
+// Parse errors are not cached.
+func (c *parseCache) get(src string) (interface{}, error) {
+	key := sha256.Sum256([]byte(src))
+
+	c.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.Unlock()
+		return el.Value.(*parseCacheItem).value, nil
+	}
+	c.Unlock()
+
+	// parse outside the lock, in the worst case the same source is parsed
+	// concurrently and one of the results is discarded
+	value, err := c.parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return el.Value.(*parseCacheItem).value, nil
+	}
+
+	c.items[key] = c.ll.PushFront(&parseCacheItem{key: key, value: value})
+	for c.ll.Len() > CodecParseCacheSize {
+		el := c.ll.Back()
+		c.ll.Remove(el)
+		delete(c.items, el.Value.(*parseCacheItem).key)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,874 @@
+package codec
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/pkg/errors"
+)
+
+func init() {
+	gob.Register(Protobuf{})
+}
+
+// Protobuf wire-types.
+const (
+	wireVarint     = 0
+	wireFixed64    = 1
+	wireBytes      = 2
+	wireStartGroup = 3
+	wireFixed32    = 5
+)
+
+// ProtobufConfig defines the configuration of the protobuf codec.
+type ProtobufConfig struct {
+	// FileDescriptorSet contains the compiled (binary) FileDescriptorSet,
+	// e.g. as generated by:
+	// protoc --include_imports --descriptor_set_out=payload.pb payload.proto
+	FileDescriptorSet []byte `json:"fileDescriptorSet"`
+
+	// Messages contains the fully-qualified message name per fPort.
+	Messages map[uint8]string `json:"messages"`
+}
+
+// ParseProtobufConfig parses and validates the given (JSON encoded)
+// protobuf codec configuration.
+func ParseProtobufConfig(s string) (ProtobufConfig, error) {
+	conf, _, err := parseProtobufConfig(s)
+	return conf, err
+}
+
+// protobufConfigs caches the parsed protobuf codec configurations, so that
+// the FileDescriptorSet is not parsed on every DecodeBytes or EncodeToBytes
+// call.
+var protobufConfigs = newParseCache(func(src string) (interface{}, error) {
+	conf, reg, err := parseProtobufConfig(src)
+	if err != nil {
+		return nil, err
+	}
+	return parsedProtobufConfig{conf: conf, reg: reg}, nil
+})
+
+// parsedProtobufConfig holds a parsed protobuf codec configuration and its
+// message registry.
+type parsedProtobufConfig struct {
+	conf ProtobufConfig
+	reg  *protobufRegistry
+}
+
+func parseProtobufConfig(s string) (ProtobufConfig, *protobufRegistry, error) {
+	var conf ProtobufConfig
+	if err := json.Unmarshal([]byte(s), &conf); err != nil {
+		return conf, nil, errors.Wrap(err, "unmarshal config error")
+	}
+
+	reg, err := newProtobufRegistry(conf.FileDescriptorSet)
+	if err != nil {
+		return conf, nil, err
+	}
+
+	if len(conf.Messages) == 0 {
+		return conf, nil, errors.New("config must contain at least one message")
+	}
+
+	for fPort, name := range conf.Messages {
+		if _, err := reg.message(name); err != nil {
+			return conf, nil, fmt.Errorf("fPort %d: %s", fPort, err)
+		}
+	}
+
+	return conf, reg, nil
+}
+
+// protobufMessage holds a message descriptor and the syntax of the file in
+// which it has been defined.
+type protobufMessage struct {
+	*descriptor.DescriptorProto
+	proto3 bool
+}
+
+// field returns the field for the given field number.
+func (m protobufMessage) field(number int32) *descriptor.FieldDescriptorProto {
+	for _, f := range m.Field {
+		if f.GetNumber() == number {
+			return f
+		}
+	}
+	return nil
+}
+
+// protobufRegistry holds the messages and enums of a FileDescriptorSet,
+// keyed by their fully-qualified name (including the leading dot).
+type protobufRegistry struct {
+	messages map[string]protobufMessage
+	enums    map[string]*descriptor.EnumDescriptorProto
+}
+
+func newProtobufRegistry(b []byte) (*protobufRegistry, error) {
+	var fds descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(b, &fds); err != nil {
+		return nil, errors.Wrap(err, "unmarshal file descriptor set error")
+	}
+
+	if len(fds.File) == 0 {
+		return nil, errors.New("file descriptor set must contain at least one file")
+	}
+
+	reg := protobufRegistry{
+		messages: make(map[string]protobufMessage),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
+	}
+
+	for _, f := range fds.File {
+		prefix := ""
+		if f.GetPackage() != "" {
+			prefix = "." + f.GetPackage()
+		}
+
+		for _, e := range f.EnumType {
+			reg.enums[prefix+"."+e.GetName()] = e
+		}
+
+		reg.addMessages(prefix, f.MessageType, f.GetSyntax() == "proto3")
+	}
+
+	return &reg, nil
+}
+
+func (r *protobufRegistry) addMessages(prefix string, messages []*descriptor.DescriptorProto, proto3 bool) {
+	for _, m := range messages {
+		name := prefix + "." + m.GetName()
+		r.messages[name] = protobufMessage{DescriptorProto: m, proto3: proto3}
+
+		for _, e := range m.EnumType {
+			r.enums[name+"."+e.GetName()] = e
+		}
+
+		r.addMessages(name, m.NestedType, proto3)
+	}
+}
+
+// message returns the message for the given (fully-qualified) name.
+func (r *protobufRegistry) message(name string) (protobufMessage, error) {
+	if !strings.HasPrefix(name, ".") {
+		name = "." + name
+	}
+
+	m, ok := r.messages[name]
+	if !ok {
+		return m, fmt.Errorf("message %s does not exist", strings.TrimPrefix(name, "."))
+	}
+	return m, nil
+}
+
+// Protobuf is a codec which decodes and encodes the payload using the
+// protobuf message configured for the fPort of the payload.
+type Protobuf struct {
+	fPort  uint8
+	config string
+	Data   map[string]interface{}
+}
+
+// NewProtobuf creates a new protobuf codec.
+func NewProtobuf(fPort uint8, config string) *Protobuf {
+	return &Protobuf{
+		fPort:  fPort,
+		config: config,
+	}
+}
+
+// Object returns the object data.
+func (p Protobuf) Object() interface{} {
+	return p.Data
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Protobuf) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Data)
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (p *Protobuf) UnmarshalJSON(text []byte) error {
+	return json.Unmarshal(text, &p.Data)
+}
+
+// DecodeBytes decodes the payload from a slice of bytes.
+func (p *Protobuf) DecodeBytes(data []byte) error {
+	reg, m, err := p.message()
+	if err != nil {
+		return err
+	}
+
+	p.Data, err = reg.decode(m, data)
+	return err
+}
+
+// EncodeToBytes encodes the payload to a slice of bytes.
+func (p Protobuf) EncodeToBytes() ([]byte, error) {
+	reg, m, err := p.message()
+	if err != nil {
+		return nil, err
+	}
+
+	return reg.encode(m, p.Data)
+}
+
+func (p Protobuf) message() (*protobufRegistry, protobufMessage, error) {
+	var m protobufMessage
+
+	v, err := protobufConfigs.get(p.config)
+	if err != nil {
+		return nil, m, errors.Wrap(err, "parse config error")
+	}
+	parsed := v.(parsedProtobufConfig)
+
+	name, ok := parsed.conf.Messages[p.fPort]
+	if !ok {
+		return nil, m, fmt.Errorf("no message for fPort %d", p.fPort)
+	}
+
+	m, err = parsed.reg.message(name)
+	return parsed.reg, m, err
+}
+
+// decode decodes the given bytes into an object, using the protobuf field
+// names as keys.
+func (r *protobufRegistry) decode(m protobufMessage, b []byte) (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, errors.New("invalid field key")
+		}
+		b = b[n:]
+
+		number := int32(key >> 3)
+		wireType := int(key & 7)
+
+		raw, n, err := readWireValue(b, wireType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %d", number)
+		}
+		b = b[n:]
+
+		f := m.field(number)
+		if f == nil {
+			// unknown fields are skipped
+			continue
+		}
+
+		if err := r.decodeField(f, wireType, raw, out); err != nil {
+			return nil, errors.Wrapf(err, "field %s", f.GetName())
+		}
+	}
+
+	// proto3 does not transmit default values, set these so that the
+	// object always contains all (scalar) fields
+	if m.proto3 {
+		for _, f := range m.Field {
+			if _, ok := out[f.GetName()]; ok || f.OneofIndex != nil {
+				continue
+			}
+
+			if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+				if r.isMapField(f) {
+					out[f.GetName()] = map[string]interface{}{}
+				} else {
+					out[f.GetName()] = []interface{}{}
+				}
+				continue
+			}
+
+			if f.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+				out[f.GetName()], _ = r.decodeScalar(f, wireTypeForField(f), zeroWireValue(f))
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (r *protobufRegistry) decodeField(f *descriptor.FieldDescriptorProto, wireType int, raw interface{}, out map[string]interface{}) error {
+	name := f.GetName()
+
+	if f.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		if wireType != wireBytes {
+			return errors.New("invalid wire-type")
+		}
+
+		sub, err := r.message(f.GetTypeName())
+		if err != nil {
+			return err
+		}
+
+		val, err := r.decode(sub, raw.([]byte))
+		if err != nil {
+			return err
+		}
+
+		if r.isMapField(f) {
+			mv, ok := out[name].(map[string]interface{})
+			if !ok {
+				mv = make(map[string]interface{})
+				out[name] = mv
+			}
+
+			var key, value interface{}
+			for _, mf := range sub.Field {
+				switch mf.GetNumber() {
+				case 1:
+					key = val[mf.GetName()]
+				case 2:
+					value = val[mf.GetName()]
+				}
+			}
+			mv[fmt.Sprintf("%v", key)] = value
+			return nil
+		}
+
+		if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			list, _ := out[name].([]interface{})
+			out[name] = append(list, val)
+			return nil
+		}
+
+		out[name] = val
+		return nil
+	}
+
+	var vals []interface{}
+
+	if wireType == wireBytes && wireTypeForField(f) != wireBytes {
+		// packed repeated scalar values
+		b := raw.([]byte)
+		for len(b) > 0 {
+			v, n, err := readWireValue(b, wireTypeForField(f))
+			if err != nil {
+				return err
+			}
+			b = b[n:]
+
+			val, err := r.decodeScalar(f, wireTypeForField(f), v)
+			if err != nil {
+				return err
+			}
+			vals = append(vals, val)
+		}
+	} else {
+		val, err := r.decodeScalar(f, wireType, raw)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, val)
+	}
+
+	if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		list, _ := out[name].([]interface{})
+		out[name] = append(list, vals...)
+		return nil
+	}
+
+	// in case of multiple values for a singular field, the last value wins
+	out[name] = vals[len(vals)-1]
+	return nil
+}
+
+func (r *protobufRegistry) decodeScalar(f *descriptor.FieldDescriptorProto, wireType int, raw interface{}) (interface{}, error) {
+	if wireType != wireTypeForField(f) {
+		return nil, errors.New("invalid wire-type")
+	}
+
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32:
+		return int32(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64:
+		return int64(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32:
+		return uint32(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		return raw.(uint64), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		v := uint32(raw.(uint64))
+		return int32(v>>1) ^ -int32(v&1), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		v := raw.(uint64)
+		return int64(v>>1) ^ -int64(v&1), nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return raw.(uint64) != 0, nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		number := int32(raw.(uint64))
+		if e, ok := r.enums[f.GetTypeName()]; ok {
+			for _, v := range e.Value {
+				if v.GetNumber() == number {
+					return v.GetName(), nil
+				}
+			}
+		}
+		return number, nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return int32(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return math.Float32frombits(uint32(raw.(uint64))), nil
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return raw.(uint64), nil
+	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return int64(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return math.Float64frombits(raw.(uint64)), nil
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return string(raw.([]byte)), nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return append([]byte{}, raw.([]byte)...), nil
+	default:
+		return nil, fmt.Errorf("unsupported field type: %s", f.GetType())
+	}
+}
+
+// encode encodes the given object into bytes. Fields are encoded in
+// field-number order, fields missing in the object are omitted.
+func (r *protobufRegistry) encode(m protobufMessage, obj map[string]interface{}) ([]byte, error) {
+	fields := make([]*descriptor.FieldDescriptorProto, len(m.Field))
+	copy(fields, m.Field)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].GetNumber() < fields[j].GetNumber()
+	})
+
+	var out []byte
+
+	for _, f := range fields {
+		v, ok := obj[f.GetName()]
+		if !ok && f.GetJsonName() != "" {
+			v, ok = obj[f.GetJsonName()]
+		}
+		if !ok || v == nil {
+			continue
+		}
+
+		b, err := r.encodeField(m, f, v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "field %s", f.GetName())
+		}
+		out = append(out, b...)
+	}
+
+	return out, nil
+}
+
+func (r *protobufRegistry) encodeField(m protobufMessage, f *descriptor.FieldDescriptorProto, v interface{}) ([]byte, error) {
+	var out []byte
+
+	if r.isMapField(f) {
+		mv, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("value must be an object")
+		}
+
+		sub, err := r.message(f.GetTypeName())
+		if err != nil {
+			return nil, err
+		}
+
+		// sort the keys so that the output is deterministic
+		var keys []string
+		for k := range mv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			entry := make(map[string]interface{})
+			for _, mf := range sub.Field {
+				switch mf.GetNumber() {
+				case 1:
+					entry[mf.GetName()] = k
+				case 2:
+					entry[mf.GetName()] = mv[k]
+				}
+			}
+
+			b, err := r.encode(sub, entry)
+			if err != nil {
+				return nil, err
+			}
+			out = appendTag(out, f.GetNumber(), wireBytes)
+			out = appendBytes(out, b)
+		}
+
+		return out, nil
+	}
+
+	if f.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		// proto3 does not transmit default values
+		if m.proto3 && f.OneofIndex == nil && f.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			raw, err := r.scalarWireValue(f, v)
+			if err != nil {
+				return nil, err
+			}
+			if b, ok := raw.([]byte); (ok && len(b) == 0) || raw == uint64(0) {
+				return nil, nil
+			}
+		}
+
+		return r.encodeValue(f, v)
+	}
+
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, errors.New("value must be an array")
+	}
+
+	// scalar values are packed by default in proto3
+	packed := m.proto3
+	if f.GetOptions() != nil && f.GetOptions().Packed != nil {
+		packed = f.GetOptions().GetPacked()
+	}
+
+	if packed && wireTypeForField(f) != wireBytes {
+		var b []byte
+		for _, item := range list {
+			raw, err := r.scalarWireValue(f, item)
+			if err != nil {
+				return nil, err
+			}
+			b = appendWireValue(b, wireTypeForField(f), raw)
+		}
+		if len(b) == 0 {
+			return nil, nil
+		}
+		out = appendTag(out, f.GetNumber(), wireBytes)
+		return appendBytes(out, b), nil
+	}
+
+	for _, item := range list {
+		b, err := r.encodeValue(f, item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b...)
+	}
+
+	return out, nil
+}
+
+// encodeValue encodes a single (tagged) value.
+func (r *protobufRegistry) encodeValue(f *descriptor.FieldDescriptorProto, v interface{}) ([]byte, error) {
+	out := appendTag(nil, f.GetNumber(), wireTypeForField(f))
+
+	if f.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("value must be an object")
+		}
+
+		sub, err := r.message(f.GetTypeName())
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := r.encode(sub, obj)
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(out, b), nil
+	}
+
+	raw, err := r.scalarWireValue(f, v)
+	if err != nil {
+		return nil, err
+	}
+	return appendWireValue(out, wireTypeForField(f), raw), nil
+}
+
+// scalarWireValue returns the wire value (uint64 or []byte) for the given
+// scalar value.
+func (r *protobufRegistry) scalarWireValue(f *descriptor.FieldDescriptorProto, v interface{}) (interface{}, error) {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		i, err := protobufInt(v, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		if f.GetType() == descriptor.FieldDescriptorProto_TYPE_SFIXED32 {
+			return uint64(uint32(int32(i))), nil
+		}
+		return uint64(i), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		i, err := protobufInt(v, math.MinInt64, math.MaxInt64)
+		return uint64(i), err
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return protobufUint(v, math.MaxUint32)
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return protobufUint(v, math.MaxUint64)
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		i, err := protobufInt(v, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+		return uint64(uint32((int32(i) << 1) ^ (int32(i) >> 31))), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		i, err := protobufInt(v, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return nil, err
+		}
+		return uint64((i << 1) ^ (i >> 63)), nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if s, ok := v.(string); ok {
+			// map keys are always strings
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, errors.Wrap(err, "parse boolean error")
+			}
+			v = b
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, errors.New("value must be a boolean")
+		}
+		if b {
+			return uint64(1), nil
+		}
+		return uint64(0), nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if s, ok := v.(string); ok {
+			if e, ok := r.enums[f.GetTypeName()]; ok {
+				for _, ev := range e.Value {
+					if ev.GetName() == s {
+						return uint64(ev.GetNumber()), nil
+					}
+				}
+			}
+			return nil, fmt.Errorf("invalid enum value: %s", s)
+		}
+		i, err := protobufInt(v, math.MinInt32, math.MaxInt32)
+		return uint64(i), err
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		f, err := toFloat64(v)
+		return uint64(math.Float32bits(float32(f))), err
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		f, err := toFloat64(v)
+		return math.Float64bits(f), err
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.New("value must be a string")
+		}
+		return []byte(s), nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		switch v := v.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			b, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return nil, errors.Wrap(err, "decode base64 error")
+			}
+			return b, nil
+		default:
+			return nil, errors.New("value must be a base64 encoded string")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported field type: %s", f.GetType())
+	}
+}
+
+// isMapField returns true when the given field is a map field.
+func (r *protobufRegistry) isMapField(f *descriptor.FieldDescriptorProto) bool {
+	if f.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE || f.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return false
+	}
+
+	m, err := r.message(f.GetTypeName())
+	if err != nil {
+		return false
+	}
+	return m.GetOptions().GetMapEntry()
+}
+
+// wireTypeForField returns the (non-packed) wire-type for the given field.
+func wireTypeForField(f *descriptor.FieldDescriptorProto) int {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_FIXED64, descriptor.FieldDescriptorProto_TYPE_SFIXED64, descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return wireFixed64
+	case descriptor.FieldDescriptorProto_TYPE_FIXED32, descriptor.FieldDescriptorProto_TYPE_SFIXED32, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return wireFixed32
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES, descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return wireBytes
+	case descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return wireStartGroup
+	default:
+		return wireVarint
+	}
+}
+
+// zeroWireValue returns the wire value representing the zero value of the
+// given field.
+func zeroWireValue(f *descriptor.FieldDescriptorProto) interface{} {
+	if wireTypeForField(f) == wireBytes {
+		return []byte{}
+	}
+	return uint64(0)
+}
+
+// readWireValue reads a single value of the given wire-type. It returns the
+// value (uint64 or []byte) and the number of bytes read.
+func readWireValue(b []byte, wireType int) (interface{}, int, error) {
+	switch wireType {
+	case wireVarint:
+		v, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, 0, errors.New("invalid varint")
+		}
+		return v, n, nil
+	case wireFixed64:
+		if len(b) < 8 {
+			return nil, 0, errors.New("unexpected end of payload")
+		}
+		return binary.LittleEndian.Uint64(b), 8, nil
+	case wireFixed32:
+		if len(b) < 4 {
+			return nil, 0, errors.New("unexpected end of payload")
+		}
+		return uint64(binary.LittleEndian.Uint32(b)), 4, nil
+	case wireBytes:
+		l, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, 0, errors.New("invalid length")
+		}
+		if uint64(len(b)-n) < l {
+			return nil, 0, errors.New("unexpected end of payload")
+		}
+		return b[n : n+int(l)], n + int(l), nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported wire-type: %d", wireType)
+	}
+}
+
+func appendTag(b []byte, number int32, wireType int) []byte {
+	return appendVarint(b, uint64(number)<<3|uint64(wireType))
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func appendBytes(b []byte, v []byte) []byte {
+	b = appendVarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendWireValue(b []byte, wireType int, v interface{}) []byte {
+	switch wireType {
+	case wireFixed64:
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], v.(uint64))
+		return append(b, buf[:]...)
+	case wireFixed32:
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], uint32(v.(uint64)))
+		return append(b, buf[:]...)
+	case wireBytes:
+		return appendBytes(b, v.([]byte))
+	default:
+		return appendVarint(b, v.(uint64))
+	}
+}
+
+// protobufInt returns the given value as int64. Strings are accepted as
+// JSON numbers can't represent all 64 bit integers.
+func protobufInt(v interface{}, min, max int64) (int64, error) {
+	if s, ok := v.(string); ok {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, errors.Wrap(err, "parse integer error")
+		}
+		v = i
+	}
+
+	var i int64
+	switch v := v.(type) {
+	case int:
+		i = int64(v)
+	case int64:
+		i = v
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, errors.New("value out of range")
+		}
+		i = int64(v)
+	case int32:
+		i = int64(v)
+	case uint32:
+		i = int64(v)
+	default:
+		f, err := toFloat64(v)
+		if err != nil {
+			return 0, err
+		}
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, errors.New("value must be an integer")
+		}
+		i = int64(f)
+	}
+
+	if i < min || i > max {
+		return 0, errors.New("value out of range")
+	}
+	return i, nil
+}
+
+// protobufUint returns the given value as uint64.
+func protobufUint(v interface{}, max uint64) (uint64, error) {
+	if s, ok := v.(string); ok {
+		i, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, errors.Wrap(err, "parse integer error")
+		}
+		v = i
+	}
+
+	var i uint64
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			return 0, errors.New("value out of range")
+		}
+		i = uint64(v)
+	case int64:
+		if v < 0 {
+			return 0, errors.New("value out of range")
+		}
+		i = uint64(v)
+	case uint64:
+		i = v
+	case uint32:
+		i = uint64(v)
+	default:
+		f, err := toFloat64(v)
+		if err != nil {
+			return 0, err
+		}
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+			return 0, errors.New("value out of range")
+		}
+		i = uint64(f)
+	}
+
+	if i > max {
+		return 0, errors.New("value out of range")
+	}
+	return i, nil
+}
@@ -0,0 +1,362 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	pbdescriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testProtobufField(name string, number int32, label pbdescriptor.FieldDescriptorProto_Label, typ pbdescriptor.FieldDescriptorProto_Type, typeName string) *pbdescriptor.FieldDescriptorProto {
+	f := pbdescriptor.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(number),
+		Label:  label.Enum(),
+		Type:   typ.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return &f
+}
+
+func testProtobufConfig(fds *pbdescriptor.FileDescriptorSet, messages map[uint8]string) string {
+	b, err := proto.Marshal(fds)
+	if err != nil {
+		panic(err)
+	}
+
+	conf, err := json.Marshal(ProtobufConfig{
+		FileDescriptorSet: b,
+		Messages:          messages,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return string(conf)
+}
+
+// testProtobufFileDescriptorSet returns the FileDescriptorSet for:
+//
+//	syntax = "proto3";
+//	package test;
+//
+//	enum Mode {
+//		OFF = 0;
+//		ON = 1;
+//	}
+//
+//	message Uplink {
+//		message Location {
+//			double lat = 1;
+//			double lon = 2;
+//		}
+//
+//		uint32 counter = 1;
+//		sint32 temperature = 2;
+//		float humidity = 3;
+//		Mode mode = 4;
+//		repeated uint32 values = 5;
+//		Location location = 6;
+//		map<string, int32> counters = 7;
+//		string name = 8;
+//		bytes raw = 9;
+//		fixed64 id = 10;
+//	}
+func testProtobufFileDescriptorSet() *pbdescriptor.FileDescriptorSet {
+	optional := pbdescriptor.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := pbdescriptor.FieldDescriptorProto_LABEL_REPEATED
+
+	return &pbdescriptor.FileDescriptorSet{
+		File: []*pbdescriptor.FileDescriptorProto{
+			{
+				Name:    proto.String("test.proto"),
+				Package: proto.String("test"),
+				Syntax:  proto.String("proto3"),
+				EnumType: []*pbdescriptor.EnumDescriptorProto{
+					{
+						Name: proto.String("Mode"),
+						Value: []*pbdescriptor.EnumValueDescriptorProto{
+							{Name: proto.String("OFF"), Number: proto.Int32(0)},
+							{Name: proto.String("ON"), Number: proto.Int32(1)},
+						},
+					},
+				},
+				MessageType: []*pbdescriptor.DescriptorProto{
+					{
+						Name: proto.String("Uplink"),
+						NestedType: []*pbdescriptor.DescriptorProto{
+							{
+								Name: proto.String("Location"),
+								Field: []*pbdescriptor.FieldDescriptorProto{
+									testProtobufField("lat", 1, optional, pbdescriptor.FieldDescriptorProto_TYPE_DOUBLE, ""),
+									testProtobufField("lon", 2, optional, pbdescriptor.FieldDescriptorProto_TYPE_DOUBLE, ""),
+								},
+							},
+							{
+								Name: proto.String("CountersEntry"),
+								Field: []*pbdescriptor.FieldDescriptorProto{
+									testProtobufField("key", 1, optional, pbdescriptor.FieldDescriptorProto_TYPE_STRING, ""),
+									testProtobufField("value", 2, optional, pbdescriptor.FieldDescriptorProto_TYPE_INT32, ""),
+								},
+								Options: &pbdescriptor.MessageOptions{
+									MapEntry: proto.Bool(true),
+								},
+							},
+						},
+						Field: []*pbdescriptor.FieldDescriptorProto{
+							testProtobufField("counter", 1, optional, pbdescriptor.FieldDescriptorProto_TYPE_UINT32, ""),
+							testProtobufField("temperature", 2, optional, pbdescriptor.FieldDescriptorProto_TYPE_SINT32, ""),
+							testProtobufField("humidity", 3, optional, pbdescriptor.FieldDescriptorProto_TYPE_FLOAT, ""),
+							testProtobufField("mode", 4, optional, pbdescriptor.FieldDescriptorProto_TYPE_ENUM, ".test.Mode"),
+							testProtobufField("values", 5, repeated, pbdescriptor.FieldDescriptorProto_TYPE_UINT32, ""),
+							testProtobufField("location", 6, optional, pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE, ".test.Uplink.Location"),
+							testProtobufField("counters", 7, repeated, pbdescriptor.FieldDescriptorProto_TYPE_MESSAGE, ".test.Uplink.CountersEntry"),
+							testProtobufField("name", 8, optional, pbdescriptor.FieldDescriptorProto_TYPE_STRING, ""),
+							testProtobufField("raw", 9, optional, pbdescriptor.FieldDescriptorProto_TYPE_BYTES, ""),
+							testProtobufField("id", 10, optional, pbdescriptor.FieldDescriptorProto_TYPE_FIXED64, ""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestProtobuf(t *testing.T) {
+	Convey("Given a protobuf codec configuration", t, func() {
+		conf := testProtobufConfig(testProtobufFileDescriptorSet(), map[uint8]string{
+			1: "test.Uplink",
+			2: ".test.Uplink.Location",
+		})
+
+		tests := []struct {
+			Name          string
+			FPort         uint8
+			Bytes         []byte
+			ExpectedJSON  string
+			ExpectedError string
+		}{
+			{
+				Name:  "all fields",
+				FPort: 1,
+				Bytes: []byte{
+					0x08, 0x96, 0x01, // counter
+					0x10, 0x03, // temperature
+					0x1d, 0x00, 0x00, 0x20, 0x42, // humidity
+					0x20, 0x01, // mode
+					0x2a, 0x02, 0x01, 0x02, // values
+					0x32, 0x09, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f, // location
+					0x3a, 0x05, 0x0a, 0x01, 0x61, 0x10, 0x01, // counters
+					0x42, 0x02, 0x61, 0x62, // name
+					0x4a, 0x02, 0x01, 0x02, // raw
+					0x51, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // id
+				},
+				ExpectedJSON: `{"counter":150,"counters":{"a":1},"humidity":40,"id":1,"location":{"lat":1.5,"lon":0},"mode":"ON","name":"ab","raw":"AQI=","temperature":-2,"values":[1,2]}`,
+			},
+			{
+				Name:         "default values",
+				FPort:        1,
+				Bytes:        []byte{0x10, 0x01},
+				ExpectedJSON: `{"counter":0,"counters":{},"humidity":0,"id":0,"mode":"OFF","name":"","raw":"","temperature":-1,"values":[]}`,
+			},
+			{
+				Name:         "nested message for fPort",
+				FPort:        2,
+				Bytes:        []byte{0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x40},
+				ExpectedJSON: `{"lat":0,"lon":2.5}`,
+			},
+			{
+				Name:          "no message for fPort",
+				FPort:         3,
+				Bytes:         []byte{0x08, 0x01},
+				ExpectedError: "no message for fPort 3",
+			},
+			{
+				Name:          "truncated payload",
+				FPort:         1,
+				Bytes:         []byte{0x42, 0x05, 0x61},
+				ExpectedError: "field 8: unexpected end of payload",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				Convey("Decoding", func() {
+					codec := NewProtobuf(test.FPort, conf)
+					err := codec.DecodeBytes(test.Bytes)
+					if test.ExpectedError != "" {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldEqual, test.ExpectedError)
+						return
+					}
+					So(err, ShouldBeNil)
+
+					b, err := json.Marshal(codec)
+					So(err, ShouldBeNil)
+					So(string(b), ShouldEqual, test.ExpectedJSON)
+				})
+
+				if test.ExpectedError != "" {
+					return
+				}
+
+				Convey("Encoding", func() {
+					codec := NewProtobuf(test.FPort, conf)
+					So(json.Unmarshal([]byte(test.ExpectedJSON), &codec), ShouldBeNil)
+
+					b, err := codec.EncodeToBytes()
+					So(err, ShouldBeNil)
+					So(b, ShouldResemble, test.Bytes)
+				})
+			})
+		}
+
+		Convey("Then encoding an invalid value returns an error", func() {
+			codec := NewProtobuf(1, conf)
+			codec.Data = map[string]interface{}{"mode": "UNKNOWN"}
+			_, err := codec.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "field mode: invalid enum value: UNKNOWN")
+
+			codec.Data = map[string]interface{}{"counter": -1}
+			_, err = codec.EncodeToBytes()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "field counter: value out of range")
+		})
+	})
+
+	Convey("Given the descriptor.proto FileDescriptorSet (proto2)", t, func() {
+		fd, _ := descriptor.ForMessage(&pbdescriptor.FileDescriptorSet{})
+		conf := testProtobufConfig(&pbdescriptor.FileDescriptorSet{
+			File: []*pbdescriptor.FileDescriptorProto{fd},
+		}, map[uint8]string{
+			1: "google.protobuf.FileDescriptorSet",
+		})
+
+		Convey("Then a message encoded by the protobuf library can be decoded and encoded", func() {
+			in := testProtobufFileDescriptorSet()
+			b, err := proto.Marshal(in)
+			So(err, ShouldBeNil)
+
+			codec := NewProtobuf(1, conf)
+			So(codec.DecodeBytes(b), ShouldBeNil)
+
+			files := codec.Data["file"].([]interface{})
+			So(files, ShouldHaveLength, 1)
+			file := files[0].(map[string]interface{})
+			So(file["name"], ShouldEqual, "test.proto")
+			So(file["package"], ShouldEqual, "test")
+
+			fields := file["message_type"].([]interface{})[0].(map[string]interface{})["field"].([]interface{})
+			So(fields, ShouldHaveLength, 10)
+			So(fields[3].(map[string]interface{})["type"], ShouldEqual, "TYPE_ENUM")
+			So(fields[3].(map[string]interface{})["type_name"], ShouldEqual, ".test.Mode")
+
+			// round-trip through JSON, as done for downlink payloads
+			jsonB, err := json.Marshal(codec)
+			So(err, ShouldBeNil)
+			codec = NewProtobuf(1, conf)
+			So(json.Unmarshal(jsonB, &codec), ShouldBeNil)
+
+			b, err = codec.EncodeToBytes()
+			So(err, ShouldBeNil)
+
+			var out pbdescriptor.FileDescriptorSet
+			So(proto.Unmarshal(b, &out), ShouldBeNil)
+			So(proto.Equal(in, &out), ShouldBeTrue)
+		})
+	})
+}
+
+func TestProtobufCache(t *testing.T) {
+	Convey("Given a protobuf codec configuration", t, func() {
+		conf := testProtobufConfig(testProtobufFileDescriptorSet(), map[uint8]string{
+			3: "test.Uplink",
+		})
+
+		Convey("When decoding a payload", func() {
+			codec := NewProtobuf(3, conf)
+			So(codec.DecodeBytes([]byte{0x08, 0x01}), ShouldBeNil)
+
+			Convey("Then the parsed configuration has been cached", func() {
+				v1, err := protobufConfigs.get(conf)
+				So(err, ShouldBeNil)
+				v2, err := protobufConfigs.get(conf)
+				So(err, ShouldBeNil)
+				So(v1.(parsedProtobufConfig).reg, ShouldEqual, v2.(parsedProtobufConfig).reg)
+
+				Convey("Then encoding uses the cached configuration", func() {
+					codec := NewProtobuf(3, conf)
+					codec.Data = map[string]interface{}{"counter": 1}
+					b, err := codec.EncodeToBytes()
+					So(err, ShouldBeNil)
+					So(b, ShouldResemble, []byte{0x08, 0x01})
+				})
+			})
+		})
+	})
+}
+
+func TestValidateProtobuf(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		fdsB, err := proto.Marshal(testProtobufFileDescriptorSet())
+		So(err, ShouldBeNil)
+
+		tests := []struct {
+			Name          string
+			Config        ProtobufConfig
+			ExpectedError string
+		}{
+			{
+				Name: "valid config",
+				Config: ProtobufConfig{
+					FileDescriptorSet: fdsB,
+					Messages:          map[uint8]string{1: "test.Uplink"},
+				},
+			},
+			{
+				Name: "empty file descriptor set",
+				Config: ProtobufConfig{
+					Messages: map[uint8]string{1: "test.Uplink"},
+				},
+				ExpectedError: "file descriptor set must contain at least one file",
+			},
+			{
+				Name: "no messages",
+				Config: ProtobufConfig{
+					FileDescriptorSet: fdsB,
+				},
+				ExpectedError: "config must contain at least one message",
+			},
+			{
+				Name: "unknown message",
+				Config: ProtobufConfig{
+					FileDescriptorSet: fdsB,
+					Messages:          map[uint8]string{10: "test.Downlink"},
+				},
+				ExpectedError: "fPort 10: message test.Downlink does not exist",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				b, err := json.Marshal(test.Config)
+				So(err, ShouldBeNil)
+
+				err = Validate(ProtobufType, "", string(b))
+				if test.ExpectedError != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.ExpectedError)
+					return
+				}
+				So(err, ShouldBeNil)
+			})
+		}
+	})
+}
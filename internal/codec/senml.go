@@ -0,0 +1,118 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// SenMLRecord defines a SenML record (RFC 8428).
+type SenMLRecord struct {
+	BaseName    string   `json:"bn,omitempty"`
+	BaseTime    float64  `json:"bt,omitempty"`
+	Name        string   `json:"n,omitempty"`
+	Unit        string   `json:"u,omitempty"`
+	Value       *float64 `json:"v,omitempty"`
+	StringValue *string  `json:"vs,omitempty"`
+	BoolValue   *bool    `json:"vb,omitempty"`
+}
+
+// SenMLBaseName returns the SenML base name for the given DevEUI.
+func SenMLBaseName(devEUI lorawan.EUI64) string {
+	return fmt.Sprintf("urn:dev:mac:%s:", devEUI)
+}
+
+// SenMLRecords converts the decoded object of the given payload into a
+// slice of SenML records. Nested objects and arrays are flattened, using
+// the path to the value (separated by a /) as record name. In case the
+// codec knows the units of its values, these are set. The base name and
+// base time are set on the first record.
+func SenMLRecords(pl Payload, baseName string, baseTime time.Time) ([]SenMLRecord, error) {
+	b, err := json.Marshal(pl.Object())
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	var unitFunc func(path []string) string
+	if u, ok := pl.(interface {
+		senMLUnit(path []string) string
+	}); ok {
+		unitFunc = u.senMLUnit
+	}
+
+	records := []SenMLRecord{}
+	senMLAppendRecords(&records, nil, obj, unitFunc)
+
+	if len(records) != 0 {
+		records[0].BaseName = baseName
+		records[0].BaseTime = float64(baseTime.UnixNano()) / float64(time.Second)
+	}
+
+	return records, nil
+}
+
+func senMLAppendRecords(records *[]SenMLRecord, path []string, v interface{}, unitFunc func(path []string) string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		var keys []string
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			senMLAppendRecords(records, senMLPath(path, k), v[k], unitFunc)
+		}
+	case []interface{}:
+		for i := range v {
+			senMLAppendRecords(records, senMLPath(path, strconv.Itoa(i)), v[i], unitFunc)
+		}
+	case float64, string, bool:
+		r := SenMLRecord{
+			Name: strings.Join(path, "/"),
+		}
+		if unitFunc != nil {
+			r.Unit = unitFunc(path)
+		}
+
+		switch v := v.(type) {
+		case float64:
+			r.Value = &v
+		case string:
+			r.StringValue = &v
+		case bool:
+			r.BoolValue = &v
+		}
+
+		*records = append(*records, r)
+	}
+}
+
+// senMLPath returns a copy of the given path with the given name appended.
+// Characters which are not allowed in a SenML name are replaced by an
+// underscore.
+func senMLPath(path []string, name string) []string {
+	out := make([]string, len(path), len(path)+1)
+	copy(out, path)
+
+	return append(out, strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == ':', r == '.', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name))
+}
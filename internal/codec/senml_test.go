@@ -0,0 +1,75 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestSenMLRecords(t *testing.T) {
+	Convey("Given a base name and base time", t, func() {
+		baseName := SenMLBaseName(lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+		baseTime := time.Unix(1538352000, 500000000)
+
+		So(baseName, ShouldEqual, "urn:dev:mac:0102030405060708:")
+
+		Convey("Given a set of tests", func() {
+			tests := []struct {
+				Name         string
+				Payload      Payload
+				Bytes        []byte
+				ExpectedJSON string
+			}{
+				{
+					Name:         "cayenne lpp with units",
+					Payload:      &CayenneLPP{},
+					Bytes:        []byte{3, 103, 1, 16, 5, 1, 1, 1, 136, 6, 118, 95, 242, 150, 10, 0, 3, 232},
+					ExpectedJSON: `[{"bn":"urn:dev:mac:0102030405060708:","bt":1538352000.5,"n":"digitalOutput/5","v":1},{"n":"gpsLocation/1/altitude","u":"m","v":10},{"n":"gpsLocation/1/latitude","u":"lat","v":42.3519},{"n":"gpsLocation/1/longitude","u":"lon","v":-87.9094},{"n":"temperatureSensor/3","u":"Cel","v":27.2}]`,
+				},
+				{
+					Name: "custom js with nested object",
+					Payload: NewCustomJS(10, "", `
+						function Decode(fPort, bytes) {
+							return {
+								"on": bytes[0] == 1,
+								"status": "ok",
+								"values": [1, 2.5],
+								"sensor data": {"level": 3, "error": null}
+							};
+						}
+					`),
+					Bytes:        []byte{1},
+					ExpectedJSON: `[{"bn":"urn:dev:mac:0102030405060708:","bt":1538352000.5,"n":"on","vb":true},{"n":"sensor_data/level","v":3},{"n":"status","vs":"ok"},{"n":"values/0","v":1},{"n":"values/1","v":2.5}]`,
+				},
+				{
+					Name: "custom js with empty object",
+					Payload: NewCustomJS(10, "", `
+						function Decode(fPort, bytes) {
+							return {};
+						}
+					`),
+					Bytes:        []byte{1},
+					ExpectedJSON: `[]`,
+				},
+			}
+
+			for i, test := range tests {
+				Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+					So(test.Payload.DecodeBytes(test.Bytes), ShouldBeNil)
+
+					records, err := SenMLRecords(test.Payload, baseName, baseTime)
+					So(err, ShouldBeNil)
+
+					b, err := json.Marshal(records)
+					So(err, ShouldBeNil)
+					So(string(b), ShouldEqual, test.ExpectedJSON)
+				})
+			}
+		})
+	})
+}
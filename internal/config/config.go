@@ -67,6 +67,40 @@ type Config struct {
 			Footer       string
 			Registration string
 		}
+
+		Email struct {
+			Enabled               bool
+			Server                string
+			Username              string
+			Password              string
+			From                  string
+			TLSMode               string        `mapstructure:"tls_mode"`
+			CACert                string        `mapstructure:"ca_cert"`
+			TemplateDir           string        `mapstructure:"template_dir"`
+			BaseURL               string        `mapstructure:"base_url"`
+			AlertInterval         time.Duration `mapstructure:"alert_interval"`
+			DeviceOfflineTimeout  time.Duration `mapstructure:"device_offline_timeout"`
+			GatewayOfflineTimeout time.Duration `mapstructure:"gateway_offline_timeout"`
+			PasswordResetTTL      time.Duration `mapstructure:"password_reset_ttl"`
+		} `mapstructure:"email"`
+
+		RemoteMulticastSetup struct {
+			SyncInterval   time.Duration `mapstructure:"sync_interval"`
+			SyncRetries    int           `mapstructure:"sync_retries"`
+			SessionTimeout time.Duration `mapstructure:"session_timeout"`
+		} `mapstructure:"remote_multicast_setup"`
+
+		DeviceEventLog struct {
+			Retention        time.Duration `mapstructure:"retention"`
+			CleanupInterval  time.Duration `mapstructure:"cleanup_interval"`
+			StoreCodecEvents bool          `mapstructure:"store_codec_events"`
+		} `mapstructure:"device_event_log"`
+
+		DeviceStats struct {
+			HourlyRetention time.Duration `mapstructure:"hourly_retention"`
+			DailyRetention  time.Duration `mapstructure:"daily_retention"`
+			CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+		} `mapstructure:"device_stats"`
 	} `mapstructure:"application_server"`
 
 	JoinServer struct {
@@ -0,0 +1,188 @@
+// Package cron implements the parsing of (standard, 5 field) cron
+// expressions and the calculation of the next matching time.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxYears defines the number of years to look ahead for a matching time.
+const maxYears = 5
+
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule defines a parsed cron expression.
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// domStar and dowStar are set when the day of month or day of week
+	// field is a wildcard. When both fields are restricted, a time matches
+	// when either of both fields matches.
+	domStar bool
+	dowStar bool
+}
+
+// Parse parses the given cron expression. The expression must contain five
+// space separated fields: minute, hour, day of month, month and day of week.
+// Each field supports wildcards (*), values, ranges (1-5), steps (*/15 or
+// 1-30/5) and lists of these (1,15,30). For the day of week, both 0 and 7
+// are interpreted as Sunday.
+func Parse(expr string) (Schedule, error) {
+	var s Schedule
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return s, fmt.Errorf("expected %d fields, got %d", len(fields), len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, f := range fields {
+		var err error
+		bits[i], err = parseField(parts[i], f)
+		if err != nil {
+			return s, errors.Wrap(err, f.name)
+		}
+	}
+
+	s.minute = bits[0]
+	s.hour = bits[1]
+	s.dom = bits[2]
+	s.month = bits[3]
+	s.dow = bits[4]
+	s.domStar = strings.HasPrefix(parts[2], "*")
+	s.dowStar = strings.HasPrefix(parts[4], "*")
+
+	// 7 is an alias for Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+// Next returns the first matching time after the given time (with minute
+// precision, in UTC). The zero time is returned when there is no match
+// within the next five years (e.g. for the 30th of February).
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + maxYears
+
+	for t.Year() <= limit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func parseField(expr string, f field) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(expr, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i != -1 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step: %s", part[i+1:])
+			}
+			part = part[:i]
+		}
+
+		var start, end int
+		switch {
+		case part == "*":
+			start, end = f.min, f.max
+		case strings.Contains(part, "-"):
+			rng := strings.SplitN(part, "-", 2)
+			var err error
+			if start, err = parseValue(rng[0], f); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(rng[1], f); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range: %s", part)
+			}
+		default:
+			var err error
+			if start, err = parseValue(part, f); err != nil {
+				return 0, err
+			}
+			end = start
+
+			// a value with step (e.g. 5/10) runs until the max value
+			if step > 1 {
+				end = f.max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %s", s)
+	}
+	if i < f.min || i > f.max {
+		return 0, fmt.Errorf("value %d out of range (%d - %d)", i, f.min, f.max)
+	}
+	return i, nil
+}
@@ -0,0 +1,69 @@
+package cron
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("Given a set of invalid expressions", t, func() {
+		tests := []string{
+			"",
+			"* * * *",
+			"* * * * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"*/0 * * * *",
+			"10-5 * * * *",
+			"a * * * *",
+		}
+
+		for i, expr := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", expr, i), func() {
+				_, err := Parse(expr)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
+
+func TestNext(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		// Thursday
+		now := time.Date(2019, 1, 17, 10, 12, 30, 0, time.UTC)
+
+		tests := []struct {
+			Expr string
+			Next time.Time
+		}{
+			{"* * * * *", time.Date(2019, 1, 17, 10, 13, 0, 0, time.UTC)},
+			{"*/15 * * * *", time.Date(2019, 1, 17, 10, 15, 0, 0, time.UTC)},
+			{"0 * * * *", time.Date(2019, 1, 17, 11, 0, 0, 0, time.UTC)},
+			{"30 6 * * *", time.Date(2019, 1, 18, 6, 30, 0, 0, time.UTC)},
+			{"0 8-18/2 * * *", time.Date(2019, 1, 17, 12, 0, 0, 0, time.UTC)},
+			{"0 6,20 * * *", time.Date(2019, 1, 17, 20, 0, 0, 0, time.UTC)},
+			{"0 0 1 * *", time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 1", time.Date(2019, 1, 21, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 7", time.Date(2019, 1, 20, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 1-5", time.Date(2019, 1, 18, 0, 0, 0, 0, time.UTC)},
+			// either the day of month or day of week must match
+			{"0 0 25 * 6", time.Date(2019, 1, 19, 0, 0, 0, 0, time.UTC)},
+			{"0 0 29 2 *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+			{"0 0 30 2 *", time.Time{}},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Expr, i), func() {
+				s, err := Parse(test.Expr)
+				So(err, ShouldBeNil)
+				So(s.Next(now), ShouldResemble, test.Next)
+			})
+		}
+	})
+}
@@ -0,0 +1,343 @@
+// Package devicefile implements the bulk import and export of devices,
+// including their OTAA keys or ABP activation.
+package devicefile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// Validate validates the given records against the given application.
+// Rows for which rowErrors already contains an error are skipped. It returns
+// rowErrors, extended with the validation errors and sorted by row.
+func Validate(db sqlx.Queryer, app storage.Application, records []Record, rowErrors []RowError) ([]RowError, error) {
+	sp, err := storage.GetServiceProfile(db, app.ServiceProfileID, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "get service-profile error")
+	}
+
+	skip := make(map[int]bool)
+	for _, rowErr := range rowErrors {
+		skip[rowErr.Row] = true
+	}
+
+	profiles := newProfileCache(db)
+	devEUIs := make(map[lorawan.EUI64]int)
+
+	for i, rec := range records {
+		row := i + 1
+		if skip[row] {
+			continue
+		}
+
+		if err := validateRecord(db, profiles, app, sp, devEUIs, rec); err != nil {
+			if _, ok := err.(invalidError); !ok {
+				return nil, err
+			}
+			rowErrors = append(rowErrors, RowError{Row: row, DevEUI: rec.DevEUI, Err: err})
+		}
+		devEUIs[rec.DevEUI] = row
+	}
+
+	sort.Slice(rowErrors, func(i, j int) bool {
+		return rowErrors[i].Row < rowErrors[j].Row
+	})
+
+	return rowErrors, nil
+}
+
+// Export returns the devices, including their keys (OTAA) or activation
+// (ABP) for the given application ID.
+func Export(db sqlx.Queryer, applicationID int64) ([]Record, error) {
+	filters := storage.DeviceFilters{
+		ApplicationID: applicationID,
+	}
+
+	count, err := storage.GetDeviceCount(db, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device count error")
+	}
+
+	filters.Limit = count
+	devices, err := storage.GetDevices(db, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "get devices error")
+	}
+
+	profiles := newProfileCache(db)
+	records := make([]Record, 0, len(devices))
+
+	for _, d := range devices {
+		dp, err := profiles.get(d.DeviceProfileID)
+		if err != nil {
+			return nil, errors.Wrap(err, "get device-profile error")
+		}
+
+		rec := Record{
+			DevEUI:          d.DevEUI,
+			Name:            d.Name,
+			Description:     d.Description,
+			DeviceProfileID: d.DeviceProfileID,
+		}
+
+		if dp.DeviceProfile.SupportsJoin {
+			err = exportKeys(db, &rec)
+		} else {
+			err = exportActivation(db, dp, &rec)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "device %s", d.DevEUI)
+		}
+
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// invalidError is returned for records that did not pass validation.
+type invalidError string
+
+func (e invalidError) Error() string {
+	return string(e)
+}
+
+func invalidf(format string, a ...interface{}) error {
+	return invalidError(fmt.Sprintf(format, a...))
+}
+
+func validateRecord(db sqlx.Queryer, profiles *profileCache, app storage.Application, sp storage.ServiceProfile, devEUIs map[lorawan.EUI64]int, rec Record) error {
+	if rec.DevEUI == (lorawan.EUI64{}) {
+		return invalidf("dev_eui must be set")
+	}
+
+	if row, ok := devEUIs[rec.DevEUI]; ok {
+		return invalidf("duplicate dev_eui (see row %d)", row)
+	}
+
+	_, err := storage.GetDevice(db, rec.DevEUI, false, true)
+	if err == nil {
+		return invalidf("device already exists")
+	}
+	if errors.Cause(err) != storage.ErrDoesNotExist {
+		return errors.Wrap(err, "get device error")
+	}
+
+	if rec.DeviceProfileID == uuid.Nil {
+		return invalidf("device_profile_id must be set")
+	}
+
+	dp, err := profiles.get(rec.DeviceProfileID)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return invalidf("device-profile does not exist")
+		}
+		return errors.Wrap(err, "get device-profile error")
+	}
+
+	if dp.OrganizationID != app.OrganizationID {
+		return invalidf("device-profile and application must be under the same organization")
+	}
+
+	if dp.NetworkServerID != sp.NetworkServerID {
+		return invalidf("device-profile and application service-profile must be under the same network-server")
+	}
+
+	abp := rec.DevAddr != nil || rec.AppSKey != nil || rec.NwkSEncKey != nil || rec.SNwkSIntKey != nil || rec.FNwkSIntKey != nil
+
+	if dp.DeviceProfile.SupportsJoin {
+		if rec.NwkKey == nil {
+			return invalidf("nwk_key must be set for OTAA devices")
+		}
+		if abp {
+			return invalidf("activation fields must not be set for OTAA devices")
+		}
+	} else {
+		if rec.DevAddr == nil || rec.AppSKey == nil || rec.NwkSEncKey == nil {
+			return invalidf("dev_addr, app_s_key and nwk_s_enc_key must be set for ABP devices")
+		}
+		if rec.NwkKey != nil || rec.AppKey != nil || rec.GenAppKey != nil {
+			return invalidf("root keys must not be set for ABP devices")
+		}
+	}
+
+	return nil
+}
+
+func createKeys(db sqlx.Execer, rec Record) error {
+	dk := storage.DeviceKeys{
+		DevEUI: rec.DevEUI,
+	}
+	if rec.NwkKey != nil {
+		dk.NwkKey = *rec.NwkKey
+	}
+	if rec.AppKey != nil {
+		dk.AppKey = *rec.AppKey
+	}
+	if rec.GenAppKey != nil {
+		dk.GenAppKey = *rec.GenAppKey
+	}
+
+	if err := storage.CreateDeviceKeys(db, &dk); err != nil {
+		return errors.Wrap(err, "create device-keys error")
+	}
+
+	return nil
+}
+
+func activate(db sqlx.Queryer, dp storage.DeviceProfile, rec Record) error {
+	nsClient, err := getNSClient(db, dp.NetworkServerID)
+	if err != nil {
+		return err
+	}
+
+	// the LoRaWAN 1.0 network session key is used for all three keys when
+	// the LoRaWAN 1.1 keys are not given
+	sNwkSIntKey := rec.NwkSEncKey
+	if rec.SNwkSIntKey != nil {
+		sNwkSIntKey = rec.SNwkSIntKey
+	}
+	fNwkSIntKey := rec.NwkSEncKey
+	if rec.FNwkSIntKey != nil {
+		fNwkSIntKey = rec.FNwkSIntKey
+	}
+
+	_, err = nsClient.ActivateDevice(context.Background(), &ns.ActivateDeviceRequest{
+		DeviceActivation: &ns.DeviceActivation{
+			DevEui:      rec.DevEUI[:],
+			DevAddr:     rec.DevAddr[:],
+			NwkSEncKey:  rec.NwkSEncKey[:],
+			SNwkSIntKey: sNwkSIntKey[:],
+			FNwkSIntKey: fNwkSIntKey[:],
+			FCntUp:      rec.FCntUp,
+			NFCntDown:   rec.NFCntDown,
+			AFCntDown:   rec.AFCntDown,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "activate device error")
+	}
+
+	err = storage.CreateDeviceActivation(db, &storage.DeviceActivation{
+		DevEUI:  rec.DevEUI,
+		DevAddr: *rec.DevAddr,
+		AppSKey: *rec.AppSKey,
+	})
+	if err != nil {
+		return errors.Wrap(err, "create device-activation error")
+	}
+
+	return nil
+}
+
+func exportKeys(db sqlx.Queryer, rec *Record) error {
+	dk, err := storage.GetDeviceKeys(db, rec.DevEUI)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil
+		}
+		return errors.Wrap(err, "get device-keys error")
+	}
+
+	rec.NwkKey = &dk.NwkKey
+	rec.AppKey = &dk.AppKey
+	rec.GenAppKey = &dk.GenAppKey
+
+	return nil
+}
+
+func exportActivation(db sqlx.Queryer, dp storage.DeviceProfile, rec *Record) error {
+	da, err := storage.GetLastDeviceActivationForDevEUI(db, rec.DevEUI)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil
+		}
+		return errors.Wrap(err, "get device-activation error")
+	}
+
+	nsClient, err := getNSClient(db, dp.NetworkServerID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := nsClient.GetDeviceActivation(context.Background(), &ns.GetDeviceActivationRequest{
+		DevEui: rec.DevEUI[:],
+	})
+	if err != nil {
+		// the device is not (or no longer) activated
+		if grpc.Code(err) == codes.NotFound {
+			return nil
+		}
+		return errors.Wrap(err, "get device-activation error")
+	}
+
+	var nwkSEncKey, sNwkSIntKey, fNwkSIntKey lorawan.AES128Key
+	copy(nwkSEncKey[:], resp.DeviceActivation.NwkSEncKey)
+	copy(sNwkSIntKey[:], resp.DeviceActivation.SNwkSIntKey)
+	copy(fNwkSIntKey[:], resp.DeviceActivation.FNwkSIntKey)
+
+	rec.DevAddr = &da.DevAddr
+	rec.AppSKey = &da.AppSKey
+	rec.NwkSEncKey = &nwkSEncKey
+	rec.SNwkSIntKey = &sNwkSIntKey
+	rec.FNwkSIntKey = &fNwkSIntKey
+	rec.FCntUp = resp.DeviceActivation.FCntUp
+	rec.NFCntDown = resp.DeviceActivation.NFCntDown
+	rec.AFCntDown = resp.DeviceActivation.AFCntDown
+
+	return nil
+}
+
+func getNSClient(db sqlx.Queryer, networkServerID int64) (ns.NetworkServerServiceClient, error) {
+	n, err := storage.GetNetworkServer(db, networkServerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server client error")
+	}
+
+	return nsClient, nil
+}
+
+// profileCache caches the device-profiles, so that they are only retrieved
+// once per import or export.
+type profileCache struct {
+	db       sqlx.Queryer
+	profiles map[uuid.UUID]storage.DeviceProfile
+}
+
+func newProfileCache(db sqlx.Queryer) *profileCache {
+	return &profileCache{
+		db:       db,
+		profiles: make(map[uuid.UUID]storage.DeviceProfile),
+	}
+}
+
+func (c *profileCache) get(id uuid.UUID) (storage.DeviceProfile, error) {
+	if dp, ok := c.profiles[id]; ok {
+		return dp, nil
+	}
+
+	dp, err := storage.GetDeviceProfile(c.db, id)
+	if err != nil {
+		return dp, err
+	}
+	c.profiles[id] = dp
+
+	return dp, nil
+}
@@ -0,0 +1,213 @@
+package devicefile
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// batchSize defines the max. number of records imported per job, before
+// the progress of the job is stored.
+const batchSize = 100
+
+// CreateImportJob creates an import job for the given records, which will
+// be processed in the background. The records must have been validated
+// first.
+func CreateImportJob(db sqlx.Execer, app storage.Application, records []Record) (storage.DeviceImportJob, error) {
+	b, err := json.Marshal(records)
+	if err != nil {
+		return storage.DeviceImportJob{}, errors.Wrap(err, "marshal json error")
+	}
+
+	j := storage.DeviceImportJob{
+		ApplicationID: app.ID,
+		Records:       b,
+		TotalCount:    len(records),
+	}
+	if err := storage.CreateDeviceImportJob(db, &j); err != nil {
+		return j, errors.Wrap(err, "create device import job error")
+	}
+
+	return j, nil
+}
+
+// ImportJobLoop is a never returning function processing the pending
+// device import jobs. When running multiple instances, a job is processed
+// by one instance at a time.
+func ImportJobLoop() {
+	for {
+		if err := ProcessImportJobs(); err != nil {
+			log.WithError(err).Error("process device import job error")
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// ProcessImportJobs processes the pending device import jobs, until there
+// are no pending jobs left.
+func ProcessImportJobs() error {
+	for {
+		processed, err := processImportJob()
+		if err != nil {
+			return err
+		}
+		if !processed {
+			return nil
+		}
+	}
+}
+
+// processImportJob imports the next batch of records of a pending job. It
+// returns false when there was no pending job.
+func processImportJob() (bool, error) {
+	var processed bool
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		j, err := storage.GetPendingDeviceImportJob(tx)
+		if err != nil {
+			return errors.Wrap(err, "get pending device import job error")
+		}
+		if j == nil {
+			return nil
+		}
+		processed = true
+
+		var records []Record
+		if err := json.Unmarshal(j.Records, &records); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+
+		app, err := storage.GetApplication(tx, j.ApplicationID)
+		if err != nil {
+			return errors.Wrap(err, "get application error")
+		}
+
+		end := j.ProcessedCount + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		profiles := newProfileCache(tx)
+
+		for i := j.ProcessedCount; i < end; i++ {
+			rec := records[i]
+
+			if err := importRecord(profiles, app, rec); err != nil {
+				log.WithError(err).WithFields(log.Fields{
+					"id":      j.ID,
+					"dev_eui": rec.DevEUI,
+				}).Error("import device error")
+
+				if err := storage.CreateDeviceImportJobError(tx, storage.DeviceImportJobError{
+					DeviceImportJobID: j.ID,
+					Row:               i + 1,
+					DevEUI:            rec.DevEUI,
+					Error:             errors.Cause(err).Error(),
+				}); err != nil {
+					return errors.Wrap(err, "create device import job error error")
+				}
+			} else {
+				j.ImportedCount++
+			}
+
+			j.ProcessedCount++
+		}
+
+		if j.ProcessedCount >= len(records) {
+			now := time.Now()
+			j.Status = storage.DeviceImportJobCompleted
+			j.CompletedAt = &now
+
+			log.WithFields(log.Fields{
+				"id":             j.ID,
+				"application_id": j.ApplicationID,
+				"total_count":    j.TotalCount,
+				"imported_count": j.ImportedCount,
+			}).Info("device import job completed")
+		} else {
+			j.Status = storage.DeviceImportJobRunning
+		}
+
+		if err := storage.UpdateDeviceImportJob(tx, j); err != nil {
+			return errors.Wrap(err, "update device import job error")
+		}
+
+		return nil
+	})
+
+	return processed, err
+}
+
+// importRecord creates the device and its keys (OTAA) or activation (ABP)
+// for the given record. This is done in a separate transaction so that an
+// error does not rollback the previously imported records. As the device
+// is also created on the network-server, it is removed from the
+// network-server again when the import of the record fails.
+func importRecord(profiles *profileCache, app storage.Application, rec Record) error {
+	dp, err := profiles.get(rec.DeviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile error")
+	}
+
+	var nsCreated bool
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		d := storage.Device{
+			DevEUI:          rec.DevEUI,
+			ApplicationID:   app.ID,
+			DeviceProfileID: rec.DeviceProfileID,
+			Name:            rec.Name,
+			Description:     rec.Description,
+		}
+		if d.Name == "" {
+			d.Name = rec.DevEUI.String()
+		}
+
+		// the network-server device is created as last step of
+		// CreateDevice
+		if err := storage.CreateDevice(tx, &d); err != nil {
+			return errors.Wrap(err, "create device error")
+		}
+		nsCreated = true
+
+		if dp.DeviceProfile.SupportsJoin {
+			return createKeys(tx, rec)
+		}
+		return activate(tx, dp, rec)
+	})
+	if err != nil && nsCreated {
+		if err := deleteNSDevice(dp, rec.DevEUI); err != nil {
+			log.WithError(err).WithField("dev_eui", rec.DevEUI).Error("rollback network-server device error")
+		}
+	}
+
+	return err
+}
+
+// deleteNSDevice deletes the given device from the network-server.
+func deleteNSDevice(dp storage.DeviceProfile, devEUI lorawan.EUI64) error {
+	nsClient, err := getNSClient(config.C.PostgreSQL.DB, dp.NetworkServerID)
+	if err != nil {
+		return err
+	}
+
+	_, err = nsClient.DeleteDevice(context.Background(), &ns.DeleteDeviceRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil && grpc.Code(err) != codes.NotFound {
+		return errors.Wrap(err, "delete device error")
+	}
+
+	return nil
+}
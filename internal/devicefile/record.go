@@ -0,0 +1,306 @@
+package devicefile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Format defines the device file format.
+type Format int
+
+// Available file formats.
+const (
+	CSV Format = iota
+	JSON
+)
+
+// Record defines a single device within the file. For OTAA devices the
+// NwkKey (and optionally the AppKey and GenAppKey) must be set, for ABP
+// devices the DevAddr and session-keys.
+type Record struct {
+	DevEUI          lorawan.EUI64      `json:"devEUI"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	DeviceProfileID uuid.UUID          `json:"deviceProfileID"`
+	NwkKey          *lorawan.AES128Key `json:"nwkKey,omitempty"`
+	AppKey          *lorawan.AES128Key `json:"appKey,omitempty"`
+	GenAppKey       *lorawan.AES128Key `json:"genAppKey,omitempty"`
+	DevAddr         *lorawan.DevAddr   `json:"devAddr,omitempty"`
+	AppSKey         *lorawan.AES128Key `json:"appSKey,omitempty"`
+	NwkSEncKey      *lorawan.AES128Key `json:"nwkSEncKey,omitempty"`
+	SNwkSIntKey     *lorawan.AES128Key `json:"sNwkSIntKey,omitempty"`
+	FNwkSIntKey     *lorawan.AES128Key `json:"fNwkSIntKey,omitempty"`
+	FCntUp          uint32             `json:"fCntUp"`
+	NFCntDown       uint32             `json:"nFCntDown"`
+	AFCntDown       uint32             `json:"aFCntDown"`
+}
+
+// RowError defines an error for a single row (starting at 1) of the file.
+type RowError struct {
+	Row    int
+	DevEUI lorawan.EUI64
+	Err    error
+}
+
+// Error implements the error interface.
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+}
+
+// columns contains the CSV columns, in the order in which they are written.
+var columns = []string{
+	"dev_eui",
+	"name",
+	"description",
+	"device_profile_id",
+	"nwk_key",
+	"app_key",
+	"gen_app_key",
+	"dev_addr",
+	"app_s_key",
+	"nwk_s_enc_key",
+	"s_nwk_s_int_key",
+	"f_nwk_s_int_key",
+	"f_cnt_up",
+	"n_f_cnt_down",
+	"a_f_cnt_down",
+}
+
+// Read reads the records from the given file content. Rows which could not
+// be parsed are returned as RowError, an error is returned when the file
+// itself could not be parsed.
+func Read(format Format, b []byte) ([]Record, []RowError, error) {
+	switch format {
+	case CSV:
+		return readCSV(b)
+	case JSON:
+		return readJSON(b)
+	default:
+		return nil, nil, fmt.Errorf("unknown format: %d", format)
+	}
+}
+
+// Write writes the given records to w.
+func Write(format Format, w io.Writer, records []Record) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, records)
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(records); err != nil {
+			return errors.Wrap(err, "encode json error")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %d", format)
+	}
+}
+
+func readCSV(b []byte) ([]Record, []RowError, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "read header error")
+	}
+
+	var rowErrors []RowError
+	var records []Record
+
+	for i := range header {
+		if !validColumn(header[i]) {
+			return nil, nil, fmt.Errorf("unknown column: %s", header[i])
+		}
+	}
+
+	for row := 1; ; row++ {
+		values, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); ok {
+				// keep the records aligned with the rows
+				rowErrors = append(rowErrors, RowError{Row: row, Err: err})
+				records = append(records, Record{})
+				continue
+			}
+			return nil, nil, errors.Wrap(err, "read row error")
+		}
+
+		var rec Record
+		for i := range values {
+			if err := rec.setField(header[i], values[i]); err != nil {
+				rowErrors = append(rowErrors, RowError{Row: row, DevEUI: rec.DevEUI, Err: err})
+				break
+			}
+		}
+		records = append(records, rec)
+	}
+
+	return records, rowErrors, nil
+}
+
+func readJSON(b []byte) ([]Record, []RowError, error) {
+	var rows []json.RawMessage
+	if err := json.Unmarshal(b, &rows); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	var rowErrors []RowError
+	records := make([]Record, len(rows))
+
+	for i := range rows {
+		if err := json.Unmarshal(rows[i], &records[i]); err != nil {
+			rowErrors = append(rowErrors, RowError{Row: i + 1, DevEUI: records[i].DevEUI, Err: err})
+		}
+	}
+
+	return records, rowErrors, nil
+}
+
+func writeCSV(w io.Writer, records []Record) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return errors.Wrap(err, "write header error")
+	}
+
+	for _, rec := range records {
+		values := make([]string, len(columns))
+		for i := range columns {
+			values[i] = rec.getField(columns[i])
+		}
+		if err := cw.Write(values); err != nil {
+			return errors.Wrap(err, "write row error")
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func validColumn(name string) bool {
+	for i := range columns {
+		if columns[i] == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Record) setField(name, value string) error {
+	// empty values are interpreted as not set
+	if value == "" {
+		return nil
+	}
+
+	var err error
+	switch name {
+	case "dev_eui":
+		err = r.DevEUI.UnmarshalText([]byte(value))
+	case "name":
+		r.Name = value
+	case "description":
+		r.Description = value
+	case "device_profile_id":
+		r.DeviceProfileID, err = uuid.FromString(value)
+	case "nwk_key":
+		r.NwkKey, err = parseKey(value)
+	case "app_key":
+		r.AppKey, err = parseKey(value)
+	case "gen_app_key":
+		r.GenAppKey, err = parseKey(value)
+	case "dev_addr":
+		r.DevAddr = &lorawan.DevAddr{}
+		err = r.DevAddr.UnmarshalText([]byte(value))
+	case "app_s_key":
+		r.AppSKey, err = parseKey(value)
+	case "nwk_s_enc_key":
+		r.NwkSEncKey, err = parseKey(value)
+	case "s_nwk_s_int_key":
+		r.SNwkSIntKey, err = parseKey(value)
+	case "f_nwk_s_int_key":
+		r.FNwkSIntKey, err = parseKey(value)
+	case "f_cnt_up":
+		r.FCntUp, err = parseUint32(value)
+	case "n_f_cnt_down":
+		r.NFCntDown, err = parseUint32(value)
+	case "a_f_cnt_down":
+		r.AFCntDown, err = parseUint32(value)
+	}
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+
+	return nil
+}
+
+func (r Record) getField(name string) string {
+	switch name {
+	case "dev_eui":
+		return r.DevEUI.String()
+	case "name":
+		return r.Name
+	case "description":
+		return r.Description
+	case "device_profile_id":
+		return r.DeviceProfileID.String()
+	case "nwk_key":
+		return formatKey(r.NwkKey)
+	case "app_key":
+		return formatKey(r.AppKey)
+	case "gen_app_key":
+		return formatKey(r.GenAppKey)
+	case "dev_addr":
+		if r.DevAddr == nil {
+			return ""
+		}
+		return r.DevAddr.String()
+	case "app_s_key":
+		return formatKey(r.AppSKey)
+	case "nwk_s_enc_key":
+		return formatKey(r.NwkSEncKey)
+	case "s_nwk_s_int_key":
+		return formatKey(r.SNwkSIntKey)
+	case "f_nwk_s_int_key":
+		return formatKey(r.FNwkSIntKey)
+	case "f_cnt_up":
+		return strconv.FormatUint(uint64(r.FCntUp), 10)
+	case "n_f_cnt_down":
+		return strconv.FormatUint(uint64(r.NFCntDown), 10)
+	case "a_f_cnt_down":
+		return strconv.FormatUint(uint64(r.AFCntDown), 10)
+	}
+	return ""
+}
+
+func parseKey(s string) (*lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+	if err := key.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func parseUint32(s string) (uint32, error) {
+	i, err := strconv.ParseUint(s, 10, 32)
+	return uint32(i), err
+}
+
+func formatKey(key *lorawan.AES128Key) string {
+	if key == nil {
+		return ""
+	}
+	return key.String()
+}
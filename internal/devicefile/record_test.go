@@ -0,0 +1,103 @@
+package devicefile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestReadWrite(t *testing.T) {
+	Convey("Given a set of records", t, func() {
+		dpID, err := uuid.FromString("c3a1e0a4-7d3c-4b4f-8b38-c1b8e1c2d3e4")
+		So(err, ShouldBeNil)
+
+		nwkKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+		devAddr := lorawan.DevAddr{1, 2, 3, 4}
+		appSKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+
+		records := []Record{
+			{
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "otaa-device",
+				Description:     "OTAA device, with a comma",
+				DeviceProfileID: dpID,
+				NwkKey:          &nwkKey,
+			},
+			{
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 9},
+				Name:            "abp-device",
+				DeviceProfileID: dpID,
+				DevAddr:         &devAddr,
+				AppSKey:         &appSKey,
+				NwkSEncKey:      &nwkKey,
+				FCntUp:          10,
+				NFCntDown:       11,
+				AFCntDown:       12,
+			},
+		}
+
+		formats := []struct {
+			Name   string
+			Format Format
+		}{
+			{"CSV", CSV},
+			{"JSON", JSON},
+		}
+
+		for _, f := range formats {
+			Convey("When writing and reading the records as "+f.Name, func() {
+				var b bytes.Buffer
+				So(Write(f.Format, &b, records), ShouldBeNil)
+
+				recordsRead, rowErrors, err := Read(f.Format, b.Bytes())
+				So(err, ShouldBeNil)
+				So(rowErrors, ShouldHaveLength, 0)
+
+				Convey("Then the records are equal", func() {
+					So(recordsRead, ShouldResemble, records)
+				})
+			})
+		}
+	})
+
+	Convey("Given a CSV file with an invalid row", t, func() {
+		data := "dev_eui,name,nwk_key\n" +
+			"0102030405060708,device-1,01020304050607080102030405060708\n" +
+			"01020304050607,device-2,01020304050607080102030405060708\n" +
+			"0102030405060709,device-3\n"
+
+		Convey("Then Read returns an error for the invalid rows", func() {
+			records, rowErrors, err := Read(CSV, []byte(data))
+			So(err, ShouldBeNil)
+			So(records, ShouldHaveLength, 3)
+			So(rowErrors, ShouldHaveLength, 2)
+			So(rowErrors[0].Row, ShouldEqual, 2)
+			So(rowErrors[1].Row, ShouldEqual, 3)
+		})
+	})
+
+	Convey("Given a CSV file with an unknown column", t, func() {
+		data := "dev_eui,foo\n0102030405060708,bar\n"
+
+		Convey("Then Read returns an error", func() {
+			_, _, err := Read(CSV, []byte(data))
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a JSON file with an invalid row", t, func() {
+		data := `[{"devEUI": "0102030405060708"}, {"devEUI": "foo"}]`
+
+		Convey("Then Read returns an error for the invalid row", func() {
+			records, rowErrors, err := Read(JSON, []byte(data))
+			So(err, ShouldBeNil)
+			So(records, ShouldHaveLength, 2)
+			So(rowErrors, ShouldHaveLength, 1)
+			So(rowErrors[0].Row, ShouldEqual, 2)
+		})
+	})
+}
@@ -0,0 +1,200 @@
+// Package devicegroup implements the execution of device-group jobs, which
+// apply a (bulk) action to each device of a device-group in the background.
+package devicegroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// batchSize defines the max. number of devices processed per job, before
+// the progress of the job is stored.
+const batchSize = 100
+
+// JobLoop is a never returning function executing the pending device-group
+// jobs. When running multiple instances, a job is processed by one instance
+// at a time.
+func JobLoop() {
+	for {
+		for {
+			processed, err := processJob()
+			if err != nil {
+				log.WithError(err).Error("process device-group job error")
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// processJob executes the action of the next batch of devices of a pending
+// job. It returns false when there was no pending job.
+func processJob() (bool, error) {
+	var processed bool
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		j, err := storage.GetPendingDeviceGroupJob(tx)
+		if err != nil {
+			return errors.Wrap(err, "get pending device-group job error")
+		}
+		if j == nil {
+			return nil
+		}
+		processed = true
+
+		devEUIs, err := storage.GetDeviceGroupDevEUIs(tx, j.DeviceGroupID, j.LastDevEUI, batchSize)
+		if err != nil {
+			return errors.Wrap(err, "get device-group deveuis error")
+		}
+
+		if len(devEUIs) == 0 {
+			now := time.Now()
+			j.Status = storage.DeviceGroupJobCompleted
+			j.CompletedAt = &now
+
+			log.WithFields(log.Fields{
+				"id":              j.ID,
+				"processed_count": j.ProcessedCount,
+				"error_count":     j.ErrorCount,
+			}).Info("device-group job completed")
+		} else {
+			j.Status = storage.DeviceGroupJobRunning
+		}
+
+		for i := range devEUIs {
+			devEUI := devEUIs[i]
+
+			if err := execute(j, devEUI); err != nil {
+				log.WithError(err).WithFields(log.Fields{
+					"id":      j.ID,
+					"dev_eui": devEUI,
+				}).Error("execute device-group job action error")
+				j.ErrorCount++
+				j.LastError = devEUI.String() + ": " + errors.Cause(err).Error()
+			}
+
+			j.ProcessedCount++
+			j.LastDevEUI = &devEUI
+		}
+
+		if err := storage.UpdateDeviceGroupJob(tx, j); err != nil {
+			return errors.Wrap(err, "update device-group job error")
+		}
+
+		return nil
+	})
+
+	return processed, err
+}
+
+// execute executes the job action for the given device. This is done in a
+// separate transaction so that an error does not rollback the progress of
+// the job.
+func execute(j *storage.DeviceGroupJob, devEUI lorawan.EUI64) error {
+	return storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		switch j.Action {
+		case storage.DeviceGroupJobEnqueueDownlink:
+			return enqueueDownlink(tx, j, devEUI)
+		case storage.DeviceGroupJobChangeDeviceProfile:
+			return changeDeviceProfile(tx, j, devEUI)
+		case storage.DeviceGroupJobAddToMulticastGroup:
+			return addToMulticastGroup(tx, j, devEUI)
+		case storage.DeviceGroupJobFlushQueue:
+			return flushQueue(tx, devEUI)
+		case storage.DeviceGroupJobDeleteDevices:
+			return storage.DeleteDevice(tx, devEUI)
+		default:
+			return storage.ErrDeviceGroupJobInvalidAction
+		}
+	})
+}
+
+func enqueueDownlink(db sqlx.Ext, j *storage.DeviceGroupJob, devEUI lorawan.EUI64) error {
+	// lock the device to avoid concurrent enqueue actions for the same
+	// device as this would result in re-use of the same frame-counter
+	if _, err := storage.GetDevice(db, devEUI, true, true); err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	if _, err := downlink.EnqueueDownlinkPayload(db, devEUI, j.Confirmed, j.FPort, j.Data); err != nil {
+		return errors.Wrap(err, "enqueue downlink payload error")
+	}
+
+	return nil
+}
+
+func changeDeviceProfile(db sqlx.Ext, j *storage.DeviceGroupJob, devEUI lorawan.EUI64) error {
+	d, err := storage.GetDevice(db, devEUI, true, false)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	if d.DeviceProfileID == *j.DeviceProfileID {
+		return nil
+	}
+
+	d.DeviceProfileID = *j.DeviceProfileID
+	if err := storage.UpdateDevice(db, &d, false); err != nil {
+		return errors.Wrap(err, "update device error")
+	}
+
+	return nil
+}
+
+func addToMulticastGroup(db sqlx.Ext, j *storage.DeviceGroupJob, devEUI lorawan.EUI64) error {
+	mg, err := storage.GetMulticastGroup(db, *j.MulticastGroupID, false, true)
+	if err != nil {
+		return errors.Wrap(err, "get multicast-group error")
+	}
+
+	if err := storage.AddDeviceToMulticastGroup(db, *j.MulticastGroupID, devEUI); err != nil {
+		return errors.Wrap(err, "add device to multicast-group error")
+	}
+
+	// configure the multicast-group on the device using the remote
+	// multicast setup
+	if mg.MCKey != (lorawan.AES128Key{}) {
+		if err := storage.CreateRemoteMulticastSetup(db, &storage.RemoteMulticastSetup{
+			DevEUI:           devEUI,
+			MulticastGroupID: *j.MulticastGroupID,
+		}); err != nil {
+			return errors.Wrap(err, "create remote multicast setup error")
+		}
+	}
+
+	return nil
+}
+
+func flushQueue(db sqlx.Ext, devEUI lorawan.EUI64) error {
+	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	_, err = nsClient.FlushDeviceQueueForDevEUI(context.Background(), &ns.FlushDeviceQueueForDevEUIRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return errors.Wrap(err, "flush device-queue error")
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package devicegroup
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestProcessJob(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with a device-group containing 150 devices", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		dg := storage.DeviceGroup{
+			ApplicationID: app.ID,
+			Name:          "test-group",
+		}
+		So(storage.CreateDeviceGroup(config.C.PostgreSQL.DB, &dg), ShouldBeNil)
+
+		var devEUIs []lorawan.EUI64
+		for i := 1; i <= 150; i++ {
+			d := storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            lorawan.EUI64{0, 0, 0, 0, 0, 0, byte(i >> 8), byte(i)}.String(),
+				DevEUI:          lorawan.EUI64{0, 0, 0, 0, 0, 0, byte(i >> 8), byte(i)},
+			}
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+			<-nsClient.CreateDeviceChan
+
+			So(storage.AddDeviceToDeviceGroup(config.C.PostgreSQL.DB, dg.ID, d.DevEUI), ShouldBeNil)
+			devEUIs = append(devEUIs, d.DevEUI)
+		}
+
+		Convey("Then processJob returns false when there is no pending job", func() {
+			processed, err := processJob()
+			So(err, ShouldBeNil)
+			So(processed, ShouldBeFalse)
+		})
+
+		Convey("When creating a flush queue job", func() {
+			j := storage.DeviceGroupJob{
+				DeviceGroupID: dg.ID,
+				Action:        storage.DeviceGroupJobFlushQueue,
+			}
+			So(storage.CreateDeviceGroupJob(config.C.PostgreSQL.DB, &j), ShouldBeNil)
+			So(j.TotalCount, ShouldEqual, 150)
+
+			Convey("Then the devices are processed in batches", func() {
+				processed, err := processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 100)
+				for i := 0; i < 100; i++ {
+					req := <-nsClient.FlushDeviceQueueForDevEUIChan
+					So(req.DevEui, ShouldResemble, devEUIs[i][:])
+				}
+
+				jGet, err := storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+				So(err, ShouldBeNil)
+				So(jGet.Status, ShouldEqual, storage.DeviceGroupJobRunning)
+				So(jGet.ProcessedCount, ShouldEqual, 100)
+				So(jGet.ErrorCount, ShouldEqual, 0)
+				So(*jGet.LastDevEUI, ShouldResemble, devEUIs[99])
+
+				processed, err = processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 50)
+				for i := 100; i < 150; i++ {
+					req := <-nsClient.FlushDeviceQueueForDevEUIChan
+					So(req.DevEui, ShouldResemble, devEUIs[i][:])
+				}
+
+				jGet, err = storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+				So(err, ShouldBeNil)
+				So(jGet.Status, ShouldEqual, storage.DeviceGroupJobRunning)
+				So(jGet.ProcessedCount, ShouldEqual, 150)
+				So(*jGet.LastDevEUI, ShouldResemble, devEUIs[149])
+				So(jGet.CompletedAt, ShouldBeNil)
+
+				Convey("Then the job completes when all devices have been processed", func() {
+					processed, err := processJob()
+					So(err, ShouldBeNil)
+					So(processed, ShouldBeTrue)
+					So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 0)
+
+					jGet, err := storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+					So(err, ShouldBeNil)
+					So(jGet.Status, ShouldEqual, storage.DeviceGroupJobCompleted)
+					So(jGet.ProcessedCount, ShouldEqual, 150)
+					So(jGet.CompletedAt, ShouldNotBeNil)
+
+					processed, err = processJob()
+					So(err, ShouldBeNil)
+					So(processed, ShouldBeFalse)
+				})
+			})
+		})
+
+		Convey("When creating an enqueue downlink job and only some devices are activated", func() {
+			for i := 1; i < 149; i++ {
+				So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &storage.DeviceActivation{
+					DevEUI:  devEUIs[i],
+					DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+				}), ShouldBeNil)
+			}
+
+			j := storage.DeviceGroupJob{
+				DeviceGroupID: dg.ID,
+				Action:        storage.DeviceGroupJobEnqueueDownlink,
+				FPort:         10,
+				Data:          []byte{1, 2, 3},
+			}
+			So(storage.CreateDeviceGroupJob(config.C.PostgreSQL.DB, &j), ShouldBeNil)
+
+			Convey("Then the errors are counted and the job continues", func() {
+				processed, err := processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 99)
+				for i := 0; i < 99; i++ {
+					<-nsClient.CreateDeviceQueueItemChan
+				}
+				for i := 0; i < 100; i++ {
+					<-nsClient.GetNextDownlinkFCntForDevEUIChan
+				}
+
+				jGet, err := storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+				So(err, ShouldBeNil)
+				So(jGet.ProcessedCount, ShouldEqual, 100)
+				So(jGet.ErrorCount, ShouldEqual, 1)
+				So(jGet.LastError, ShouldStartWith, devEUIs[0].String()+": ")
+
+				processed, err = processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 49)
+
+				jGet, err = storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+				So(err, ShouldBeNil)
+				So(jGet.ProcessedCount, ShouldEqual, 150)
+				So(jGet.ErrorCount, ShouldEqual, 2)
+				So(jGet.LastError, ShouldStartWith, devEUIs[149].String()+": ")
+			})
+		})
+
+		Convey("When creating a delete devices job", func() {
+			j := storage.DeviceGroupJob{
+				DeviceGroupID: dg.ID,
+				Action:        storage.DeviceGroupJobDeleteDevices,
+			}
+			So(storage.CreateDeviceGroupJob(config.C.PostgreSQL.DB, &j), ShouldBeNil)
+
+			Convey("Then all devices are deleted, while paginating over the remaining devices", func() {
+				processed, err := processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(nsClient.DeleteDeviceChan, ShouldHaveLength, 100)
+				for i := 0; i < 100; i++ {
+					<-nsClient.DeleteDeviceChan
+				}
+
+				count, err := storage.GetDeviceCount(config.C.PostgreSQL.DB, storage.DeviceFilters{DeviceGroupID: dg.ID})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 50)
+
+				jGet, err := storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+				So(err, ShouldBeNil)
+				So(jGet.ProcessedCount, ShouldEqual, 100)
+				So(*jGet.LastDevEUI, ShouldResemble, devEUIs[99])
+
+				processed, err = processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(nsClient.DeleteDeviceChan, ShouldHaveLength, 50)
+
+				processed, err = processJob()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+
+				count, err = storage.GetDeviceCount(config.C.PostgreSQL.DB, storage.DeviceFilters{ApplicationID: app.ID})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+
+				jGet, err = storage.GetDeviceGroupJob(config.C.PostgreSQL.DB, j.ID, false)
+				So(err, ShouldBeNil)
+				So(jGet.Status, ShouldEqual, storage.DeviceGroupJobCompleted)
+				So(jGet.ProcessedCount, ShouldEqual, 150)
+				So(jGet.ErrorCount, ShouldEqual, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,167 @@
+// Package devicestats aggregates the per-device link and traffic statistics
+// in hourly and daily intervals.
+package devicestats
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// HandleUplink updates the device stats of all intervals with the given
+// uplink, received at the given time. As the stats are read and updated,
+// the caller must make sure that this function is not called concurrently
+// for the same device (e.g. by locking the device within the transaction).
+func HandleUplink(db sqlx.Ext, devEUI lorawan.EUI64, ts time.Time, fCnt uint32, txInfo *gw.UplinkTXInfo, rxInfo []*gw.UplinkRXInfo) error {
+	for _, interval := range storage.DeviceStatsIntervals {
+		last, err := storage.GetLastDeviceStats(db, devEUI, interval, true)
+		if err != nil && err != storage.ErrDoesNotExist {
+			return errors.Wrap(err, "get last device stats error")
+		}
+		exists := err == nil
+
+		timestamp := IntervalTimestamp(interval, ts)
+		if exists && last.Timestamp.Equal(timestamp) {
+			Aggregate(&last, &last.FCntLast, fCnt, txInfo, rxInfo)
+			if err := storage.UpdateDeviceStats(db, &last); err != nil {
+				return errors.Wrap(err, "update device stats error")
+			}
+			continue
+		}
+
+		s := storage.DeviceStats{
+			DevEUI:    devEUI,
+			Interval:  interval,
+			Timestamp: timestamp,
+		}
+
+		var prevFCnt *int64
+		if exists {
+			prevFCnt = &last.FCntLast
+		}
+
+		Aggregate(&s, prevFCnt, fCnt, txInfo, rxInfo)
+		if err := storage.CreateDeviceStats(db, &s); err != nil {
+			return errors.Wrap(err, "create device stats error")
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpiredStatsLoop is a never returning function which periodically
+// deletes the device stats that exceed the configured retention.
+func DeleteExpiredStatsLoop() {
+	for {
+		retentions := map[storage.DeviceStatsInterval]time.Duration{
+			storage.DeviceStatsIntervalHour: config.C.ApplicationServer.DeviceStats.HourlyRetention,
+			storage.DeviceStatsIntervalDay:  config.C.ApplicationServer.DeviceStats.DailyRetention,
+		}
+
+		for interval, retention := range retentions {
+			if retention == 0 {
+				continue
+			}
+
+			if _, err := storage.DeleteExpiredDeviceStats(config.C.PostgreSQL.DB, interval, retention); err != nil {
+				log.WithError(err).WithField("interval", interval).Error("delete expired device stats error")
+			}
+		}
+
+		time.Sleep(config.C.ApplicationServer.DeviceStats.CleanupInterval)
+	}
+}
+
+// IntervalTimestamp returns the (UTC) start timestamp of the interval
+// containing the given time.
+func IntervalTimestamp(interval storage.DeviceStatsInterval, t time.Time) time.Time {
+	t = t.UTC()
+
+	switch interval {
+	case storage.DeviceStatsIntervalDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return t.Truncate(time.Hour)
+	}
+}
+
+// Aggregate adds the given uplink to the given device stats. When prevFCnt
+// is set, it must contain the frame-counter of the previous uplink and the
+// gap between both frame-counters is counted as lost packets. A lower or
+// equal frame-counter (e.g. after a re-join) is not counted as gap.
+func Aggregate(s *storage.DeviceStats, prevFCnt *int64, fCnt uint32, txInfo *gw.UplinkTXInfo, rxInfo []*gw.UplinkRXInfo) {
+	if prevFCnt != nil && int64(fCnt) > *prevFCnt+1 {
+		s.LostPackets += int(int64(fCnt) - *prevFCnt - 1)
+	}
+	s.FCntLast = int64(fCnt)
+
+	// use the reception of the gateway with the best RSSI and SNR
+	if len(rxInfo) != 0 {
+		rssi := int(rxInfo[0].Rssi)
+		snr := rxInfo[0].LoraSnr
+		for _, rx := range rxInfo[1:] {
+			if int(rx.Rssi) > rssi {
+				rssi = int(rx.Rssi)
+			}
+			if rx.LoraSnr > snr {
+				snr = rx.LoraSnr
+			}
+		}
+
+		if s.RXPackets == 0 || rssi > s.RSSIMax {
+			s.RSSIMax = rssi
+		}
+		if s.RXPackets == 0 || snr > s.SNRMax {
+			s.SNRMax = snr
+		}
+		s.RSSISum += int64(rssi)
+		s.SNRSum += snr
+	}
+
+	s.RXPackets++
+
+	if lora := txInfo.GetLoraModulationInfo(); lora != nil {
+		incrementHstore(&s.RXPacketsPerSF, strconv.FormatUint(uint64(lora.SpreadingFactor), 10))
+	}
+
+	for _, rx := range rxInfo {
+		incrementHstore(&s.RXPacketsPerGateway, hex.EncodeToString(rx.GatewayId))
+	}
+}
+
+// HstoreCounts returns the counts stored in the given hstore as map.
+// Invalid values are ignored.
+func HstoreCounts(h hstore.Hstore) map[string]int {
+	out := make(map[string]int)
+	for k, v := range h.Map {
+		if !v.Valid {
+			continue
+		}
+		i, err := strconv.Atoi(v.String)
+		if err != nil {
+			continue
+		}
+		out[k] = i
+	}
+	return out
+}
+
+func incrementHstore(h *hstore.Hstore, key string) {
+	if h.Map == nil {
+		h.Map = make(map[string]sql.NullString)
+	}
+
+	i, _ := strconv.Atoi(h.Map[key].String)
+	h.Map[key] = sql.NullString{Valid: true, String: strconv.Itoa(i + 1)}
+}
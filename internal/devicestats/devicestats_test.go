@@ -0,0 +1,92 @@
+package devicestats
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/gw"
+)
+
+func TestIntervalTimestamp(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		ts := time.Date(2018, 11, 22, 13, 45, 12, 0, time.FixedZone("CET", 3600))
+
+		tests := []struct {
+			Interval  storage.DeviceStatsInterval
+			Timestamp time.Time
+		}{
+			{storage.DeviceStatsIntervalHour, time.Date(2018, 11, 22, 12, 0, 0, 0, time.UTC)},
+			{storage.DeviceStatsIntervalDay, time.Date(2018, 11, 22, 0, 0, 0, 0, time.UTC)},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Interval, i), func() {
+				So(IntervalTimestamp(test.Interval, ts), ShouldResemble, test.Timestamp)
+			})
+		}
+	})
+}
+
+func TestAggregate(t *testing.T) {
+	Convey("Given empty device stats", t, func() {
+		var s storage.DeviceStats
+
+		txInfo := func(sf uint32) *gw.UplinkTXInfo {
+			return &gw.UplinkTXInfo{
+				ModulationInfo: &gw.UplinkTXInfo_LoraModulationInfo{
+					LoraModulationInfo: &gw.LoRaModulationInfo{
+						SpreadingFactor: sf,
+					},
+				},
+			}
+		}
+
+		Convey("When aggregating a set of uplinks", func() {
+			Aggregate(&s, nil, 10, txInfo(7), []*gw.UplinkRXInfo{
+				{GatewayId: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Rssi: -80, LoraSnr: 5},
+				{GatewayId: []byte{2, 2, 2, 2, 2, 2, 2, 2}, Rssi: -100, LoraSnr: 7},
+			})
+			Aggregate(&s, &s.FCntLast, 11, txInfo(7), []*gw.UplinkRXInfo{
+				{GatewayId: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Rssi: -90, LoraSnr: 2},
+			})
+			Aggregate(&s, &s.FCntLast, 14, txInfo(9), []*gw.UplinkRXInfo{
+				{GatewayId: []byte{3, 3, 3, 3, 3, 3, 3, 3}, Rssi: -110, LoraSnr: -3},
+			})
+
+			Convey("Then the packet counters are set", func() {
+				So(s.RXPackets, ShouldEqual, 3)
+				So(s.LostPackets, ShouldEqual, 2)
+				So(s.FCntLast, ShouldEqual, 14)
+			})
+
+			Convey("Then the best and summed RSSI and SNR are set", func() {
+				So(s.RSSIMax, ShouldEqual, -80)
+				So(s.RSSISum, ShouldEqual, -280)
+				So(s.SNRMax, ShouldEqual, 7)
+				So(s.SNRSum, ShouldEqual, 6)
+			})
+
+			Convey("Then the packets per spreading-factor and gateway are set", func() {
+				So(HstoreCounts(s.RXPacketsPerSF), ShouldResemble, map[string]int{
+					"7": 2,
+					"9": 1,
+				})
+				So(HstoreCounts(s.RXPacketsPerGateway), ShouldResemble, map[string]int{
+					"0101010101010101": 2,
+					"0202020202020202": 1,
+					"0303030303030303": 1,
+				})
+			})
+
+			Convey("Then a frame-counter reset is not counted as lost packets", func() {
+				Aggregate(&s, &s.FCntLast, 0, txInfo(7), nil)
+				So(s.LostPackets, ShouldEqual, 2)
+				So(s.FCntLast, ShouldEqual, 0)
+			})
+		})
+	})
+}
@@ -3,6 +3,7 @@ package downlink
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -50,19 +51,45 @@ func handleDataDownPayload(pl integration.DataDownPayload) error {
 			return errors.New("enqueue downlink payload: device does not exist for given application")
 		}
 
-		// if Object is set, try to encode it to bytes using the application codec
+		// if Desired is set, update the desired state of the device twin
+		if pl.Desired != nil {
+			app, err := storage.GetApplication(tx, d.ApplicationID)
+			if err != nil {
+				return errors.Wrap(err, "get application error")
+			}
+
+			var desired storage.DeviceTwinState
+			if err := json.Unmarshal(pl.Desired, &desired); err != nil {
+				return errors.Wrap(err, "unmarshal desired state error")
+			}
+
+			if _, err := UpdateTwinDesired(tx, d, app, pl.FPort, desired); err != nil {
+				return errors.Wrap(err, "update device twin desired state error")
+			}
+
+			return nil
+		}
+
+		// if Object is set, try to encode it to bytes using the device-profile
+		// or application codec
 		if pl.Object != nil {
 			app, err := storage.GetApplication(tx, d.ApplicationID)
 			if err != nil {
 				return errors.Wrap(err, "get application error")
 			}
 
-			// get the codec payload configured for the application
-			codecPL := codec.NewPayload(app.PayloadCodec, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+			payloadCodec, encoderScript, decoderScript, err := storage.GetPayloadCodecForDevice(tx, d, app)
+			if err != nil {
+				return errors.Wrap(err, "get payload codec error")
+			}
+
+			// get the codec payload configured for the device-profile or application
+			codecPL := codec.NewPayload(payloadCodec, pl.FPort, encoderScript, decoderScript)
 			if codecPL == nil {
-				logCodecError(app, d, errors.New("no or invalid codec configured for application"))
-				return errors.New("no or invalid codec configured for application")
+				logCodecError(app, d, errors.New("no or invalid codec configured for device-profile or application"))
+				return errors.New("no or invalid codec configured for device-profile or application")
 			}
+			codec.SetApplicationID(codecPL, app.ID)
 
 			err = json.Unmarshal(pl.Object, &codecPL)
 			if err != nil {
@@ -70,7 +97,9 @@ func handleDataDownPayload(pl integration.DataDownPayload) error {
 				return errors.Wrap(err, "unmarshal to codec payload error")
 			}
 
+			start := time.Now()
 			pl.Data, err = codecPL.EncodeToBytes()
+			LogCodecEvent(d, "encode", payloadCodec, pl.FPort, codecPL, time.Since(start), err)
 			if err != nil {
 				logCodecError(app, d, err)
 				return errors.Wrap(err, "marshal codec payload to binary error")
@@ -138,6 +167,18 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, f
 		"confirmed": confirmed,
 	}).Info("downlink device-queue item handled")
 
+	if err := eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
+		Type: eventlog.Downlink,
+		Payload: eventlog.DownlinkEvent{
+			FCnt:      resp.FCnt,
+			FPort:     fPort,
+			Confirmed: confirmed,
+			Data:      data,
+		},
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
 	return resp.FCnt, nil
 }
 
@@ -147,6 +188,7 @@ func logCodecError(a storage.Application, d storage.Device, err error) {
 		ApplicationName: a.Name,
 		DeviceName:      d.Name,
 		DevEUI:          d.DevEUI,
+		Tags:            d.TagsMap(),
 		Type:            "CODEC",
 		Error:           err.Error(),
 	}
@@ -162,3 +204,26 @@ func logCodecError(a storage.Application, d storage.Device, err error) {
 		log.WithError(err).Error("send error notification to integration error")
 	}
 }
+
+// LogCodecEvent logs the codec event for the given device, containing the
+// console output, execution time and error (if any) of the given codec
+// operation (encode or decode).
+func LogCodecEvent(d storage.Device, operation string, payloadCodec codec.Type, fPort uint8, codecPL codec.Payload, execTime time.Duration, err error) {
+	codecEvent := eventlog.CodecEvent{
+		Codec:         string(payloadCodec),
+		Operation:     operation,
+		FPort:         fPort,
+		Console:       codec.GetConsoleOutput(codecPL),
+		ExecutionTime: execTime.String(),
+	}
+	if err != nil {
+		codecEvent.Error = err.Error()
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Codec,
+		Payload: codecEvent,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+}
@@ -170,6 +170,36 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 						Object:        json.RawMessage(`{"Bytes": [4, 3, 2, 1]}`),
 					},
 
+					ExpectedCreateDeviceQueueItemRequest: ns.CreateDeviceQueueItemRequest{
+						Item: &ns.DeviceQueueItem{
+							DevEui:     device.DevEUI[:],
+							FrmPayload: b,
+							FCnt:       12,
+							FPort:      2,
+							Confirmed:  false,
+						},
+					},
+				},
+				{
+					Name:         "device twin desired state",
+					PayloadCodec: codec.CustomJSType,
+					PayloadEncoderScript: `
+						function Encode(fPort, obj) {
+							return [
+								obj.Bytes[3],
+								obj.Bytes[2],
+								obj.Bytes[1],
+								obj.Bytes[0]
+							];
+						}
+					`,
+					Payload: integration.DataDownPayload{
+						ApplicationID: app.ID,
+						DevEUI:        device.DevEUI,
+						FPort:         2,
+						Desired:       json.RawMessage(`{"Bytes": [4, 3, 2, 1]}`),
+					},
+
 					ExpectedCreateDeviceQueueItemRequest: ns.CreateDeviceQueueItemRequest{
 						Item: &ns.DeviceQueueItem{
 							DevEui:     device.DevEUI[:],
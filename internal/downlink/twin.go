@@ -0,0 +1,248 @@
+package downlink
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// twinSyncUplinks defines the number of uplinks after which the desired
+// state is sent again when the reported state did not converge. The
+// downlink is sent after the first uplink, the second uplink is the first
+// one able to report the updated state.
+const twinSyncUplinks = 2
+
+// TwinDelta returns the desired state fields which are not equal to the
+// reported state.
+func TwinDelta(desired, reported storage.DeviceTwinState) storage.DeviceTwinState {
+	delta := make(storage.DeviceTwinState)
+	for k, v := range desired {
+		if rv, ok := reported[k]; !ok || !reflect.DeepEqual(v, rv) {
+			delta[k] = v
+		}
+	}
+	return delta
+}
+
+// TwinState returns the given (decoded) object as device twin state. As
+// the decoded object can be of any type, it is converted using its JSON
+// representation. Nil is returned when the object is not a JSON object.
+func TwinState(object interface{}) (storage.DeviceTwinState, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var state storage.DeviceTwinState
+	if err := json.Unmarshal(b, &state); err != nil {
+		return nil, nil
+	}
+
+	return state, nil
+}
+
+// HandleTwinUplink updates the reported state of the device twin with the
+// decoded uplink object. When the reported state does not match the
+// desired state, the desired state is sent (again) to the device.
+// Devices without desired state are skipped, the twin is created once the
+// desired state is set.
+// This function must be called within a transaction.
+func HandleTwinUplink(db sqlx.Ext, d storage.Device, app storage.Application, object interface{}) error {
+	state, err := TwinState(object)
+	if err != nil {
+		return err
+	}
+	if len(state) == 0 {
+		return nil
+	}
+
+	// check first without locking, as most devices do not have a twin
+	t, err := storage.GetDeviceTwin(db, d.DevEUI, false)
+	if err != nil {
+		if err == storage.ErrDoesNotExist {
+			return nil
+		}
+		return errors.Wrap(err, "get device twin error")
+	}
+	if len(t.Desired) == 0 {
+		return nil
+	}
+
+	// lock the device, as a downlink might be enqueued
+	if _, err := storage.GetDevice(db, d.DevEUI, true, true); err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	t, err = storage.GetDeviceTwin(db, d.DevEUI, true)
+	if err != nil {
+		return errors.Wrap(err, "get device twin error")
+	}
+
+	now := time.Now()
+
+	if t.Reported == nil {
+		t.Reported = make(storage.DeviceTwinState)
+	}
+	for k, v := range state {
+		t.Reported[k] = v
+	}
+	t.ReportedAt = &now
+	t.UplinksSinceSync++
+
+	delta := TwinDelta(t.Desired, t.Reported)
+	if len(delta) != 0 && (t.SyncAt == nil || t.UplinksSinceSync >= twinSyncUplinks) {
+		// the previous downlink might still be pending, e.g. when the
+		// device did not open its receive windows
+		pending, err := twinDownlinkPending(db, d.DevEUI, t.FPort)
+		if err != nil {
+			return err
+		}
+
+		if !pending {
+			if err := enqueueTwinDelta(db, d, app, &t, delta); err != nil {
+				return err
+			}
+		}
+	}
+
+	return storage.UpdateDeviceTwin(db, &t)
+}
+
+// twinDownlinkPending returns true when the network-server device-queue
+// contains an item for the given fPort.
+func twinDownlinkPending(db sqlx.Queryer, devEUI lorawan.EUI64, fPort uint8) (bool, error) {
+	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
+	if err != nil {
+		return false, errors.Wrap(err, "get network-server error")
+	}
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return false, errors.Wrap(err, "get network-server client error")
+	}
+
+	resp, err := nsClient.GetDeviceQueueItemsForDevEUI(context.Background(), &ns.GetDeviceQueueItemsForDevEUIRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "get device-queue items error")
+	}
+
+	for _, qi := range resp.Items {
+		if qi.FPort == uint32(fPort) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// UpdateTwinDesired sets the desired state of the device twin. When the
+// desired state differs from the reported state, the difference is sent
+// to the device using the given fPort.
+// This function must be called within a transaction.
+func UpdateTwinDesired(db sqlx.Ext, d storage.Device, app storage.Application, fPort uint8, desired storage.DeviceTwinState) (storage.DeviceTwin, error) {
+	// lock the device, as a downlink might be enqueued
+	if _, err := storage.GetDevice(db, d.DevEUI, true, true); err != nil {
+		return storage.DeviceTwin{}, errors.Wrap(err, "get device error")
+	}
+
+	if desired == nil {
+		desired = make(storage.DeviceTwinState)
+	}
+
+	create := false
+	t, err := storage.GetDeviceTwin(db, d.DevEUI, true)
+	if err != nil {
+		if err != storage.ErrDoesNotExist {
+			return t, errors.Wrap(err, "get device twin error")
+		}
+		create = true
+		t = storage.DeviceTwin{
+			DevEUI:   d.DevEUI,
+			Reported: storage.DeviceTwinState{},
+		}
+	}
+
+	t.FPort = fPort
+	t.Desired = desired
+
+	if err := t.Validate(); err != nil {
+		return t, err
+	}
+
+	delta := TwinDelta(t.Desired, t.Reported)
+	if len(delta) != 0 {
+		if err := enqueueTwinDelta(db, d, app, &t, delta); err != nil {
+			return t, err
+		}
+	}
+
+	if create {
+		err = storage.CreateDeviceTwin(db, &t)
+	} else {
+		err = storage.UpdateDeviceTwin(db, &t)
+	}
+
+	return t, err
+}
+
+// enqueueTwinDelta encodes the given delta using the device-profile or
+// application codec and enqueues it as downlink payload.
+func enqueueTwinDelta(db sqlx.Ext, d storage.Device, app storage.Application, t *storage.DeviceTwin, delta storage.DeviceTwinState) error {
+	payloadCodec, encoderScript, decoderScript, err := storage.GetPayloadCodecForDevice(db, d, app)
+	if err != nil {
+		return errors.Wrap(err, "get payload codec error")
+	}
+
+	codecPL := codec.NewPayload(payloadCodec, t.FPort, encoderScript, decoderScript)
+	if codecPL == nil {
+		logCodecError(app, d, errors.New("no or invalid codec configured for device-profile or application"))
+		return errors.New("no or invalid codec configured for device-profile or application")
+	}
+	codec.SetApplicationID(codecPL, app.ID)
+
+	b, err := json.Marshal(delta)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	if err := json.Unmarshal(b, &codecPL); err != nil {
+		logCodecError(app, d, err)
+		return errors.Wrap(err, "unmarshal to codec payload error")
+	}
+
+	start := time.Now()
+	data, err := codecPL.EncodeToBytes()
+	LogCodecEvent(d, "encode", payloadCodec, t.FPort, codecPL, time.Since(start), err)
+	if err != nil {
+		logCodecError(app, d, err)
+		return errors.Wrap(err, "marshal codec payload to binary error")
+	}
+
+	fCnt, err := EnqueueDownlinkPayload(db, d.DevEUI, false, t.FPort, data)
+	if err != nil {
+		return errors.Wrap(err, "enqueue downlink payload error")
+	}
+
+	now := time.Now()
+	t.SyncAt = &now
+	t.UplinksSinceSync = 0
+
+	log.WithFields(log.Fields{
+		"dev_eui": d.DevEUI,
+		"f_cnt":   fCnt,
+	}).Info("device twin desired state enqueued")
+
+	return nil
+}
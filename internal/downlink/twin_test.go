@@ -0,0 +1,243 @@
+package downlink
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+func TestTwinDelta(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name     string
+			Desired  storage.DeviceTwinState
+			Reported storage.DeviceTwinState
+			Delta    storage.DeviceTwinState
+		}{
+			{
+				Name:     "equal",
+				Desired:  storage.DeviceTwinState{"interval": 60.0},
+				Reported: storage.DeviceTwinState{"interval": 60.0, "temperature": 21.5},
+				Delta:    storage.DeviceTwinState{},
+			},
+			{
+				Name:     "different value",
+				Desired:  storage.DeviceTwinState{"interval": 60.0, "led": true},
+				Reported: storage.DeviceTwinState{"interval": 120.0, "led": true},
+				Delta:    storage.DeviceTwinState{"interval": 60.0},
+			},
+			{
+				Name:     "not reported",
+				Desired:  storage.DeviceTwinState{"led": true},
+				Reported: storage.DeviceTwinState{},
+				Delta:    storage.DeviceTwinState{"led": true},
+			},
+			{
+				Name:     "nested value",
+				Desired:  storage.DeviceTwinState{"config": map[string]interface{}{"a": 1.0}},
+				Reported: storage.DeviceTwinState{"config": map[string]interface{}{"a": 2.0}},
+				Delta:    storage.DeviceTwinState{"config": map[string]interface{}{"a": 1.0}},
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				So(TwinDelta(test.Desired, test.Reported), ShouldResemble, test.Delta)
+			})
+		}
+	})
+}
+
+func TestTwinState(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name   string
+			Object interface{}
+			State  storage.DeviceTwinState
+		}{
+			{
+				Name:   "map",
+				Object: map[string]interface{}{"interval": 60},
+				State:  storage.DeviceTwinState{"interval": 60.0},
+			},
+			{
+				Name: "struct",
+				Object: struct {
+					Interval int `json:"interval"`
+				}{60},
+				State: storage.DeviceTwinState{"interval": 60.0},
+			},
+			{
+				Name:   "array",
+				Object: []int{1, 2, 3},
+			},
+			{
+				Name: "nil",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				state, err := TwinState(test.Object)
+				So(err, ShouldBeNil)
+				So(state, ShouldResemble, test.State)
+			})
+		}
+	})
+}
+
+func TestTwinReconciliation(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with an application (with codec) and device", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+			PayloadCodec:     codec.CustomJSType,
+			PayloadEncoderScript: `
+				function Encode(fPort, obj) {
+					return [obj.interval];
+				}
+			`,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+
+		da := storage.DeviceActivation{
+			DevEUI:  device.DevEUI,
+			DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+			AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		}
+		So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &da), ShouldBeNil)
+
+		Convey("When an uplink is received without desired state", func() {
+			So(HandleTwinUplink(config.C.PostgreSQL.DB, device, app, map[string]interface{}{"interval": 120}), ShouldBeNil)
+
+			Convey("Then no device twin is created", func() {
+				_, err := storage.GetDeviceTwin(config.C.PostgreSQL.DB, device.DevEUI, false)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+				So(nsClient.GetDeviceQueueItemsForDevEUIChan, ShouldHaveLength, 0)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When the desired state is set", func() {
+			_, err := UpdateTwinDesired(config.C.PostgreSQL.DB, device, app, 10, storage.DeviceTwinState{"interval": 60.0})
+			So(err, ShouldBeNil)
+
+			Convey("Then the delta is enqueued", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				req := <-nsClient.CreateDeviceQueueItemChan
+				So(req.Item.FPort, ShouldEqual, 10)
+			})
+
+			Convey("Then the reported state is set on uplink", func() {
+				<-nsClient.CreateDeviceQueueItemChan
+
+				So(HandleTwinUplink(config.C.PostgreSQL.DB, device, app, map[string]interface{}{"interval": 120}), ShouldBeNil)
+
+				twin, err := storage.GetDeviceTwin(config.C.PostgreSQL.DB, device.DevEUI, false)
+				So(err, ShouldBeNil)
+				So(twin.Reported, ShouldResemble, storage.DeviceTwinState{"interval": 120.0})
+				So(twin.ReportedAt, ShouldNotBeNil)
+			})
+
+			Convey("Then the delta is enqueued again after two non-converged uplinks", func() {
+				<-nsClient.CreateDeviceQueueItemChan
+
+				So(HandleTwinUplink(config.C.PostgreSQL.DB, device, app, map[string]interface{}{"interval": 120}), ShouldBeNil)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+
+				So(HandleTwinUplink(config.C.PostgreSQL.DB, device, app, map[string]interface{}{"interval": 120}), ShouldBeNil)
+				So(nsClient.GetDeviceQueueItemsForDevEUIChan, ShouldHaveLength, 1)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+			})
+
+			Convey("Then the delta is not enqueued again while still pending in the device-queue", func() {
+				<-nsClient.CreateDeviceQueueItemChan
+
+				nsClient.GetDeviceQueueItemsForDevEUIResponse = ns.GetDeviceQueueItemsForDevEUIResponse{
+					Items: []*ns.DeviceQueueItem{
+						{DevEui: device.DevEUI[:], FPort: 10},
+					},
+				}
+
+				for i := 0; i < 3; i++ {
+					So(HandleTwinUplink(config.C.PostgreSQL.DB, device, app, map[string]interface{}{"interval": 120}), ShouldBeNil)
+				}
+				So(nsClient.GetDeviceQueueItemsForDevEUIChan, ShouldHaveLength, 2)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+
+			Convey("Then no delta is enqueued once the states converged", func() {
+				<-nsClient.CreateDeviceQueueItemChan
+
+				for i := 0; i < 3; i++ {
+					So(HandleTwinUplink(config.C.PostgreSQL.DB, device, app, map[string]interface{}{"interval": 60}), ShouldBeNil)
+				}
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Then setting the desired state with an invalid fPort returns an error", func() {
+			_, err := UpdateTwinDesired(config.C.PostgreSQL.DB, device, app, 0, storage.DeviceTwinState{"interval": 60.0})
+			So(err, ShouldEqual, storage.ErrDeviceTwinInvalidFPort)
+		})
+	})
+}
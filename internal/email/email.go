@@ -0,0 +1,339 @@
+// Package email implements the sending of (templated) e-mail messages
+// over SMTP. It is used for sending alerts to subscribed users and for
+// account related e-mails like invitations and password resets.
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// alertLockKeyTempl defines the key used to make sure the same alert
+// is not sent more than once within the configured alert interval.
+const alertLockKeyTempl = "lora:as:email:alert:%s:%d:%s"
+
+// TLS modes.
+const (
+	TLSModeNone     = "none"
+	TLSModeSTARTTLS = "starttls"
+	TLSModeTLS      = "tls"
+)
+
+// Template defines the e-mail template name.
+type Template string
+
+// Available templates. Alert templates are also used as alert type
+// for the e-mail alert subscriptions.
+const (
+	DeviceOffline      Template = "device_offline"
+	GatewayOffline     Template = "gateway_offline"
+	IntegrationFailure Template = "integration_failure"
+	Invitation         Template = "invitation"
+	PasswordReset      Template = "password_reset"
+)
+
+// ErrNotEnabled is returned when sending an e-mail while e-mail sending
+// has not been enabled.
+var ErrNotEnabled = errors.New("e-mail sending is not enabled")
+
+var templates map[Template]*template.Template
+
+// Setup configures the e-mail package. It loads the (default) templates,
+// which can be overridden by placing a <name>.tmpl file in the configured
+// template directory.
+func Setup() error {
+	conf := config.C.ApplicationServer.Email
+	if !conf.Enabled {
+		return nil
+	}
+
+	switch conf.TLSMode {
+	case "", TLSModeNone, TLSModeSTARTTLS, TLSModeTLS:
+	default:
+		return fmt.Errorf("invalid tls_mode: %s", conf.TLSMode)
+	}
+
+	if conf.From == "" {
+		return errors.New("from must be set")
+	}
+
+	tmpls := make(map[Template]*template.Template)
+	for name, text := range defaultTemplates {
+		if conf.TemplateDir != "" {
+			b, err := ioutil.ReadFile(filepath.Join(conf.TemplateDir, string(name)+".tmpl"))
+			if err == nil {
+				text = string(b)
+			} else if !os.IsNotExist(err) {
+				return errors.Wrap(err, "read template error")
+			}
+		}
+
+		t, err := template.New(string(name)).Funcs(template.FuncMap{
+			"baseURL": func() string {
+				return strings.TrimRight(config.C.ApplicationServer.Email.BaseURL, "/")
+			},
+		}).Parse(text)
+		if err != nil {
+			return errors.Wrapf(err, "parse %s template error", name)
+		}
+
+		for _, def := range []string{"subject", "body"} {
+			if t.Lookup(def) == nil {
+				return fmt.Errorf("template %s must define %s", name, def)
+			}
+		}
+
+		tmpls[name] = t
+	}
+
+	templates = tmpls
+
+	log.WithFields(log.Fields{
+		"server":   conf.Server,
+		"tls_mode": conf.TLSMode,
+	}).Info("e-mail sending enabled")
+
+	return nil
+}
+
+// Enabled returns true when e-mail sending has been enabled.
+func Enabled() bool {
+	return config.C.ApplicationServer.Email.Enabled && templates != nil
+}
+
+// Send renders the given template and sends it to the given recipients.
+func Send(to []string, tmpl Template, data interface{}) error {
+	if !Enabled() {
+		return ErrNotEnabled
+	}
+
+	if len(to) == 0 {
+		return nil
+	}
+
+	msg, err := renderMessage(to, tmpl, data)
+	if err != nil {
+		return errors.Wrap(err, "render message error")
+	}
+
+	if err := sendMail(to, msg); err != nil {
+		return errors.Wrap(err, "send mail error")
+	}
+
+	log.WithFields(log.Fields{
+		"template":   tmpl,
+		"recipients": len(to),
+	}).Info("e-mail sent")
+
+	return nil
+}
+
+// SendAlert sends the given alert to the users of the given organization
+// who are subscribed to this alert type. The key identifies the alert
+// subject (e.g. the DevEUI of the device) and is used to make sure that
+// the same alert is sent at most once per configured alert interval.
+func SendAlert(db sqlx.Queryer, organizationID int64, tmpl Template, key string, data interface{}) error {
+	if !Enabled() {
+		return nil
+	}
+
+	locked, err := acquireAlertLock(organizationID, tmpl, key)
+	if err != nil {
+		return errors.Wrap(err, "acquire alert lock error")
+	}
+	if !locked {
+		return nil
+	}
+
+	return sendAlert(db, organizationID, tmpl, data)
+}
+
+// SendAlertAsync is like SendAlert, but sends the alert in the background.
+// As the alert interval is checked before, this can be used on frequently
+// called code paths (e.g. the handling of uplinks).
+func SendAlertAsync(db sqlx.Queryer, organizationID int64, tmpl Template, key string, data interface{}) error {
+	if !Enabled() {
+		return nil
+	}
+
+	locked, err := acquireAlertLock(organizationID, tmpl, key)
+	if err != nil {
+		return errors.Wrap(err, "acquire alert lock error")
+	}
+	if !locked {
+		return nil
+	}
+
+	go func() {
+		if err := sendAlert(db, organizationID, tmpl, data); err != nil {
+			log.WithError(err).WithField("template", tmpl).Error("send alert error")
+		}
+	}()
+
+	return nil
+}
+
+// acquireAlertLock returns false when the given alert has already been sent
+// within the configured alert interval.
+func acquireAlertLock(organizationID int64, tmpl Template, key string) (bool, error) {
+	interval := config.C.ApplicationServer.Email.AlertInterval
+	if interval <= 0 {
+		return true, nil
+	}
+
+	return acquireLock(fmt.Sprintf(alertLockKeyTempl, tmpl, organizationID, key), interval)
+}
+
+func sendAlert(db sqlx.Queryer, organizationID int64, tmpl Template, data interface{}) error {
+	to, err := storage.GetEmailAlertRecipients(db, organizationID, string(tmpl))
+	if err != nil {
+		return errors.Wrap(err, "get e-mail alert recipients error")
+	}
+
+	if len(to) == 0 {
+		return nil
+	}
+
+	return Send(to, tmpl, data)
+}
+
+// acquireLock acquires the given lock key for the given duration. It
+// returns false when the lock has already been acquired.
+func acquireLock(key string, ttl time.Duration) (bool, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", key, "lock", "PX", int64(ttl/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func renderMessage(to []string, tmpl Template, data interface{}) ([]byte, error) {
+	t, ok := templates[tmpl]
+	if !ok {
+		return nil, fmt.Errorf("unknown template: %s", tmpl)
+	}
+
+	var subject, body bytes.Buffer
+	if err := t.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, errors.Wrap(err, "execute subject template error")
+	}
+	if err := t.ExecuteTemplate(&body, "body", data); err != nil {
+		return nil, errors.Wrap(err, "execute body template error")
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", config.C.ApplicationServer.Email.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", strings.TrimSpace(subject.String())))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.Replace(strings.TrimSpace(body.String()), "\n", "\r\n", -1))
+	msg.WriteString("\r\n")
+
+	return msg.Bytes(), nil
+}
+
+func sendMail(to []string, msg []byte) error {
+	conf := config.C.ApplicationServer.Email
+
+	host, _, err := net.SplitHostPort(conf.Server)
+	if err != nil {
+		return errors.Wrap(err, "split host-port error")
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: host,
+	}
+
+	if conf.CACert != "" {
+		b, err := ioutil.ReadFile(conf.CACert)
+		if err != nil {
+			return errors.Wrap(err, "read ca certificate error")
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(b) {
+			return errors.New("append ca certificate error")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	var conn net.Conn
+	if conf.TLSMode == TLSModeTLS {
+		conn, err = tls.Dial("tcp", conf.Server, tlsConfig)
+	} else {
+		conn, err = net.Dial("tcp", conf.Server)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dial error")
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "new smtp client error")
+	}
+	defer c.Close()
+
+	if conf.TLSMode == TLSModeSTARTTLS {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return errors.Wrap(err, "starttls error")
+		}
+	}
+
+	if conf.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", conf.Username, conf.Password, host)); err != nil {
+			return errors.Wrap(err, "auth error")
+		}
+	}
+
+	if err := c.Mail(conf.From); err != nil {
+		return errors.Wrap(err, "mail error")
+	}
+
+	for _, rcpt := range to {
+		if err := c.Rcpt(rcpt); err != nil {
+			return errors.Wrap(err, "rcpt error")
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return errors.Wrap(err, "data error")
+	}
+	if _, err := w.Write(msg); err != nil {
+		return errors.Wrap(err, "write message error")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "close data error")
+	}
+
+	return c.Quit()
+}
@@ -0,0 +1,231 @@
+package email
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lorawan"
+)
+
+// testMessage defines a message received by the test SMTP server.
+type testMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// testSMTPServer implements a minimal SMTP server which can be used as
+// stand-in for a real SMTP server.
+type testSMTPServer struct {
+	ln       net.Listener
+	messages chan testMessage
+}
+
+func newTestSMTPServer() (*testSMTPServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := testSMTPServer{
+		ln:       ln,
+		messages: make(chan testMessage, 10),
+	}
+	go s.serve()
+
+	return &s, nil
+}
+
+func (s *testSMTPServer) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	var msg testMessage
+	reply("220 localhost test smtp server")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			msg.From = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			msg.To = append(msg.To, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data []string
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				l = strings.TrimRight(l, "\r\n")
+				if l == "." {
+					break
+				}
+				data = append(data, l)
+			}
+			msg.Data = strings.Join(data, "\n")
+			s.messages <- msg
+			msg = testMessage{}
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *testSMTPServer) Close() error {
+	return s.ln.Close()
+}
+
+type EmailTestSuite struct {
+	suite.Suite
+	server *testSMTPServer
+}
+
+func (ts *EmailTestSuite) SetupSuite() {
+	assert := require.New(ts.T())
+
+	server, err := newTestSMTPServer()
+	assert.NoError(err)
+	ts.server = server
+}
+
+func (ts *EmailTestSuite) TearDownSuite() {
+	ts.server.Close()
+}
+
+func (ts *EmailTestSuite) SetupTest() {
+	assert := require.New(ts.T())
+
+	config.C.ApplicationServer.Email.Enabled = true
+	config.C.ApplicationServer.Email.Server = ts.server.ln.Addr().String()
+	config.C.ApplicationServer.Email.From = "lora-app-server@example.com"
+	config.C.ApplicationServer.Email.TLSMode = TLSModeNone
+	config.C.ApplicationServer.Email.BaseURL = "https://example.com/"
+	config.C.ApplicationServer.Email.TemplateDir = ""
+	assert.NoError(Setup())
+}
+
+func (ts *EmailTestSuite) TestSetup() {
+	ts.T().Run("Invalid TLS mode", func(t *testing.T) {
+		assert := require.New(t)
+
+		config.C.ApplicationServer.Email.TLSMode = "foo"
+		assert.Error(Setup())
+	})
+
+	ts.T().Run("Template override", func(t *testing.T) {
+		assert := require.New(t)
+
+		dir, err := ioutil.TempDir("", "email")
+		assert.NoError(err)
+		defer os.RemoveAll(dir)
+
+		assert.NoError(ioutil.WriteFile(filepath.Join(dir, "invitation.tmpl"), []byte(`{{ define "subject" }}Welcome {{ .Username }}{{ end }}{{ define "body" }}Hello!{{ end }}`), 0644))
+
+		config.C.ApplicationServer.Email.TLSMode = TLSModeNone
+		config.C.ApplicationServer.Email.TemplateDir = dir
+		assert.NoError(Setup())
+
+		assert.NoError(Send([]string{"foo@example.com"}, Invitation, InvitationData{Username: "foo"}))
+		msg := <-ts.server.messages
+		assert.Contains(msg.Data, "Subject: Welcome foo")
+		assert.Contains(msg.Data, "Hello!")
+	})
+
+	ts.T().Run("Template without body", func(t *testing.T) {
+		assert := require.New(t)
+
+		dir, err := ioutil.TempDir("", "email")
+		assert.NoError(err)
+		defer os.RemoveAll(dir)
+
+		assert.NoError(ioutil.WriteFile(filepath.Join(dir, "invitation.tmpl"), []byte(`{{ define "subject" }}Welcome{{ end }}`), 0644))
+
+		config.C.ApplicationServer.Email.TemplateDir = dir
+		assert.Error(Setup())
+	})
+}
+
+func (ts *EmailTestSuite) TestSend() {
+	ts.T().Run("Invitation", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(Send([]string{"foo@example.com", "bar@example.com"}, Invitation, InvitationData{Username: "foo"}))
+		msg := <-ts.server.messages
+
+		assert.Equal("lora-app-server@example.com", msg.From)
+		assert.Equal([]string{"foo@example.com", "bar@example.com"}, msg.To)
+		assert.Contains(msg.Data, "From: lora-app-server@example.com")
+		assert.Contains(msg.Data, "To: foo@example.com, bar@example.com")
+		assert.Contains(msg.Data, "Subject: Your LoRa App Server account")
+		assert.Contains(msg.Data, "username foo")
+		assert.Contains(msg.Data, "https://example.com/#/login")
+	})
+
+	ts.T().Run("Integration failure", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(Send([]string{"foo@example.com"}, IntegrationFailure, IntegrationFailureData{
+			ApplicationID:   1,
+			ApplicationName: "test-app",
+			DeviceName:      "test-device",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Error:           "connection refused",
+		}))
+		msg := <-ts.server.messages
+
+		assert.Contains(msg.Data, "Subject: Integration failure for application test-app")
+		assert.Contains(msg.Data, "test-device (0102030405060708)")
+		assert.Contains(msg.Data, "connection refused")
+		assert.Contains(msg.Data, "https://example.com/#/applications/1/integrations")
+	})
+
+	ts.T().Run("Not enabled", func(t *testing.T) {
+		assert := require.New(t)
+
+		config.C.ApplicationServer.Email.Enabled = false
+		assert.Equal(ErrNotEnabled, Send([]string{"foo@example.com"}, Invitation, InvitationData{Username: "foo"}))
+	})
+}
+
+func TestEmail(t *testing.T) {
+	suite.Run(t, new(EmailTestSuite))
+}
@@ -0,0 +1,122 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// offlineCheckInterval defines the interval in which the devices and
+// gateways which went offline are checked.
+const offlineCheckInterval = time.Minute
+
+// offlineLockKeyTempl defines the key used to make sure that when running
+// multiple instances, the offline alert for the same device or gateway
+// (and last-seen timestamp) is handled only once.
+const offlineLockKeyTempl = "lora:as:email:offline:%s:%s:%d"
+
+// OfflineAlertLoop is a never returning function which sends the
+// device_offline and gateway_offline alerts for the devices and gateways
+// which have not been seen for the configured timeout.
+func OfflineAlertLoop() {
+	from := time.Now()
+
+	for {
+		time.Sleep(offlineCheckInterval)
+
+		to := time.Now()
+		if err := checkOffline(from, to); err != nil {
+			log.WithError(err).Error("check offline error")
+		}
+		from = to
+	}
+}
+
+// checkOffline sends the alerts for the devices and gateways which went
+// offline between from and to, meaning that their last-seen timestamp is
+// within the same time-range, minus the configured offline timeout.
+func checkOffline(from, to time.Time) error {
+	conf := config.C.ApplicationServer.Email
+
+	if timeout := conf.DeviceOfflineTimeout; timeout > 0 {
+		if err := checkDevicesOffline(from.Add(-timeout), to.Add(-timeout), timeout); err != nil {
+			return errors.Wrap(err, "check devices offline error")
+		}
+	}
+
+	if timeout := conf.GatewayOfflineTimeout; timeout > 0 {
+		if err := checkGatewaysOffline(from.Add(-timeout), to.Add(-timeout), timeout); err != nil {
+			return errors.Wrap(err, "check gateways offline error")
+		}
+	}
+
+	return nil
+}
+
+func checkDevicesOffline(from, to time.Time, timeout time.Duration) error {
+	devices, err := storage.GetEmailAlertOfflineDevices(config.C.PostgreSQL.DB, from, to)
+	if err != nil {
+		return errors.Wrap(err, "get offline devices error")
+	}
+
+	for i := range devices {
+		d := devices[i]
+
+		locked, err := acquireLock(fmt.Sprintf(offlineLockKeyTempl, DeviceOffline, d.DevEUI, d.LastSeenAt.UnixNano()), timeout)
+		if err != nil {
+			return errors.Wrap(err, "acquire lock error")
+		}
+		if !locked {
+			continue
+		}
+
+		if err := SendAlert(config.C.PostgreSQL.DB, d.OrganizationID, DeviceOffline, d.DevEUI.String(), DeviceOfflineData{
+			ApplicationID:   d.ApplicationID,
+			ApplicationName: d.ApplicationName,
+			DeviceName:      d.Name,
+			DevEUI:          d.DevEUI,
+			LastSeenAt:      &d.LastSeenAt,
+		}); err != nil {
+			log.WithError(err).WithField("dev_eui", d.DevEUI).Error("send device offline alert error")
+		}
+	}
+
+	return nil
+}
+
+func checkGatewaysOffline(from, to time.Time, timeout time.Duration) error {
+	// the last-seen timestamp is updated for the gateways receiving an
+	// uplink, see storage.UpdateGatewaysLastSeenAt
+	gateways, err := storage.GetEmailAlertOfflineGateways(config.C.PostgreSQL.DB, from, to)
+	if err != nil {
+		return errors.Wrap(err, "get offline gateways error")
+	}
+
+	for i := range gateways {
+		gw := gateways[i]
+
+		locked, err := acquireLock(fmt.Sprintf(offlineLockKeyTempl, GatewayOffline, gw.MAC, gw.LastSeenAt.UnixNano()), timeout)
+		if err != nil {
+			return errors.Wrap(err, "acquire lock error")
+		}
+		if !locked {
+			continue
+		}
+
+		if err := SendAlert(config.C.PostgreSQL.DB, gw.OrganizationID, GatewayOffline, gw.MAC.String(), GatewayOfflineData{
+			OrganizationID: gw.OrganizationID,
+			GatewayName:    gw.Name,
+			GatewayID:      gw.MAC,
+			LastSeenAt:     &gw.LastSeenAt,
+		}); err != nil {
+			log.WithError(err).WithField("mac", gw.MAC).Error("send gateway offline alert error")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package email
+
+import (
+	"time"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceOfflineData defines the data passed to the device_offline template.
+type DeviceOfflineData struct {
+	ApplicationID   int64
+	ApplicationName string
+	DeviceName      string
+	DevEUI          lorawan.EUI64
+	LastSeenAt      *time.Time
+}
+
+// GatewayOfflineData defines the data passed to the gateway_offline
+// template.
+type GatewayOfflineData struct {
+	OrganizationID int64
+	GatewayName    string
+	GatewayID      lorawan.EUI64
+	LastSeenAt     *time.Time
+}
+
+// IntegrationFailureData defines the data passed to the
+// integration_failure template.
+type IntegrationFailureData struct {
+	ApplicationID   int64
+	ApplicationName string
+	DeviceName      string
+	DevEUI          lorawan.EUI64
+	Error           string
+}
+
+// InvitationData defines the data passed to the invitation template.
+type InvitationData struct {
+	Username string
+}
+
+// PasswordResetData defines the data passed to the password_reset template.
+type PasswordResetData struct {
+	Username string
+	Token    string
+}
+
+var defaultTemplates = map[Template]string{
+	DeviceOffline: `{{ define "subject" }}Device {{ .DeviceName }} is offline{{ end }}
+{{ define "body" }}
+The device {{ .DeviceName }} ({{ .DevEUI }}) of application {{ .ApplicationName }} is offline.
+{{ if .LastSeenAt }}
+It was last seen at {{ .LastSeenAt.Format "2006-01-02 15:04:05 MST" }}.
+{{ end }}
+{{ if baseURL }}
+{{ baseURL }}/#/applications/{{ .ApplicationID }}/devices/{{ .DevEUI }}
+{{ end }}
+{{ end }}`,
+
+	GatewayOffline: `{{ define "subject" }}Gateway {{ .GatewayName }} is offline{{ end }}
+{{ define "body" }}
+The gateway {{ .GatewayName }} ({{ .GatewayID }}) is offline.
+{{ if .LastSeenAt }}
+It was last seen at {{ .LastSeenAt.Format "2006-01-02 15:04:05 MST" }}.
+{{ end }}
+{{ if baseURL }}
+{{ baseURL }}/#/organizations/{{ .OrganizationID }}/gateways/{{ .GatewayID }}
+{{ end }}
+{{ end }}`,
+
+	IntegrationFailure: `{{ define "subject" }}Integration failure for application {{ .ApplicationName }}{{ end }}
+{{ define "body" }}
+Forwarding data of device {{ .DeviceName }} ({{ .DevEUI }}) to the integration(s)
+of application {{ .ApplicationName }} failed:
+
+{{ .Error }}
+{{ if baseURL }}
+{{ baseURL }}/#/applications/{{ .ApplicationID }}/integrations
+{{ end }}
+{{ end }}`,
+
+	Invitation: `{{ define "subject" }}Your LoRa App Server account{{ end }}
+{{ define "body" }}
+An account has been created for you with the username {{ .Username }}.
+{{ if baseURL }}
+You can log in at: {{ baseURL }}/#/login
+{{ end }}
+{{ end }}`,
+
+	PasswordReset: `{{ define "subject" }}Reset your LoRa App Server password{{ end }}
+{{ define "body" }}
+A password reset has been requested for the account {{ .Username }}.
+{{ if baseURL }}
+Use the following link to set a new password:
+{{ baseURL }}/#/reset-password?token={{ .Token }}
+{{ else }}
+Your password reset token is: {{ .Token }}
+{{ end }}
+If you did not request this password reset, you can ignore this e-mail.
+{{ end }}`,
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -11,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 )
 
@@ -26,16 +28,63 @@ const (
 	Error    = "error"
 	Status   = "status"
 	Location = "location"
+	Codec    = "codec"
+	Downlink = "downlink"
 )
 
+// RetentionCacheTTL defines how long the device event retention of a device
+// is cached, so that it is not retrieved from the database for every event.
+// Retention changes take effect after this interval.
+var RetentionCacheTTL = time.Minute
+
+var retentions = newRetentionCache()
+
 // EventLog contains an event log.
 type EventLog struct {
 	Type    string
 	Payload interface{}
 }
 
-// LogEventForDevice logs an event for the given device.
+// CodecEvent contains the diagnostics of a payload codec execution.
+type CodecEvent struct {
+	Codec         string   `json:"codec"`
+	Operation     string   `json:"operation"`
+	FPort         uint8    `json:"fPort"`
+	Console       []string `json:"console"`
+	ExecutionTime string   `json:"executionTime"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// DownlinkEvent contains a downlink payload enqueued for the device.
+type DownlinkEvent struct {
+	FCnt      uint32 `json:"fCnt"`
+	FPort     uint8  `json:"fPort"`
+	Confirmed bool   `json:"confirmed"`
+	Data      []byte `json:"data"`
+}
+
+// LogEventForDevice logs an event for the given device. When a device event
+// retention has been configured (globally or for the organization of the
+// device), the event is also stored in the database. Publishing and storing
+// the event are independent of each other, an error of one does not prevent
+// the other.
 func LogEventForDevice(devEUI lorawan.EUI64, el EventLog) error {
+	publishErr := publishEvent(devEUI, el)
+	storeErr := storeEvent(devEUI, el)
+
+	if publishErr != nil && storeErr != nil {
+		log.WithError(publishErr).WithField("dev_eui", devEUI).Error("publish device event error")
+	}
+	if storeErr != nil {
+		return storeErr
+	}
+
+	return publishErr
+}
+
+// publishEvent publishes the event to the subscribers of the device event
+// log.
+func publishEvent(devEUI lorawan.EUI64, el EventLog) error {
 	c := config.C.Redis.Pool.Get()
 	defer c.Close()
 
@@ -52,6 +101,113 @@ func LogEventForDevice(devEUI lorawan.EUI64, el EventLog) error {
 	return nil
 }
 
+// storeEvent stores the event in the database, unless the device event
+// retention for the device is 0. Codec events of successful executions are
+// only stored when this has been configured.
+func storeEvent(devEUI lorawan.EUI64, el EventLog) error {
+	if ce, ok := el.Payload.(CodecEvent); ok && ce.Error == "" && !config.C.ApplicationServer.DeviceEventLog.StoreCodecEvents {
+		return nil
+	}
+
+	retention, err := retentions.get(devEUI)
+	if err != nil {
+		// the device might have been deleted in the meantime
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil
+		}
+		return errors.Wrap(err, "get device event retention error")
+	}
+
+	if retention == 0 {
+		return nil
+	}
+
+	pl, err := json.Marshal(el.Payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	if err := storage.CreateDeviceEvent(config.C.PostgreSQL.DB, &storage.DeviceEvent{
+		DevEUI:  devEUI,
+		Type:    el.Type,
+		Payload: pl,
+	}); err != nil {
+		return errors.Wrap(err, "create device event error")
+	}
+
+	return nil
+}
+
+// retentionCache caches the device event retention per device.
+type retentionCache struct {
+	sync.Mutex
+	items    map[lorawan.EUI64]retentionCacheItem
+	purgedAt time.Time
+}
+
+type retentionCacheItem struct {
+	retention time.Duration
+	expiresAt time.Time
+}
+
+func newRetentionCache() *retentionCache {
+	return &retentionCache{
+		items:    make(map[lorawan.EUI64]retentionCacheItem),
+		purgedAt: time.Now(),
+	}
+}
+
+// get returns the device event retention for the given device. In case it is
+// not cached (or expired), it is retrieved from the database.
+func (c *retentionCache) get(devEUI lorawan.EUI64) (time.Duration, error) {
+	now := time.Now()
+
+	c.Lock()
+	item, ok := c.items[devEUI]
+	c.Unlock()
+	if ok && now.Before(item.expiresAt) {
+		return item.retention, nil
+	}
+
+	retention, err := storage.GetDeviceEventRetention(config.C.PostgreSQL.DB, devEUI, config.C.ApplicationServer.DeviceEventLog.Retention)
+	if err != nil {
+		return 0, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	// remove the expired items, so that the cache only holds the devices
+	// which were recently active
+	if now.Sub(c.purgedAt) > RetentionCacheTTL {
+		for k, v := range c.items {
+			if !now.Before(v.expiresAt) {
+				delete(c.items, k)
+			}
+		}
+		c.purgedAt = now
+	}
+
+	c.items[devEUI] = retentionCacheItem{
+		retention: retention,
+		expiresAt: now.Add(RetentionCacheTTL),
+	}
+
+	return retention, nil
+}
+
+// DeleteExpiredEventsLoop is a never returning function which periodically
+// deletes the device events that exceed the configured retention.
+func DeleteExpiredEventsLoop() {
+	for {
+		if _, err := storage.DeleteExpiredDeviceEvents(config.C.PostgreSQL.DB, config.C.ApplicationServer.DeviceEventLog.Retention); err != nil {
+			log.WithError(err).Error("delete expired device events error")
+		}
+
+		time.Sleep(config.C.ApplicationServer.DeviceEventLog.CleanupInterval)
+	}
+}
+
 // GetEventLogForDevice subscribes to the device events for the given DevEUI
 // and sends this to the given channel.
 func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan chan EventLog) error {
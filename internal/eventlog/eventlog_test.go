@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/brocaar/lora-app-server/internal/config"
@@ -16,10 +17,17 @@ import (
 
 func TestEventLog(t *testing.T) {
 	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
 	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
 	config.C.Redis.Pool = p
 
-	Convey("Given a clean Redis database", t, func() {
+	Convey("Given a clean database", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
 		test.MustFlushRedis(p)
 
 		Convey("Testing GetEventLogForDevice", func() {
@@ -58,5 +66,141 @@ func TestEventLog(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Given a device", func() {
+			config.C.NetworkServer.Pool = test.NewNetworkServerPool(test.NewNetworkServerClient())
+
+			org := storage.Organization{
+				Name: "test-org",
+			}
+			So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+			n := storage.NetworkServer{
+				Name:   "test-ns",
+				Server: "test-ns:1234",
+			}
+			So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+			sp := storage.ServiceProfile{
+				Name:            "test-sp",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+			}
+			So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+			spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+			So(err, ShouldBeNil)
+
+			dp := storage.DeviceProfile{
+				Name:            "test-dp",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+			}
+			So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+			dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+			So(err, ShouldBeNil)
+
+			app := storage.Application{
+				Name:             "test-app",
+				OrganizationID:   org.ID,
+				ServiceProfileID: spID,
+			}
+			So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+			d := storage.Device{
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            "test-device",
+			}
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+			retentions = newRetentionCache()
+
+			retention := config.C.ApplicationServer.DeviceEventLog.Retention
+			config.C.ApplicationServer.DeviceEventLog.Retention = 0
+			defer func() {
+				config.C.ApplicationServer.DeviceEventLog.Retention = retention
+			}()
+
+			el := EventLog{
+				Type: Uplink,
+				Payload: map[string]interface{}{
+					"fCnt": 10,
+				},
+			}
+
+			Convey("When no retention has been configured", func() {
+				So(LogEventForDevice(d.DevEUI, el), ShouldBeNil)
+
+				Convey("Then the event has not been stored", func() {
+					count, err := storage.GetDeviceEventCount(config.C.PostgreSQL.DB, storage.DeviceEventFilters{DevEUI: d.DevEUI})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When only the organization retention has been configured", func() {
+				org.DeviceEventRetentionDays = 1
+				So(storage.UpdateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+				So(LogEventForDevice(d.DevEUI, el), ShouldBeNil)
+
+				Convey("Then the event has been stored", func() {
+					count, err := storage.GetDeviceEventCount(config.C.PostgreSQL.DB, storage.DeviceEventFilters{DevEUI: d.DevEUI})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey("Then the retention is cached", func() {
+					org.DeviceEventRetentionDays = 0
+					So(storage.UpdateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+					So(LogEventForDevice(d.DevEUI, el), ShouldBeNil)
+
+					count, err := storage.GetDeviceEventCount(config.C.PostgreSQL.DB, storage.DeviceEventFilters{DevEUI: d.DevEUI})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 2)
+				})
+			})
+
+			Convey("When logging codec events", func() {
+				org.DeviceEventRetentionDays = 1
+				So(storage.UpdateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+				So(LogEventForDevice(d.DevEUI, EventLog{
+					Type:    Codec,
+					Payload: CodecEvent{Codec: "CUSTOM_JS", Operation: "decode"},
+				}), ShouldBeNil)
+				So(LogEventForDevice(d.DevEUI, EventLog{
+					Type:    Codec,
+					Payload: CodecEvent{Codec: "CUSTOM_JS", Operation: "decode", Error: "execution timeout"},
+				}), ShouldBeNil)
+
+				Convey("Then only the codec event containing an error has been stored", func() {
+					events, err := storage.GetDeviceEvents(config.C.PostgreSQL.DB, storage.DeviceEventFilters{DevEUI: d.DevEUI, Limit: 10})
+					So(err, ShouldBeNil)
+					So(events, ShouldHaveLength, 1)
+					So(string(events[0].Payload), ShouldContainSubstring, "execution timeout")
+				})
+
+				Convey("When storing codec events has been enabled", func() {
+					config.C.ApplicationServer.DeviceEventLog.StoreCodecEvents = true
+					defer func() {
+						config.C.ApplicationServer.DeviceEventLog.StoreCodecEvents = false
+					}()
+
+					So(LogEventForDevice(d.DevEUI, EventLog{
+						Type:    Codec,
+						Payload: CodecEvent{Codec: "CUSTOM_JS", Operation: "decode"},
+					}), ShouldBeNil)
+
+					Convey("Then the successful codec event has been stored", func() {
+						count, err := storage.GetDeviceEventCount(config.C.PostgreSQL.DB, storage.DeviceEventFilters{DevEUI: d.DevEUI})
+						So(err, ShouldBeNil)
+						So(count, ShouldEqual, 2)
+					})
+				})
+			})
+		})
 	})
 }
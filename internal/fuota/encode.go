@@ -0,0 +1,78 @@
+package fuota
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Encode splits the given data into fragments of the given fragment size
+// and appends the given number of redundancy (parity) fragments, using the
+// forward error correction scheme of the LoRaWAN Fragmented Data Block
+// Transport specification. The length of the data must be a multiple of the
+// fragment size.
+func Encode(data []byte, fragSize, redundancy int) ([][]byte, error) {
+	if fragSize <= 0 || len(data)%fragSize != 0 {
+		return nil, errors.New("length of data must be a multiple of the fragment size")
+	}
+
+	var fragments [][]byte
+	for i := 0; i < len(data)/fragSize; i++ {
+		fragments = append(fragments, data[i*fragSize:(i+1)*fragSize])
+	}
+	w := len(fragments)
+
+	for y := 0; y < redundancy; y++ {
+		parity := make([]byte, fragSize)
+		line := matrixLine(y+1, w)
+
+		for x := 0; x < w; x++ {
+			if !line[x] {
+				continue
+			}
+			for m := 0; m < fragSize; m++ {
+				parity[m] ^= fragments[x][m]
+			}
+		}
+
+		fragments = append(fragments, parity)
+	}
+
+	return fragments, nil
+}
+
+// matrixLine returns line n of the parity check matrix for m uncoded
+// fragments. A true value at index x means that the uncoded fragment x is
+// part of the parity fragment.
+func matrixLine(n, m int) []bool {
+	line := make([]bool, m)
+
+	var mm int
+	if isPowerOf2(m) {
+		mm = 1
+	}
+
+	x := 1 + (1001 * n)
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = x % (m + mm)
+		}
+		line[r] = true
+	}
+
+	return line
+}
+
+// prbs23 implements the 23 bit pseudo-random binary sequence generator.
+func prbs23(x int) int {
+	b0 := x & 1
+	b1 := (x & 32) / 32
+	return (x / 2) + ((b0 ^ b1) << 22)
+}
+
+func isPowerOf2(n int) bool {
+	for n%2 == 0 && n > 1 {
+		n = n / 2
+	}
+	return n == 1
+}
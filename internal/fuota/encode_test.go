@@ -0,0 +1,74 @@
+package fuota
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEncode(t *testing.T) {
+	Convey("Given a payload of 10 fragments", t, func() {
+		fragSize := 8
+		data := make([]byte, 10*fragSize)
+		for i := range data {
+			data[i] = byte(i * 7)
+		}
+
+		Convey("Then Encode returns an error when the data is not a multiple of the fragment size", func() {
+			_, err := Encode(data[:len(data)-1], fragSize, 5)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When encoding with a redundancy of 5", func() {
+			fragments, err := Encode(data, fragSize, 5)
+			So(err, ShouldBeNil)
+
+			Convey("Then the uncoded fragments are returned first", func() {
+				So(fragments, ShouldHaveLength, 15)
+				So(bytes.Join(fragments[:10], nil), ShouldResemble, data)
+			})
+
+			Convey("Then every parity fragment combines half of the uncoded fragments", func() {
+				for y := 1; y <= 5; y++ {
+					var count int
+					for _, v := range matrixLine(y, 10) {
+						if v {
+							count++
+						}
+					}
+					So(count, ShouldBeGreaterThan, 0)
+					So(count, ShouldBeLessThanOrEqualTo, 5)
+				}
+			})
+
+			for lost := 0; lost < 10; lost++ {
+				Convey(fmt.Sprintf("Then a lost fragment %d can be recovered", lost), func() {
+					var recovered []byte
+
+					for y := 1; y <= 5 && recovered == nil; y++ {
+						line := matrixLine(y, 10)
+						if !line[lost] {
+							continue
+						}
+
+						recovered = make([]byte, fragSize)
+						copy(recovered, fragments[10+y-1])
+						for x := 0; x < 10; x++ {
+							if x == lost || !line[x] {
+								continue
+							}
+							for m := range recovered {
+								recovered[m] ^= fragments[x][m]
+							}
+						}
+					}
+
+					So(recovered, ShouldNotBeNil)
+					So(recovered, ShouldResemble, data[lost*fragSize:(lost+1)*fragSize])
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,530 @@
+// Package fuota implements firmware updates over the air, using the LoRaWAN
+// Fragmented Data Block Transport (TS004).
+package fuota
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/multicast"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+)
+
+// StepRetryInterval defines the interval after which a failed deployment
+// step is retried. The interval is doubled on every consecutive failure of
+// the step, up to MaxStepRetryInterval.
+var StepRetryInterval = 10 * time.Second
+
+// MaxStepRetryInterval defines the max. interval after which a failed
+// deployment step is retried.
+var MaxStepRetryInterval = time.Hour
+
+// DeploymentLoop is a never returning function executing the pending steps
+// of the FUOTA deployments.
+func DeploymentLoop() {
+	for {
+		for {
+			processed, err := processDeployment()
+			if err != nil {
+				log.WithError(err).Error("process fuota deployment error")
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// HandleUplinkCommand handles the given fragmentation-session commands,
+// received on the fragmentation fPort, and updates the state of the device
+// within its FUOTA deployment.
+func HandleUplinkCommand(db sqlx.Ext, devEUI lorawan.EUI64, b []byte) error {
+	var cmds fragmentation.Commands
+	if err := cmds.UnmarshalBinary(true, b); err != nil {
+		return errors.Wrap(err, "unmarshal commands error")
+	}
+
+	for _, cmd := range cmds {
+		var err error
+		switch pl := cmd.Payload.(type) {
+		case *fragmentation.FragSessionSetupAnsPayload:
+			err = handleFragSessionSetupAns(db, devEUI, pl)
+		case *fragmentation.FragSessionStatusAnsPayload:
+			err = handleFragSessionStatusAns(db, devEUI, pl)
+		case *fragmentation.FragSessionDeleteAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":                devEUI,
+				"frag_index":             pl.Status.FragIndex,
+				"session_does_not_exist": pl.Status.SessionDoesNotExist,
+			}).Info("fuota: FragSessionDeleteAns received")
+		case *fragmentation.PackageVersionAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":            devEUI,
+				"package_identifier": pl.PackageIdentifier,
+				"package_version":    pl.PackageVersion,
+			}).Info("fuota: PackageVersionAns received")
+		default:
+			log.WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"cid":     cmd.CID,
+			}).Warning("fuota: unexpected fragmentation command")
+		}
+		if err != nil {
+			return errors.Wrapf(err, "handle cid %d error", cmd.CID)
+		}
+	}
+
+	return nil
+}
+
+func handleFragSessionSetupAns(db sqlx.Ext, devEUI lorawan.EUI64, pl *fragmentation.FragSessionSetupAnsPayload) error {
+	dd, d, err := getActiveDeploymentDevice(db, devEUI, int(pl.StatusBitMaks.FragIndex))
+	if err != nil {
+		return err
+	}
+
+	if dd.State != storage.FUOTADeploymentDevicePending || d.State != storage.FUOTADeploymentEnqueue {
+		log.WithFields(log.Fields{
+			"dev_eui":             devEUI,
+			"fuota_deployment_id": d.ID,
+		}).Warning("fuota: unexpected FragSessionSetupAns, ignoring")
+		return nil
+	}
+
+	var errs []string
+	if pl.StatusBitMaks.EncodingUnsupported {
+		errs = append(errs, "encoding unsupported")
+	}
+	if pl.StatusBitMaks.NotEngoughMemory {
+		errs = append(errs, "not enough memory")
+	}
+	if pl.StatusBitMaks.FragSessionIndexNotSupported {
+		errs = append(errs, "fragmentation session index not supported")
+	}
+	if pl.StatusBitMaks.WrongDescriptor {
+		errs = append(errs, "wrong descriptor")
+	}
+
+	if len(errs) != 0 {
+		dd.State = storage.FUOTADeploymentDeviceError
+		dd.ErrorMessage = "fragmentation session setup failed: " + strings.Join(errs, ", ")
+	} else {
+		dd.State = storage.FUOTADeploymentDeviceSetupCompleted
+	}
+
+	if err := storage.UpdateFUOTADeploymentDevice(db, &dd); err != nil {
+		return errors.Wrap(err, "update fuota deployment device error")
+	}
+
+	return nil
+}
+
+func handleFragSessionStatusAns(db sqlx.Ext, devEUI lorawan.EUI64, pl *fragmentation.FragSessionStatusAnsPayload) error {
+	dd, d, err := getActiveDeploymentDevice(db, devEUI, int(pl.ReceivedAndIndex.FragIndex))
+	if err != nil {
+		return err
+	}
+
+	if dd.State != storage.FUOTADeploymentDeviceSetupCompleted {
+		log.WithFields(log.Fields{
+			"dev_eui":             devEUI,
+			"fuota_deployment_id": d.ID,
+		}).Warning("fuota: unexpected FragSessionStatusAns, ignoring")
+		return nil
+	}
+
+	dd.NbFragReceived = int(pl.ReceivedAndIndex.NbFragReceived)
+	dd.MissingFrag = int(pl.MissingFrag)
+
+	switch {
+	case pl.Status.NotEnoughMatrixMemory:
+		dd.State = storage.FUOTADeploymentDeviceError
+		dd.ErrorMessage = "not enough matrix memory"
+	case pl.MissingFrag != 0:
+		dd.State = storage.FUOTADeploymentDeviceError
+		dd.ErrorMessage = fmt.Sprintf("%d fragments missing", pl.MissingFrag)
+	default:
+		dd.State = storage.FUOTADeploymentDeviceCompleted
+	}
+
+	if err := storage.UpdateFUOTADeploymentDevice(db, &dd); err != nil {
+		return errors.Wrap(err, "update fuota deployment device error")
+	}
+
+	return nil
+}
+
+// getActiveDeploymentDevice returns the deployment device and deployment
+// in progress for the given DevEUI, validating the fragmentation session
+// index.
+func getActiveDeploymentDevice(db sqlx.Queryer, devEUI lorawan.EUI64, fragIndex int) (storage.FUOTADeploymentDevice, storage.FUOTADeployment, error) {
+	var d storage.FUOTADeployment
+
+	dd, err := storage.GetActiveFUOTADeploymentDeviceForDevEUI(db, devEUI)
+	if err != nil {
+		return dd, d, errors.Wrap(err, "get active fuota deployment device error")
+	}
+
+	d, err = storage.GetFUOTADeployment(db, dd.FUOTADeploymentID, false)
+	if err != nil {
+		return dd, d, errors.Wrap(err, "get fuota deployment error")
+	}
+
+	if d.FragIndex != fragIndex {
+		return dd, d, fmt.Errorf("expected frag index %d, got %d", d.FragIndex, fragIndex)
+	}
+
+	return dd, d, nil
+}
+
+// processDeployment executes the next step of a pending FUOTA deployment.
+// It returns false when there was no pending deployment.
+//
+// The transaction only holds the lock on the deployment. The steps enqueue
+// the payloads using their own (per device) transactions, so that the
+// progress is stored, also when the step fails. A failed step is retried
+// after a back-off interval, so that it does not block other deployments.
+func processDeployment() (bool, error) {
+	var d *storage.FUOTADeployment
+	var state storage.FUOTADeploymentState
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetPendingFUOTADeployment(tx)
+		if err != nil {
+			return errors.Wrap(err, "get pending fuota deployment error")
+		}
+		if d == nil {
+			return nil
+		}
+		state = d.State
+
+		switch d.State {
+		case storage.FUOTADeploymentFragSessionSetup:
+			err = stepFragSessionSetup(d)
+		case storage.FUOTADeploymentEnqueue:
+			err = stepEnqueue(d)
+		case storage.FUOTADeploymentStatusRequest:
+			err = stepStatusRequest(d)
+		case storage.FUOTADeploymentFragSessionDelete:
+			err = stepFragSessionDelete(d)
+		default:
+			err = fmt.Errorf("unexpected state: %s", d.State)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "fuota deployment step %s error", d.State)
+		}
+
+		d.RetryCount = 0
+		d.ErrorMessage = ""
+		if err := storage.UpdateFUOTADeploymentState(tx, d); err != nil {
+			return errors.Wrap(err, "update fuota deployment state error")
+		}
+
+		return nil
+	})
+	if err != nil && d != nil {
+		if err := setStepError(d, state, err); err != nil {
+			log.WithError(err).WithField("fuota_deployment_id", d.ID).Error("fuota: set step error error")
+		}
+	}
+
+	return d != nil, err
+}
+
+// setStepError stores the error of the failed step and schedules the retry
+// of the step.
+func setStepError(d *storage.FUOTADeployment, state storage.FUOTADeploymentState, stepErr error) error {
+	d.State = state
+	d.RetryCount++
+	d.ErrorMessage = stepErr.Error()
+	d.NextStepAfter = time.Now().Add(stepRetryInterval(d.RetryCount))
+
+	return storage.UpdateFUOTADeploymentState(config.C.PostgreSQL.DB, d)
+}
+
+// stepRetryInterval returns the interval after which a step is retried,
+// given the number of consecutive failures.
+func stepRetryInterval(retryCount int) time.Duration {
+	interval := StepRetryInterval
+	for i := 1; i < retryCount && interval < MaxStepRetryInterval; i++ {
+		interval *= 2
+	}
+	if interval > MaxStepRetryInterval {
+		return MaxStepRetryInterval
+	}
+	return interval
+}
+
+// stepFragSessionSetup sends the FragSessionSetupReq to all devices of the
+// deployment.
+func stepFragSessionSetup(d *storage.FUOTADeployment) error {
+	cmd := fragmentation.Command{
+		CID: fragmentation.FragSessionSetupReq,
+		Payload: &fragmentation.FragSessionSetupReqPayload{
+			FragSession: fragmentation.FragSessionSetupReqPayloadFragSession{
+				FragIndex: uint8(d.FragIndex),
+			},
+			NbFrag:   uint16(d.NbFrag()),
+			FragSize: uint8(d.FragSize),
+			Control: fragmentation.FragSessionSetupReqPayloadControl{
+				BlockAckDelay: uint8(d.BlockAckDelay),
+			},
+			Padding: uint8(d.NbFrag()*d.FragSize - len(d.Payload)),
+		},
+	}
+
+	pl := cmd.Payload.(*fragmentation.FragSessionSetupReqPayload)
+	copy(pl.Descriptor[:], d.Descriptor)
+	if d.MulticastGroupID != nil {
+		pl.FragSession.McGroupBitMask[d.McGroupID] = true
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	if err := enqueueForDevices(d, []storage.FUOTADeploymentDeviceState{storage.FUOTADeploymentDevicePending}, b); err != nil {
+		return err
+	}
+
+	d.State = storage.FUOTADeploymentEnqueue
+	d.NextStepAfter = time.Now().Add(time.Duration(d.Timeout) * time.Second)
+
+	return nil
+}
+
+// stepEnqueue enqueues the (encoded) fragments, either to the multicast-group
+// or to each device which completed the fragmentation session setup.
+func stepEnqueue(d *storage.FUOTADeployment) error {
+	db := config.C.PostgreSQL.DB
+
+	if err := storage.SetFUOTADeploymentDevicesError(db, d.ID, []storage.FUOTADeploymentDeviceState{storage.FUOTADeploymentDevicePending}, "fragmentation session setup timeout"); err != nil {
+		return errors.Wrap(err, "set fuota deployment devices error")
+	}
+
+	devices, err := storage.GetFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get fuota deployment devices error")
+	}
+
+	var setupCompleted []storage.FUOTADeploymentDevice
+	for _, dd := range devices {
+		if dd.State == storage.FUOTADeploymentDeviceSetupCompleted {
+			setupCompleted = append(setupCompleted, dd)
+		}
+	}
+
+	if len(setupCompleted) == 0 {
+		log.WithField("fuota_deployment_id", d.ID).Warning("fuota: no device completed the fragmentation session setup")
+		d.State = storage.FUOTADeploymentDone
+		d.NextStepAfter = time.Now()
+		return nil
+	}
+
+	// pad the payload to a multiple of the fragment size
+	payload := make([]byte, d.NbFrag()*d.FragSize)
+	copy(payload, d.Payload)
+
+	fragments, err := Encode(payload, d.FragSize, d.Redundancy)
+	if err != nil {
+		return errors.Wrap(err, "encode payload error")
+	}
+
+	var items [][]byte
+	for i, frag := range fragments {
+		cmd := fragmentation.Command{
+			CID: fragmentation.DataFragment,
+			Payload: &fragmentation.DataFragmentPayload{
+				IndexAndN: fragmentation.DataFragmentPayloadIndexAndN{
+					FragIndex: uint8(d.FragIndex),
+					N:         uint16(i + 1),
+				},
+				Payload: frag,
+			},
+		}
+
+		b, err := cmd.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "marshal binary error")
+		}
+		items = append(items, b)
+	}
+
+	if d.MulticastGroupID != nil {
+		// a retry of this step continues with the first fragment which has
+		// not been enqueued
+		for d.NbFragEnqueued < len(items) {
+			err := storage.Transaction(db, func(tx sqlx.Ext) error {
+				_, err := multicast.Enqueue(tx, *d.MulticastGroupID, fragmentation.DefaultFPort, items[d.NbFragEnqueued])
+				return err
+			})
+			if err != nil {
+				return errors.Wrap(err, "enqueue multicast payload error")
+			}
+			d.NbFragEnqueued++
+		}
+	} else {
+		for i := range setupCompleted {
+			if err := enqueueForDevice(d, &setupCompleted[i], items); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"fuota_deployment_id": d.ID,
+		"fragments":           len(items),
+	}).Info("fuota: fragments enqueued")
+
+	d.State = storage.FUOTADeploymentStatusRequest
+	d.NextStepAfter = time.Now().Add(time.Duration(d.Timeout) * time.Second)
+
+	return nil
+}
+
+// stepStatusRequest sends the FragSessionStatusReq to the devices which
+// completed the fragmentation session setup.
+func stepStatusRequest(d *storage.FUOTADeployment) error {
+	cmd := fragmentation.Command{
+		CID: fragmentation.FragSessionStatusReq,
+		Payload: &fragmentation.FragSessionStatusReqPayload{
+			FragStatusReqParam: fragmentation.FragSessionStatusReqPayloadFragStatusReqParam{
+				FragIndex:    uint8(d.FragIndex),
+				Participants: true,
+			},
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	if err := enqueueForDevices(d, []storage.FUOTADeploymentDeviceState{storage.FUOTADeploymentDeviceSetupCompleted}, b); err != nil {
+		return err
+	}
+
+	d.State = storage.FUOTADeploymentFragSessionDelete
+	d.NextStepAfter = time.Now().Add(time.Duration(d.Timeout) * time.Second)
+
+	return nil
+}
+
+// stepFragSessionDelete sends the FragSessionDeleteReq to the devices of the
+// deployment, so that the fragmentation session is removed from the devices.
+// Devices which did not answer the status request are set to the error
+// state.
+func stepFragSessionDelete(d *storage.FUOTADeployment) error {
+	db := config.C.PostgreSQL.DB
+
+	if err := storage.SetFUOTADeploymentDevicesError(db, d.ID, []storage.FUOTADeploymentDeviceState{storage.FUOTADeploymentDeviceSetupCompleted}, "fragmentation session status timeout"); err != nil {
+		return errors.Wrap(err, "set fuota deployment devices error")
+	}
+
+	cmd := fragmentation.Command{
+		CID: fragmentation.FragSessionDeleteReq,
+		Payload: &fragmentation.FragSessionDeleteReqPayload{
+			Param: fragmentation.FragSessionDeleteReqPayloadParam{
+				FragIndex: uint8(d.FragIndex),
+			},
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	devices, err := storage.GetFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get fuota deployment devices error")
+	}
+
+	for i := range devices {
+		if err := enqueueForDevice(d, &devices[i], [][]byte{b}); err != nil {
+			return err
+		}
+	}
+
+	d.State = storage.FUOTADeploymentDone
+	d.NextStepAfter = time.Now()
+
+	return nil
+}
+
+// enqueueForDevices enqueues the given payload for all devices of the
+// deployment which are in one of the given states.
+func enqueueForDevices(d *storage.FUOTADeployment, states []storage.FUOTADeploymentDeviceState, b []byte) error {
+	devices, err := storage.GetFUOTADeploymentDevices(config.C.PostgreSQL.DB, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get fuota deployment devices error")
+	}
+
+	for i := range devices {
+		for _, s := range states {
+			if devices[i].State == s {
+				if err := enqueueForDevice(d, &devices[i], [][]byte{b}); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// enqueueForDevice enqueues the given payloads for the given device and
+// stores that the payloads of the current deployment state have been
+// enqueued, within a single transaction. When the payloads were already
+// enqueued (e.g. the step is retried), nothing is enqueued. In case of an
+// enqueue error, the device is set to the error state, except for the
+// FragSessionDeleteReq which is sent on a best-effort basis.
+func enqueueForDevice(d *storage.FUOTADeployment, dd *storage.FUOTADeploymentDevice, items [][]byte) error {
+	if dd.EnqueuedState == d.State {
+		return nil
+	}
+
+	return storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		for _, b := range items {
+			_, err := downlink.EnqueueDownlinkPayload(tx, dd.DevEUI, false, fragmentation.DefaultFPort, b)
+			if err == nil {
+				continue
+			}
+
+			log.WithFields(log.Fields{
+				"fuota_deployment_id": dd.FUOTADeploymentID,
+				"dev_eui":             dd.DevEUI,
+			}).WithError(err).Error("fuota: enqueue downlink payload error")
+
+			if d.State != storage.FUOTADeploymentFragSessionDelete {
+				dd.State = storage.FUOTADeploymentDeviceError
+				dd.ErrorMessage = fmt.Sprintf("enqueue downlink payload error: %s", err)
+				if err := storage.UpdateFUOTADeploymentDevice(tx, dd); err != nil {
+					return errors.Wrap(err, "update fuota deployment device error")
+				}
+			}
+			break
+		}
+
+		if err := storage.SetFUOTADeploymentDeviceEnqueuedState(tx, dd.FUOTADeploymentID, dd.DevEUI, d.State); err != nil {
+			return errors.Wrap(err, "set fuota deployment device enqueued state error")
+		}
+		dd.EnqueuedState = d.State
+
+		return nil
+	})
+}
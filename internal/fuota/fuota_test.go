@@ -0,0 +1,158 @@
+package fuota
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestStepRetryInterval(t *testing.T) {
+	Convey("Given a retry interval of 10 seconds and a max. interval of one minute", t, func() {
+		StepRetryInterval = 10 * time.Second
+		MaxStepRetryInterval = time.Minute
+
+		Convey("Then the interval is doubled for every consecutive failure", func() {
+			So(stepRetryInterval(1), ShouldEqual, 10*time.Second)
+			So(stepRetryInterval(2), ShouldEqual, 20*time.Second)
+			So(stepRetryInterval(3), ShouldEqual, 40*time.Second)
+			So(stepRetryInterval(4), ShouldEqual, time.Minute)
+			So(stepRetryInterval(100), ShouldEqual, time.Minute)
+		})
+	})
+}
+
+func TestProcessDeployment(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with a FUOTA deployment for two devices", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d := storage.FUOTADeployment{
+			ApplicationID: app.ID,
+			Name:          "test-deployment",
+			Payload:       []byte{1, 2, 3, 4, 5},
+			FragSize:      2,
+			Redundancy:    1,
+			Descriptor:    []byte{1, 2, 3, 4},
+			Timeout:       60,
+		}
+		So(storage.CreateFUOTADeployment(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+		var devEUIs []lorawan.EUI64
+		for i := 1; i <= 2; i++ {
+			device := storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, byte(i)}.String(),
+				DevEUI:          lorawan.EUI64{0, 0, 0, 0, 0, 0, 0, byte(i)},
+			}
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+			<-nsClient.CreateDeviceChan
+
+			So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &storage.DeviceActivation{
+				DevEUI:  device.DevEUI,
+				DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+			}), ShouldBeNil)
+
+			So(storage.CreateFUOTADeploymentDevice(config.C.PostgreSQL.DB, &storage.FUOTADeploymentDevice{
+				FUOTADeploymentID: d.ID,
+				DevEUI:            device.DevEUI,
+			}), ShouldBeNil)
+
+			devEUIs = append(devEUIs, device.DevEUI)
+		}
+
+		Convey("When the FragSessionSetupReq was already enqueued for the first device by a failed attempt", func() {
+			So(storage.SetFUOTADeploymentDeviceEnqueuedState(config.C.PostgreSQL.DB, d.ID, devEUIs[0], storage.FUOTADeploymentFragSessionSetup), ShouldBeNil)
+
+			processed, err := processDeployment()
+			So(err, ShouldBeNil)
+			So(processed, ShouldBeTrue)
+
+			Convey("Then the FragSessionSetupReq is only enqueued for the second device", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				req := <-nsClient.CreateDeviceQueueItemChan
+				So(req.Item.DevEui, ShouldResemble, devEUIs[1][:])
+				<-nsClient.GetNextDownlinkFCntForDevEUIChan
+
+				dd, err := storage.GetFUOTADeploymentDevice(config.C.PostgreSQL.DB, d.ID, devEUIs[1])
+				So(err, ShouldBeNil)
+				So(dd.EnqueuedState, ShouldEqual, storage.FUOTADeploymentFragSessionSetup)
+
+				dGet, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID, false)
+				So(err, ShouldBeNil)
+				So(dGet.State, ShouldEqual, storage.FUOTADeploymentEnqueue)
+			})
+		})
+
+		Convey("When a step of the deployment fails", func() {
+			StepRetryInterval = time.Minute
+			So(setStepError(&d, d.State, errors.New("boom")), ShouldBeNil)
+
+			Convey("Then the error is stored and the step is retried after the retry interval", func() {
+				dGet, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID, false)
+				So(err, ShouldBeNil)
+				So(dGet.State, ShouldEqual, storage.FUOTADeploymentFragSessionSetup)
+				So(dGet.RetryCount, ShouldEqual, 1)
+				So(dGet.ErrorMessage, ShouldEqual, "boom")
+				So(dGet.NextStepAfter.After(time.Now().Add(50*time.Second)), ShouldBeTrue)
+
+				processed, err := processDeployment()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,64 @@
+// Package gps implements the conversion between the (UTC) time and the
+// GPS time, as used by the LoRaWAN application layer packages.
+package gps
+
+import (
+	"time"
+)
+
+var gpsEpochTime = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// leapSecondsTable contains the leap seconds which were added since the
+// GPS epoch.
+var leapSecondsTable = []struct {
+	Time     time.Time
+	Duration time.Duration
+}{
+	{time.Date(1981, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1982, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1983, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1985, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1987, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1989, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1990, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1992, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1993, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1994, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1995, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1997, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(1998, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(2005, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(2008, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(2012, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(2015, time.June, 30, 23, 59, 59, 0, time.UTC), time.Second},
+	{time.Date(2016, time.December, 31, 23, 59, 59, 0, time.UTC), time.Second},
+}
+
+// Time represents a GPS time.
+type Time time.Time
+
+// NewFromTimeSinceGPSEpoch returns a new Time given the time since the
+// GPS epoch, correcting for the leap seconds.
+func NewFromTimeSinceGPSEpoch(sinceEpoch time.Duration) Time {
+	t := gpsEpochTime.Add(sinceEpoch)
+	for _, ls := range leapSecondsTable {
+		if ls.Time.Before(t) {
+			t = t.Add(-ls.Duration)
+		}
+	}
+
+	return Time(t)
+}
+
+// TimeSinceGPSEpoch returns the time duration since the GPS epoch,
+// including the leap seconds.
+func (g Time) TimeSinceGPSEpoch() time.Duration {
+	var offset time.Duration
+	for _, ls := range leapSecondsTable {
+		if ls.Time.Before(time.Time(g)) {
+			offset += ls.Duration
+		}
+	}
+
+	return time.Time(g).Sub(gpsEpochTime) + offset
+}
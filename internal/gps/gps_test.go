@@ -0,0 +1,34 @@
+package gps
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGPSTime(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Time              time.Time
+			TimeSinceGPSEpoch time.Duration
+		}{
+			{gpsEpochTime, 0},
+			{time.Date(2010, time.January, 28, 16, 36, 24, 0, time.UTC), 948731799 * time.Second},
+			{time.Date(2025, time.July, 14, 0, 0, 0, 0, time.UTC), 1436486418 * time.Second},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Time, i), func() {
+				Convey("Then TimeSinceGPSEpoch returns the expected value", func() {
+					So(Time(test.Time).TimeSinceGPSEpoch(), ShouldEqual, test.TimeSinceGPSEpoch)
+				})
+
+				Convey("Then NewFromTimeSinceGPSEpoch returns the expected time", func() {
+					So(time.Time(NewFromTimeSinceGPSEpoch(test.TimeSinceGPSEpoch)).Equal(test.Time), ShouldBeTrue)
+				})
+			})
+		}
+	})
+}
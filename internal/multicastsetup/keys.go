@@ -0,0 +1,84 @@
+package multicastsetup
+
+import (
+	"crypto/aes"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// GetMcRootKeyForGenAppKey returns the McRootKey given a GenAppKey
+// (LoRaWAN 1.0.x devices).
+func GetMcRootKeyForGenAppKey(genAppKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	return getKey(genAppKey, [16]byte{})
+}
+
+// GetMcRootKeyForAppKey returns the McRootKey given an AppKey (LoRaWAN 1.1
+// devices).
+func GetMcRootKeyForAppKey(appKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	return getKey(appKey, [16]byte{0x20})
+}
+
+// GetMcKEKey returns the McKEKey given the McRootKey.
+func GetMcKEKey(mcRootKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	return getKey(mcRootKey, [16]byte{})
+}
+
+// GetMcAppSKey returns the McAppSKey given the McKey and McAddr.
+func GetMcAppSKey(mcKey lorawan.AES128Key, mcAddr lorawan.DevAddr) (lorawan.AES128Key, error) {
+	return getMcSKey(mcKey, mcAddr, 0x01)
+}
+
+// GetMcNwkSKey returns the McNwkSKey given the McKey and McAddr.
+func GetMcNwkSKey(mcKey lorawan.AES128Key, mcAddr lorawan.DevAddr) (lorawan.AES128Key, error) {
+	return getMcSKey(mcKey, mcAddr, 0x02)
+}
+
+// GetMcKeyEncrypted returns the McKey encrypted with the McKEKey, as sent
+// to the device within the McGroupSetupReq.
+func GetMcKeyEncrypted(mcKEKey, mcKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+
+	block, err := aes.NewCipher(mcKEKey[:])
+	if err != nil {
+		return key, err
+	}
+	if block.BlockSize() != len(mcKey) {
+		return key, fmt.Errorf("block-size of %d bytes is expected", len(mcKey))
+	}
+
+	// the device uses the aes128_encrypt operation to decrypt the McKey
+	block.Decrypt(key[:], mcKey[:])
+
+	return key, nil
+}
+
+func getMcSKey(mcKey lorawan.AES128Key, mcAddr lorawan.DevAddr, typ byte) (lorawan.AES128Key, error) {
+	var b [16]byte
+	b[0] = typ
+
+	mcAddrB, err := mcAddr.MarshalBinary()
+	if err != nil {
+		return lorawan.AES128Key{}, errors.Wrap(err, "marshal binary error")
+	}
+	copy(b[1:5], mcAddrB)
+
+	return getKey(mcKey, b)
+}
+
+func getKey(key lorawan.AES128Key, b [16]byte) (lorawan.AES128Key, error) {
+	var out lorawan.AES128Key
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return out, err
+	}
+	if block.BlockSize() != len(b) {
+		return out, fmt.Errorf("block-size of %d bytes is expected", len(b))
+	}
+	block.Encrypt(out[:], b[:])
+
+	return out, nil
+}
@@ -0,0 +1,55 @@
+package multicastsetup
+
+import (
+	"crypto/aes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestKeys(t *testing.T) {
+	Convey("Given a GenAppKey, AppKey, McKey and McAddr", t, func() {
+		genAppKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+		mcKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+		mcAddr := lorawan.DevAddr{1, 2, 3, 4}
+
+		Convey("Then the McRootKey depends on the LoRaWAN version", func() {
+			k10, err := GetMcRootKeyForGenAppKey(genAppKey)
+			So(err, ShouldBeNil)
+			k11, err := GetMcRootKeyForAppKey(genAppKey)
+			So(err, ShouldBeNil)
+			So(k10, ShouldNotEqual, k11)
+		})
+
+		Convey("Then the McKeyEncrypted can be decrypted by the device using the McKEKey", func() {
+			mcRootKey, err := GetMcRootKeyForGenAppKey(genAppKey)
+			So(err, ShouldBeNil)
+			mcKEKey, err := GetMcKEKey(mcRootKey)
+			So(err, ShouldBeNil)
+
+			mcKeyEncrypted, err := GetMcKeyEncrypted(mcKEKey, mcKey)
+			So(err, ShouldBeNil)
+			So(mcKeyEncrypted, ShouldNotEqual, mcKey)
+
+			block, err := aes.NewCipher(mcKEKey[:])
+			So(err, ShouldBeNil)
+			var out lorawan.AES128Key
+			block.Encrypt(out[:], mcKeyEncrypted[:])
+			So(out, ShouldEqual, mcKey)
+		})
+
+		Convey("Then the McAppSKey and McNwkSKey are derived from the McKey", func() {
+			appSKey, err := GetMcAppSKey(mcKey, mcAddr)
+			So(err, ShouldBeNil)
+			nwkSKey, err := GetMcNwkSKey(mcKey, mcAddr)
+			So(err, ShouldBeNil)
+			So(appSKey, ShouldNotEqual, nwkSKey)
+
+			appSKey2, err := GetMcAppSKey(mcKey, lorawan.DevAddr{4, 3, 2, 1})
+			So(err, ShouldBeNil)
+			So(appSKey, ShouldNotEqual, appSKey2)
+		})
+	})
+}
@@ -0,0 +1,392 @@
+// Package multicastsetup implements the LoRaWAN Remote Multicast Setup
+// (TS005), used to configure the multicast-groups on the devices.
+package multicastsetup
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/gps"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+)
+
+// syncBatchSize defines the max number of remote multicast setups that are
+// handled within a single transaction.
+const syncBatchSize = 100
+
+// SyncRemoteMulticastSetupLoop is a never returning function sending the
+// pending remote multicast setup commands to the devices.
+func SyncRemoteMulticastSetupLoop() {
+	for {
+		if err := syncRemoteMulticastSetup(); err != nil {
+			log.WithError(err).Error("sync remote multicast setup error")
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// HandleUplinkCommand handles the given remote multicast setup commands,
+// received on the remote multicast setup fPort, and updates the state of the
+// remote multicast setup of the device.
+func HandleUplinkCommand(db sqlx.Ext, devEUI lorawan.EUI64, b []byte) error {
+	var cmds multicastsetup.Commands
+	if err := cmds.UnmarshalBinary(true, b); err != nil {
+		return errors.Wrap(err, "unmarshal commands error")
+	}
+
+	for _, cmd := range cmds {
+		var err error
+		switch pl := cmd.Payload.(type) {
+		case *multicastsetup.McGroupSetupAnsPayload:
+			err = handleMcGroupSetupAns(db, devEUI, pl)
+		case *multicastsetup.McClassCSessionAnsPayload:
+			var errs []string
+			if pl.StatusAndMcGroupID.McGroupUndefined {
+				errs = append(errs, "multicast-group undefined")
+			}
+			if pl.StatusAndMcGroupID.FreqError {
+				errs = append(errs, "frequency error")
+			}
+			if pl.StatusAndMcGroupID.DRError {
+				errs = append(errs, "data-rate error")
+			}
+			err = handleMcSessionAns(db, devEUI, int(pl.StatusAndMcGroupID.McGroupID), errs)
+		case *multicastsetup.McClassBSessionAnsPayload:
+			var errs []string
+			if pl.StatusAndMcGroupID.McGroupUndefined {
+				errs = append(errs, "multicast-group undefined")
+			}
+			if pl.StatusAndMcGroupID.FreqError {
+				errs = append(errs, "frequency error")
+			}
+			if pl.StatusAndMcGroupID.DRError {
+				errs = append(errs, "data-rate error")
+			}
+			err = handleMcSessionAns(db, devEUI, int(pl.StatusAndMcGroupID.McGroupID), errs)
+		case *multicastsetup.McGroupDeleteAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":            devEUI,
+				"mc_group_id":        pl.McGroupIDHeader.McGroupID,
+				"mc_group_undefined": pl.McGroupIDHeader.McGroupUndefined,
+			}).Info("multicastsetup: McGroupDeleteAns received")
+		case *multicastsetup.McGroupStatusAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":         devEUI,
+				"nb_total_groups": pl.Status.NbTotalGroups,
+				"ans_group_mask":  pl.Status.AnsGroupMask,
+			}).Info("multicastsetup: McGroupStatusAns received")
+		case *multicastsetup.PackageVersionAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":            devEUI,
+				"package_identifier": pl.PackageIdentifier,
+				"package_version":    pl.PackageVersion,
+			}).Info("multicastsetup: PackageVersionAns received")
+		default:
+			log.WithFields(log.Fields{
+				"dev_eui": devEUI,
+				"cid":     cmd.CID,
+			}).Warning("multicastsetup: unexpected remote multicast setup command")
+		}
+		if err != nil {
+			return errors.Wrapf(err, "handle cid %d error", cmd.CID)
+		}
+	}
+
+	return nil
+}
+
+// DeleteRemoteMulticastSetup sends the McGroupDeleteReq to the device and
+// deletes the remote multicast setup of the device.
+func DeleteRemoteMulticastSetup(db sqlx.Ext, devEUI lorawan.EUI64, multicastGroupID uuid.UUID) error {
+	rms, err := storage.GetRemoteMulticastSetup(db, devEUI, multicastGroupID, true)
+	if err != nil {
+		return errors.Wrap(err, "get remote multicast setup error")
+	}
+
+	cmd := multicastsetup.Command{
+		CID: multicastsetup.McGroupDeleteReq,
+		Payload: &multicastsetup.McGroupDeleteReqPayload{
+			McGroupIDHeader: multicastsetup.McGroupDeleteReqPayloadMcGroupIDHeader{
+				McGroupID: uint8(rms.McGroupID),
+			},
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	if _, err := downlink.EnqueueDownlinkPayload(db, devEUI, false, multicastsetup.DefaultFPort, b); err != nil {
+		log.WithFields(log.Fields{
+			"dev_eui":            devEUI,
+			"multicast_group_id": multicastGroupID,
+		}).WithError(err).Error("multicastsetup: enqueue McGroupDeleteReq error")
+	}
+
+	if err := storage.DeleteRemoteMulticastSetup(db, devEUI, multicastGroupID); err != nil {
+		return errors.Wrap(err, "delete remote multicast setup error")
+	}
+
+	return nil
+}
+
+func handleMcGroupSetupAns(db sqlx.Ext, devEUI lorawan.EUI64, pl *multicastsetup.McGroupSetupAnsPayload) error {
+	rms, err := storage.GetRemoteMulticastSetupByMcGroupID(db, devEUI, int(pl.McGroupIDHeader.McGroupID), true)
+	if err != nil {
+		return errors.Wrap(err, "get remote multicast setup error")
+	}
+
+	if rms.State != storage.RemoteMulticastSetupMcGroupSetup {
+		log.WithFields(log.Fields{
+			"dev_eui":            devEUI,
+			"multicast_group_id": rms.MulticastGroupID,
+		}).Warning("multicastsetup: unexpected McGroupSetupAns, ignoring")
+		return nil
+	}
+
+	if pl.McGroupIDHeader.IDError {
+		rms.State = storage.RemoteMulticastSetupError
+		rms.ErrorMessage = "multicast-group setup failed: multicast-group index not supported"
+	} else {
+		rms.State = storage.RemoteMulticastSetupMcSessionSetup
+		rms.RetryCount = 0
+		rms.RetryAfter = time.Now()
+	}
+
+	if err := storage.UpdateRemoteMulticastSetup(db, &rms); err != nil {
+		return errors.Wrap(err, "update remote multicast setup error")
+	}
+
+	return nil
+}
+
+func handleMcSessionAns(db sqlx.Ext, devEUI lorawan.EUI64, mcGroupID int, errs []string) error {
+	rms, err := storage.GetRemoteMulticastSetupByMcGroupID(db, devEUI, mcGroupID, true)
+	if err != nil {
+		return errors.Wrap(err, "get remote multicast setup error")
+	}
+
+	if rms.State != storage.RemoteMulticastSetupMcSessionSetup {
+		log.WithFields(log.Fields{
+			"dev_eui":            devEUI,
+			"multicast_group_id": rms.MulticastGroupID,
+		}).Warning("multicastsetup: unexpected multicast session answer, ignoring")
+		return nil
+	}
+
+	if len(errs) != 0 {
+		rms.State = storage.RemoteMulticastSetupError
+		rms.ErrorMessage = "multicast session setup failed: " + strings.Join(errs, ", ")
+	} else {
+		rms.State = storage.RemoteMulticastSetupCompleted
+	}
+
+	if err := storage.UpdateRemoteMulticastSetup(db, &rms); err != nil {
+		return errors.Wrap(err, "update remote multicast setup error")
+	}
+
+	return nil
+}
+
+// syncRemoteMulticastSetup sends the commands for the pending remote
+// multicast setups.
+func syncRemoteMulticastSetup() error {
+	return storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		items, err := storage.GetPendingRemoteMulticastSetups(tx, syncBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get pending remote multicast setups error")
+		}
+
+		for i := range items {
+			if err := syncRemoteMulticastSetupItem(tx, &items[i]); err != nil {
+				return errors.Wrap(err, "sync remote multicast setup error")
+			}
+		}
+
+		return nil
+	})
+}
+
+func syncRemoteMulticastSetupItem(db sqlx.Ext, rms *storage.RemoteMulticastSetup) error {
+	if rms.RetryCount > config.C.ApplicationServer.RemoteMulticastSetup.SyncRetries {
+		rms.State = storage.RemoteMulticastSetupError
+		rms.ErrorMessage = "max retries exceeded"
+		if err := storage.UpdateRemoteMulticastSetup(db, rms); err != nil {
+			return errors.Wrap(err, "update remote multicast setup error")
+		}
+		return nil
+	}
+
+	mg, err := storage.GetMulticastGroup(db, rms.MulticastGroupID, false, false)
+	if err != nil {
+		return errors.Wrap(err, "get multicast-group error")
+	}
+
+	var cmd multicastsetup.Command
+	switch rms.State {
+	case storage.RemoteMulticastSetupMcGroupSetup:
+		cmd, err = getMcGroupSetupReq(db, rms, mg)
+	case storage.RemoteMulticastSetupMcSessionSetup:
+		cmd, err = getMcSessionReq(rms, mg)
+	default:
+		err = fmt.Errorf("unexpected state: %s", rms.State)
+	}
+	if err != nil {
+		return err
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	if _, err := downlink.EnqueueDownlinkPayload(db, rms.DevEUI, false, multicastsetup.DefaultFPort, b); err != nil {
+		log.WithFields(log.Fields{
+			"dev_eui":            rms.DevEUI,
+			"multicast_group_id": rms.MulticastGroupID,
+		}).WithError(err).Error("multicastsetup: enqueue downlink payload error")
+	}
+
+	rms.RetryCount++
+	rms.RetryAfter = time.Now().Add(config.C.ApplicationServer.RemoteMulticastSetup.SyncInterval)
+	if err := storage.UpdateRemoteMulticastSetup(db, rms); err != nil {
+		return errors.Wrap(err, "update remote multicast setup error")
+	}
+
+	return nil
+}
+
+// getMcGroupSetupReq returns the McGroupSetupReq for the given remote
+// multicast setup. The McKey is encrypted using the McKEKey, which is
+// derived from the GenAppKey (LoRaWAN 1.0.x) or AppKey (LoRaWAN 1.1) of the
+// device.
+func getMcGroupSetupReq(db sqlx.Queryer, rms *storage.RemoteMulticastSetup, mg storage.MulticastGroup) (multicastsetup.Command, error) {
+	var cmd multicastsetup.Command
+
+	dk, err := storage.GetDeviceKeys(db, rms.DevEUI)
+	if err != nil {
+		return cmd, errors.Wrap(err, "get device-keys error")
+	}
+
+	var mcRootKey lorawan.AES128Key
+	switch {
+	case dk.GenAppKey != lorawan.AES128Key{}:
+		mcRootKey, err = GetMcRootKeyForGenAppKey(dk.GenAppKey)
+	case dk.AppKey != lorawan.AES128Key{}:
+		mcRootKey, err = GetMcRootKeyForAppKey(dk.AppKey)
+	default:
+		return cmd, errors.New("device has no gen_app_key or app_key")
+	}
+	if err != nil {
+		return cmd, errors.Wrap(err, "get McRootKey error")
+	}
+
+	mcKEKey, err := GetMcKEKey(mcRootKey)
+	if err != nil {
+		return cmd, errors.Wrap(err, "get McKEKey error")
+	}
+
+	mcKeyEncrypted, err := GetMcKeyEncrypted(mcKEKey, mg.MCKey)
+	if err != nil {
+		return cmd, errors.Wrap(err, "get McKeyEncrypted error")
+	}
+
+	var mcAddr lorawan.DevAddr
+	copy(mcAddr[:], mg.MulticastGroup.McAddr)
+
+	return multicastsetup.Command{
+		CID: multicastsetup.McGroupSetupReq,
+		Payload: &multicastsetup.McGroupSetupReqPayload{
+			McGroupIDHeader: multicastsetup.McGroupSetupReqPayloadMcGroupIDHeader{
+				McGroupID: uint8(rms.McGroupID),
+			},
+			McAddr:         mcAddr,
+			McKeyEncrypted: mcKeyEncrypted,
+			MinMcFCnt:      mg.MulticastGroup.FCnt,
+			MaxMcFCnt:      math.MaxUint32,
+		},
+	}, nil
+}
+
+// getMcSessionReq returns the McClassCSessionReq or McClassBSessionReq for
+// the given remote multicast setup, depending on the multicast-group type.
+// The session starts after the sync interval, so that the device is able to
+// receive the command before the start of the session.
+func getMcSessionReq(rms *storage.RemoteMulticastSetup, mg storage.MulticastGroup) (multicastsetup.Command, error) {
+	sessionTime := uint32(gps.Time(time.Now().Add(config.C.ApplicationServer.RemoteMulticastSetup.SyncInterval)).TimeSinceGPSEpoch() / time.Second)
+	timeOut := getSessionTimeOut(config.C.ApplicationServer.RemoteMulticastSetup.SessionTimeout)
+
+	switch mg.MulticastGroup.GroupType {
+	case ns.MulticastGroupType_CLASS_C:
+		return multicastsetup.Command{
+			CID: multicastsetup.McClassCSessionReq,
+			Payload: &multicastsetup.McClassCSessionReqPayload{
+				McGroupIDHeader: multicastsetup.McClassCSessionReqPayloadMcGroupIDHeader{
+					McGroupID: uint8(rms.McGroupID),
+				},
+				SessionTime: sessionTime,
+				SessionTimeOut: multicastsetup.McClassCSessionReqPayloadSessionTimeOut{
+					TimeOut: timeOut,
+				},
+				DLFrequency: mg.MulticastGroup.Frequency,
+				DR:          uint8(mg.MulticastGroup.Dr),
+			},
+		}, nil
+	case ns.MulticastGroupType_CLASS_B:
+		// the Class-B session must start at a beacon boundary
+		if rem := sessionTime % 128; rem != 0 {
+			sessionTime += 128 - rem
+		}
+
+		return multicastsetup.Command{
+			CID: multicastsetup.McClassBSessionReq,
+			Payload: &multicastsetup.McClassBSessionReqPayload{
+				McGroupIDHeader: multicastsetup.McClassBSessionReqPayloadMcGroupIDHeader{
+					McGroupID: uint8(rms.McGroupID),
+				},
+				SessionTime: sessionTime,
+				TimeOutPeriodicity: multicastsetup.McClassBSessionReqPayloadTimeOutPeriodicity{
+					Periodicity: getPeriodicity(int(mg.MulticastGroup.PingSlotPeriod)),
+					TimeOut:     timeOut,
+				},
+				DLFrequency: mg.MulticastGroup.Frequency,
+				DR:          uint8(mg.MulticastGroup.Dr),
+			},
+		}, nil
+	default:
+		return multicastsetup.Command{}, fmt.Errorf("unexpected multicast-group type: %s", mg.MulticastGroup.GroupType)
+	}
+}
+
+// getSessionTimeOut returns the session timeout exponent, such that
+// 2^TimeOut seconds is at least the given duration (with a max of 15).
+func getSessionTimeOut(d time.Duration) uint8 {
+	var timeOut uint8
+	for timeOut < 15 && time.Duration(1<<timeOut)*time.Second < d {
+		timeOut++
+	}
+	return timeOut
+}
+
+// getPeriodicity returns the Class-B ping-slot periodicity given the
+// ping-slot period (in number of 30ms slots, 32 = every second).
+func getPeriodicity(pingSlotPeriod int) uint8 {
+	var periodicity uint8
+	for periodicity < 7 && 32<<periodicity < pingSlotPeriod {
+		periodicity++
+	}
+	return periodicity
+}
@@ -0,0 +1,52 @@
+package multicastsetup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetSessionTimeOut(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Duration time.Duration
+			TimeOut  uint8
+		}{
+			{0, 0},
+			{time.Second, 0},
+			{2 * time.Second, 1},
+			{3 * time.Second, 2},
+			{time.Hour, 12},
+			{24 * time.Hour, 15},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Duration, i), func() {
+				So(getSessionTimeOut(test.Duration), ShouldEqual, test.TimeOut)
+			})
+		}
+	})
+}
+
+func TestGetPeriodicity(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			PingSlotPeriod int
+			Periodicity    uint8
+		}{
+			{32, 0},
+			{64, 1},
+			{128, 2},
+			{32 * 128, 7},
+			{32 * 256, 7},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %d [%d]", test.PingSlotPeriod, i), func() {
+				So(getPeriodicity(test.PingSlotPeriod), ShouldEqual, test.Periodicity)
+			})
+		}
+	})
+}
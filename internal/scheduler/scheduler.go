@@ -0,0 +1,165 @@
+// Package scheduler implements the enqueueing of scheduled (and recurring)
+// device and multicast-group downlinks. When multiple LoRa App Server
+// instances are running, only the elected leader processes the scheduled
+// downlinks.
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/multicast"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+const (
+	leaderKey = "lora:as:scheduler:leader"
+	leaderTTL = 10 * time.Second
+)
+
+// leaderScript extends the leadership when the key is owned by the given
+// instance id, or else tries to acquire the leadership.
+var leaderScript = redis.NewScript(1, `
+	if redis.call("get", KEYS[1]) == ARGV[1] then
+		return redis.call("pexpire", KEYS[1], ARGV[2])
+	end
+	return redis.call("set", KEYS[1], ARGV[1], "NX", "PX", ARGV[2])
+`)
+
+// DownlinkLoop is a never returning function enqueueing the scheduled
+// downlinks which are due, for as long as this instance is the leader.
+func DownlinkLoop() {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.WithError(err).Fatal("new uuid v4 error")
+	}
+
+	var leader bool
+
+	for {
+		isLeader, err := acquireLeadership(id)
+		if err != nil {
+			log.WithError(err).Error("scheduler leader election error")
+			isLeader = false
+		}
+		if isLeader != leader {
+			log.WithFields(log.Fields{
+				"instance_id": id,
+				"leader":      isLeader,
+			}).Info("scheduler leadership changed")
+			leader = isLeader
+		}
+
+		if leader {
+			for {
+				processed, err := processScheduledDownlink()
+				if err != nil {
+					log.WithError(err).Error("process scheduled downlink error")
+					break
+				}
+				if !processed {
+					break
+				}
+			}
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+// acquireLeadership acquires or extends the leadership for the given
+// instance id. It returns true when the instance is the leader.
+func acquireLeadership(id uuid.UUID) (bool, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	reply, err := leaderScript.Do(c, leaderKey, id.String(), int64(leaderTTL/time.Millisecond))
+	if err != nil {
+		return false, errors.Wrap(err, "execute leader script error")
+	}
+
+	return isLeaderReply(reply)
+}
+
+// isLeaderReply returns true when the given leader script reply indicates
+// that the leadership was acquired (OK) or extended (1).
+func isLeaderReply(reply interface{}) (bool, error) {
+	switch v := reply.(type) {
+	case nil:
+		return false, nil
+	case int64:
+		return v == 1, nil
+	case string:
+		return v == "OK", nil
+	default:
+		return false, fmt.Errorf("unexpected reply type: %T", reply)
+	}
+}
+
+func processScheduledDownlink() (bool, error) {
+	var processed bool
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		d, err := storage.GetPendingScheduledDownlink(tx)
+		if err != nil {
+			return errors.Wrap(err, "get pending scheduled downlink error")
+		}
+		if d == nil {
+			return nil
+		}
+		processed = true
+
+		now := time.Now()
+		d.LastRunAt = &now
+		d.LastError = ""
+
+		if err := enqueue(d); err != nil {
+			log.WithError(err).WithField("id", d.ID).Error("enqueue scheduled downlink error")
+			d.LastError = errors.Cause(err).Error()
+		}
+
+		d.NextRunAt = d.NextRunAfter(now)
+
+		if err := storage.UpdateScheduledDownlink(tx, d); err != nil {
+			return errors.Wrap(err, "update scheduled downlink error")
+		}
+
+		return nil
+	})
+
+	return processed, err
+}
+
+// enqueue enqueues the payload of the scheduled downlink. This is done in
+// a separate transaction so that an enqueue error does not rollback the
+// update of the scheduled downlink.
+func enqueue(d *storage.ScheduledDownlink) error {
+	return storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var fCnt uint32
+		var err error
+
+		if d.DevEUI != nil {
+			fCnt, err = downlink.EnqueueDownlinkPayload(tx, *d.DevEUI, d.Confirmed, d.FPort, d.Data)
+		} else {
+			fCnt, err = multicast.Enqueue(tx, *d.MulticastGroupID, d.FPort, d.Data)
+		}
+		if err != nil {
+			return errors.Wrap(err, "enqueue downlink payload error")
+		}
+
+		log.WithFields(log.Fields{
+			"id":    d.ID,
+			"f_cnt": fCnt,
+		}).Info("scheduled downlink enqueued")
+
+		return nil
+	})
+}
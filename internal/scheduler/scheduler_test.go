@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIsLeaderReply(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Reply         interface{}
+			Leader        bool
+			ExpectedError bool
+		}{
+			{nil, false, false},
+			{"OK", true, false},
+			{int64(1), true, false},
+			{int64(0), false, false},
+			{[]byte("OK"), false, true},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %v [%d]", test.Reply, i), func() {
+				leader, err := isLeaderReply(test.Reply)
+				So(leader, ShouldEqual, test.Leader)
+				So(err != nil, ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+}
@@ -23,6 +23,9 @@ type Application struct {
 	PayloadCodec         codec.Type `db:"payload_codec"`
 	PayloadEncoderScript string     `db:"payload_encoder_script"`
 	PayloadDecoderScript string     `db:"payload_decoder_script"`
+	CodecID              *int64     `db:"codec_id"`
+	CodecVersion         *int64     `db:"codec_version"`
+	SenMLOutput          bool       `db:"senml_output"`
 }
 
 // ApplicationListItem devices the application as a list item.
@@ -37,6 +40,10 @@ func (a Application) Validate() error {
 		return ErrApplicationInvalidName
 	}
 
+	if a.CodecVersion != nil && a.CodecID == nil {
+		return ErrApplicationInvalidCodecVersion
+	}
+
 	return nil
 }
 
@@ -54,8 +61,11 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 			service_profile_id,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+			payload_decoder_script,
+			codec_id,
+			codec_version,
+			senml_output
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) returning id`,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -63,6 +73,9 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.CodecID,
+		item.CodecVersion,
+		item.SenMLOutput,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -294,7 +307,10 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 			service_profile_id = $5,
 			payload_codec = $6,
 			payload_encoder_script = $7,
-			payload_decoder_script = $8
+			payload_decoder_script = $8,
+			codec_id = $9,
+			codec_version = $10,
+			senml_output = $11
 		where id = $1`,
 		item.ID,
 		item.Name,
@@ -304,6 +320,9 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.CodecID,
+		item.CodecVersion,
+		item.SenMLOutput,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
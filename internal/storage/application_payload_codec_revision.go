@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+)
+
+// ApplicationPayloadCodecRevision defines a revision of the payload codec
+// of an application.
+type ApplicationPayloadCodecRevision struct {
+	ApplicationID        int64      `db:"application_id"`
+	Revision             int        `db:"revision"`
+	CreatedAt            time.Time  `db:"created_at"`
+	Username             string     `db:"username"`
+	PayloadCodec         codec.Type `db:"payload_codec"`
+	PayloadEncoderScript string     `db:"payload_encoder_script"`
+	PayloadDecoderScript string     `db:"payload_decoder_script"`
+	CodecID              *int64     `db:"codec_id"`
+	CodecVersion         *int64     `db:"codec_version"`
+}
+
+// CreateApplicationPayloadCodecRevision creates a new payload codec revision
+// for the given application. The revision number is set to the next
+// available revision for the application. To serialize concurrent revisions
+// of the same application, the application row is locked. Therefore this
+// must be called within a transaction.
+func CreateApplicationPayloadCodecRevision(db sqlx.Queryer, rev *ApplicationPayloadCodecRevision) error {
+	var id int64
+	err := sqlx.Get(db, &id, "select id from application where id = $1 for update", rev.ApplicationID)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	rev.CreatedAt = time.Now()
+
+	err = sqlx.Get(db, &rev.Revision, `
+		insert into application_payload_codec_revision (
+			application_id,
+			revision,
+			created_at,
+			username,
+			payload_codec,
+			payload_encoder_script,
+			payload_decoder_script,
+			codec_id,
+			codec_version
+		) values (
+			$1,
+			(select coalesce(max(revision), 0) + 1 from application_payload_codec_revision where application_id = $1),
+			$2, $3, $4, $5, $6, $7, $8)
+		returning revision`,
+		rev.ApplicationID,
+		rev.CreatedAt,
+		rev.Username,
+		rev.PayloadCodec,
+		rev.PayloadEncoderScript,
+		rev.PayloadDecoderScript,
+		rev.CodecID,
+		rev.CodecVersion,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": rev.ApplicationID,
+		"revision":       rev.Revision,
+		"username":       rev.Username,
+	}).Info("application payload codec revision created")
+
+	return nil
+}
+
+// CreateApplicationPayloadCodecRevisionForApplication creates a new payload
+// codec revision containing the current payload codec of the given
+// application.
+func CreateApplicationPayloadCodecRevisionForApplication(db sqlx.Queryer, app Application, username string) (ApplicationPayloadCodecRevision, error) {
+	rev := ApplicationPayloadCodecRevision{
+		ApplicationID:        app.ID,
+		Username:             username,
+		PayloadCodec:         app.PayloadCodec,
+		PayloadEncoderScript: app.PayloadEncoderScript,
+		PayloadDecoderScript: app.PayloadDecoderScript,
+		CodecID:              app.CodecID,
+		CodecVersion:         app.CodecVersion,
+	}
+	return rev, CreateApplicationPayloadCodecRevision(db, &rev)
+}
+
+// GetApplicationPayloadCodecRevision returns the given payload codec revision
+// of the given application.
+func GetApplicationPayloadCodecRevision(db sqlx.Queryer, applicationID int64, revision int) (ApplicationPayloadCodecRevision, error) {
+	var rev ApplicationPayloadCodecRevision
+	err := sqlx.Get(db, &rev, `
+		select
+			*
+		from application_payload_codec_revision
+		where
+			application_id = $1
+			and revision = $2`,
+		applicationID,
+		revision,
+	)
+	if err != nil {
+		return rev, handlePSQLError(Select, err, "select error")
+	}
+
+	return rev, nil
+}
+
+// GetApplicationPayloadCodecRevisionCount returns the total number of payload
+// codec revisions for the given application.
+func GetApplicationPayloadCodecRevisionCount(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from application_payload_codec_revision
+		where
+			application_id = $1`,
+		applicationID,
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetApplicationPayloadCodecRevisions returns the payload codec revisions for
+// the given application, sorted by revision (newest first) and respecting
+// the given limit and offset.
+func GetApplicationPayloadCodecRevisions(db sqlx.Queryer, applicationID int64, limit, offset int) ([]ApplicationPayloadCodecRevision, error) {
+	var revs []ApplicationPayloadCodecRevision
+	err := sqlx.Select(db, &revs, `
+		select
+			*
+		from application_payload_codec_revision
+		where
+			application_id = $1
+		order by
+			revision desc
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return revs, nil
+}
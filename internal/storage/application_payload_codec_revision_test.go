@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func (ts *StorageTestSuite) TestApplicationPayloadCodecRevision() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:                 "test-app",
+		OrganizationID:       org.ID,
+		PayloadCodec:         codec.CustomJSType,
+		PayloadEncoderScript: "function Encode(fPort, obj) { return []; }",
+		PayloadDecoderScript: "function Decode(fPort, bytes) { return {}; }",
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	ts.T().Run("Create for non-existing application", func(t *testing.T) {
+		assert := require.New(t)
+
+		rev := ApplicationPayloadCodecRevision{
+			ApplicationID: app.ID + 1,
+			Username:      "user1",
+			PayloadCodec:  codec.CayenneLPPType,
+		}
+		assert.Equal(ErrDoesNotExist, errors.Cause(CreateApplicationPayloadCodecRevision(ts.Tx(), &rev)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		codecID := int64(10)
+		codecVersion := int64(2)
+
+		rev1, err := CreateApplicationPayloadCodecRevisionForApplication(ts.Tx(), app, "user1")
+		assert.NoError(err)
+		assert.Equal(1, rev1.Revision)
+
+		rev2 := ApplicationPayloadCodecRevision{
+			ApplicationID:        app.ID,
+			Username:             "user2",
+			PayloadCodec:         codec.CayenneLPPType,
+			PayloadEncoderScript: "",
+			PayloadDecoderScript: "",
+			CodecID:              &codecID,
+			CodecVersion:         &codecVersion,
+		}
+		assert.NoError(CreateApplicationPayloadCodecRevision(ts.Tx(), &rev2))
+		assert.Equal(2, rev2.Revision)
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			rev, err := GetApplicationPayloadCodecRevision(ts.Tx(), app.ID, 1)
+			assert.NoError(err)
+			assert.Equal("user1", rev.Username)
+			assert.Equal(app.PayloadCodec, rev.PayloadCodec)
+			assert.Equal(app.PayloadEncoderScript, rev.PayloadEncoderScript)
+			assert.Equal(app.PayloadDecoderScript, rev.PayloadDecoderScript)
+			assert.Equal(rev1.CreatedAt.Unix(), rev.CreatedAt.Unix())
+			assert.Nil(rev.CodecID)
+			assert.Nil(rev.CodecVersion)
+
+			rev, err = GetApplicationPayloadCodecRevision(ts.Tx(), app.ID, 2)
+			assert.NoError(err)
+			assert.Equal(&codecID, rev.CodecID)
+			assert.Equal(&codecVersion, rev.CodecVersion)
+
+			_, err = GetApplicationPayloadCodecRevision(ts.Tx(), app.ID, 3)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetApplicationPayloadCodecRevisionCount(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.Equal(2, count)
+
+			revs, err := GetApplicationPayloadCodecRevisions(ts.Tx(), app.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(revs, 2)
+			assert.Equal(2, revs[0].Revision)
+			assert.Equal(1, revs[1].Revision)
+
+			revs, err = GetApplicationPayloadCodecRevisions(ts.Tx(), app.ID, 1, 1)
+			assert.NoError(err)
+			assert.Len(revs, 1)
+			assert.Equal(1, revs[0].Revision)
+		})
+
+		t.Run("Delete application", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteApplication(ts.Tx(), app.ID))
+
+			count, err := GetApplicationPayloadCodecRevisionCount(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+	})
+}
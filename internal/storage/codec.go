@@ -0,0 +1,350 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+)
+
+// Codec defines a payload codec shared within an organization. Applications
+// referencing a codec use its payload codec and scripts.
+type Codec struct {
+	ID                   int64      `db:"id"`
+	CreatedAt            time.Time  `db:"created_at"`
+	UpdatedAt            time.Time  `db:"updated_at"`
+	OrganizationID       int64      `db:"organization_id"`
+	Name                 string     `db:"name"`
+	Description          string     `db:"description"`
+	Version              int64      `db:"version"`
+	PayloadCodec         codec.Type `db:"payload_codec"`
+	PayloadEncoderScript string     `db:"payload_encoder_script"`
+	PayloadDecoderScript string     `db:"payload_decoder_script"`
+}
+
+// CodecListItem defines the codec as list item.
+type CodecListItem struct {
+	ID               int64      `db:"id"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+	OrganizationID   int64      `db:"organization_id"`
+	Name             string     `db:"name"`
+	Description      string     `db:"description"`
+	Version          int64      `db:"version"`
+	PayloadCodec     codec.Type `db:"payload_codec"`
+	ApplicationCount int        `db:"application_count"`
+}
+
+// CodecVersion defines a (stored) version of a codec. Applications can pin
+// a specific version of a codec.
+type CodecVersion struct {
+	CodecID              int64      `db:"codec_id"`
+	Version              int64      `db:"version"`
+	CreatedAt            time.Time  `db:"created_at"`
+	PayloadCodec         codec.Type `db:"payload_codec"`
+	PayloadEncoderScript string     `db:"payload_encoder_script"`
+	PayloadDecoderScript string     `db:"payload_decoder_script"`
+}
+
+// CodecVersionListItem defines the codec version as list item.
+type CodecVersionListItem struct {
+	CodecID      int64      `db:"codec_id"`
+	Version      int64      `db:"version"`
+	CreatedAt    time.Time  `db:"created_at"`
+	PayloadCodec codec.Type `db:"payload_codec"`
+}
+
+// Validate validates the codec data.
+func (c Codec) Validate() error {
+	if c.Name == "" {
+		return ErrCodecInvalidName
+	}
+	return nil
+}
+
+// CreateCodec creates the given codec. The version is set to 1 and stored
+// as first version of the codec.
+func CreateCodec(db sqlx.Ext, c *Codec) error {
+	if err := c.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	c.Version = 1
+
+	err := sqlx.Get(db, &c.ID, `
+		insert into codec (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			description,
+			version,
+			payload_codec,
+			payload_encoder_script,
+			payload_decoder_script
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		returning id`,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.OrganizationID,
+		c.Name,
+		c.Description,
+		c.Version,
+		c.PayloadCodec,
+		c.PayloadEncoderScript,
+		c.PayloadDecoderScript,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	if err := createCodecVersion(db, *c); err != nil {
+		return errors.Wrap(err, "create codec version error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              c.ID,
+		"organization_id": c.OrganizationID,
+		"name":            c.Name,
+	}).Info("codec created")
+
+	return nil
+}
+
+// GetCodec returns the codec for the given id.
+func GetCodec(db sqlx.Queryer, id int64) (Codec, error) {
+	var c Codec
+	err := sqlx.Get(db, &c, "select * from codec where id = $1", id)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+
+	return c, nil
+}
+
+// GetCodecCountForOrganizationID returns the total number of codecs for the
+// given organization.
+func GetCodecCountForOrganizationID(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from codec
+		where
+			organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetCodecsForOrganizationID returns a slice of codecs for the given
+// organization, sorted by name and respecting the given limit and offset.
+func GetCodecsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]CodecListItem, error) {
+	var codecs []CodecListItem
+	err := sqlx.Select(db, &codecs, `
+		select
+			c.id,
+			c.created_at,
+			c.updated_at,
+			c.organization_id,
+			c.name,
+			c.description,
+			c.version,
+			c.payload_codec,
+			(select count(*) from application a where a.codec_id = c.id) as application_count
+		from codec c
+		where
+			c.organization_id = $1
+		order by
+			c.name
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return codecs, nil
+}
+
+// UpdateCodec updates the given codec and increments its version. The
+// updated codec is stored as new version of the codec.
+// Note that the organization of a codec can not be changed.
+func UpdateCodec(db sqlx.Ext, c *Codec) error {
+	if err := c.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	current, err := GetCodec(db, c.ID)
+	if err != nil {
+		return errors.Wrap(err, "get codec error")
+	}
+
+	c.UpdatedAt = time.Now()
+
+	err = sqlx.Get(db, &c.Version, `
+		update codec
+		set
+			updated_at = $2,
+			name = $3,
+			description = $4,
+			version = version + 1,
+			payload_codec = $5,
+			payload_encoder_script = $6,
+			payload_decoder_script = $7
+		where
+			id = $1
+		returning version`,
+		c.ID,
+		c.UpdatedAt,
+		c.Name,
+		c.Description,
+		c.PayloadCodec,
+		c.PayloadEncoderScript,
+		c.PayloadDecoderScript,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	c.CreatedAt = current.CreatedAt
+	c.OrganizationID = current.OrganizationID
+
+	if err := createCodecVersion(db, *c); err != nil {
+		return errors.Wrap(err, "create codec version error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      c.ID,
+		"version": c.Version,
+	}).Info("codec updated")
+
+	return nil
+}
+
+// DeleteCodec deletes the codec matching the given id. A codec which is
+// still used by applications can not be deleted.
+func DeleteCodec(db sqlx.Ext, id int64) error {
+	res, err := db.Exec("delete from codec where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id": id,
+	}).Info("codec deleted")
+
+	return nil
+}
+
+// createCodecVersion stores the current version of the given codec.
+func createCodecVersion(db sqlx.Execer, c Codec) error {
+	_, err := db.Exec(`
+		insert into codec_version (
+			codec_id,
+			version,
+			created_at,
+			payload_codec,
+			payload_encoder_script,
+			payload_decoder_script
+		) values ($1, $2, $3, $4, $5, $6)`,
+		c.ID,
+		c.Version,
+		c.UpdatedAt,
+		c.PayloadCodec,
+		c.PayloadEncoderScript,
+		c.PayloadDecoderScript,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetCodecVersion returns the given version of the given codec.
+func GetCodecVersion(db sqlx.Queryer, codecID, version int64) (CodecVersion, error) {
+	var v CodecVersion
+	err := sqlx.Get(db, &v, `
+		select
+			*
+		from codec_version
+		where
+			codec_id = $1
+			and version = $2`,
+		codecID,
+		version,
+	)
+	if err != nil {
+		return v, handlePSQLError(Select, err, "select error")
+	}
+
+	return v, nil
+}
+
+// GetCodecVersionCount returns the total number of versions of the given
+// codec.
+func GetCodecVersionCount(db sqlx.Queryer, codecID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from codec_version
+		where
+			codec_id = $1`,
+		codecID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetCodecVersions returns a slice of versions of the given codec, sorted by
+// version (newest first) and respecting the given limit and offset.
+func GetCodecVersions(db sqlx.Queryer, codecID int64, limit, offset int) ([]CodecVersionListItem, error) {
+	var versions []CodecVersionListItem
+	err := sqlx.Select(db, &versions, `
+		select
+			codec_id,
+			version,
+			created_at,
+			payload_codec
+		from codec_version
+		where
+			codec_id = $1
+		order by
+			version desc
+		limit $2
+		offset $3`,
+		codecID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,225 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestCodec() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	ts.T().Run("Create with invalid name", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := Codec{
+			OrganizationID: org.ID,
+		}
+		assert.Equal(ErrCodecInvalidName, errors.Cause(CreateCodec(ts.Tx(), &c)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := Codec{
+			OrganizationID:       org.ID,
+			Name:                 "test-codec",
+			Description:          "test codec",
+			PayloadCodec:         codec.CustomJSType,
+			PayloadEncoderScript: "function Encode(fPort, obj) { return []; }",
+			PayloadDecoderScript: "function Decode(fPort, bytes) { return {}; }",
+		}
+		assert.NoError(CreateCodec(ts.Tx(), &c))
+		assert.EqualValues(1, c.Version)
+		c.CreatedAt = c.CreatedAt.UTC().Truncate(time.Millisecond)
+		c.UpdatedAt = c.UpdatedAt.UTC().Truncate(time.Millisecond)
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			c2, err := GetCodec(ts.Tx(), c.ID)
+			assert.NoError(err)
+			c2.CreatedAt = c2.CreatedAt.UTC().Truncate(time.Millisecond)
+			c2.UpdatedAt = c2.UpdatedAt.UTC().Truncate(time.Millisecond)
+			assert.Equal(c, c2)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			c.Name = "test-codec-updated"
+			c.PayloadCodec = codec.CayenneLPPType
+			c.PayloadEncoderScript = ""
+			c.PayloadDecoderScript = ""
+			assert.NoError(UpdateCodec(ts.Tx(), &c))
+			assert.EqualValues(2, c.Version)
+
+			c2, err := GetCodec(ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal("test-codec-updated", c2.Name)
+			assert.Equal(codec.CayenneLPPType, c2.PayloadCodec)
+			assert.EqualValues(2, c2.Version)
+		})
+
+		t.Run("Versions", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetCodecVersionCount(ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal(2, count)
+
+			items, err := GetCodecVersions(ts.Tx(), c.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.EqualValues(2, items[0].Version)
+			assert.Equal(codec.CayenneLPPType, items[0].PayloadCodec)
+			assert.EqualValues(1, items[1].Version)
+			assert.Equal(codec.CustomJSType, items[1].PayloadCodec)
+
+			v, err := GetCodecVersion(ts.Tx(), c.ID, 1)
+			assert.NoError(err)
+			assert.Equal("function Decode(fPort, bytes) { return {}; }", v.PayloadDecoderScript)
+
+			_, err = GetCodecVersion(ts.Tx(), c.ID, 3)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+
+		t.Run("Used by application", func(t *testing.T) {
+			assert := require.New(t)
+
+			app := Application{
+				Name:           "test-app",
+				OrganizationID: org.ID,
+				CodecID:        &c.ID,
+			}
+			copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+			assert.NoError(CreateApplication(ts.Tx(), &app))
+
+			t.Run("List", func(t *testing.T) {
+				assert := require.New(t)
+
+				count, err := GetCodecCountForOrganizationID(ts.Tx(), org.ID)
+				assert.NoError(err)
+				assert.Equal(1, count)
+
+				items, err := GetCodecsForOrganizationID(ts.Tx(), org.ID, 10, 0)
+				assert.NoError(err)
+				assert.Len(items, 1)
+				assert.Equal(c.ID, items[0].ID)
+				assert.Equal(c.Name, items[0].Name)
+				assert.EqualValues(2, items[0].Version)
+				assert.Equal(1, items[0].ApplicationCount)
+			})
+
+			t.Run("GetPayloadCodecForDevice", func(t *testing.T) {
+				assert := require.New(t)
+
+				dp := DeviceProfile{
+					Name:            "test-dp",
+					OrganizationID:  org.ID,
+					NetworkServerID: n.ID,
+				}
+				assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+				dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+				assert.NoError(err)
+
+				d := Device{
+					DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					ApplicationID:   app.ID,
+					DeviceProfileID: dpID,
+					Name:            "test-device",
+				}
+				assert.NoError(CreateDevice(ts.Tx(), &d))
+
+				payloadCodec, encoderScript, decoderScript, err := GetPayloadCodecForDevice(ts.Tx(), d, app)
+				assert.NoError(err)
+				assert.Equal(c.PayloadCodec, payloadCodec)
+				assert.Equal(c.PayloadEncoderScript, encoderScript)
+				assert.Equal(c.PayloadDecoderScript, decoderScript)
+			})
+
+			t.Run("Pinned version", func(t *testing.T) {
+				assert := require.New(t)
+
+				version := int64(1)
+				app.CodecVersion = &version
+				assert.NoError(UpdateApplication(ts.Tx(), app))
+
+				dp := DeviceProfile{
+					Name:            "test-dp-pinned",
+					OrganizationID:  org.ID,
+					NetworkServerID: n.ID,
+				}
+				assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+				dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+				assert.NoError(err)
+
+				d := Device{
+					ApplicationID:   app.ID,
+					DeviceProfileID: dpID,
+				}
+
+				payloadCodec, _, decoderScript, err := GetPayloadCodecForDevice(ts.Tx(), d, app)
+				assert.NoError(err)
+				assert.Equal(codec.CustomJSType, payloadCodec)
+				assert.Equal("function Decode(fPort, bytes) { return {}; }", decoderScript)
+
+				app.CodecVersion = nil
+				assert.NoError(UpdateApplication(ts.Tx(), app))
+			})
+
+			t.Run("Version without codec", func(t *testing.T) {
+				assert := require.New(t)
+
+				version := int64(1)
+				app := app
+				app.CodecID = nil
+				app.CodecVersion = &version
+				assert.Equal(ErrApplicationInvalidCodecVersion, errors.Cause(UpdateApplication(ts.Tx(), app)))
+			})
+
+			// the codec can only be deleted when not used by applications
+			app.CodecID = nil
+			assert.NoError(UpdateApplication(ts.Tx(), app))
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteCodec(ts.Tx(), c.ID))
+			assert.Equal(ErrDoesNotExist, DeleteCodec(ts.Tx(), c.ID))
+
+			_, err := GetCodec(ts.Tx(), c.ID)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	uuid "github.com/gofrs/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -35,6 +36,10 @@ type Device struct {
 	Latitude                  *float64      `db:"latitude"`
 	Longitude                 *float64      `db:"longitude"`
 	Altitude                  *float64      `db:"altitude"`
+	Variables                 hstore.Hstore `db:"variables"`
+	SecretVariables           hstore.Hstore `db:"secret_variables"`
+	Tags                      hstore.Hstore `db:"tags"`
+	LastValues                DeviceValues  `db:"last_values"`
 }
 
 // DeviceListItem defines the Device as list item.
@@ -48,6 +53,31 @@ func (d Device) Validate() error {
 	return nil
 }
 
+// VariablesMap returns the device variables as map. Variables with a NULL
+// value are omitted. The secret variables are not included, as this map is
+// passed to the codec scripts, which could return (and thus publish) these.
+func (d Device) VariablesMap() map[string]string {
+	vars := make(map[string]string)
+	for k, v := range d.Variables.Map {
+		if v.Valid {
+			vars[k] = v.String
+		}
+	}
+	return vars
+}
+
+// TagsMap returns the device tags as map. Tags with a NULL value are
+// omitted.
+func (d Device) TagsMap() map[string]string {
+	tags := make(map[string]string)
+	for k, v := range d.Tags.Map {
+		if v.Valid {
+			tags[k] = v.String
+		}
+	}
+	return tags
+}
+
 // DeviceKeys defines the keys for a LoRaWAN device.
 type DeviceKeys struct {
 	CreatedAt time.Time         `db:"created_at"`
@@ -55,6 +85,7 @@ type DeviceKeys struct {
 	DevEUI    lorawan.EUI64     `db:"dev_eui"`
 	NwkKey    lorawan.AES128Key `db:"nwk_key"`
 	AppKey    lorawan.AES128Key `db:"app_key"`
+	GenAppKey lorawan.AES128Key `db:"gen_app_key"`
 	JoinNonce int               `db:"join_nonce"`
 }
 
@@ -92,8 +123,11 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 			last_seen_at,
 			latitude,
 			longitude,
-			altitude
-        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+			altitude,
+			variables,
+			secret_variables,
+			tags
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`,
 		d.DevEUI[:],
 		d.CreatedAt,
 		d.UpdatedAt,
@@ -108,6 +142,9 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		d.Latitude,
 		d.Longitude,
 		d.Altitude,
+		d.Variables,
+		d.SecretVariables,
+		d.Tags,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -206,6 +243,7 @@ type DeviceFilters struct {
 	ApplicationID    int64     `db:"application_id"`
 	MulticastGroupID uuid.UUID `db:"multicast_group_id"`
 	ServiceProfileID uuid.UUID `db:"service_profile_id"`
+	DeviceGroupID    uuid.UUID `db:"device_group_id"`
 	Search           string    `db:"search"`
 
 	// Limit and Offset are added for convenience so that this struct can
@@ -230,8 +268,12 @@ func (f DeviceFilters) SQL() string {
 		filters = append(filters, "a.service_profile_id = :service_profile_id")
 	}
 
+	if f.DeviceGroupID != uuid.Nil {
+		filters = append(filters, "exists (select 1 from device_group dg where dg.id = :device_group_id and "+deviceGroupMemberSQL+")")
+	}
+
 	if f.Search != "" {
-		filters = append(filters, "(d.name ilike :search or encode(d.dev_eui, 'hex') ilike :search)")
+		filters = append(filters, "(d.name ilike :search or encode(d.dev_eui, 'hex') ilike :search or exists (select 1 from each(d.tags) where key ilike :search or value ilike :search))")
 	}
 
 	if len(filters) == 0 {
@@ -329,7 +371,10 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 			latitude = $10,
 			longitude = $11,
 			altitude = $12,
-			device_status_external_power_source = $13
+			device_status_external_power_source = $13,
+			variables = $14,
+			secret_variables = $15,
+			tags = $16
         where
             dev_eui = $1`,
 		d.DevEUI[:],
@@ -345,6 +390,9 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 		d.Longitude,
 		d.Altitude,
 		d.DeviceStatusExternalPower,
+		d.Variables,
+		d.SecretVariables,
+		d.Tags,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -421,6 +469,12 @@ func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
 		return ErrDoesNotExist
 	}
 
+	// the device events do not reference the device table, so that events
+	// can be stored while the device is locked by a transaction
+	if err := DeleteDeviceEventsForDevEUI(db, devEUI); err != nil {
+		return errors.Wrap(err, "delete device events error")
+	}
+
 	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
 	if err != nil {
 		return errors.Wrap(err, "get network-server client error")
@@ -441,6 +495,108 @@ func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
 	return nil
 }
 
+// MoveDevice moves the device matching the given DevEUI to the given
+// application (which might belong to an other organization), using the
+// given device-profile. When the given device-profile ID is uuid.Nil, the
+// current device-profile is used. As the device is updated (not re-created) on the
+// network-server, the keys, activation, device-queue and history of the
+// device are retained. Memberships of multicast-groups and (static)
+// device-groups that are not available to the target application are
+// removed.
+func MoveDevice(db sqlx.Ext, devEUI lorawan.EUI64, applicationID int64, deviceProfileID uuid.UUID) error {
+	d, err := GetDevice(db, devEUI, true, false)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	n, err := GetNetworkServerForDevEUI(db, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	app, err := GetApplication(db, applicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	sp, err := GetServiceProfile(db, app.ServiceProfileID, true)
+	if err != nil {
+		return errors.Wrap(err, "get service-profile error")
+	}
+
+	if sp.NetworkServerID != n.ID {
+		return ErrDeviceMoveInvalidNetworkServer
+	}
+
+	if deviceProfileID == uuid.Nil {
+		deviceProfileID = d.DeviceProfileID
+	}
+
+	dp, err := GetLocalDeviceProfile(db, deviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile error")
+	}
+
+	if dp.OrganizationID != sp.OrganizationID || dp.NetworkServerID != sp.NetworkServerID {
+		return ErrDeviceMoveInvalidDeviceProfile
+	}
+
+	// multicast-groups are bound to a service-profile
+	var multicastGroupIDs []uuid.UUID
+	err = sqlx.Select(db, &multicastGroupIDs, `
+		select
+			dmg.multicast_group_id
+		from device_multicast_group dmg
+		inner join multicast_group mg
+			on mg.id = dmg.multicast_group_id
+		where
+			dmg.dev_eui = $1
+			and mg.service_profile_id != $2`,
+		devEUI[:],
+		app.ServiceProfileID,
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	for _, id := range multicastGroupIDs {
+		if err := RemoveDeviceFromMulticastGroup(db, id, devEUI); err != nil {
+			return errors.Wrap(err, "remove device from multicast-group error")
+		}
+	}
+
+	// device-groups are bound to an application
+	_, err = db.Exec(`
+		delete from device_group_device
+		where
+			dev_eui = $1
+			and device_group_id in (
+				select id from device_group where application_id != $2
+			)`,
+		devEUI[:],
+		applicationID,
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+
+	oldApplicationID := d.ApplicationID
+	d.ApplicationID = applicationID
+	d.DeviceProfileID = deviceProfileID
+
+	if err := UpdateDevice(db, &d, false); err != nil {
+		return errors.Wrap(err, "update device error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":            devEUI,
+		"old_application_id": oldApplicationID,
+		"application_id":     applicationID,
+	}).Info("device moved")
+
+	return nil
+}
+
 // CreateDeviceKeys creates the keys for the given device.
 func CreateDeviceKeys(db sqlx.Execer, dc *DeviceKeys) error {
 	now := time.Now()
@@ -454,14 +610,16 @@ func CreateDeviceKeys(db sqlx.Execer, dc *DeviceKeys) error {
             dev_eui,
 			nwk_key,
 			app_key,
-			join_nonce
-        ) values ($1, $2, $3, $4, $5, $6)`,
+			join_nonce,
+			gen_app_key
+        ) values ($1, $2, $3, $4, $5, $6, $7)`,
 		dc.CreatedAt,
 		dc.UpdatedAt,
 		dc.DevEUI[:],
 		dc.NwkKey[:],
 		dc.AppKey[:],
 		dc.JoinNonce,
+		dc.GenAppKey[:],
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -496,7 +654,8 @@ func UpdateDeviceKeys(db sqlx.Execer, dc *DeviceKeys) error {
             updated_at = $2,
 			nwk_key = $3,
 			app_key = $4,
-			join_nonce = $5
+			join_nonce = $5,
+			gen_app_key = $6
         where
             dev_eui = $1`,
 		dc.DevEUI[:],
@@ -504,6 +663,7 @@ func UpdateDeviceKeys(db sqlx.Execer, dc *DeviceKeys) error {
 		dc.NwkKey[:],
 		dc.AppKey[:],
 		dc.JoinNonce,
+		dc.GenAppKey[:],
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
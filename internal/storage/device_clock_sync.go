@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceClockSync defines the application-layer clock synchronization
+// (TS003) state of a device.
+type DeviceClockSync struct {
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+
+	// LastSyncAt holds the time of the last received AppTimeReq.
+	LastSyncAt *time.Time `db:"last_sync_at"`
+
+	// TimeCorrection holds the last time correction (in seconds) as sent
+	// to the device.
+	TimeCorrection int `db:"time_correction"`
+
+	// ClockDrift holds the clock drift (in ppm) of the device, measured
+	// between the last two synchronizations.
+	ClockDrift float64 `db:"clock_drift"`
+
+	// RequestedPeriodicity holds the periodicity that was requested using
+	// the DeviceAppTimePeriodicityReq, Periodicity holds the periodicity
+	// as acknowledged by the device.
+	RequestedPeriodicity *int `db:"requested_periodicity"`
+	Periodicity          *int `db:"periodicity"`
+}
+
+// CreateDeviceClockSync creates the given device clock sync.
+func CreateDeviceClockSync(db sqlx.Execer, dcs *DeviceClockSync) error {
+	now := time.Now()
+	dcs.CreatedAt = now
+	dcs.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into device_clock_sync (
+			dev_eui,
+			created_at,
+			updated_at,
+			last_sync_at,
+			time_correction,
+			clock_drift,
+			requested_periodicity,
+			periodicity
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		dcs.DevEUI[:],
+		dcs.CreatedAt,
+		dcs.UpdatedAt,
+		dcs.LastSyncAt,
+		dcs.TimeCorrection,
+		dcs.ClockDrift,
+		dcs.RequestedPeriodicity,
+		dcs.Periodicity,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithField("dev_eui", dcs.DevEUI).Info("device clock sync created")
+
+	return nil
+}
+
+// GetDeviceClockSync returns the device clock sync for the given DevEUI.
+func GetDeviceClockSync(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate bool) (DeviceClockSync, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var dcs DeviceClockSync
+	err := sqlx.Get(db, &dcs, `
+		select
+			*
+		from device_clock_sync
+		where
+			dev_eui = $1`+fu,
+		devEUI[:],
+	)
+	if err != nil {
+		return dcs, handlePSQLError(Select, err, "select error")
+	}
+
+	return dcs, nil
+}
+
+// UpdateDeviceClockSync updates the given device clock sync.
+func UpdateDeviceClockSync(db sqlx.Execer, dcs *DeviceClockSync) error {
+	dcs.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_clock_sync
+		set
+			updated_at = $2,
+			last_sync_at = $3,
+			time_correction = $4,
+			clock_drift = $5,
+			requested_periodicity = $6,
+			periodicity = $7
+		where
+			dev_eui = $1`,
+		dcs.DevEUI[:],
+		dcs.UpdatedAt,
+		dcs.LastSyncAt,
+		dcs.TimeCorrection,
+		dcs.ClockDrift,
+		dcs.RequestedPeriodicity,
+		dcs.Periodicity,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("dev_eui", dcs.DevEUI).Info("device clock sync updated")
+
+	return nil
+}
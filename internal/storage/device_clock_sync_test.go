@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceClockSync() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	ts.T().Run("Get non-existing", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := GetDeviceClockSync(ts.Tx(), d.DevEUI, false)
+		assert.Equal(ErrDoesNotExist, err)
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		lastSyncAt := time.Now().Round(time.Second).UTC()
+		dcs := DeviceClockSync{
+			DevEUI:         d.DevEUI,
+			LastSyncAt:     &lastSyncAt,
+			TimeCorrection: -5,
+		}
+		assert.NoError(CreateDeviceClockSync(ts.Tx(), &dcs))
+		dcs.CreatedAt = dcs.CreatedAt.Round(time.Second).UTC()
+		dcs.UpdatedAt = dcs.UpdatedAt.Round(time.Second).UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			dcsGet, err := GetDeviceClockSync(ts.Tx(), d.DevEUI, false)
+			assert.NoError(err)
+
+			dcsGet.CreatedAt = dcsGet.CreatedAt.Round(time.Second).UTC()
+			dcsGet.UpdatedAt = dcsGet.UpdatedAt.Round(time.Second).UTC()
+			lastSyncAt := dcsGet.LastSyncAt.Round(time.Second).UTC()
+			dcsGet.LastSyncAt = &lastSyncAt
+			assert.Equal(dcs, dcsGet)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			periodicity := 3
+			dcs.TimeCorrection = 2
+			dcs.ClockDrift = 1.5
+			dcs.RequestedPeriodicity = &periodicity
+			dcs.Periodicity = &periodicity
+			assert.NoError(UpdateDeviceClockSync(ts.Tx(), &dcs))
+			dcs.UpdatedAt = dcs.UpdatedAt.Round(time.Second).UTC()
+
+			dcsGet, err := GetDeviceClockSync(ts.Tx(), d.DevEUI, false)
+			assert.NoError(err)
+
+			dcsGet.CreatedAt = dcsGet.CreatedAt.Round(time.Second).UTC()
+			dcsGet.UpdatedAt = dcsGet.UpdatedAt.Round(time.Second).UTC()
+			lastSyncAt := dcsGet.LastSyncAt.Round(time.Second).UTC()
+			dcsGet.LastSyncAt = &lastSyncAt
+			assert.Equal(dcs, dcsGet)
+		})
+	})
+}
@@ -0,0 +1,213 @@
+package storage
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceEvent defines a persisted device event (e.g. uplink, ack or error).
+type DeviceEvent struct {
+	ID        int64           `db:"id"`
+	CreatedAt time.Time       `db:"created_at"`
+	DevEUI    lorawan.EUI64   `db:"dev_eui"`
+	Type      string          `db:"type"`
+	Payload   json.RawMessage `db:"payload"`
+}
+
+// DeviceEventFilters provides filters that can be used to filter on
+// device events. Note that empty values are not used as filter.
+type DeviceEventFilters struct {
+	DevEUI lorawan.EUI64 `db:"dev_eui"`
+	Type   string        `db:"type"`
+	Start  *time.Time    `db:"start"`
+	End    *time.Time    `db:"end"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceEventFilters) SQL() string {
+	var filters []string
+
+	if f.DevEUI != (lorawan.EUI64{}) {
+		filters = append(filters, "dev_eui = :dev_eui")
+	}
+
+	if f.Type != "" {
+		filters = append(filters, "type = :type")
+	}
+
+	if f.Start != nil {
+		filters = append(filters, "created_at >= :start")
+	}
+
+	if f.End != nil {
+		filters = append(filters, "created_at < :end")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// CreateDeviceEvent creates the given device event.
+func CreateDeviceEvent(db sqlx.Queryer, e *DeviceEvent) error {
+	e.CreatedAt = time.Now()
+
+	err := sqlx.Get(db, &e.ID, `
+		insert into device_event (
+			created_at,
+			dev_eui,
+			type,
+			payload
+		) values ($1, $2, $3, $4)
+		returning id`,
+		e.CreatedAt,
+		e.DevEUI[:],
+		e.Type,
+		[]byte(e.Payload),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeviceEventRetention returns the device event retention for the given
+// DevEUI. This is the retention of the organization to which the device
+// belongs, or defaultRetention when the organization does not define a
+// retention.
+func GetDeviceEventRetention(db sqlx.Queryer, devEUI lorawan.EUI64, defaultRetention time.Duration) (time.Duration, error) {
+	var days int
+	err := sqlx.Get(db, &days, `
+		select
+			o.device_event_retention_days
+		from device d
+		inner join application a
+			on a.id = d.application_id
+		inner join organization o
+			on o.id = a.organization_id
+		where
+			d.dev_eui = $1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	if days > 0 {
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	return defaultRetention, nil
+}
+
+// GetDeviceEventCount returns the number of device events matching the
+// given filters.
+func GetDeviceEventCount(db sqlx.Queryer, filters DeviceEventFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from device_event
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceEvents returns the device events matching the given filters,
+// most recent first.
+func GetDeviceEvents(db sqlx.Queryer, filters DeviceEventFilters) ([]DeviceEvent, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from device_event
+		`+filters.SQL()+`
+		order by
+			created_at desc,
+			id desc
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var events []DeviceEvent
+	err = sqlx.Select(db, &events, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return events, nil
+}
+
+// DeleteDeviceEventsForDevEUI deletes all the device events for the given
+// DevEUI.
+func DeleteDeviceEventsForDevEUI(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	_, err := db.Exec("delete from device_event where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+
+	return nil
+}
+
+// DeleteExpiredDeviceEvents deletes the device events which are older than
+// the retention of the organization to which the device belongs. When the
+// organization does not define a retention, defaultRetention is used.
+// It returns the number of deleted events.
+func DeleteExpiredDeviceEvents(db sqlx.Execer, defaultRetention time.Duration) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_event de
+		using
+			device d,
+			application a,
+			organization o
+		where
+			d.dev_eui = de.dev_eui
+			and a.id = d.application_id
+			and o.id = a.organization_id
+			and de.created_at < now() - (
+				case when o.device_event_retention_days > 0
+					then o.device_event_retention_days * interval '1 day'
+					else $1 * interval '1 second'
+				end
+			)`,
+		int64(defaultRetention/time.Second),
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	if ra != 0 {
+		log.WithField("count", ra).Info("expired device events deleted")
+	}
+
+	return ra, nil
+}
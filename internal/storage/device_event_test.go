@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceEvent() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	events := []DeviceEvent{
+		{DevEUI: d.DevEUI, Type: "uplink", Payload: json.RawMessage(`{"fCnt":1}`)},
+		{DevEUI: d.DevEUI, Type: "error", Payload: json.RawMessage(`{"error":"decode error"}`)},
+		{DevEUI: d.DevEUI, Type: "uplink", Payload: json.RawMessage(`{"fCnt":2}`)},
+	}
+
+	ts.T().Run("Get retention", func(t *testing.T) {
+		assert := require.New(t)
+
+		retention, err := GetDeviceEventRetention(ts.Tx(), d.DevEUI, 7*24*time.Hour)
+		assert.NoError(err)
+		assert.Equal(7*24*time.Hour, retention)
+
+		retention, err = GetDeviceEventRetention(ts.Tx(), d.DevEUI, 0)
+		assert.NoError(err)
+		assert.Equal(time.Duration(0), retention)
+
+		_, err = GetDeviceEventRetention(ts.Tx(), lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, 0)
+		assert.Equal(ErrDoesNotExist, err)
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		for i := range events {
+			assert.NoError(CreateDeviceEvent(ts.Tx(), &events[i]))
+			assert.NotEqual(0, events[i].ID)
+		}
+
+		t.Run("Get count and events", func(t *testing.T) {
+			assert := require.New(t)
+
+			filters := DeviceEventFilters{
+				DevEUI: d.DevEUI,
+				Limit:  10,
+			}
+
+			count, err := GetDeviceEventCount(ts.Tx(), filters)
+			assert.NoError(err)
+			assert.Equal(3, count)
+
+			items, err := GetDeviceEvents(ts.Tx(), filters)
+			assert.NoError(err)
+			assert.Len(items, 3)
+			assert.Equal(events[2].ID, items[0].ID)
+			assert.Equal(events[0].ID, items[2].ID)
+			assert.JSONEq(`{"fCnt":2}`, string(items[0].Payload))
+		})
+
+		t.Run("Filter on type", func(t *testing.T) {
+			assert := require.New(t)
+
+			filters := DeviceEventFilters{
+				DevEUI: d.DevEUI,
+				Type:   "error",
+				Limit:  10,
+			}
+
+			count, err := GetDeviceEventCount(ts.Tx(), filters)
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			items, err := GetDeviceEvents(ts.Tx(), filters)
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(events[1].ID, items[0].ID)
+		})
+
+		t.Run("Filter on time range", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := ts.Tx().Exec("update device_event set created_at = created_at - interval '2 days' where id = $1", events[0].ID)
+			assert.NoError(err)
+
+			start := time.Now().Add(-36 * time.Hour)
+			end := time.Now().Add(-12 * time.Hour)
+
+			count, err := GetDeviceEventCount(ts.Tx(), DeviceEventFilters{
+				DevEUI: d.DevEUI,
+				Start:  &start,
+			})
+			assert.NoError(err)
+			assert.Equal(2, count)
+
+			items, err := GetDeviceEvents(ts.Tx(), DeviceEventFilters{
+				DevEUI: d.DevEUI,
+				End:    &end,
+				Limit:  10,
+			})
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(events[0].ID, items[0].ID)
+		})
+
+		t.Run("Delete expired", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := DeleteExpiredDeviceEvents(ts.Tx(), 7*24*time.Hour)
+			assert.NoError(err)
+			assert.EqualValues(0, count)
+
+			org.DeviceEventRetentionDays = 1
+			assert.NoError(UpdateOrganization(ts.Tx(), &org))
+
+			retention, err := GetDeviceEventRetention(ts.Tx(), d.DevEUI, 0)
+			assert.NoError(err)
+			assert.Equal(24*time.Hour, retention)
+
+			count, err = DeleteExpiredDeviceEvents(ts.Tx(), 7*24*time.Hour)
+			assert.NoError(err)
+			assert.EqualValues(1, count)
+
+			count2, err := GetDeviceEventCount(ts.Tx(), DeviceEventFilters{DevEUI: d.DevEUI})
+			assert.NoError(err)
+			assert.Equal(2, count2)
+		})
+
+		t.Run("Delete device", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteDevice(ts.Tx(), d.DevEUI))
+
+			count, err := GetDeviceEventCount(ts.Tx(), DeviceEventFilters{DevEUI: d.DevEUI})
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+	})
+}
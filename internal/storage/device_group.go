@@ -0,0 +1,359 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// deviceGroupMemberSQL matches the devices (d) which are member of the
+// device-group (dg). Note that the cast function is used instead of ::,
+// as this query is also used with named arguments.
+const deviceGroupMemberSQL = `
+	d.application_id = dg.application_id
+	and (
+		(
+			dg.dynamic = false
+			and exists (
+				select 1
+				from device_group_device dgd
+				where
+					dgd.device_group_id = dg.id
+					and dgd.dev_eui = d.dev_eui
+			)
+		)
+		or (
+			dg.dynamic = true
+			and coalesce(d.tags, cast('' as hstore)) @> coalesce(dg.filter_tags, cast('' as hstore))
+			and (dg.filter_device_profile_id is null or d.device_profile_id = dg.filter_device_profile_id)
+		)
+	)`
+
+// DeviceGroup defines a group of devices within an application. The devices
+// of a static group are added explicitly, a dynamic group contains all the
+// devices of the application matching the tag and device-profile filters.
+type DeviceGroup struct {
+	ID            uuid.UUID `db:"id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	ApplicationID int64     `db:"application_id"`
+	Name          string    `db:"name"`
+	Description   string    `db:"description"`
+	Dynamic       bool      `db:"dynamic"`
+
+	// FilterTags and FilterDeviceProfileID are only used by dynamic groups.
+	// A device matches when it has all the given tags (with equal values)
+	// and uses the given device-profile (when set).
+	FilterTags            hstore.Hstore `db:"filter_tags"`
+	FilterDeviceProfileID *uuid.UUID    `db:"filter_device_profile_id"`
+}
+
+// DeviceGroupFilters provides filters that can be used to filter on
+// device-groups. Note that empty values are not used as filter.
+type DeviceGroupFilters struct {
+	ApplicationID int64         `db:"application_id"`
+	DevEUI        lorawan.EUI64 `db:"dev_eui"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceGroupFilters) SQL() string {
+	var filters []string
+
+	if f.ApplicationID != 0 {
+		filters = append(filters, "dg.application_id = :application_id")
+	}
+
+	if f.DevEUI != (lorawan.EUI64{}) {
+		filters = append(filters, "exists (select 1 from device d where d.dev_eui = :dev_eui and "+deviceGroupMemberSQL+")")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// Validate validates the device-group data.
+func (g DeviceGroup) Validate() error {
+	if g.Name == "" {
+		return ErrDeviceGroupInvalidName
+	}
+	return nil
+}
+
+// CreateDeviceGroup creates the given device-group.
+func CreateDeviceGroup(db sqlx.Execer, g *DeviceGroup) error {
+	if err := g.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	now := time.Now()
+	g.ID = id
+	g.CreatedAt = now
+	g.UpdatedAt = now
+
+	_, err = db.Exec(`
+		insert into device_group (
+			id,
+			created_at,
+			updated_at,
+			application_id,
+			name,
+			description,
+			dynamic,
+			filter_tags,
+			filter_device_profile_id
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		g.ID,
+		g.CreatedAt,
+		g.UpdatedAt,
+		g.ApplicationID,
+		g.Name,
+		g.Description,
+		g.Dynamic,
+		g.FilterTags,
+		g.FilterDeviceProfileID,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithField("id", g.ID).Info("device-group created")
+
+	return nil
+}
+
+// GetDeviceGroup returns the device-group for the given id.
+// When forUpdate is set to true, the row will be locked.
+func GetDeviceGroup(db sqlx.Queryer, id uuid.UUID, forUpdate bool) (DeviceGroup, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var g DeviceGroup
+	err := sqlx.Get(db, &g, "select * from device_group where id = $1"+fu, id)
+	if err != nil {
+		return g, handlePSQLError(Select, err, "select error")
+	}
+
+	return g, nil
+}
+
+// GetDeviceGroupCount returns the number of device-groups matching the
+// given filters.
+func GetDeviceGroupCount(db sqlx.Queryer, filters DeviceGroupFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from device_group dg
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceGroups returns the device-groups matching the given filters,
+// ordered by name.
+func GetDeviceGroups(db sqlx.Queryer, filters DeviceGroupFilters) ([]DeviceGroup, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			dg.*
+		from device_group dg
+		`+filters.SQL()+`
+		order by
+			dg.name
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var groups []DeviceGroup
+	err = sqlx.Select(db, &groups, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return groups, nil
+}
+
+// UpdateDeviceGroup updates the given device-group. Note that the
+// application can not be changed.
+func UpdateDeviceGroup(db sqlx.Execer, g *DeviceGroup) error {
+	if err := g.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	g.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_group
+		set
+			updated_at = $2,
+			name = $3,
+			description = $4,
+			dynamic = $5,
+			filter_tags = $6,
+			filter_device_profile_id = $7
+		where
+			id = $1`,
+		g.ID,
+		g.UpdatedAt,
+		g.Name,
+		g.Description,
+		g.Dynamic,
+		g.FilterTags,
+		g.FilterDeviceProfileID,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", g.ID).Info("device-group updated")
+
+	return nil
+}
+
+// DeleteDeviceGroup deletes the device-group matching the given id. This
+// does not delete the devices of the group.
+func DeleteDeviceGroup(db sqlx.Execer, id uuid.UUID) error {
+	res, err := db.Exec("delete from device_group where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("device-group deleted")
+
+	return nil
+}
+
+// AddDeviceToDeviceGroup adds the given device to the given (static)
+// device-group.
+func AddDeviceToDeviceGroup(db sqlx.Execer, deviceGroupID uuid.UUID, devEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		insert into device_group_device (
+			device_group_id,
+			dev_eui,
+			created_at
+		) values ($1, $2, $3)`,
+		deviceGroupID,
+		devEUI[:],
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":         devEUI,
+		"device_group_id": deviceGroupID,
+	}).Info("device added to device-group")
+
+	return nil
+}
+
+// RemoveDeviceFromDeviceGroup removes the given device from the given
+// (static) device-group.
+func RemoveDeviceFromDeviceGroup(db sqlx.Execer, deviceGroupID uuid.UUID, devEUI lorawan.EUI64) error {
+	res, err := db.Exec(`
+		delete from device_group_device
+		where
+			device_group_id = $1
+			and dev_eui = $2`,
+		deviceGroupID,
+		devEUI[:],
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":         devEUI,
+		"device_group_id": deviceGroupID,
+	}).Info("device removed from device-group")
+
+	return nil
+}
+
+// GetDeviceGroupDevEUIs returns at most limit DevEUIs of the devices within
+// the given device-group, ordered by DevEUI. When after is set, only the
+// DevEUIs greater than the given DevEUI are returned. This makes it possible
+// to iterate over the group while devices are added or removed.
+func GetDeviceGroupDevEUIs(db sqlx.Queryer, deviceGroupID uuid.UUID, after *lorawan.EUI64, limit int) ([]lorawan.EUI64, error) {
+	var afterB []byte
+	if after != nil {
+		afterB = after[:]
+	}
+
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select
+			d.dev_eui
+		from device d
+		inner join device_group dg
+			on dg.id = $1
+		where
+			`+deviceGroupMemberSQL+`
+			and ($2::bytea is null or d.dev_eui > $2)
+		order by
+			d.dev_eui
+		limit $3`,
+		deviceGroupID,
+		afterB,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
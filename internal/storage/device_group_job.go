@@ -0,0 +1,317 @@
+package storage
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceGroupJobAction defines the action executed by a device-group job.
+type DeviceGroupJobAction string
+
+// Available device-group job actions.
+const (
+	DeviceGroupJobEnqueueDownlink     DeviceGroupJobAction = "ENQUEUE_DOWNLINK"
+	DeviceGroupJobChangeDeviceProfile DeviceGroupJobAction = "CHANGE_DEVICE_PROFILE"
+	DeviceGroupJobAddToMulticastGroup DeviceGroupJobAction = "ADD_TO_MULTICAST_GROUP"
+	DeviceGroupJobFlushQueue          DeviceGroupJobAction = "FLUSH_QUEUE"
+	DeviceGroupJobDeleteDevices       DeviceGroupJobAction = "DELETE_DEVICES"
+)
+
+// DeviceGroupJobStatus defines the status of a device-group job.
+type DeviceGroupJobStatus string
+
+// Available device-group job statuses.
+const (
+	DeviceGroupJobPending   DeviceGroupJobStatus = "PENDING"
+	DeviceGroupJobRunning   DeviceGroupJobStatus = "RUNNING"
+	DeviceGroupJobCompleted DeviceGroupJobStatus = "COMPLETED"
+)
+
+// DeviceGroupJob defines an action which is executed in the background for
+// each device of a device-group.
+type DeviceGroupJob struct {
+	ID            uuid.UUID            `db:"id"`
+	CreatedAt     time.Time            `db:"created_at"`
+	UpdatedAt     time.Time            `db:"updated_at"`
+	DeviceGroupID uuid.UUID            `db:"device_group_id"`
+	Action        DeviceGroupJobAction `db:"action"`
+
+	// Action parameters.
+	FPort            uint8      `db:"f_port"`
+	Confirmed        bool       `db:"confirmed"`
+	Data             []byte     `db:"data"`
+	DeviceProfileID  *uuid.UUID `db:"device_profile_id"`
+	MulticastGroupID *uuid.UUID `db:"multicast_group_id"`
+
+	// Progress. TotalCount holds the number of devices within the group
+	// at the time the job was created. LastDevEUI holds the last processed
+	// device, as the devices are processed in order of their DevEUI.
+	Status         DeviceGroupJobStatus `db:"status"`
+	TotalCount     int                  `db:"total_count"`
+	ProcessedCount int                  `db:"processed_count"`
+	ErrorCount     int                  `db:"error_count"`
+	LastError      string               `db:"last_error"`
+	LastDevEUI     *lorawan.EUI64       `db:"last_dev_eui"`
+	CompletedAt    *time.Time           `db:"completed_at"`
+}
+
+// DeviceGroupJobFilters provides filters that can be used to filter on
+// device-group jobs. Note that empty values are not used as filter.
+type DeviceGroupJobFilters struct {
+	DeviceGroupID uuid.UUID `db:"device_group_id"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceGroupJobFilters) SQL() string {
+	var filters []string
+
+	if f.DeviceGroupID != uuid.Nil {
+		filters = append(filters, "device_group_id = :device_group_id")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// Validate validates the device-group job data.
+func (j DeviceGroupJob) Validate() error {
+	switch j.Action {
+	case DeviceGroupJobEnqueueDownlink:
+		if j.FPort < 1 || j.FPort > 223 {
+			return ErrDeviceGroupJobInvalidFPort
+		}
+	case DeviceGroupJobChangeDeviceProfile:
+		if j.DeviceProfileID == nil {
+			return ErrDeviceGroupJobDeviceProfileRequired
+		}
+	case DeviceGroupJobAddToMulticastGroup:
+		if j.MulticastGroupID == nil {
+			return ErrDeviceGroupJobMulticastGroupRequired
+		}
+	case DeviceGroupJobFlushQueue, DeviceGroupJobDeleteDevices:
+	default:
+		return ErrDeviceGroupJobInvalidAction
+	}
+	return nil
+}
+
+// CreateDeviceGroupJob creates the given device-group job. The job will be
+// created in the pending state.
+func CreateDeviceGroupJob(db sqlx.Ext, j *DeviceGroupJob) error {
+	if err := j.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	count, err := GetDeviceCount(db, DeviceFilters{DeviceGroupID: j.DeviceGroupID})
+	if err != nil {
+		return errors.Wrap(err, "get device count error")
+	}
+
+	now := time.Now()
+	j.ID = id
+	j.CreatedAt = now
+	j.UpdatedAt = now
+	j.Status = DeviceGroupJobPending
+	j.TotalCount = count
+
+	_, err = db.Exec(`
+		insert into device_group_job (
+			id,
+			created_at,
+			updated_at,
+			device_group_id,
+			action,
+			f_port,
+			confirmed,
+			data,
+			device_profile_id,
+			multicast_group_id,
+			status,
+			total_count,
+			processed_count,
+			error_count,
+			last_error,
+			last_dev_eui,
+			completed_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`,
+		j.ID,
+		j.CreatedAt,
+		j.UpdatedAt,
+		j.DeviceGroupID,
+		j.Action,
+		j.FPort,
+		j.Confirmed,
+		j.Data,
+		j.DeviceProfileID,
+		j.MulticastGroupID,
+		j.Status,
+		j.TotalCount,
+		j.ProcessedCount,
+		j.ErrorCount,
+		j.LastError,
+		j.LastDevEUI,
+		j.CompletedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              j.ID,
+		"device_group_id": j.DeviceGroupID,
+		"action":          j.Action,
+	}).Info("device-group job created")
+
+	return nil
+}
+
+// GetDeviceGroupJob returns the device-group job for the given id.
+// When forUpdate is set to true, the row will be locked.
+func GetDeviceGroupJob(db sqlx.Queryer, id uuid.UUID, forUpdate bool) (DeviceGroupJob, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var j DeviceGroupJob
+	err := sqlx.Get(db, &j, "select * from device_group_job where id = $1"+fu, id)
+	if err != nil {
+		return j, handlePSQLError(Select, err, "select error")
+	}
+
+	return j, nil
+}
+
+// GetPendingDeviceGroupJob returns the oldest device-group job which has
+// not yet completed, or nil when there is no such job. The returned job is
+// locked, jobs locked by other transactions are skipped.
+func GetPendingDeviceGroupJob(db sqlx.Queryer) (*DeviceGroupJob, error) {
+	var j DeviceGroupJob
+	err := sqlx.Get(db, &j, `
+		select
+			*
+		from device_group_job
+		where
+			status != $1
+		order by
+			created_at
+		limit 1
+		for update skip locked`,
+		DeviceGroupJobCompleted,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return &j, nil
+}
+
+// GetDeviceGroupJobCount returns the number of device-group jobs matching
+// the given filters.
+func GetDeviceGroupJobCount(db sqlx.Queryer, filters DeviceGroupJobFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from device_group_job
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceGroupJobs returns the device-group jobs matching the given
+// filters, most recent job first.
+func GetDeviceGroupJobs(db sqlx.Queryer, filters DeviceGroupJobFilters) ([]DeviceGroupJob, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from device_group_job
+		`+filters.SQL()+`
+		order by
+			created_at desc
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var jobs []DeviceGroupJob
+	err = sqlx.Select(db, &jobs, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return jobs, nil
+}
+
+// UpdateDeviceGroupJob updates the progress of the given device-group job.
+func UpdateDeviceGroupJob(db sqlx.Execer, j *DeviceGroupJob) error {
+	j.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_group_job
+		set
+			updated_at = $2,
+			status = $3,
+			processed_count = $4,
+			error_count = $5,
+			last_error = $6,
+			last_dev_eui = $7,
+			completed_at = $8
+		where
+			id = $1`,
+		j.ID,
+		j.UpdatedAt,
+		j.Status,
+		j.ProcessedCount,
+		j.ErrorCount,
+		j.LastError,
+		j.LastDevEUI,
+		j.CompletedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
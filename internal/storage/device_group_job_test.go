@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDeviceGroupJobValidate(t *testing.T) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Job   DeviceGroupJob
+		Error error
+	}{
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobEnqueueDownlink,
+				FPort:  10,
+			},
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobEnqueueDownlink,
+			},
+			Error: ErrDeviceGroupJobInvalidFPort,
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobEnqueueDownlink,
+				FPort:  224,
+			},
+			Error: ErrDeviceGroupJobInvalidFPort,
+		},
+		{
+			Job: DeviceGroupJob{
+				Action:          DeviceGroupJobChangeDeviceProfile,
+				DeviceProfileID: &id,
+			},
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobChangeDeviceProfile,
+			},
+			Error: ErrDeviceGroupJobDeviceProfileRequired,
+		},
+		{
+			Job: DeviceGroupJob{
+				Action:           DeviceGroupJobAddToMulticastGroup,
+				MulticastGroupID: &id,
+			},
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobAddToMulticastGroup,
+			},
+			Error: ErrDeviceGroupJobMulticastGroupRequired,
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobFlushQueue,
+			},
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: DeviceGroupJobDeleteDevices,
+			},
+		},
+		{
+			Job: DeviceGroupJob{
+				Action: "FOO",
+			},
+			Error: ErrDeviceGroupJobInvalidAction,
+		},
+	}
+
+	assert := require.New(t)
+
+	for _, tst := range tests {
+		assert.Equal(tst.Error, tst.Job.Validate())
+	}
+}
+
+func (ts *StorageTestSuite) TestDeviceGroupJob() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	devices := []Device{
+		{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device-1",
+			Tags: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"floor": {String: "1", Valid: true},
+				},
+			},
+		},
+		{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device-2",
+		},
+	}
+	for i := range devices {
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+
+	g := DeviceGroup{
+		ApplicationID: app.ID,
+		Name:          "test-group",
+		Dynamic:       true,
+	}
+	assert.NoError(CreateDeviceGroup(ts.Tx(), &g))
+
+	ts.T().Run("Create with invalid action", func(t *testing.T) {
+		assert := require.New(t)
+
+		j := DeviceGroupJob{
+			DeviceGroupID: g.ID,
+			Action:        "FOO",
+		}
+		assert.Equal(ErrDeviceGroupJobInvalidAction, errors.Cause(CreateDeviceGroupJob(ts.Tx(), &j)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		j := DeviceGroupJob{
+			DeviceGroupID: g.ID,
+			Action:        DeviceGroupJobEnqueueDownlink,
+			FPort:         10,
+			Confirmed:     true,
+			Data:          []byte{1, 2, 3},
+		}
+		assert.NoError(CreateDeviceGroupJob(ts.Tx(), &j))
+		assert.Equal(DeviceGroupJobPending, j.Status)
+		assert.Equal(2, j.TotalCount)
+
+		j.CreatedAt = j.CreatedAt.Round(time.Second).UTC()
+		j.UpdatedAt = j.UpdatedAt.Round(time.Second).UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			jGet, err := GetDeviceGroupJob(ts.Tx(), j.ID, false)
+			assert.NoError(err)
+
+			jGet.CreatedAt = jGet.CreatedAt.Round(time.Second).UTC()
+			jGet.UpdatedAt = jGet.UpdatedAt.Round(time.Second).UTC()
+			assert.Equal(j, jGet)
+		})
+
+		t.Run("Get pending", func(t *testing.T) {
+			assert := require.New(t)
+
+			jPending, err := GetPendingDeviceGroupJob(ts.Tx())
+			assert.NoError(err)
+			assert.NotNil(jPending)
+			assert.Equal(j.ID, jPending.ID)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetDeviceGroupJobCount(ts.Tx(), DeviceGroupJobFilters{DeviceGroupID: g.ID})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			jobs, err := GetDeviceGroupJobs(ts.Tx(), DeviceGroupJobFilters{DeviceGroupID: g.ID, Limit: 10})
+			assert.NoError(err)
+			assert.Len(jobs, 1)
+			assert.Equal(j.ID, jobs[0].ID)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			now := time.Now().Round(time.Second).UTC()
+			j.Status = DeviceGroupJobCompleted
+			j.ProcessedCount = 2
+			j.ErrorCount = 1
+			j.LastError = "test error"
+			j.LastDevEUI = &devices[1].DevEUI
+			j.CompletedAt = &now
+			assert.NoError(UpdateDeviceGroupJob(ts.Tx(), &j))
+
+			jGet, err := GetDeviceGroupJob(ts.Tx(), j.ID, false)
+			assert.NoError(err)
+			assert.Equal(DeviceGroupJobCompleted, jGet.Status)
+			assert.Equal(2, jGet.ProcessedCount)
+			assert.Equal(1, jGet.ErrorCount)
+			assert.Equal("test error", jGet.LastError)
+			assert.Equal(&devices[1].DevEUI, jGet.LastDevEUI)
+			assert.True(jGet.CompletedAt.Equal(now))
+
+			t.Run("No pending job", func(t *testing.T) {
+				assert := require.New(t)
+
+				jPending, err := GetPendingDeviceGroupJob(ts.Tx())
+				assert.NoError(err)
+				assert.Nil(jPending)
+			})
+		})
+
+		t.Run("Delete device-group", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteDeviceGroup(ts.Tx(), g.ID))
+
+			_, err := GetDeviceGroupJob(ts.Tx(), j.ID, false)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+	})
+}
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceGroup() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	devices := []Device{
+		{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device-1",
+			Tags: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"floor": {String: "1", Valid: true},
+				},
+			},
+		},
+		{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device-2",
+		},
+	}
+	for i := range devices {
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+
+	ts.T().Run("Create with invalid name", func(t *testing.T) {
+		assert := require.New(t)
+
+		g := DeviceGroup{
+			ApplicationID: app.ID,
+		}
+		assert.Equal(ErrDeviceGroupInvalidName, errors.Cause(CreateDeviceGroup(ts.Tx(), &g)))
+	})
+
+	ts.T().Run("Create static", func(t *testing.T) {
+		assert := require.New(t)
+
+		g := DeviceGroup{
+			ApplicationID: app.ID,
+			Name:          "static",
+			Description:   "static group",
+		}
+		assert.NoError(CreateDeviceGroup(ts.Tx(), &g))
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			gGet, err := GetDeviceGroup(ts.Tx(), g.ID, false)
+			assert.NoError(err)
+			assert.Equal(app.ID, gGet.ApplicationID)
+			assert.Equal("static", gGet.Name)
+			assert.Equal("static group", gGet.Description)
+			assert.False(gGet.Dynamic)
+		})
+
+		t.Run("Add device", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(AddDeviceToDeviceGroup(ts.Tx(), g.ID, devices[1].DevEUI))
+			assert.Equal(ErrAlreadyExists, AddDeviceToDeviceGroup(ts.Tx(), g.ID, devices[1].DevEUI))
+
+			devEUIs, err := GetDeviceGroupDevEUIs(ts.Tx(), g.ID, nil, 10)
+			assert.NoError(err)
+			assert.Equal([]lorawan.EUI64{devices[1].DevEUI}, devEUIs)
+
+			count, err := GetDeviceCount(ts.Tx(), DeviceFilters{DeviceGroupID: g.ID})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			groups, err := GetDeviceGroups(ts.Tx(), DeviceGroupFilters{DevEUI: devices[1].DevEUI, Limit: 10})
+			assert.NoError(err)
+			assert.Len(groups, 1)
+			assert.Equal(g.ID, groups[0].ID)
+
+			groups, err = GetDeviceGroups(ts.Tx(), DeviceGroupFilters{DevEUI: devices[0].DevEUI, Limit: 10})
+			assert.NoError(err)
+			assert.Len(groups, 0)
+		})
+
+		t.Run("Remove device", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(RemoveDeviceFromDeviceGroup(ts.Tx(), g.ID, devices[1].DevEUI))
+			assert.Equal(ErrDoesNotExist, RemoveDeviceFromDeviceGroup(ts.Tx(), g.ID, devices[1].DevEUI))
+
+			devEUIs, err := GetDeviceGroupDevEUIs(ts.Tx(), g.ID, nil, 10)
+			assert.NoError(err)
+			assert.Len(devEUIs, 0)
+		})
+
+		t.Run("Update to dynamic", func(t *testing.T) {
+			assert := require.New(t)
+
+			g.Name = "dynamic"
+			g.Dynamic = true
+			assert.NoError(UpdateDeviceGroup(ts.Tx(), &g))
+
+			gGet, err := GetDeviceGroup(ts.Tx(), g.ID, false)
+			assert.NoError(err)
+			assert.Equal("dynamic", gGet.Name)
+			assert.True(gGet.Dynamic)
+
+			// without filters, all devices of the application are member
+			devEUIs, err := GetDeviceGroupDevEUIs(ts.Tx(), g.ID, nil, 10)
+			assert.NoError(err)
+			assert.Equal([]lorawan.EUI64{devices[0].DevEUI, devices[1].DevEUI}, devEUIs)
+
+			devEUIs, err = GetDeviceGroupDevEUIs(ts.Tx(), g.ID, &devices[0].DevEUI, 10)
+			assert.NoError(err)
+			assert.Equal([]lorawan.EUI64{devices[1].DevEUI}, devEUIs)
+
+			devEUIs, err = GetDeviceGroupDevEUIs(ts.Tx(), g.ID, nil, 1)
+			assert.NoError(err)
+			assert.Equal([]lorawan.EUI64{devices[0].DevEUI}, devEUIs)
+		})
+
+		t.Run("Dynamic filters", func(t *testing.T) {
+			assert := require.New(t)
+
+			g.FilterTags = hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"floor": {String: "1", Valid: true},
+				},
+			}
+			g.FilterDeviceProfileID = &dpID
+			assert.NoError(UpdateDeviceGroup(ts.Tx(), &g))
+
+			devEUIs, err := GetDeviceGroupDevEUIs(ts.Tx(), g.ID, nil, 10)
+			assert.NoError(err)
+			assert.Equal([]lorawan.EUI64{devices[0].DevEUI}, devEUIs)
+
+			items, err := GetDevices(ts.Tx(), DeviceFilters{DeviceGroupID: g.ID, Limit: 10})
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal("test-device-1", items[0].Name)
+
+			otherDPID, err := uuid.NewV4()
+			assert.NoError(err)
+			g.FilterDeviceProfileID = &otherDPID
+			assert.NoError(UpdateDeviceGroup(ts.Tx(), &g))
+
+			devEUIs, err = GetDeviceGroupDevEUIs(ts.Tx(), g.ID, nil, 10)
+			assert.NoError(err)
+			assert.Len(devEUIs, 0)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetDeviceGroupCount(ts.Tx(), DeviceGroupFilters{ApplicationID: app.ID})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			groups, err := GetDeviceGroups(ts.Tx(), DeviceGroupFilters{ApplicationID: app.ID, Limit: 10})
+			assert.NoError(err)
+			assert.Len(groups, 1)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteDeviceGroup(ts.Tx(), g.ID))
+			assert.Equal(ErrDoesNotExist, DeleteDeviceGroup(ts.Tx(), g.ID))
+
+			_, err := GetDeviceGroup(ts.Tx(), g.ID, false)
+			assert.Equal(ErrDoesNotExist, err)
+
+			// the devices are not deleted
+			_, err = GetDevice(ts.Tx(), devices[0].DevEUI, false, true)
+			assert.NoError(err)
+		})
+	})
+}
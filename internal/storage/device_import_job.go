@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceImportJobStatus defines the status of a device import job.
+type DeviceImportJobStatus string
+
+// Available device import job statuses.
+const (
+	DeviceImportJobPending   DeviceImportJobStatus = "PENDING"
+	DeviceImportJobRunning   DeviceImportJobStatus = "RUNNING"
+	DeviceImportJobCompleted DeviceImportJobStatus = "COMPLETED"
+)
+
+// DeviceImportJob defines a (validated) device file which is imported in
+// the background.
+type DeviceImportJob struct {
+	ID            uuid.UUID `db:"id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	ApplicationID int64     `db:"application_id"`
+
+	// Records holds the JSON encoded records to import. As these contain
+	// the device keys, they are removed once the job has completed.
+	Records json.RawMessage `db:"records"`
+
+	// Progress. As the records are processed in order, ProcessedCount
+	// holds the index of the next record to import.
+	Status         DeviceImportJobStatus `db:"status"`
+	TotalCount     int                   `db:"total_count"`
+	ProcessedCount int                   `db:"processed_count"`
+	ImportedCount  int                   `db:"imported_count"`
+	CompletedAt    *time.Time            `db:"completed_at"`
+}
+
+// DeviceImportJobError defines the import error of a single row of the
+// device file.
+type DeviceImportJobError struct {
+	DeviceImportJobID uuid.UUID     `db:"device_import_job_id"`
+	Row               int           `db:"file_row"`
+	DevEUI            lorawan.EUI64 `db:"dev_eui"`
+	Error             string        `db:"error"`
+}
+
+// CreateDeviceImportJob creates the given device import job. The job will
+// be created in the pending state.
+func CreateDeviceImportJob(db sqlx.Execer, j *DeviceImportJob) error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	now := time.Now()
+	j.ID = id
+	j.CreatedAt = now
+	j.UpdatedAt = now
+	j.Status = DeviceImportJobPending
+
+	_, err = db.Exec(`
+		insert into device_import_job (
+			id,
+			created_at,
+			updated_at,
+			application_id,
+			records,
+			status,
+			total_count,
+			processed_count,
+			imported_count,
+			completed_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		j.ID,
+		j.CreatedAt,
+		j.UpdatedAt,
+		j.ApplicationID,
+		[]byte(j.Records),
+		j.Status,
+		j.TotalCount,
+		j.ProcessedCount,
+		j.ImportedCount,
+		j.CompletedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":             j.ID,
+		"application_id": j.ApplicationID,
+		"total_count":    j.TotalCount,
+	}).Info("device import job created")
+
+	return nil
+}
+
+// GetDeviceImportJob returns the device import job for the given id.
+// When forUpdate is set to true, the row will be locked.
+func GetDeviceImportJob(db sqlx.Queryer, id uuid.UUID, forUpdate bool) (DeviceImportJob, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var j DeviceImportJob
+	err := sqlx.Get(db, &j, "select * from device_import_job where id = $1"+fu, id)
+	if err != nil {
+		return j, handlePSQLError(Select, err, "select error")
+	}
+
+	return j, nil
+}
+
+// GetPendingDeviceImportJob returns the oldest device import job which has
+// not yet completed, or nil when there is no such job. The returned job is
+// locked, jobs locked by other transactions are skipped.
+func GetPendingDeviceImportJob(db sqlx.Queryer) (*DeviceImportJob, error) {
+	var j DeviceImportJob
+	err := sqlx.Get(db, &j, `
+		select
+			*
+		from device_import_job
+		where
+			status != $1
+		order by
+			created_at
+		limit 1
+		for update skip locked`,
+		DeviceImportJobCompleted,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return &j, nil
+}
+
+// UpdateDeviceImportJob updates the progress of the given device import
+// job. The records are removed when the job has completed.
+func UpdateDeviceImportJob(db sqlx.Execer, j *DeviceImportJob) error {
+	j.UpdatedAt = time.Now()
+	if j.Status == DeviceImportJobCompleted {
+		j.Records = nil
+	}
+
+	res, err := db.Exec(`
+		update device_import_job
+		set
+			updated_at = $2,
+			records = $3,
+			status = $4,
+			processed_count = $5,
+			imported_count = $6,
+			completed_at = $7
+		where
+			id = $1`,
+		j.ID,
+		j.UpdatedAt,
+		[]byte(j.Records),
+		j.Status,
+		j.ProcessedCount,
+		j.ImportedCount,
+		j.CompletedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// CreateDeviceImportJobError creates the given device import job error.
+func CreateDeviceImportJobError(db sqlx.Execer, e DeviceImportJobError) error {
+	_, err := db.Exec(`
+		insert into device_import_job_error (
+			device_import_job_id,
+			file_row,
+			dev_eui,
+			error
+		) values ($1, $2, $3, $4)`,
+		e.DeviceImportJobID,
+		e.Row,
+		e.DevEUI[:],
+		e.Error,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeviceImportJobErrors returns the errors of the given device import
+// job, ordered by row.
+func GetDeviceImportJobErrors(db sqlx.Queryer, id uuid.UUID) ([]DeviceImportJobError, error) {
+	var errs []DeviceImportJobError
+	err := sqlx.Select(db, &errs, `
+		select
+			*
+		from device_import_job_error
+		where
+			device_import_job_id = $1
+		order by
+			file_row`,
+		id,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return errs, nil
+}
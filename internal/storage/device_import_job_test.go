@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceImportJob() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		j := DeviceImportJob{
+			ApplicationID: app.ID,
+			Records:       []byte(`[{"devEUI":"0102030405060708"}]`),
+			TotalCount:    1,
+		}
+		assert.NoError(CreateDeviceImportJob(ts.Tx(), &j))
+		assert.Equal(DeviceImportJobPending, j.Status)
+
+		j.CreatedAt = j.CreatedAt.Round(time.Second).UTC()
+		j.UpdatedAt = j.UpdatedAt.Round(time.Second).UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			jGet, err := GetDeviceImportJob(ts.Tx(), j.ID, false)
+			assert.NoError(err)
+
+			jGet.CreatedAt = jGet.CreatedAt.Round(time.Second).UTC()
+			jGet.UpdatedAt = jGet.UpdatedAt.Round(time.Second).UTC()
+			assert.Equal(j.ID, jGet.ID)
+			assert.Equal(j.CreatedAt, jGet.CreatedAt)
+			assert.Equal(j.ApplicationID, jGet.ApplicationID)
+			assert.JSONEq(string(j.Records), string(jGet.Records))
+			assert.Equal(1, jGet.TotalCount)
+		})
+
+		t.Run("Get pending", func(t *testing.T) {
+			assert := require.New(t)
+
+			jPending, err := GetPendingDeviceImportJob(ts.Tx())
+			assert.NoError(err)
+			assert.NotNil(jPending)
+			assert.Equal(j.ID, jPending.ID)
+		})
+
+		t.Run("Errors", func(t *testing.T) {
+			assert := require.New(t)
+
+			e := DeviceImportJobError{
+				DeviceImportJobID: j.ID,
+				Row:               1,
+				DevEUI:            lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Error:             "test error",
+			}
+			assert.NoError(CreateDeviceImportJobError(ts.Tx(), e))
+
+			errs, err := GetDeviceImportJobErrors(ts.Tx(), j.ID)
+			assert.NoError(err)
+			assert.Equal([]DeviceImportJobError{e}, errs)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			now := time.Now().Round(time.Second).UTC()
+			j.Status = DeviceImportJobCompleted
+			j.ProcessedCount = 1
+			j.CompletedAt = &now
+			assert.NoError(UpdateDeviceImportJob(ts.Tx(), &j))
+
+			jGet, err := GetDeviceImportJob(ts.Tx(), j.ID, false)
+			assert.NoError(err)
+			assert.Equal(DeviceImportJobCompleted, jGet.Status)
+			assert.Equal(1, jGet.ProcessedCount)
+			assert.Equal(0, jGet.ImportedCount)
+			assert.True(jGet.CompletedAt.Equal(now))
+
+			// the records are removed on completion
+			assert.Nil(jGet.Records)
+
+			t.Run("No pending job", func(t *testing.T) {
+				assert := require.New(t)
+
+				jPending, err := GetPendingDeviceImportJob(ts.Tx())
+				assert.NoError(err)
+				assert.Nil(jPending)
+			})
+		})
+
+		t.Run("Delete application", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteApplication(ts.Tx(), app.ID))
+
+			_, err := GetDeviceImportJob(ts.Tx(), j.ID, false)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+	})
+}
@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceLocation defines a (resolved) location of a device.
+type DeviceLocation struct {
+	ID        int64         `db:"id"`
+	CreatedAt time.Time     `db:"created_at"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	Latitude  float64       `db:"latitude"`
+	Longitude float64       `db:"longitude"`
+	Altitude  float64       `db:"altitude"`
+
+	// Source holds the location source (e.g. GEO_RESOLVER, GPS or CONFIG),
+	// Accuracy the accuracy in meters (0 = unknown).
+	Source   string `db:"source"`
+	Accuracy int    `db:"accuracy"`
+}
+
+// DeviceLocationFilters provides filters that can be used to filter on
+// device locations. Note that empty values are not used as filter.
+type DeviceLocationFilters struct {
+	DevEUI lorawan.EUI64 `db:"dev_eui"`
+	Start  *time.Time    `db:"start"`
+	End    *time.Time    `db:"end"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f DeviceLocationFilters) SQL() string {
+	var filters []string
+
+	if f.DevEUI != (lorawan.EUI64{}) {
+		filters = append(filters, "dev_eui = :dev_eui")
+	}
+
+	if f.Start != nil {
+		filters = append(filters, "created_at >= :start")
+	}
+
+	if f.End != nil {
+		filters = append(filters, "created_at < :end")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// CreateDeviceLocation creates the given device location.
+func CreateDeviceLocation(db sqlx.Queryer, l *DeviceLocation) error {
+	l.CreatedAt = time.Now()
+
+	err := sqlx.Get(db, &l.ID, `
+		insert into device_location (
+			created_at,
+			dev_eui,
+			latitude,
+			longitude,
+			altitude,
+			source,
+			accuracy
+		) values ($1, $2, $3, $4, $5, $6, $7)
+		returning id`,
+		l.CreatedAt,
+		l.DevEUI[:],
+		l.Latitude,
+		l.Longitude,
+		l.Altitude,
+		l.Source,
+		l.Accuracy,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// SetDeviceLocation sets the given location as the latest location of the
+// device and stores it in the location history of the device.
+func SetDeviceLocation(db sqlx.Ext, l *DeviceLocation) error {
+	res, err := db.Exec(`
+		update device
+		set
+			latitude = $2,
+			longitude = $3,
+			altitude = $4
+		where
+			dev_eui = $1`,
+		l.DevEUI[:],
+		l.Latitude,
+		l.Longitude,
+		l.Altitude,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return CreateDeviceLocation(db, l)
+}
+
+// GetLastDeviceLocation returns the most recent location stored for the
+// given DevEUI.
+func GetLastDeviceLocation(db sqlx.Queryer, devEUI lorawan.EUI64) (DeviceLocation, error) {
+	var l DeviceLocation
+	err := sqlx.Get(db, &l, `
+		select
+			*
+		from device_location
+		where
+			dev_eui = $1
+		order by
+			created_at desc,
+			id desc
+		limit 1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return l, handlePSQLError(Select, err, "select error")
+	}
+
+	return l, nil
+}
+
+// GetDeviceLocationCount returns the number of device locations matching
+// the given filters.
+func GetDeviceLocationCount(db sqlx.Queryer, filters DeviceLocationFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from device_location
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceLocations returns a slice of device locations matching the given
+// filters, ordered by time (oldest first).
+func GetDeviceLocations(db sqlx.Queryer, filters DeviceLocationFilters) ([]DeviceLocation, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from device_location
+		`+filters.SQL()+`
+		order by
+			created_at,
+			id
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var locations []DeviceLocation
+	err = sqlx.Select(db, &locations, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return locations, nil
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceLocation() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	locations := []DeviceLocation{
+		{DevEUI: d.DevEUI, Latitude: 1.1, Longitude: 2.1, Altitude: 3.1, Source: "GEO_RESOLVER", Accuracy: 10},
+		{DevEUI: d.DevEUI, Latitude: 1.2, Longitude: 2.2, Altitude: 3.2, Source: "GPS"},
+		{DevEUI: d.DevEUI, Latitude: 1.3, Longitude: 2.3, Altitude: 3.3, Source: "GPS"},
+	}
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		for i := range locations {
+			assert.NoError(CreateDeviceLocation(ts.Tx(), &locations[i]))
+			assert.NotEqual(0, locations[i].ID)
+		}
+
+		t.Run("Get locations", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetDeviceLocationCount(ts.Tx(), DeviceLocationFilters{DevEUI: d.DevEUI})
+			assert.NoError(err)
+			assert.Equal(3, count)
+
+			items, err := GetDeviceLocations(ts.Tx(), DeviceLocationFilters{DevEUI: d.DevEUI, Limit: 10})
+			assert.NoError(err)
+			assert.Len(items, 3)
+
+			for i := range items {
+				assert.Equal(locations[i].ID, items[i].ID)
+				assert.Equal(locations[i].Latitude, items[i].Latitude)
+				assert.Equal(locations[i].Longitude, items[i].Longitude)
+				assert.Equal(locations[i].Altitude, items[i].Altitude)
+				assert.Equal(locations[i].Source, items[i].Source)
+				assert.Equal(locations[i].Accuracy, items[i].Accuracy)
+			}
+		})
+
+		t.Run("Filter on time range", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := ts.Tx().Exec("update device_location set created_at = created_at - interval '2 days' where id = $1", locations[0].ID)
+			assert.NoError(err)
+
+			start := time.Now().Add(-24 * time.Hour)
+			items, err := GetDeviceLocations(ts.Tx(), DeviceLocationFilters{
+				DevEUI: d.DevEUI,
+				Start:  &start,
+				Limit:  10,
+			})
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.Equal(locations[1].ID, items[0].ID)
+
+			items, err = GetDeviceLocations(ts.Tx(), DeviceLocationFilters{
+				DevEUI: d.DevEUI,
+				End:    &start,
+				Limit:  10,
+			})
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(locations[0].ID, items[0].ID)
+		})
+
+		t.Run("Paginate", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetDeviceLocations(ts.Tx(), DeviceLocationFilters{
+				DevEUI: d.DevEUI,
+				Limit:  1,
+				Offset: 1,
+			})
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(locations[1].ID, items[0].ID)
+		})
+
+		t.Run("Get last location", func(t *testing.T) {
+			assert := require.New(t)
+
+			l, err := GetLastDeviceLocation(ts.Tx(), d.DevEUI)
+			assert.NoError(err)
+			assert.Equal(locations[2].ID, l.ID)
+		})
+	})
+
+	ts.T().Run("Set location", func(t *testing.T) {
+		assert := require.New(t)
+
+		l := DeviceLocation{
+			DevEUI:    d.DevEUI,
+			Latitude:  1.4,
+			Longitude: 2.4,
+			Altitude:  3.4,
+			Source:    "CONFIG",
+		}
+		assert.NoError(SetDeviceLocation(ts.Tx(), &l))
+		assert.NotEqual(0, l.ID)
+
+		d2, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+		assert.NoError(err)
+		assert.Equal(1.4, *d2.Latitude)
+		assert.Equal(2.4, *d2.Longitude)
+		assert.Equal(3.4, *d2.Altitude)
+
+		last, err := GetLastDeviceLocation(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.Equal(l.ID, last.ID)
+		assert.Equal("CONFIG", last.Source)
+
+		l.DevEUI = lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+		assert.Equal(ErrDoesNotExist, SetDeviceLocation(ts.Tx(), &l))
+
+		_, err = GetLastDeviceLocation(ts.Tx(), l.DevEUI)
+		assert.Equal(ErrDoesNotExist, err)
+	})
+}
@@ -11,18 +11,22 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/loraserver/api/ns"
 )
 
 // DeviceProfile defines the device-profile.
 type DeviceProfile struct {
-	NetworkServerID int64            `db:"network_server_id"`
-	OrganizationID  int64            `db:"organization_id"`
-	CreatedAt       time.Time        `db:"created_at"`
-	UpdatedAt       time.Time        `db:"updated_at"`
-	Name            string           `db:"name"`
-	DeviceProfile   ns.DeviceProfile `db:"-"`
+	NetworkServerID      int64            `db:"network_server_id"`
+	OrganizationID       int64            `db:"organization_id"`
+	CreatedAt            time.Time        `db:"created_at"`
+	UpdatedAt            time.Time        `db:"updated_at"`
+	Name                 string           `db:"name"`
+	PayloadCodec         codec.Type       `db:"payload_codec"`
+	PayloadEncoderScript string           `db:"payload_encoder_script"`
+	PayloadDecoderScript string           `db:"payload_decoder_script"`
+	DeviceProfile        ns.DeviceProfile `db:"-"`
 }
 
 // DeviceProfileMeta defines the device-profile meta record.
@@ -68,14 +72,20 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             organization_id,
             created_at,
             updated_at,
-            name
-		) values ($1, $2, $3, $4, $5, $6)`,
+            name,
+            payload_codec,
+            payload_encoder_script,
+            payload_decoder_script
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
 		dpID,
 		dp.NetworkServerID,
 		dp.OrganizationID,
 		dp.CreatedAt,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.PayloadCodec,
+		dp.PayloadEncoderScript,
+		dp.PayloadDecoderScript,
 	)
 	if err != nil {
 		log.WithField("id", dpID).Errorf("create device-profile error: %s", err)
@@ -108,6 +118,39 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 
 // GetDeviceProfile returns the device-profile matching the given id.
 func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
+	dp, err := GetLocalDeviceProfile(db, id)
+	if err != nil {
+		return dp, err
+	}
+
+	n, err := GetNetworkServer(db, dp.NetworkServerID)
+	if err != nil {
+		return dp, errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return dp, errors.Wrap(err, "get network-server client error")
+	}
+
+	resp, err := nsClient.GetDeviceProfile(context.Background(), &ns.GetDeviceProfileRequest{
+		Id: id.Bytes(),
+	})
+	if err != nil {
+		return dp, handleGrpcError(err, "get device-profile error")
+	}
+	if resp.DeviceProfile == nil {
+		return dp, errors.New("device_profile must not be nil")
+	}
+
+	dp.DeviceProfile = *resp.DeviceProfile
+
+	return dp, nil
+}
+
+// GetLocalDeviceProfile returns the device-profile matching the given id,
+// without fetching the network-server side of the device-profile.
+func GetLocalDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 	var dp DeviceProfile
 
 	row := db.QueryRowx(`
@@ -116,7 +159,10 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			payload_codec,
+			payload_encoder_script,
+			payload_decoder_script
 		from device_profile
 		where
 			device_profile_id = $1`,
@@ -126,34 +172,49 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 		return dp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name)
+	err := row.Scan(&dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name, &dp.PayloadCodec, &dp.PayloadEncoderScript, &dp.PayloadDecoderScript)
 	if err != nil {
 		return dp, handlePSQLError(Scan, err, "scan error")
 	}
 
-	n, err := GetNetworkServer(db, dp.NetworkServerID)
-	if err != nil {
-		return dp, errors.Wrap(err, "get network-server error")
-	}
+	dp.DeviceProfile.Id = id.Bytes()
 
-	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	return dp, nil
+}
+
+// GetPayloadCodecForDevice returns the payload codec, encoder and decoder
+// script to use for the given device. The codec configured on the
+// device-profile takes precedence, the codec of the application is used
+// as fallback. When the application references a shared codec, the codec
+// configuration of this shared codec is used (or of the pinned version of
+// this codec).
+func GetPayloadCodecForDevice(db sqlx.Queryer, d Device, app Application) (codec.Type, string, string, error) {
+	dp, err := GetLocalDeviceProfile(db, d.DeviceProfileID)
 	if err != nil {
-		return dp, errors.Wrap(err, "get network-server client error")
+		return "", "", "", errors.Wrap(err, "get device-profile error")
 	}
 
-	resp, err := nsClient.GetDeviceProfile(context.Background(), &ns.GetDeviceProfileRequest{
-		Id: id.Bytes(),
-	})
-	if err != nil {
-		return dp, handleGrpcError(err, "get device-profile error")
+	if dp.PayloadCodec != "" {
+		return dp.PayloadCodec, dp.PayloadEncoderScript, dp.PayloadDecoderScript, nil
 	}
-	if resp.DeviceProfile == nil {
-		return dp, errors.New("device_profile must not be nil")
+
+	if app.CodecID != nil && app.CodecVersion != nil {
+		v, err := GetCodecVersion(db, *app.CodecID, *app.CodecVersion)
+		if err != nil {
+			return "", "", "", errors.Wrap(err, "get codec version error")
+		}
+		return v.PayloadCodec, v.PayloadEncoderScript, v.PayloadDecoderScript, nil
 	}
 
-	dp.DeviceProfile = *resp.DeviceProfile
+	if app.CodecID != nil {
+		c, err := GetCodec(db, *app.CodecID)
+		if err != nil {
+			return "", "", "", errors.Wrap(err, "get codec error")
+		}
+		return c.PayloadCodec, c.PayloadEncoderScript, c.PayloadDecoderScript, nil
+	}
 
-	return dp, nil
+	return app.PayloadCodec, app.PayloadEncoderScript, app.PayloadDecoderScript, nil
 }
 
 // UpdateDeviceProfile updates the given device-profile.
@@ -190,11 +251,17 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
         update device_profile
         set
             updated_at = $2,
-            name = $3
+            name = $3,
+            payload_codec = $4,
+            payload_encoder_script = $5,
+            payload_decoder_script = $6
 		where device_profile_id = $1`,
 		dpID,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.PayloadCodec,
+		dp.PayloadEncoderScript,
+		dp.PayloadDecoderScript,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -326,7 +393,13 @@ func GetDeviceProfileCountForApplicationID(db sqlx.Queryer, applicationID int64)
 func GetDeviceProfiles(db sqlx.Queryer, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
-		select *
+		select
+			device_profile_id,
+			network_server_id,
+			organization_id,
+			created_at,
+			updated_at,
+			name
 		from device_profile
 		order by name
 		limit $1 offset $2`,
@@ -344,7 +417,13 @@ func GetDeviceProfiles(db sqlx.Queryer, limit, offset int) ([]DeviceProfileMeta,
 func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
-		select *
+		select
+			device_profile_id,
+			network_server_id,
+			organization_id,
+			created_at,
+			updated_at,
+			name
 		from device_profile
 		where
 			organization_id = $1
@@ -365,7 +444,13 @@ func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, l
 func GetDeviceProfilesForUser(db sqlx.Queryer, username string, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
-		select dp.*
+		select
+			dp.device_profile_id,
+			dp.network_server_id,
+			dp.organization_id,
+			dp.created_at,
+			dp.updated_at,
+			dp.name
 		from device_profile dp
 		inner join organization o
 			on o.id = dp.organization_id
@@ -395,7 +480,12 @@ func GetDeviceProfilesForApplicationID(db sqlx.Queryer, applicationID int64, lim
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
 		select
-			dp.*
+			dp.device_profile_id,
+			dp.network_server_id,
+			dp.organization_id,
+			dp.created_at,
+			dp.updated_at,
+			dp.name
 		from device_profile dp
 		inner join network_server ns
 			on ns.id = dp.network_server_id
@@ -422,7 +512,7 @@ func GetDeviceProfilesForApplicationID(db sqlx.Queryer, applicationID int64, lim
 // given an organization id.
 func DeleteAllDeviceProfilesForOrganizationID(db sqlx.Ext, organizationID int64) error {
 	var dps []DeviceProfileMeta
-	err := sqlx.Select(db, &dps, "select * from device_profile where organization_id = $1", organizationID)
+	err := sqlx.Select(db, &dps, "select device_profile_id from device_profile where organization_id = $1", organizationID)
 	if err != nil {
 		return handlePSQLError(Select, err, "select error")
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/ns"
@@ -70,9 +71,12 @@ func (ts *StorageTestSuite) TestDeviceProfile() {
 		assert := require.New(t)
 
 		dp := DeviceProfile{
-			NetworkServerID: n.ID,
-			OrganizationID:  org.ID,
-			Name:            "device-profile",
+			NetworkServerID:      n.ID,
+			OrganizationID:       org.ID,
+			Name:                 "device-profile",
+			PayloadCodec:         codec.CustomJSType,
+			PayloadEncoderScript: "Encode() {}",
+			PayloadDecoderScript: "Decode() {}",
 			DeviceProfile: ns.DeviceProfile{
 				SupportsClassB:     true,
 				ClassBTimeout:      10,
@@ -119,6 +123,54 @@ func (ts *StorageTestSuite) TestDeviceProfile() {
 			assert.Equal(dp, dpGet)
 		})
 
+		t.Run("GetLocalDeviceProfile", func(t *testing.T) {
+			assert := require.New(t)
+
+			dpGet, err := GetLocalDeviceProfile(ts.Tx(), dpID)
+			assert.NoError(err)
+			assert.Equal(dp.Name, dpGet.Name)
+			assert.Equal(dp.PayloadCodec, dpGet.PayloadCodec)
+			assert.Equal(dp.DeviceProfile.Id, dpGet.DeviceProfile.Id)
+			assert.False(dpGet.DeviceProfile.SupportsJoin)
+		})
+
+		t.Run("GetPayloadCodecForDevice", func(t *testing.T) {
+			assert := require.New(t)
+
+			d := Device{
+				DeviceProfileID: dpID,
+			}
+			app := Application{
+				PayloadCodec:         codec.CayenneLPPType,
+				PayloadEncoderScript: "app encoder",
+				PayloadDecoderScript: "app decoder",
+			}
+
+			payloadCodec, encoderScript, decoderScript, err := GetPayloadCodecForDevice(ts.Tx(), d, app)
+			assert.NoError(err)
+			assert.Equal(codec.CustomJSType, payloadCodec)
+			assert.Equal("Encode() {}", encoderScript)
+			assert.Equal("Decode() {}", decoderScript)
+
+			t.Run("Application fallback", func(t *testing.T) {
+				assert := require.New(t)
+
+				dp2 := dp
+				dp2.PayloadCodec = ""
+				assert.NoError(UpdateDeviceProfile(ts.Tx(), &dp2))
+				<-nsClient.UpdateDeviceProfileChan
+
+				payloadCodec, encoderScript, decoderScript, err := GetPayloadCodecForDevice(ts.Tx(), d, app)
+				assert.NoError(err)
+				assert.Equal(codec.CayenneLPPType, payloadCodec)
+				assert.Equal("app encoder", encoderScript)
+				assert.Equal("app decoder", decoderScript)
+
+				assert.NoError(UpdateDeviceProfile(ts.Tx(), &dp))
+				<-nsClient.UpdateDeviceProfileChan
+			})
+		})
+
 		t.Run("GetDeviceProfiles", func(t *testing.T) {
 			assert := require.New(t)
 
@@ -0,0 +1,222 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceStatsInterval defines the aggregation interval of the device stats.
+type DeviceStatsInterval string
+
+// Available device stats intervals.
+const (
+	DeviceStatsIntervalHour DeviceStatsInterval = "HOUR"
+	DeviceStatsIntervalDay  DeviceStatsInterval = "DAY"
+)
+
+// DeviceStatsIntervals contains all the device stats intervals.
+var DeviceStatsIntervals = []DeviceStatsInterval{
+	DeviceStatsIntervalHour,
+	DeviceStatsIntervalDay,
+}
+
+// DeviceStats defines the aggregated uplink statistics of a device for a
+// single interval.
+type DeviceStats struct {
+	DevEUI    lorawan.EUI64       `db:"dev_eui"`
+	Interval  DeviceStatsInterval `db:"interval"`
+	Timestamp time.Time           `db:"timestamp"`
+
+	// RXPackets holds the number of received uplinks, LostPackets the number
+	// of uplinks missed based on the gaps in the frame-counter. FCntLast
+	// holds the last received frame-counter.
+	RXPackets   int   `db:"rx_packets"`
+	LostPackets int   `db:"lost_packets"`
+	FCntLast    int64 `db:"f_cnt_last"`
+
+	// The RSSI and SNR values are those of the gateway with the best
+	// reception of each uplink.
+	RSSIMax int     `db:"rssi_max"`
+	RSSISum int64   `db:"rssi_sum"`
+	SNRMax  float64 `db:"snr_max"`
+	SNRSum  float64 `db:"snr_sum"`
+
+	// RXPacketsPerSF holds the number of uplinks per spreading-factor,
+	// RXPacketsPerGateway the number of uplinks per gateway ID.
+	RXPacketsPerSF      hstore.Hstore `db:"rx_packets_per_sf"`
+	RXPacketsPerGateway hstore.Hstore `db:"rx_packets_per_gateway"`
+}
+
+// CreateDeviceStats creates the given device stats.
+func CreateDeviceStats(db sqlx.Execer, s *DeviceStats) error {
+	_, err := db.Exec(`
+		insert into device_stats (
+			dev_eui,
+			interval,
+			timestamp,
+			rx_packets,
+			lost_packets,
+			f_cnt_last,
+			rssi_max,
+			rssi_sum,
+			snr_max,
+			snr_sum,
+			rx_packets_per_sf,
+			rx_packets_per_gateway
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		s.DevEUI[:],
+		s.Interval,
+		s.Timestamp,
+		s.RXPackets,
+		s.LostPackets,
+		s.FCntLast,
+		s.RSSIMax,
+		s.RSSISum,
+		s.SNRMax,
+		s.SNRSum,
+		s.RXPacketsPerSF,
+		s.RXPacketsPerGateway,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetLastDeviceStats returns the most recent device stats for the given
+// DevEUI and interval.
+func GetLastDeviceStats(db sqlx.Queryer, devEUI lorawan.EUI64, interval DeviceStatsInterval, forUpdate bool) (DeviceStats, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var s DeviceStats
+	err := sqlx.Get(db, &s, `
+		select
+			*
+		from device_stats
+		where
+			dev_eui = $1
+			and interval = $2
+		order by
+			timestamp desc
+		limit 1`+fu,
+		devEUI[:],
+		interval,
+	)
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+
+	return s, nil
+}
+
+// GetDeviceStats returns the device stats for the given DevEUI and interval,
+// with a timestamp within the given time-range (end exclusive).
+func GetDeviceStats(db sqlx.Queryer, devEUI lorawan.EUI64, interval DeviceStatsInterval, start, end time.Time) ([]DeviceStats, error) {
+	var stats []DeviceStats
+	err := sqlx.Select(db, &stats, `
+		select
+			*
+		from device_stats
+		where
+			dev_eui = $1
+			and interval = $2
+			and timestamp >= $3
+			and timestamp < $4
+		order by
+			timestamp`,
+		devEUI[:],
+		interval,
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return stats, nil
+}
+
+// UpdateDeviceStats updates the given device stats.
+func UpdateDeviceStats(db sqlx.Execer, s *DeviceStats) error {
+	res, err := db.Exec(`
+		update device_stats
+		set
+			rx_packets = $4,
+			lost_packets = $5,
+			f_cnt_last = $6,
+			rssi_max = $7,
+			rssi_sum = $8,
+			snr_max = $9,
+			snr_sum = $10,
+			rx_packets_per_sf = $11,
+			rx_packets_per_gateway = $12
+		where
+			dev_eui = $1
+			and interval = $2
+			and timestamp = $3`,
+		s.DevEUI[:],
+		s.Interval,
+		s.Timestamp,
+		s.RXPackets,
+		s.LostPackets,
+		s.FCntLast,
+		s.RSSIMax,
+		s.RSSISum,
+		s.SNRMax,
+		s.SNRSum,
+		s.RXPacketsPerSF,
+		s.RXPacketsPerGateway,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// DeleteExpiredDeviceStats deletes the device stats of the given interval
+// with a timestamp older than the given retention. It returns the number of
+// deleted device stats.
+func DeleteExpiredDeviceStats(db sqlx.Execer, interval DeviceStatsInterval, retention time.Duration) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_stats
+		where
+			interval = $1
+			and timestamp < $2`,
+		interval,
+		time.Now().Add(-retention),
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "get rows affected error")
+	}
+
+	if ra != 0 {
+		log.WithFields(log.Fields{
+			"interval": interval,
+			"count":    ra,
+		}).Info("expired device stats deleted")
+	}
+
+	return ra, nil
+}
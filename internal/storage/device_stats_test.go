@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceStats() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	hour := time.Now().UTC().Truncate(time.Hour)
+
+	ts.T().Run("Get last non-existing", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := GetLastDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalHour, false)
+		assert.Equal(ErrDoesNotExist, err)
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		stats := []DeviceStats{
+			{
+				DevEUI:      d.DevEUI,
+				Interval:    DeviceStatsIntervalHour,
+				Timestamp:   hour.Add(-time.Hour),
+				RXPackets:   2,
+				LostPackets: 1,
+				FCntLast:    10,
+				RSSIMax:     -80,
+				RSSISum:     -170,
+				SNRMax:      7,
+				SNRSum:      12,
+				RXPacketsPerSF: hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"7": sql.NullString{Valid: true, String: "2"},
+					},
+				},
+				RXPacketsPerGateway: hstore.Hstore{
+					Map: map[string]sql.NullString{
+						"0101010101010101": sql.NullString{Valid: true, String: "2"},
+					},
+				},
+			},
+			{
+				DevEUI:    d.DevEUI,
+				Interval:  DeviceStatsIntervalHour,
+				Timestamp: hour,
+				RXPackets: 1,
+				FCntLast:  11,
+			},
+			{
+				DevEUI:    d.DevEUI,
+				Interval:  DeviceStatsIntervalDay,
+				Timestamp: hour.Truncate(24 * time.Hour),
+				RXPackets: 3,
+				FCntLast:  11,
+			},
+		}
+
+		for i := range stats {
+			assert.NoError(CreateDeviceStats(ts.Tx(), &stats[i]))
+		}
+
+		t.Run("Get last", func(t *testing.T) {
+			assert := require.New(t)
+
+			s, err := GetLastDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalHour, true)
+			assert.NoError(err)
+			assert.True(s.Timestamp.Equal(hour))
+			assert.Equal(1, s.RXPackets)
+		})
+
+		t.Run("Get for time-range", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalHour, hour.Add(-24*time.Hour), hour.Add(time.Hour))
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.True(items[0].Timestamp.Equal(stats[0].Timestamp))
+			assert.Equal(stats[0].RXPacketsPerSF, items[0].RXPacketsPerSF)
+			assert.Equal(stats[0].RXPacketsPerGateway, items[0].RXPacketsPerGateway)
+
+			items, err = GetDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalHour, hour, hour.Add(time.Hour))
+			assert.NoError(err)
+			assert.Len(items, 1)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			stats[1].RXPackets = 2
+			stats[1].LostPackets = 3
+			stats[1].FCntLast = 15
+			assert.NoError(UpdateDeviceStats(ts.Tx(), &stats[1]))
+
+			s, err := GetLastDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalHour, false)
+			assert.NoError(err)
+			assert.Equal(2, s.RXPackets)
+			assert.Equal(3, s.LostPackets)
+			assert.EqualValues(15, s.FCntLast)
+		})
+
+		t.Run("Delete expired", func(t *testing.T) {
+			assert := require.New(t)
+
+			// this expires the stats before hour - 30 minutes
+			count, err := DeleteExpiredDeviceStats(ts.Tx(), DeviceStatsIntervalHour, time.Since(hour)+30*time.Minute)
+			assert.NoError(err)
+			assert.EqualValues(1, count)
+
+			items, err := GetDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalHour, hour.Add(-24*time.Hour), hour.Add(time.Hour))
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.True(items[0].Timestamp.Equal(hour))
+
+			items, err = GetDeviceStats(ts.Tx(), d.DevEUI, DeviceStatsIntervalDay, hour.Add(-48*time.Hour), hour.Add(24*time.Hour))
+			assert.NoError(err)
+			assert.Len(items, 1)
+		})
+	})
+}
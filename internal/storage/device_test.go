@@ -1,10 +1,13 @@
 package storage
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/brocaar/lora-app-server/internal/config"
@@ -116,10 +119,26 @@ func (ts *StorageTestSuite) TestDevice() {
 			DeviceStatusMargin:  &eleven,
 			SkipFCntCheck:       true,
 			ReferenceAltitude:   5.6,
+			Variables: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"foo": sql.NullString{String: "bar", Valid: true},
+				},
+			},
+			SecretVariables: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"token": sql.NullString{String: "secret", Valid: true},
+				},
+			},
+			Tags: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"building": sql.NullString{String: "b-42", Valid: true},
+				},
+			},
 		}
 		assert.NoError(CreateDevice(ts.Tx(), &d))
 		d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Millisecond)
 		d.UpdatedAt = d.UpdatedAt.UTC().Truncate(time.Millisecond)
+		assert.Equal(map[string]string{"foo": "bar"}, d.VariablesMap())
 
 		createReq := <-nsClient.CreateDeviceChan
 		assert.Equal(ns.CreateDeviceRequest{
@@ -157,6 +176,27 @@ func (ts *StorageTestSuite) TestDevice() {
 			assert.Equal(1, count)
 		})
 
+		t.Run("List by tag search", func(t *testing.T) {
+			assert := require.New(t)
+
+			devices, err := GetDevices(ts.Tx(), DeviceFilters{Limit: 10, Search: "b-42"})
+			assert.NoError(err)
+			assert.Len(devices, 1)
+
+			count, err := GetDeviceCount(ts.Tx(), DeviceFilters{Search: "b-43"})
+			assert.NoError(err)
+			assert.Equal(0, count)
+
+			count, err = GetDeviceCount(ts.Tx(), DeviceFilters{Search: "building"})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			// the hstore text representation must not be matched
+			count, err = GetDeviceCount(ts.Tx(), DeviceFilters{Search: "=>"})
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+
 		t.Run("Get", func(t *testing.T) {
 			nsClient.GetDeviceResponse = ns.GetDeviceResponse{
 				Device: createReq.Device,
@@ -191,6 +231,8 @@ func (ts *StorageTestSuite) TestDevice() {
 			d.Latitude = &lat
 			d.Longitude = &long
 			d.Altitude = &alt
+			d.Variables.Map["calibration"] = sql.NullString{String: "1.5", Valid: true}
+			d.Tags.Map["floor"] = sql.NullString{String: "3", Valid: true}
 
 			assert.NoError(UpdateDevice(ts.Tx(), &d, false))
 			d.UpdatedAt = d.UpdatedAt.UTC().Truncate(time.Millisecond)
@@ -315,3 +357,151 @@ func (ts *StorageTestSuite) TestDevice() {
 		})
 	})
 }
+
+func (ts *StorageTestSuite) TestMoveDevice() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	networkServers := []NetworkServer{
+		{Name: "test-ns-1", Server: "test-ns-1:1234"},
+		{Name: "test-ns-2", Server: "test-ns-2:1234"},
+	}
+	for i := range networkServers {
+		assert.NoError(CreateNetworkServer(ts.Tx(), &networkServers[i]))
+	}
+
+	orgs := []Organization{
+		{Name: "test-org-1"},
+		{Name: "test-org-2"},
+	}
+	for i := range orgs {
+		assert.NoError(CreateOrganization(ts.Tx(), &orgs[i]))
+	}
+
+	// org 1 on ns 1, org 2 on ns 1 and org 2 on ns 2
+	sps := []ServiceProfile{
+		{Name: "test-sp-1", OrganizationID: orgs[0].ID, NetworkServerID: networkServers[0].ID},
+		{Name: "test-sp-2", OrganizationID: orgs[1].ID, NetworkServerID: networkServers[0].ID},
+		{Name: "test-sp-3", OrganizationID: orgs[1].ID, NetworkServerID: networkServers[1].ID},
+	}
+	spIDs := make([]uuid.UUID, len(sps))
+	for i := range sps {
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sps[i]))
+		copy(spIDs[i][:], sps[i].ServiceProfile.Id)
+	}
+
+	dps := []DeviceProfile{
+		{Name: "test-dp-1", OrganizationID: orgs[0].ID, NetworkServerID: networkServers[0].ID},
+		{Name: "test-dp-2", OrganizationID: orgs[1].ID, NetworkServerID: networkServers[0].ID},
+	}
+	dpIDs := make([]uuid.UUID, len(dps))
+	for i := range dps {
+		assert.NoError(CreateDeviceProfile(ts.Tx(), &dps[i]))
+		copy(dpIDs[i][:], dps[i].DeviceProfile.Id)
+	}
+
+	apps := []Application{
+		{Name: "test-app-1", OrganizationID: orgs[0].ID, ServiceProfileID: spIDs[0]},
+		{Name: "test-app-2", OrganizationID: orgs[1].ID, ServiceProfileID: spIDs[1]},
+		{Name: "test-app-3", OrganizationID: orgs[1].ID, ServiceProfileID: spIDs[2]},
+	}
+	for i := range apps {
+		assert.NoError(CreateApplication(ts.Tx(), &apps[i]))
+	}
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   apps[0].ID,
+		DeviceProfileID: dpIDs[0],
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+	<-nsClient.CreateDeviceChan
+
+	dk := DeviceKeys{
+		DevEUI: d.DevEUI,
+		NwkKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+	}
+	assert.NoError(CreateDeviceKeys(ts.Tx(), &dk))
+
+	mg := MulticastGroup{
+		Name:             "test-mg",
+		ServiceProfileID: spIDs[0],
+	}
+	assert.NoError(CreateMulticastGroup(ts.Tx(), &mg))
+	mgID, err := uuid.FromBytes(mg.MulticastGroup.Id)
+	assert.NoError(err)
+	assert.NoError(AddDeviceToMulticastGroup(ts.Tx(), mgID, d.DevEUI))
+
+	g := DeviceGroup{
+		ApplicationID: apps[0].ID,
+		Name:          "test-group",
+	}
+	assert.NoError(CreateDeviceGroup(ts.Tx(), &g))
+	assert.NoError(AddDeviceToDeviceGroup(ts.Tx(), g.ID, d.DevEUI))
+
+	ts.T().Run("Other network-server", func(t *testing.T) {
+		assert := require.New(t)
+
+		err := MoveDevice(ts.Tx(), d.DevEUI, apps[2].ID, uuid.Nil)
+		assert.Equal(ErrDeviceMoveInvalidNetworkServer, errors.Cause(err))
+	})
+
+	ts.T().Run("Device-profile of other organization", func(t *testing.T) {
+		assert := require.New(t)
+
+		err := MoveDevice(ts.Tx(), d.DevEUI, apps[1].ID, uuid.Nil)
+		assert.Equal(ErrDeviceMoveInvalidDeviceProfile, errors.Cause(err))
+	})
+
+	ts.T().Run("Move", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(MoveDevice(ts.Tx(), d.DevEUI, apps[1].ID, dpIDs[1]))
+
+		updateReq := <-nsClient.UpdateDeviceChan
+		assert.Equal(d.DevEUI[:], updateReq.Device.DevEui)
+		assert.Equal(spIDs[1].Bytes(), updateReq.Device.ServiceProfileId)
+		assert.Equal(dpIDs[1].Bytes(), updateReq.Device.DeviceProfileId)
+
+		// the device is updated (not re-created) on the network-server
+		assert.Len(nsClient.CreateDeviceChan, 0)
+		assert.Len(nsClient.DeleteDeviceChan, 0)
+
+		dGet, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+		assert.NoError(err)
+		assert.Equal(apps[1].ID, dGet.ApplicationID)
+		assert.Equal(dpIDs[1], dGet.DeviceProfileID)
+
+		t.Run("Keys are retained", func(t *testing.T) {
+			assert := require.New(t)
+
+			dkGet, err := GetDeviceKeys(ts.Tx(), d.DevEUI)
+			assert.NoError(err)
+			assert.Equal(dk.NwkKey, dkGet.NwkKey)
+		})
+
+		t.Run("Multicast-group membership is removed", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.Equal(ns.RemoveDeviceFromMulticastGroupRequest{
+				DevEui:           d.DevEUI[:],
+				MulticastGroupId: mgID.Bytes(),
+			}, <-nsClient.RemoveDeviceFromMulticastGroupChan)
+
+			count, err := GetDeviceCountForMulticastGroup(ts.Tx(), mgID)
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+
+		t.Run("Device-group membership is removed", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetDeviceCount(ts.Tx(), DeviceFilters{DeviceGroupID: g.ID})
+			assert.NoError(err)
+			assert.Equal(0, count)
+		})
+	})
+}
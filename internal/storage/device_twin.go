@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceTwinState defines a (desired or reported) device twin state
+// document.
+type DeviceTwinState map[string]interface{}
+
+// Value implements the driver.Valuer interface.
+func (s DeviceTwinState) Value() (driver.Value, error) {
+	if s == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(s)
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *DeviceTwinState) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+
+	return json.Unmarshal(b, s)
+}
+
+// DeviceTwin defines the twin of a device, containing the state desired by
+// the application and the state reported by the device.
+type DeviceTwin struct {
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+
+	// FPort defines the fPort used for the downlink containing the desired
+	// state.
+	FPort      uint8           `db:"f_port"`
+	Desired    DeviceTwinState `db:"desired"`
+	Reported   DeviceTwinState `db:"reported"`
+	ReportedAt *time.Time      `db:"reported_at"`
+
+	// SyncAt holds the timestamp of the last downlink containing the
+	// desired state, UplinksSinceSync the number of uplinks received since.
+	SyncAt           *time.Time `db:"sync_at"`
+	UplinksSinceSync int        `db:"uplinks_since_sync"`
+}
+
+// Validate validates the device twin data.
+func (t DeviceTwin) Validate() error {
+	if len(t.Desired) != 0 && (t.FPort < 1 || t.FPort > 223) {
+		return ErrDeviceTwinInvalidFPort
+	}
+	return nil
+}
+
+// CreateDeviceTwin creates the given device twin.
+func CreateDeviceTwin(db sqlx.Execer, t *DeviceTwin) error {
+	if err := t.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into device_twin (
+			dev_eui,
+			created_at,
+			updated_at,
+			f_port,
+			desired,
+			reported,
+			reported_at,
+			sync_at,
+			uplinks_since_sync
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		t.DevEUI[:],
+		t.CreatedAt,
+		t.UpdatedAt,
+		t.FPort,
+		t.Desired,
+		t.Reported,
+		t.ReportedAt,
+		t.SyncAt,
+		t.UplinksSinceSync,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithField("dev_eui", t.DevEUI).Info("device twin created")
+
+	return nil
+}
+
+// GetDeviceTwin returns the device twin for the given DevEUI.
+// When forUpdate is set to true, the row will be locked.
+func GetDeviceTwin(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate bool) (DeviceTwin, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var t DeviceTwin
+	err := sqlx.Get(db, &t, "select * from device_twin where dev_eui = $1"+fu, devEUI[:])
+	if err != nil {
+		return t, handlePSQLError(Select, err, "select error")
+	}
+
+	return t, nil
+}
+
+// UpdateDeviceTwin updates the given device twin.
+func UpdateDeviceTwin(db sqlx.Execer, t *DeviceTwin) error {
+	if err := t.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	t.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_twin
+		set
+			updated_at = $2,
+			f_port = $3,
+			desired = $4,
+			reported = $5,
+			reported_at = $6,
+			sync_at = $7,
+			uplinks_since_sync = $8
+		where
+			dev_eui = $1`,
+		t.DevEUI[:],
+		t.UpdatedAt,
+		t.FPort,
+		t.Desired,
+		t.Reported,
+		t.ReportedAt,
+		t.SyncAt,
+		t.UplinksSinceSync,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceTwin() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	ts.T().Run("Create with invalid fPort", func(t *testing.T) {
+		assert := require.New(t)
+
+		err := CreateDeviceTwin(ts.Tx(), &DeviceTwin{
+			DevEUI:  d.DevEUI,
+			Desired: DeviceTwinState{"interval": 60.0},
+		})
+		assert.Equal(ErrDeviceTwinInvalidFPort, errors.Cause(err))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		twin := DeviceTwin{
+			DevEUI:   d.DevEUI,
+			FPort:    10,
+			Desired:  DeviceTwinState{"interval": 60.0},
+			Reported: DeviceTwinState{"interval": 120.0, "temperature": 21.5},
+		}
+		assert.NoError(CreateDeviceTwin(ts.Tx(), &twin))
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			twinGet, err := GetDeviceTwin(ts.Tx(), d.DevEUI, false)
+			assert.NoError(err)
+			assert.EqualValues(10, twinGet.FPort)
+			assert.Equal(twin.Desired, twinGet.Desired)
+			assert.Equal(twin.Reported, twinGet.Reported)
+			assert.Nil(twinGet.ReportedAt)
+			assert.Nil(twinGet.SyncAt)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			twin.Reported["interval"] = 60.0
+			twin.UplinksSinceSync = 2
+			assert.NoError(UpdateDeviceTwin(ts.Tx(), &twin))
+
+			twinGet, err := GetDeviceTwin(ts.Tx(), d.DevEUI, false)
+			assert.NoError(err)
+			assert.Equal(DeviceTwinState{"interval": 60.0, "temperature": 21.5}, twinGet.Reported)
+			assert.Equal(2, twinGet.UplinksSinceSync)
+		})
+
+		t.Run("Delete device", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteDevice(ts.Tx(), d.DevEUI))
+
+			_, err := GetDeviceTwin(ts.Tx(), d.DevEUI, false)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+	})
+}
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceValue defines the last known value of a decoded object field.
+type DeviceValue struct {
+	Value      interface{} `json:"value"`
+	ReceivedAt time.Time   `json:"receivedAt"`
+}
+
+// DeviceValues defines the last known values of a device, by field path
+// (e.g. "temperatureSensor.3").
+type DeviceValues map[string]DeviceValue
+
+// NewDeviceValues returns the given (flattened) object fields as device
+// values, received at the given timestamp.
+func NewDeviceValues(fields map[string]interface{}, receivedAt time.Time) DeviceValues {
+	values := make(DeviceValues)
+	for k, v := range fields {
+		values[k] = DeviceValue{
+			Value:      v,
+			ReceivedAt: receivedAt,
+		}
+	}
+	return values
+}
+
+// Value implements the driver.Valuer interface.
+func (v DeviceValues) Value() (driver.Value, error) {
+	if v == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *DeviceValues) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// UpdateDeviceLastValues merges the given values into the last known values
+// of the device. Values of fields not included are retained.
+func UpdateDeviceLastValues(db sqlx.Execer, devEUI lorawan.EUI64, values DeviceValues) error {
+	res, err := db.Exec(`
+		update device
+		set
+			last_values = last_values || $2::jsonb
+		where
+			dev_eui = $1`,
+		devEUI[:],
+		values,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
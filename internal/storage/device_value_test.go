@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceLastValues() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	ts.T().Run("Get without values", func(t *testing.T) {
+		assert := require.New(t)
+
+		dGet, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+		assert.NoError(err)
+		assert.Len(dGet.LastValues, 0)
+	})
+
+	ts.T().Run("Update", func(t *testing.T) {
+		assert := require.New(t)
+
+		t1 := time.Now().Add(-time.Minute).Round(time.Second).UTC()
+		t2 := time.Now().Round(time.Second).UTC()
+
+		assert.NoError(UpdateDeviceLastValues(ts.Tx(), d.DevEUI, NewDeviceValues(map[string]interface{}{
+			"temperature": 21.5,
+			"humidity":    60.0,
+		}, t1)))
+		assert.NoError(UpdateDeviceLastValues(ts.Tx(), d.DevEUI, NewDeviceValues(map[string]interface{}{
+			"temperature": 22.5,
+		}, t2)))
+
+		t.Run("Values are merged", func(t *testing.T) {
+			assert := require.New(t)
+
+			dGet, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+			assert.NoError(err)
+			assert.Len(dGet.LastValues, 2)
+			assert.Equal(22.5, dGet.LastValues["temperature"].Value)
+			assert.True(t2.Equal(dGet.LastValues["temperature"].ReceivedAt))
+			assert.Equal(60.0, dGet.LastValues["humidity"].Value)
+			assert.True(t1.Equal(dGet.LastValues["humidity"].ReceivedAt))
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			devices, err := GetDevices(ts.Tx(), DeviceFilters{ApplicationID: app.ID, Limit: 10})
+			assert.NoError(err)
+			assert.Len(devices, 1)
+			assert.Len(devices[0].LastValues, 2)
+		})
+	})
+
+	ts.T().Run("Update unknown device", func(t *testing.T) {
+		assert := require.New(t)
+
+		err := UpdateDeviceLastValues(ts.Tx(), lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, NewDeviceValues(map[string]interface{}{"temperature": 21.5}, time.Now()))
+		assert.Equal(ErrDoesNotExist, err)
+	})
+}
@@ -0,0 +1,255 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Available e-mail alert types.
+const (
+	EmailAlertDeviceOffline      = "device_offline"
+	EmailAlertGatewayOffline     = "gateway_offline"
+	EmailAlertIntegrationFailure = "integration_failure"
+)
+
+// EmailAlertSubscription defines the subscription of an user to an
+// e-mail alert type for an organization.
+type EmailAlertSubscription struct {
+	UserID         int64     `db:"user_id"`
+	OrganizationID int64     `db:"organization_id"`
+	AlertType      string    `db:"alert_type"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// Validate validates the e-mail alert subscription data.
+func (s EmailAlertSubscription) Validate() error {
+	switch s.AlertType {
+	case EmailAlertDeviceOffline, EmailAlertGatewayOffline, EmailAlertIntegrationFailure:
+		return nil
+	default:
+		return ErrEmailAlertInvalidType
+	}
+}
+
+// EmailAlertDevice defines a device for which an e-mail alert can be sent.
+type EmailAlertDevice struct {
+	DevEUI          lorawan.EUI64 `db:"dev_eui"`
+	Name            string        `db:"name"`
+	LastSeenAt      time.Time     `db:"last_seen_at"`
+	ApplicationID   int64         `db:"application_id"`
+	ApplicationName string        `db:"application_name"`
+	OrganizationID  int64         `db:"organization_id"`
+}
+
+// EmailAlertGateway defines a gateway for which an e-mail alert can be sent.
+type EmailAlertGateway struct {
+	MAC            lorawan.EUI64 `db:"mac"`
+	Name           string        `db:"name"`
+	LastSeenAt     time.Time     `db:"last_seen_at"`
+	OrganizationID int64         `db:"organization_id"`
+}
+
+// CreateEmailAlertSubscription creates the given e-mail alert subscription.
+func CreateEmailAlertSubscription(db sqlx.Execer, s *EmailAlertSubscription) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	s.CreatedAt = time.Now()
+
+	_, err := db.Exec(`
+		insert into email_alert_subscription (
+			user_id,
+			organization_id,
+			alert_type,
+			created_at
+		) values ($1, $2, $3, $4)`,
+		s.UserID,
+		s.OrganizationID,
+		s.AlertType,
+		s.CreatedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":         s.UserID,
+		"organization_id": s.OrganizationID,
+		"alert_type":      s.AlertType,
+	}).Info("e-mail alert subscription created")
+	return nil
+}
+
+// DeleteEmailAlertSubscription deletes the given e-mail alert subscription.
+func DeleteEmailAlertSubscription(db sqlx.Execer, userID, organizationID int64, alertType string) error {
+	res, err := db.Exec(`
+		delete from email_alert_subscription
+		where
+			user_id = $1
+			and organization_id = $2
+			and alert_type = $3`,
+		userID,
+		organizationID,
+		alertType,
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":         userID,
+		"organization_id": organizationID,
+		"alert_type":      alertType,
+	}).Info("e-mail alert subscription deleted")
+	return nil
+}
+
+// GetEmailAlertSubscriptionsForUser returns the e-mail alert subscriptions
+// of the given user.
+func GetEmailAlertSubscriptionsForUser(db sqlx.Queryer, userID int64) ([]EmailAlertSubscription, error) {
+	var subs []EmailAlertSubscription
+	err := sqlx.Select(db, &subs, `
+		select *
+		from email_alert_subscription
+		where
+			user_id = $1
+		order by organization_id, alert_type`,
+		userID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return subs, nil
+}
+
+// GetEmailAlertRecipients returns the e-mail addresses of the active users
+// subscribed to the given alert type for the given organization. Users
+// which are no longer member of the organization (and are not global
+// admin) are excluded.
+func GetEmailAlertRecipients(db sqlx.Queryer, organizationID int64, alertType string) ([]string, error) {
+	var emails []string
+	err := sqlx.Select(db, &emails, `
+		select
+			u.email
+		from email_alert_subscription s
+		inner join "user" u
+			on u.id = s.user_id
+		left join organization_user ou
+			on ou.user_id = s.user_id and ou.organization_id = s.organization_id
+		where
+			s.organization_id = $1
+			and s.alert_type = $2
+			and u.is_active = true
+			and u.email != ''
+			and (ou.user_id is not null or u.is_admin = true)
+		order by u.email`,
+		organizationID,
+		alertType,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return emails, nil
+}
+
+// GetEmailAlertOrganizationIDs returns the IDs of the organizations having
+// one or more subscriptions to the given alert type.
+func GetEmailAlertOrganizationIDs(db sqlx.Queryer, alertType string) ([]int64, error) {
+	var ids []int64
+	err := sqlx.Select(db, &ids, `
+		select
+			distinct organization_id
+		from email_alert_subscription
+		where
+			alert_type = $1
+		order by organization_id`,
+		alertType,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ids, nil
+}
+
+// GetEmailAlertOfflineDevices returns the devices which were last seen
+// after from and at or before to, of the organizations having one or more
+// subscriptions to the device_offline alert type.
+func GetEmailAlertOfflineDevices(db sqlx.Queryer, from, to time.Time) ([]EmailAlertDevice, error) {
+	var devices []EmailAlertDevice
+	err := sqlx.Select(db, &devices, `
+		select
+			d.dev_eui,
+			d.name,
+			d.last_seen_at,
+			a.id as application_id,
+			a.name as application_name,
+			a.organization_id
+		from device d
+		inner join application a
+			on a.id = d.application_id
+		where
+			d.last_seen_at > $1
+			and d.last_seen_at <= $2
+			and exists (
+				select 1
+				from email_alert_subscription s
+				where
+					s.organization_id = a.organization_id
+					and s.alert_type = $3
+			)
+		order by d.dev_eui`,
+		from,
+		to,
+		EmailAlertDeviceOffline,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// GetEmailAlertOfflineGateways returns the gateways which were last seen
+// after from and at or before to, of the organizations having one or more
+// subscriptions to the gateway_offline alert type.
+func GetEmailAlertOfflineGateways(db sqlx.Queryer, from, to time.Time) ([]EmailAlertGateway, error) {
+	var gateways []EmailAlertGateway
+	err := sqlx.Select(db, &gateways, `
+		select
+			g.mac,
+			g.name,
+			g.last_seen_at,
+			g.organization_id
+		from gateway g
+		where
+			g.last_seen_at > $1
+			and g.last_seen_at <= $2
+			and exists (
+				select 1
+				from email_alert_subscription s
+				where
+					s.organization_id = g.organization_id
+					and s.alert_type = $3
+			)
+		order by g.mac`,
+		from,
+		to,
+		EmailAlertGatewayOffline,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return gateways, nil
+}
@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestEmailAlertSubscription() {
+	assert := require.New(ts.T())
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "foo@example.com",
+	}
+	_, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+
+	ts.T().Run("Create with invalid type", func(t *testing.T) {
+		assert := require.New(t)
+
+		s := EmailAlertSubscription{
+			UserID:         user.ID,
+			OrganizationID: org.ID,
+			AlertType:      "foo",
+		}
+		assert.Equal(ErrEmailAlertInvalidType, errors.Cause(CreateEmailAlertSubscription(ts.Tx(), &s)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		s := EmailAlertSubscription{
+			UserID:         user.ID,
+			OrganizationID: org.ID,
+			AlertType:      "device_offline",
+		}
+		assert.NoError(CreateEmailAlertSubscription(ts.Tx(), &s))
+
+		t.Run("Get for user", func(t *testing.T) {
+			assert := require.New(t)
+
+			subs, err := GetEmailAlertSubscriptionsForUser(ts.Tx(), user.ID)
+			assert.NoError(err)
+			assert.Len(subs, 1)
+			assert.Equal(org.ID, subs[0].OrganizationID)
+			assert.Equal("device_offline", subs[0].AlertType)
+		})
+
+		t.Run("Get organization ids", func(t *testing.T) {
+			assert := require.New(t)
+
+			ids, err := GetEmailAlertOrganizationIDs(ts.Tx(), EmailAlertDeviceOffline)
+			assert.NoError(err)
+			assert.Equal([]int64{org.ID}, ids)
+
+			ids, err = GetEmailAlertOrganizationIDs(ts.Tx(), EmailAlertGatewayOffline)
+			assert.NoError(err)
+			assert.Len(ids, 0)
+		})
+
+		t.Run("Get recipients when user is not an organization user", func(t *testing.T) {
+			assert := require.New(t)
+
+			emails, err := GetEmailAlertRecipients(ts.Tx(), org.ID, "device_offline")
+			assert.NoError(err)
+			assert.Len(emails, 0)
+		})
+
+		t.Run("Get recipients when user is an organization user", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(CreateOrganizationUser(ts.Tx(), org.ID, user.ID, false))
+
+			emails, err := GetEmailAlertRecipients(ts.Tx(), org.ID, "device_offline")
+			assert.NoError(err)
+			assert.Equal([]string{"foo@example.com"}, emails)
+
+			emails, err = GetEmailAlertRecipients(ts.Tx(), org.ID, "gateway_offline")
+			assert.NoError(err)
+			assert.Len(emails, 0)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteEmailAlertSubscription(ts.Tx(), user.ID, org.ID, "device_offline"))
+			assert.Equal(ErrDoesNotExist, DeleteEmailAlertSubscription(ts.Tx(), user.ID, org.ID, "device_offline"))
+
+			subs, err := GetEmailAlertSubscriptionsForUser(ts.Tx(), user.ID)
+			assert.NoError(err)
+			assert.Len(subs, 0)
+		})
+	})
+}
+
+func (ts *StorageTestSuite) TestEmailAlertOfflineGateways() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "foo@example.com",
+	}
+	_, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+
+	gw := Gateway{
+		MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		Name:            "test-gw",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateGateway(ts.Tx(), &gw))
+
+	lastSeenAt := time.Now().Add(-time.Hour).Round(time.Second).UTC()
+	assert.NoError(UpdateGatewaysLastSeenAt(ts.Tx(), []lorawan.EUI64{gw.MAC}, lastSeenAt))
+
+	ts.T().Run("Without subscription", func(t *testing.T) {
+		assert := require.New(t)
+
+		gws, err := GetEmailAlertOfflineGateways(ts.Tx(), lastSeenAt.Add(-time.Minute), lastSeenAt)
+		assert.NoError(err)
+		assert.Len(gws, 0)
+	})
+
+	ts.T().Run("With subscription", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(CreateEmailAlertSubscription(ts.Tx(), &EmailAlertSubscription{
+			UserID:         user.ID,
+			OrganizationID: org.ID,
+			AlertType:      EmailAlertGatewayOffline,
+		}))
+
+		gws, err := GetEmailAlertOfflineGateways(ts.Tx(), lastSeenAt.Add(-time.Minute), lastSeenAt)
+		assert.NoError(err)
+		assert.Len(gws, 1)
+		assert.Equal(gw.MAC, gws[0].MAC)
+		assert.Equal(org.ID, gws[0].OrganizationID)
+		assert.True(gws[0].LastSeenAt.Equal(lastSeenAt))
+
+		t.Run("Outside time range", func(t *testing.T) {
+			assert := require.New(t)
+
+			gws, err := GetEmailAlertOfflineGateways(ts.Tx(), lastSeenAt, lastSeenAt.Add(time.Minute))
+			assert.NoError(err)
+			assert.Len(gws, 0)
+		})
+
+		t.Run("Seen again", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(UpdateGatewaysLastSeenAt(ts.Tx(), []lorawan.EUI64{gw.MAC}, time.Now()))
+
+			gws, err := GetEmailAlertOfflineGateways(ts.Tx(), lastSeenAt.Add(-time.Minute), lastSeenAt)
+			assert.NoError(err)
+			assert.Len(gws, 0)
+		})
+	})
+}
@@ -21,21 +21,46 @@ const (
 
 // errors
 var (
-	ErrAlreadyExists                   = errors.New("object already exists")
-	ErrDoesNotExist                    = errors.New("object does not exist")
-	ErrUsedByOtherObjects              = errors.New("this object is used by other objects, remove them first")
-	ErrApplicationInvalidName          = errors.New("invalid application name")
-	ErrNodeInvalidName                 = errors.New("invalid node name")
-	ErrNodeMaxRXDelay                  = errors.New("max value of RXDelay is 15")
-	ErrCFListTooManyChannels           = errors.New("too many channels in channel-list")
-	ErrUserInvalidUsername             = errors.New("username name may only be composed of upper and lower case characters and digits")
-	ErrUserPasswordLength              = errors.New("passwords must be at least 6 characters long")
-	ErrInvalidUsernameOrPassword       = errors.New("invalid username or password")
-	ErrOrganizationInvalidName         = errors.New("invalid organization name")
-	ErrGatewayInvalidName              = errors.New("invalid gateway name")
-	ErrInvalidEmail                    = errors.New("invalid e-mail")
-	ErrInvalidGatewayDiscoveryInterval = errors.New("invalid gateway-discovery interval, it must be greater than 0")
-	ErrDeviceProfileInvalidName        = errors.New("invalid device-profile name")
+	ErrAlreadyExists                           = errors.New("object already exists")
+	ErrDoesNotExist                            = errors.New("object does not exist")
+	ErrUsedByOtherObjects                      = errors.New("this object is used by other objects, remove them first")
+	ErrApplicationInvalidName                  = errors.New("invalid application name")
+	ErrNodeInvalidName                         = errors.New("invalid node name")
+	ErrNodeMaxRXDelay                          = errors.New("max value of RXDelay is 15")
+	ErrCFListTooManyChannels                   = errors.New("too many channels in channel-list")
+	ErrUserInvalidUsername                     = errors.New("username name may only be composed of upper and lower case characters and digits")
+	ErrUserPasswordLength                      = errors.New("passwords must be at least 6 characters long")
+	ErrInvalidUsernameOrPassword               = errors.New("invalid username or password")
+	ErrOrganizationInvalidName                 = errors.New("invalid organization name")
+	ErrGatewayInvalidName                      = errors.New("invalid gateway name")
+	ErrInvalidEmail                            = errors.New("invalid e-mail")
+	ErrInvalidGatewayDiscoveryInterval         = errors.New("invalid gateway-discovery interval, it must be greater than 0")
+	ErrDeviceProfileInvalidName                = errors.New("invalid device-profile name")
+	ErrCodecInvalidName                        = errors.New("invalid codec name")
+	ErrApplicationInvalidCodecVersion          = errors.New("a codec version can only be set in combination with a codec")
+	ErrFUOTADeploymentInvalidName              = errors.New("invalid fuota deployment name")
+	ErrFUOTADeploymentInvalidPayload           = errors.New("fuota deployment payload must not be empty")
+	ErrFUOTADeploymentInvalidFragSize          = errors.New("fragment size must be between 1 and 255 bytes")
+	ErrFUOTADeploymentTooManyFragments         = errors.New("too many fragments, the number of fragments (including redundancy) must not exceed 16383")
+	ErrFUOTADeploymentInvalidSessionParameters = errors.New("invalid fragmentation session parameters")
+	ErrRemoteMulticastSetupNoFreeMcGroupID     = errors.New("all multicast-group indices (0 - 3) of the device are in use")
+	ErrScheduledDownlinkInvalidTarget          = errors.New("either a device or a multicast-group must be given")
+	ErrScheduledDownlinkInvalidFPort           = errors.New("fPort must be between 1 and 223")
+	ErrScheduledDownlinkConfirmedMulticast     = errors.New("multicast downlinks can not be confirmed")
+	ErrScheduledDownlinkInvalidRecurrence      = errors.New("cron and interval can not be used together")
+	ErrScheduledDownlinkInvalidInterval        = errors.New("interval must be at least one minute")
+	ErrScheduledDownlinkInvalidCron            = errors.New("invalid cron expression")
+	ErrDeviceTwinInvalidFPort                  = errors.New("fPort must be between 1 and 223")
+	ErrDeviceGroupInvalidName                  = errors.New("invalid device-group name")
+	ErrDeviceGroupNotStatic                    = errors.New("devices can only be added to or removed from a static device-group")
+	ErrDeviceGroupJobInvalidAction             = errors.New("invalid device-group job action")
+	ErrDeviceGroupJobInvalidFPort              = errors.New("fPort must be between 1 and 223")
+	ErrDeviceGroupJobDeviceProfileRequired     = errors.New("device-profile must be given")
+	ErrDeviceGroupJobMulticastGroupRequired    = errors.New("multicast-group must be given")
+	ErrDeviceMoveInvalidNetworkServer          = errors.New("the target application must use the same network-server as the device")
+	ErrDeviceMoveInvalidDeviceProfile          = errors.New("the device-profile must belong to the organization and network-server of the target application")
+	ErrEmailAlertInvalidType                   = errors.New("invalid e-mail alert type")
+	ErrPasswordResetInvalidToken               = errors.New("invalid or expired password reset token")
 )
 
 func handlePSQLError(action Action, err error, description string) error {
@@ -0,0 +1,588 @@
+package storage
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// FUOTADeploymentState defines the state of a FUOTA deployment.
+type FUOTADeploymentState string
+
+// Available FUOTA deployment states. A deployment starts in the
+// FragSessionSetup state and moves to the next state when its
+// NextStepAfter timestamp has passed.
+const (
+	FUOTADeploymentFragSessionSetup  FUOTADeploymentState = "FRAG_SESSION_SETUP"
+	FUOTADeploymentEnqueue           FUOTADeploymentState = "ENQUEUE"
+	FUOTADeploymentStatusRequest     FUOTADeploymentState = "STATUS_REQUEST"
+	FUOTADeploymentFragSessionDelete FUOTADeploymentState = "FRAG_SESSION_DELETE"
+	FUOTADeploymentDone              FUOTADeploymentState = "DONE"
+)
+
+// FUOTADeploymentDeviceState defines the state of a device within a FUOTA
+// deployment.
+type FUOTADeploymentDeviceState string
+
+// Available FUOTA deployment device states.
+const (
+	FUOTADeploymentDevicePending        FUOTADeploymentDeviceState = "PENDING"
+	FUOTADeploymentDeviceSetupCompleted FUOTADeploymentDeviceState = "SETUP_COMPLETED"
+	FUOTADeploymentDeviceCompleted      FUOTADeploymentDeviceState = "COMPLETED"
+	FUOTADeploymentDeviceError          FUOTADeploymentDeviceState = "ERROR"
+)
+
+// FUOTADeployment defines a firmware update over the air deployment, using
+// the LoRaWAN Fragmented Data Block Transport (TS004).
+type FUOTADeployment struct {
+	ID               int64                `db:"id"`
+	CreatedAt        time.Time            `db:"created_at"`
+	UpdatedAt        time.Time            `db:"updated_at"`
+	ApplicationID    int64                `db:"application_id"`
+	MulticastGroupID *uuid.UUID           `db:"multicast_group_id"`
+	Name             string               `db:"name"`
+	Payload          []byte               `db:"payload"`
+	FragSize         int                  `db:"frag_size"`
+	Redundancy       int                  `db:"redundancy"`
+	FragIndex        int                  `db:"frag_index"`
+	McGroupID        int                  `db:"mc_group_id"`
+	BlockAckDelay    int                  `db:"block_ack_delay"`
+	Descriptor       []byte               `db:"descriptor"`
+	Timeout          int                  `db:"timeout"`
+	State            FUOTADeploymentState `db:"state"`
+	NextStepAfter    time.Time            `db:"next_step_after"`
+	RetryCount       int                  `db:"retry_count"`
+	ErrorMessage     string               `db:"error_message"`
+	NbFragEnqueued   int                  `db:"nb_frag_enqueued"`
+}
+
+// FUOTADeploymentListItem defines a FUOTA deployment for listing.
+type FUOTADeploymentListItem struct {
+	ID            int64                `db:"id"`
+	CreatedAt     time.Time            `db:"created_at"`
+	UpdatedAt     time.Time            `db:"updated_at"`
+	Name          string               `db:"name"`
+	State         FUOTADeploymentState `db:"state"`
+	NextStepAfter time.Time            `db:"next_step_after"`
+}
+
+// FUOTADeploymentDevice defines the state of a device within a FUOTA
+// deployment.
+type FUOTADeploymentDevice struct {
+	FUOTADeploymentID int64                      `db:"fuota_deployment_id"`
+	DevEUI            lorawan.EUI64              `db:"dev_eui"`
+	CreatedAt         time.Time                  `db:"created_at"`
+	UpdatedAt         time.Time                  `db:"updated_at"`
+	State             FUOTADeploymentDeviceState `db:"state"`
+	ErrorMessage      string                     `db:"error_message"`
+	NbFragReceived    int                        `db:"nb_frag_received"`
+	MissingFrag       int                        `db:"missing_frag"`
+	EnqueuedState     FUOTADeploymentState       `db:"enqueued_state"`
+}
+
+// FUOTADeploymentDeviceListItem defines a FUOTA deployment device for
+// listing.
+type FUOTADeploymentDeviceListItem struct {
+	FUOTADeploymentDevice
+	DeviceName string `db:"device_name"`
+}
+
+// NbFrag returns the number of (uncoded) fragments of the deployment
+// payload. The last fragment is padded to the fragment size.
+func (d FUOTADeployment) NbFrag() int {
+	if d.FragSize <= 0 {
+		return 0
+	}
+	return (len(d.Payload) + d.FragSize - 1) / d.FragSize
+}
+
+// Validate validates the FUOTA deployment data.
+func (d FUOTADeployment) Validate() error {
+	if d.Name == "" {
+		return ErrFUOTADeploymentInvalidName
+	}
+	if len(d.Payload) == 0 {
+		return ErrFUOTADeploymentInvalidPayload
+	}
+	if d.FragSize < 1 || d.FragSize > 255 {
+		return ErrFUOTADeploymentInvalidFragSize
+	}
+	// the fragment counter N is a 14 bit field
+	if d.Redundancy < 0 || d.NbFrag()+d.Redundancy > 16383 {
+		return ErrFUOTADeploymentTooManyFragments
+	}
+	if d.FragIndex < 0 || d.FragIndex > 3 || d.McGroupID < 0 || d.McGroupID > 3 || d.BlockAckDelay < 0 || d.BlockAckDelay > 7 || len(d.Descriptor) != 4 {
+		return ErrFUOTADeploymentInvalidSessionParameters
+	}
+	return nil
+}
+
+// CreateFUOTADeployment creates the given FUOTA deployment. The deployment
+// will start with the FragSessionSetup state.
+func CreateFUOTADeployment(db sqlx.Queryer, d *FUOTADeployment) error {
+	if err := d.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	d.State = FUOTADeploymentFragSessionSetup
+	d.NextStepAfter = now
+
+	err := sqlx.Get(db, &d.ID, `
+		insert into fuota_deployment (
+			created_at,
+			updated_at,
+			application_id,
+			multicast_group_id,
+			name,
+			payload,
+			frag_size,
+			redundancy,
+			frag_index,
+			mc_group_id,
+			block_ack_delay,
+			descriptor,
+			timeout,
+			state,
+			next_step_after
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		returning id`,
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.ApplicationID,
+		d.MulticastGroupID,
+		d.Name,
+		d.Payload,
+		d.FragSize,
+		d.Redundancy,
+		d.FragIndex,
+		d.McGroupID,
+		d.BlockAckDelay,
+		d.Descriptor,
+		d.Timeout,
+		d.State,
+		d.NextStepAfter,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":             d.ID,
+		"application_id": d.ApplicationID,
+	}).Info("fuota deployment created")
+
+	return nil
+}
+
+// GetFUOTADeployment returns the FUOTA deployment for the given id.
+// When forUpdate is set to true, the row will be locked.
+func GetFUOTADeployment(db sqlx.Queryer, id int64, forUpdate bool) (FUOTADeployment, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var d FUOTADeployment
+	err := sqlx.Get(db, &d, "select * from fuota_deployment where id = $1"+fu, id)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+
+	return d, nil
+}
+
+// GetPendingFUOTADeployment returns a FUOTA deployment for which the next
+// step must be executed, or nil when there is no such deployment. The
+// returned deployment is locked, deployments locked by other transactions
+// are skipped.
+func GetPendingFUOTADeployment(db sqlx.Queryer) (*FUOTADeployment, error) {
+	var d FUOTADeployment
+	err := sqlx.Get(db, &d, `
+		select
+			*
+		from fuota_deployment
+		where
+			state != $1
+			and next_step_after <= $2
+		order by
+			next_step_after
+		limit 1
+		for update skip locked`,
+		FUOTADeploymentDone,
+		time.Now(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return &d, nil
+}
+
+// GetFUOTADeploymentCountForApplicationID returns the number of FUOTA
+// deployments for the given application.
+func GetFUOTADeploymentCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from fuota_deployment
+		where
+			application_id = $1`,
+		applicationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetFUOTADeploymentsForApplicationID returns the FUOTA deployments for the
+// given application, sorted by creation time (newest first).
+func GetFUOTADeploymentsForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]FUOTADeploymentListItem, error) {
+	var items []FUOTADeploymentListItem
+	err := sqlx.Select(db, &items, `
+		select
+			id,
+			created_at,
+			updated_at,
+			name,
+			state,
+			next_step_after
+		from fuota_deployment
+		where
+			application_id = $1
+		order by
+			created_at desc
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// UpdateFUOTADeploymentState updates the state, next step timestamp and
+// step progress (retry count, error and enqueued fragments) of the given
+// FUOTA deployment.
+func UpdateFUOTADeploymentState(db sqlx.Execer, d *FUOTADeployment) error {
+	d.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update fuota_deployment
+		set
+			updated_at = $2,
+			state = $3,
+			next_step_after = $4,
+			retry_count = $5,
+			error_message = $6,
+			nb_frag_enqueued = $7
+		where
+			id = $1`,
+		d.ID,
+		d.UpdatedAt,
+		d.State,
+		d.NextStepAfter,
+		d.RetryCount,
+		d.ErrorMessage,
+		d.NbFragEnqueued,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":              d.ID,
+		"state":           d.State,
+		"next_step_after": d.NextStepAfter,
+		"retry_count":     d.RetryCount,
+	}).Info("fuota deployment state updated")
+
+	return nil
+}
+
+// CreateFUOTADeploymentDevice adds the given device to a FUOTA deployment.
+func CreateFUOTADeploymentDevice(db sqlx.Execer, dd *FUOTADeploymentDevice) error {
+	now := time.Now()
+	dd.CreatedAt = now
+	dd.UpdatedAt = now
+	if dd.State == "" {
+		dd.State = FUOTADeploymentDevicePending
+	}
+
+	_, err := db.Exec(`
+		insert into fuota_deployment_device (
+			fuota_deployment_id,
+			dev_eui,
+			created_at,
+			updated_at,
+			state,
+			error_message,
+			nb_frag_received,
+			missing_frag
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		dd.FUOTADeploymentID,
+		dd.DevEUI[:],
+		dd.CreatedAt,
+		dd.UpdatedAt,
+		dd.State,
+		dd.ErrorMessage,
+		dd.NbFragReceived,
+		dd.MissingFrag,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"fuota_deployment_id": dd.FUOTADeploymentID,
+		"dev_eui":             dd.DevEUI,
+	}).Info("fuota deployment device created")
+
+	return nil
+}
+
+// GetFUOTADeploymentDevice returns the FUOTA deployment device for the given
+// deployment and DevEUI.
+func GetFUOTADeploymentDevice(db sqlx.Queryer, fuotaDeploymentID int64, devEUI lorawan.EUI64) (FUOTADeploymentDevice, error) {
+	var dd FUOTADeploymentDevice
+	err := sqlx.Get(db, &dd, `
+		select
+			*
+		from fuota_deployment_device
+		where
+			fuota_deployment_id = $1
+			and dev_eui = $2`,
+		fuotaDeploymentID,
+		devEUI[:],
+	)
+	if err != nil {
+		return dd, handlePSQLError(Select, err, "select error")
+	}
+
+	return dd, nil
+}
+
+// GetActiveFUOTADeploymentDeviceForDevEUI returns the FUOTA deployment device
+// of the deployment which is in progress for the given DevEUI.
+func GetActiveFUOTADeploymentDeviceForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (FUOTADeploymentDevice, error) {
+	var dd FUOTADeploymentDevice
+	err := sqlx.Get(db, &dd, `
+		select
+			dd.*
+		from fuota_deployment_device dd
+		inner join fuota_deployment d
+			on d.id = dd.fuota_deployment_id
+		where
+			dd.dev_eui = $1
+			and d.state != $2
+		order by
+			d.created_at desc
+		limit 1`,
+		devEUI[:],
+		FUOTADeploymentDone,
+	)
+	if err != nil {
+		return dd, handlePSQLError(Select, err, "select error")
+	}
+
+	return dd, nil
+}
+
+// GetFUOTADeploymentDevices returns all the devices of the given FUOTA
+// deployment.
+func GetFUOTADeploymentDevices(db sqlx.Queryer, fuotaDeploymentID int64) ([]FUOTADeploymentDevice, error) {
+	var items []FUOTADeploymentDevice
+	err := sqlx.Select(db, &items, `
+		select
+			*
+		from fuota_deployment_device
+		where
+			fuota_deployment_id = $1
+		order by
+			dev_eui`,
+		fuotaDeploymentID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// GetFUOTADeploymentDeviceCount returns the number of devices of the given
+// FUOTA deployment.
+func GetFUOTADeploymentDeviceCount(db sqlx.Queryer, fuotaDeploymentID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from fuota_deployment_device
+		where
+			fuota_deployment_id = $1`,
+		fuotaDeploymentID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetFUOTADeploymentDeviceListItems returns the devices of the given FUOTA
+// deployment, respecting the given limit and offset.
+func GetFUOTADeploymentDeviceListItems(db sqlx.Queryer, fuotaDeploymentID int64, limit, offset int) ([]FUOTADeploymentDeviceListItem, error) {
+	var items []FUOTADeploymentDeviceListItem
+	err := sqlx.Select(db, &items, `
+		select
+			dd.*,
+			d.name as device_name
+		from fuota_deployment_device dd
+		inner join device d
+			on d.dev_eui = dd.dev_eui
+		where
+			dd.fuota_deployment_id = $1
+		order by
+			d.name
+		limit $2
+		offset $3`,
+		fuotaDeploymentID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// UpdateFUOTADeploymentDevice updates the given FUOTA deployment device.
+func UpdateFUOTADeploymentDevice(db sqlx.Execer, dd *FUOTADeploymentDevice) error {
+	dd.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update fuota_deployment_device
+		set
+			updated_at = $3,
+			state = $4,
+			error_message = $5,
+			nb_frag_received = $6,
+			missing_frag = $7
+		where
+			fuota_deployment_id = $1
+			and dev_eui = $2`,
+		dd.FUOTADeploymentID,
+		dd.DevEUI[:],
+		dd.UpdatedAt,
+		dd.State,
+		dd.ErrorMessage,
+		dd.NbFragReceived,
+		dd.MissingFrag,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"fuota_deployment_id": dd.FUOTADeploymentID,
+		"dev_eui":             dd.DevEUI,
+		"state":               dd.State,
+	}).Info("fuota deployment device updated")
+
+	return nil
+}
+
+// SetFUOTADeploymentDeviceEnqueuedState records that the payload of the
+// given deployment state (step) has been enqueued for the given device, so
+// that it is not enqueued again when the step is retried.
+func SetFUOTADeploymentDeviceEnqueuedState(db sqlx.Execer, fuotaDeploymentID int64, devEUI lorawan.EUI64, state FUOTADeploymentState) error {
+	res, err := db.Exec(`
+		update fuota_deployment_device
+		set
+			updated_at = $3,
+			enqueued_state = $4
+		where
+			fuota_deployment_id = $1
+			and dev_eui = $2`,
+		fuotaDeploymentID,
+		devEUI[:],
+		time.Now(),
+		state,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// SetFUOTADeploymentDevicesError sets the error state and the given error
+// message for all devices of the given FUOTA deployment which are in one of
+// the given states.
+func SetFUOTADeploymentDevicesError(db sqlx.Execer, fuotaDeploymentID int64, states []FUOTADeploymentDeviceState, errorMessage string) error {
+	var stateStrings []string
+	for _, s := range states {
+		stateStrings = append(stateStrings, string(s))
+	}
+
+	_, err := db.Exec(`
+		update fuota_deployment_device
+		set
+			updated_at = $2,
+			state = $3,
+			error_message = $4
+		where
+			fuota_deployment_id = $1
+			and state = any($5)`,
+		fuotaDeploymentID,
+		time.Now(),
+		FUOTADeploymentDeviceError,
+		errorMessage,
+		pq.Array(stateStrings),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestFUOTADeployment() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		OrganizationID: org.ID,
+		Name:           "test-app",
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+	assert.NoError(err)
+
+	devices := []Device{
+		{DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, ApplicationID: app.ID, DeviceProfileID: dpID, Name: "device-1"},
+		{DevEUI: lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8}, ApplicationID: app.ID, DeviceProfileID: dpID, Name: "device-2"},
+	}
+	for i := range devices {
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+
+	ts.T().Run("Validate", func(t *testing.T) {
+		assert := require.New(t)
+
+		valid := FUOTADeployment{
+			ApplicationID: app.ID,
+			Name:          "test-deployment",
+			Payload:       make([]byte, 100),
+			FragSize:      10,
+			Redundancy:    5,
+			Descriptor:    []byte{1, 2, 3, 4},
+		}
+		assert.NoError(valid.Validate())
+		assert.Equal(10, valid.NbFrag())
+
+		tests := []struct {
+			Name          string
+			Modify        func(d *FUOTADeployment)
+			ExpectedError error
+		}{
+			{"empty name", func(d *FUOTADeployment) { d.Name = "" }, ErrFUOTADeploymentInvalidName},
+			{"empty payload", func(d *FUOTADeployment) { d.Payload = nil }, ErrFUOTADeploymentInvalidPayload},
+			{"invalid fragment size", func(d *FUOTADeployment) { d.FragSize = 0 }, ErrFUOTADeploymentInvalidFragSize},
+			{"too many fragments", func(d *FUOTADeployment) { d.Redundancy = 16380 }, ErrFUOTADeploymentTooManyFragments},
+			{"invalid fragmentation index", func(d *FUOTADeployment) { d.FragIndex = 4 }, ErrFUOTADeploymentInvalidSessionParameters},
+			{"invalid descriptor", func(d *FUOTADeployment) { d.Descriptor = []byte{1, 2, 3} }, ErrFUOTADeploymentInvalidSessionParameters},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				d := valid
+				tst.Modify(&d)
+				assert.Equal(tst.ExpectedError, d.Validate())
+			})
+		}
+	})
+
+	ts.T().Run("Create with invalid name", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := FUOTADeployment{
+			ApplicationID: app.ID,
+			Payload:       []byte{1, 2, 3, 4},
+			FragSize:      2,
+			Descriptor:    []byte{1, 2, 3, 4},
+		}
+		assert.Equal(ErrFUOTADeploymentInvalidName, errors.Cause(CreateFUOTADeployment(ts.Tx(), &d)))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := FUOTADeployment{
+			ApplicationID: app.ID,
+			Name:          "test-deployment",
+			Payload:       []byte{1, 2, 3, 4, 5},
+			FragSize:      2,
+			Redundancy:    1,
+			Descriptor:    []byte{1, 2, 3, 4},
+			Timeout:       60,
+		}
+		assert.NoError(CreateFUOTADeployment(ts.Tx(), &d))
+		assert.Equal(FUOTADeploymentFragSessionSetup, d.State)
+		d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Millisecond)
+		d.UpdatedAt = d.UpdatedAt.UTC().Truncate(time.Millisecond)
+		d.NextStepAfter = d.NextStepAfter.UTC().Truncate(time.Millisecond)
+
+		for _, device := range devices {
+			assert.NoError(CreateFUOTADeploymentDevice(ts.Tx(), &FUOTADeploymentDevice{
+				FUOTADeploymentID: d.ID,
+				DevEUI:            device.DevEUI,
+			}))
+		}
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			d2, err := GetFUOTADeployment(ts.Tx(), d.ID, false)
+			assert.NoError(err)
+			d2.CreatedAt = d2.CreatedAt.UTC().Truncate(time.Millisecond)
+			d2.UpdatedAt = d2.UpdatedAt.UTC().Truncate(time.Millisecond)
+			d2.NextStepAfter = d2.NextStepAfter.UTC().Truncate(time.Millisecond)
+			assert.Equal(d, d2)
+		})
+
+		t.Run("GetPendingFUOTADeployment", func(t *testing.T) {
+			assert := require.New(t)
+
+			pending, err := GetPendingFUOTADeployment(ts.Tx())
+			assert.NoError(err)
+			assert.NotNil(pending)
+			assert.Equal(d.ID, pending.ID)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetFUOTADeploymentCountForApplicationID(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			items, err := GetFUOTADeploymentsForApplicationID(ts.Tx(), app.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(d.ID, items[0].ID)
+			assert.Equal(d.Name, items[0].Name)
+			assert.Equal(FUOTADeploymentFragSessionSetup, items[0].State)
+		})
+
+		t.Run("List devices", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetFUOTADeploymentDeviceCount(ts.Tx(), d.ID)
+			assert.NoError(err)
+			assert.Equal(2, count)
+
+			items, err := GetFUOTADeploymentDeviceListItems(ts.Tx(), d.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.Equal("device-1", items[0].DeviceName)
+			assert.Equal(FUOTADeploymentDevicePending, items[0].State)
+		})
+
+		t.Run("Update device", func(t *testing.T) {
+			assert := require.New(t)
+
+			dd, err := GetActiveFUOTADeploymentDeviceForDevEUI(ts.Tx(), devices[0].DevEUI)
+			assert.NoError(err)
+			assert.Equal(d.ID, dd.FUOTADeploymentID)
+
+			dd.State = FUOTADeploymentDeviceSetupCompleted
+			dd.NbFragReceived = 3
+			assert.NoError(UpdateFUOTADeploymentDevice(ts.Tx(), &dd))
+
+			dd2, err := GetFUOTADeploymentDevice(ts.Tx(), d.ID, devices[0].DevEUI)
+			assert.NoError(err)
+			assert.Equal(FUOTADeploymentDeviceSetupCompleted, dd2.State)
+			assert.Equal(3, dd2.NbFragReceived)
+		})
+
+		t.Run("SetFUOTADeploymentDeviceEnqueuedState", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(SetFUOTADeploymentDeviceEnqueuedState(ts.Tx(), d.ID, devices[0].DevEUI, FUOTADeploymentFragSessionSetup))
+
+			dd, err := GetFUOTADeploymentDevice(ts.Tx(), d.ID, devices[0].DevEUI)
+			assert.NoError(err)
+			assert.Equal(FUOTADeploymentFragSessionSetup, dd.EnqueuedState)
+			assert.Equal(FUOTADeploymentDeviceSetupCompleted, dd.State)
+
+			assert.Equal(ErrDoesNotExist, SetFUOTADeploymentDeviceEnqueuedState(ts.Tx(), d.ID+1, devices[0].DevEUI, FUOTADeploymentFragSessionSetup))
+		})
+
+		t.Run("SetFUOTADeploymentDevicesError", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(SetFUOTADeploymentDevicesError(ts.Tx(), d.ID, []FUOTADeploymentDeviceState{FUOTADeploymentDevicePending}, "timeout"))
+
+			items, err := GetFUOTADeploymentDevices(ts.Tx(), d.ID)
+			assert.NoError(err)
+			assert.Len(items, 2)
+			assert.Equal(FUOTADeploymentDeviceSetupCompleted, items[0].State)
+			assert.Equal(FUOTADeploymentDeviceError, items[1].State)
+			assert.Equal("timeout", items[1].ErrorMessage)
+		})
+
+		t.Run("UpdateFUOTADeploymentState", func(t *testing.T) {
+			assert := require.New(t)
+
+			d.State = FUOTADeploymentDone
+			d.RetryCount = 2
+			d.ErrorMessage = "enqueue error"
+			d.NbFragEnqueued = 3
+			assert.NoError(UpdateFUOTADeploymentState(ts.Tx(), &d))
+
+			d2, err := GetFUOTADeployment(ts.Tx(), d.ID, false)
+			assert.NoError(err)
+			assert.Equal(FUOTADeploymentDone, d2.State)
+			assert.Equal(2, d2.RetryCount)
+			assert.Equal("enqueue error", d2.ErrorMessage)
+			assert.Equal(3, d2.NbFragEnqueued)
+
+			pending, err := GetPendingFUOTADeployment(ts.Tx())
+			assert.NoError(err)
+			assert.Nil(pending)
+
+			_, err = GetActiveFUOTADeploymentDeviceForDevEUI(ts.Tx(), devices[0].DevEUI)
+			assert.Equal(ErrDoesNotExist, errors.Cause(err))
+		})
+	})
+}
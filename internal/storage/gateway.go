@@ -37,6 +37,7 @@ type Gateway struct {
 	LastPingSentAt   *time.Time    `db:"last_ping_sent_at"`
 	NetworkServerID  int64         `db:"network_server_id"`
 	GatewayProfileID *string       `db:"gateway_profile_id"`
+	LastSeenAt       *time.Time    `db:"last_seen_at"`
 }
 
 // GatewayPing represents a gateway ping.
@@ -331,6 +332,31 @@ func GetGatewaysForMACs(db sqlx.Queryer, macs []lorawan.EUI64) (map[lorawan.EUI6
 	return out, nil
 }
 
+// UpdateGatewaysLastSeenAt sets the last-seen timestamp of the given
+// gateways, e.g. the gateways which received an uplink.
+func UpdateGatewaysLastSeenAt(db sqlx.Execer, macs []lorawan.EUI64, lastSeenAt time.Time) error {
+	var macsB [][]byte
+	for i := range macs {
+		macsB = append(macsB, macs[i][:])
+	}
+
+	_, err := db.Exec(`
+		update gateway
+		set
+			last_seen_at = $2
+		where
+			mac = any($1)
+			and (last_seen_at is null or last_seen_at < $2)`,
+		pq.ByteaArray(macsB),
+		lastSeenAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	return nil
+}
+
 // GetGatewayCountForOrganizationID returns the total number of gateways
 // given an organization ID.
 func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, search string) (int, error) {
@@ -22,6 +22,7 @@ type MulticastGroup struct {
 	UpdatedAt        time.Time         `db:"updated_at"`
 	Name             string            `db:"name"`
 	MCAppSKey        lorawan.AES128Key `db:"mc_app_s_key"`
+	MCKey            lorawan.AES128Key `db:"mc_key"`
 	ServiceProfileID uuid.UUID         `db:"service_profile_id"`
 	MulticastGroup   ns.MulticastGroup `db:"-"`
 }
@@ -55,8 +56,9 @@ func CreateMulticastGroup(db sqlx.Ext, mg *MulticastGroup) error {
 			updated_at,
 			name,
 			service_profile_id,
-			mc_app_s_key
-		) values ($1, $2, $3, $4, $5, $6)
+			mc_app_s_key,
+			mc_key
+		) values ($1, $2, $3, $4, $5, $6, $7)
 	`,
 		mgID,
 		mg.CreatedAt,
@@ -64,6 +66,7 @@ func CreateMulticastGroup(db sqlx.Ext, mg *MulticastGroup) error {
 		mg.Name,
 		mg.ServiceProfileID,
 		mg.MCAppSKey,
+		mg.MCKey,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -103,7 +106,8 @@ func GetMulticastGroup(db sqlx.Queryer, id uuid.UUID, forUpdate, localOnly bool)
 			updated_at,
 			name,
 			service_profile_id,
-			mc_app_s_key
+			mc_app_s_key,
+			mc_key
 		from
 			multicast_group
 		where
@@ -152,7 +156,8 @@ func UpdateMulticastGroup(db sqlx.Ext, mg *MulticastGroup) error {
 		set
 			updated_at = $2,
 			name = $3,
-			mc_app_s_key = $4
+			mc_app_s_key = $4,
+			mc_key = $5
 		where
 			id = $1
 	`,
@@ -160,6 +165,7 @@ func UpdateMulticastGroup(db sqlx.Ext, mg *MulticastGroup) error {
 		mg.UpdatedAt,
 		mg.Name,
 		mg.MCAppSKey,
+		mg.MCKey,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -19,6 +19,10 @@ type Organization struct {
 	Name            string    `db:"name"`
 	DisplayName     string    `db:"display_name"`
 	CanHaveGateways bool      `db:"can_have_gateways"`
+
+	// DeviceEventRetentionDays defines the number of days the device events
+	// are retained. When set to 0, the configured default is used.
+	DeviceEventRetentionDays int `db:"device_event_retention_days"`
 }
 
 // Validate validates the data of the Organization.
@@ -52,13 +56,15 @@ func CreateOrganization(db sqlx.Queryer, org *Organization) error {
 			updated_at,
 			name,
 			display_name,
-			can_have_gateways
-		) values ($1, $2, $3, $4, $5) returning id`,
+			can_have_gateways,
+			device_event_retention_days
+		) values ($1, $2, $3, $4, $5, $6) returning id`,
 		now,
 		now,
 		org.Name,
 		org.DisplayName,
 		org.CanHaveGateways,
+		org.DeviceEventRetentionDays,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -208,13 +214,15 @@ func UpdateOrganization(db sqlx.Execer, org *Organization) error {
 			name = $2,
 			display_name = $3,
 			can_have_gateways = $4,
-			updated_at = $5
+			updated_at = $5,
+			device_event_retention_days = $6
 		where id = $1`,
 		org.ID,
 		org.Name,
 		org.DisplayName,
 		org.CanHaveGateways,
 		now,
+		org.DeviceEventRetentionDays,
 	)
 
 	if err != nil {
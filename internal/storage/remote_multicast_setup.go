@@ -0,0 +1,319 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// RemoteMulticastSetupState defines the state of the remote multicast
+// setup of a device.
+type RemoteMulticastSetupState string
+
+// Available remote multicast setup states. The setup starts with the
+// McGroupSetup state, followed by the McSessionSetup state once the device
+// has acknowledged the multicast-group setup.
+const (
+	RemoteMulticastSetupMcGroupSetup   RemoteMulticastSetupState = "MC_GROUP_SETUP"
+	RemoteMulticastSetupMcSessionSetup RemoteMulticastSetupState = "MC_SESSION_SETUP"
+	RemoteMulticastSetupCompleted      RemoteMulticastSetupState = "COMPLETED"
+	RemoteMulticastSetupError          RemoteMulticastSetupState = "ERROR"
+)
+
+// RemoteMulticastSetup defines the remote multicast setup (TS005) of a
+// device within a multicast-group.
+type RemoteMulticastSetup struct {
+	DevEUI           lorawan.EUI64             `db:"dev_eui"`
+	MulticastGroupID uuid.UUID                 `db:"multicast_group_id"`
+	CreatedAt        time.Time                 `db:"created_at"`
+	UpdatedAt        time.Time                 `db:"updated_at"`
+	McGroupID        int                       `db:"mc_group_id"`
+	State            RemoteMulticastSetupState `db:"state"`
+	ErrorMessage     string                    `db:"error_message"`
+	RetryCount       int                       `db:"retry_count"`
+	RetryAfter       time.Time                 `db:"retry_after"`
+}
+
+// RemoteMulticastSetupListItem defines the remote multicast setup for
+// listing.
+type RemoteMulticastSetupListItem struct {
+	RemoteMulticastSetup
+	DeviceName string `db:"device_name"`
+}
+
+// CreateRemoteMulticastSetup creates the given remote multicast setup.
+// The multicast-group index (McGroupID) is set to the lowest index which is
+// not yet in use by the device.
+func CreateRemoteMulticastSetup(db sqlx.Ext, rms *RemoteMulticastSetup) error {
+	var ids []int
+	err := sqlx.Select(db, &ids, `
+		select
+			mc_group_id
+		from remote_multicast_setup
+		where
+			dev_eui = $1`,
+		rms.DevEUI[:],
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	rms.McGroupID = -1
+	for i := 0; i < 4 && rms.McGroupID == -1; i++ {
+		rms.McGroupID = i
+		for _, id := range ids {
+			if id == i {
+				rms.McGroupID = -1
+				break
+			}
+		}
+	}
+	if rms.McGroupID == -1 {
+		return ErrRemoteMulticastSetupNoFreeMcGroupID
+	}
+
+	now := time.Now()
+	rms.CreatedAt = now
+	rms.UpdatedAt = now
+	rms.State = RemoteMulticastSetupMcGroupSetup
+	rms.RetryAfter = now
+
+	_, err = db.Exec(`
+		insert into remote_multicast_setup (
+			dev_eui,
+			multicast_group_id,
+			created_at,
+			updated_at,
+			mc_group_id,
+			state,
+			error_message,
+			retry_count,
+			retry_after
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		rms.DevEUI[:],
+		rms.MulticastGroupID,
+		rms.CreatedAt,
+		rms.UpdatedAt,
+		rms.McGroupID,
+		rms.State,
+		rms.ErrorMessage,
+		rms.RetryCount,
+		rms.RetryAfter,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":            rms.DevEUI,
+		"multicast_group_id": rms.MulticastGroupID,
+		"mc_group_id":        rms.McGroupID,
+	}).Info("remote multicast setup created")
+
+	return nil
+}
+
+// GetRemoteMulticastSetup returns the remote multicast setup for the given
+// DevEUI and multicast-group id.
+func GetRemoteMulticastSetup(db sqlx.Queryer, devEUI lorawan.EUI64, multicastGroupID uuid.UUID, forUpdate bool) (RemoteMulticastSetup, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var rms RemoteMulticastSetup
+	err := sqlx.Get(db, &rms, `
+		select
+			*
+		from remote_multicast_setup
+		where
+			dev_eui = $1
+			and multicast_group_id = $2`+fu,
+		devEUI[:],
+		multicastGroupID,
+	)
+	if err != nil {
+		return rms, handlePSQLError(Select, err, "select error")
+	}
+
+	return rms, nil
+}
+
+// GetRemoteMulticastSetupByMcGroupID returns the remote multicast setup for
+// the given DevEUI and multicast-group index (as known by the device).
+func GetRemoteMulticastSetupByMcGroupID(db sqlx.Queryer, devEUI lorawan.EUI64, mcGroupID int, forUpdate bool) (RemoteMulticastSetup, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var rms RemoteMulticastSetup
+	err := sqlx.Get(db, &rms, `
+		select
+			*
+		from remote_multicast_setup
+		where
+			dev_eui = $1
+			and mc_group_id = $2`+fu,
+		devEUI[:],
+		mcGroupID,
+	)
+	if err != nil {
+		return rms, handlePSQLError(Select, err, "select error")
+	}
+
+	return rms, nil
+}
+
+// GetPendingRemoteMulticastSetups returns the remote multicast setups for
+// which a (re)try is pending. The returned items are locked, items locked
+// by other transactions are skipped.
+func GetPendingRemoteMulticastSetups(db sqlx.Queryer, limit int) ([]RemoteMulticastSetup, error) {
+	var items []RemoteMulticastSetup
+	err := sqlx.Select(db, &items, `
+		select
+			*
+		from remote_multicast_setup
+		where
+			state in ($1, $2)
+			and retry_after <= $3
+		order by
+			retry_after
+		limit $4
+		for update skip locked`,
+		RemoteMulticastSetupMcGroupSetup,
+		RemoteMulticastSetupMcSessionSetup,
+		time.Now(),
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// UpdateRemoteMulticastSetup updates the given remote multicast setup.
+func UpdateRemoteMulticastSetup(db sqlx.Execer, rms *RemoteMulticastSetup) error {
+	rms.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update remote_multicast_setup
+		set
+			updated_at = $3,
+			state = $4,
+			error_message = $5,
+			retry_count = $6,
+			retry_after = $7
+		where
+			dev_eui = $1
+			and multicast_group_id = $2`,
+		rms.DevEUI[:],
+		rms.MulticastGroupID,
+		rms.UpdatedAt,
+		rms.State,
+		rms.ErrorMessage,
+		rms.RetryCount,
+		rms.RetryAfter,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":            rms.DevEUI,
+		"multicast_group_id": rms.MulticastGroupID,
+		"state":              rms.State,
+	}).Info("remote multicast setup updated")
+
+	return nil
+}
+
+// DeleteRemoteMulticastSetup deletes the remote multicast setup for the
+// given DevEUI and multicast-group id.
+func DeleteRemoteMulticastSetup(db sqlx.Execer, devEUI lorawan.EUI64, multicastGroupID uuid.UUID) error {
+	res, err := db.Exec(`
+		delete from remote_multicast_setup
+		where
+			dev_eui = $1
+			and multicast_group_id = $2`,
+		devEUI[:],
+		multicastGroupID,
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":            devEUI,
+		"multicast_group_id": multicastGroupID,
+	}).Info("remote multicast setup deleted")
+
+	return nil
+}
+
+// GetRemoteMulticastSetupCount returns the number of remote multicast
+// setups for the given multicast-group.
+func GetRemoteMulticastSetupCount(db sqlx.Queryer, multicastGroupID uuid.UUID) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from remote_multicast_setup
+		where
+			multicast_group_id = $1`,
+		multicastGroupID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetRemoteMulticastSetupListItems returns the remote multicast setups for
+// the given multicast-group, respecting the given limit and offset.
+func GetRemoteMulticastSetupListItems(db sqlx.Queryer, multicastGroupID uuid.UUID, limit, offset int) ([]RemoteMulticastSetupListItem, error) {
+	var items []RemoteMulticastSetupListItem
+	err := sqlx.Select(db, &items, `
+		select
+			rms.*,
+			d.name as device_name
+		from remote_multicast_setup rms
+		inner join device d
+			on d.dev_eui = rms.dev_eui
+		where
+			rms.multicast_group_id = $1
+		order by
+			d.name
+		limit $2
+		offset $3`,
+		multicastGroupID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
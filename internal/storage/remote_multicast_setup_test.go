@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestRemoteMulticastSetup() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	var mgIDs []uuid.UUID
+	for i := 0; i < 5; i++ {
+		mg := MulticastGroup{
+			Name:  "test-mg",
+			MCKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			MulticastGroup: ns.MulticastGroup{
+				McAddr:           []byte{1, 2, 3, byte(i)},
+				GroupType:        ns.MulticastGroupType_CLASS_C,
+				ServiceProfileId: sp.ServiceProfile.Id,
+			},
+		}
+		copy(mg.ServiceProfileID[:], sp.ServiceProfile.Id)
+		assert.NoError(CreateMulticastGroup(ts.Tx(), &mg))
+
+		var mgID uuid.UUID
+		copy(mgID[:], mg.MulticastGroup.Id)
+		mgIDs = append(mgIDs, mgID)
+	}
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		rms := RemoteMulticastSetup{
+			DevEUI:           d.DevEUI,
+			MulticastGroupID: mgIDs[0],
+		}
+		assert.NoError(CreateRemoteMulticastSetup(ts.Tx(), &rms))
+		assert.Equal(0, rms.McGroupID)
+		assert.Equal(RemoteMulticastSetupMcGroupSetup, rms.State)
+
+		rms.CreatedAt = rms.CreatedAt.Round(time.Second).UTC()
+		rms.UpdatedAt = rms.UpdatedAt.Round(time.Second).UTC()
+		rms.RetryAfter = rms.RetryAfter.Round(time.Second).UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			rmsGet, err := GetRemoteMulticastSetup(ts.Tx(), d.DevEUI, mgIDs[0], false)
+			assert.NoError(err)
+
+			rmsGet.CreatedAt = rmsGet.CreatedAt.Round(time.Second).UTC()
+			rmsGet.UpdatedAt = rmsGet.UpdatedAt.Round(time.Second).UTC()
+			rmsGet.RetryAfter = rmsGet.RetryAfter.Round(time.Second).UTC()
+			assert.Equal(rms, rmsGet)
+
+			rmsGet, err = GetRemoteMulticastSetupByMcGroupID(ts.Tx(), d.DevEUI, 0, false)
+			assert.NoError(err)
+			assert.Equal(mgIDs[0], rmsGet.MulticastGroupID)
+
+			_, err = GetRemoteMulticastSetupByMcGroupID(ts.Tx(), d.DevEUI, 1, false)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+
+		t.Run("Get pending", func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetPendingRemoteMulticastSetups(ts.Tx(), 10)
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(mgIDs[0], items[0].MulticastGroupID)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetRemoteMulticastSetupCount(ts.Tx(), mgIDs[0])
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			items, err := GetRemoteMulticastSetupListItems(ts.Tx(), mgIDs[0], 10, 0)
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(d.Name, items[0].DeviceName)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			rms.State = RemoteMulticastSetupCompleted
+			rms.RetryCount = 2
+			rms.RetryAfter = time.Now().Add(time.Hour).Round(time.Second).UTC()
+			assert.NoError(UpdateRemoteMulticastSetup(ts.Tx(), &rms))
+			rms.UpdatedAt = rms.UpdatedAt.Round(time.Second).UTC()
+
+			rmsGet, err := GetRemoteMulticastSetup(ts.Tx(), d.DevEUI, mgIDs[0], false)
+			assert.NoError(err)
+			rmsGet.CreatedAt = rmsGet.CreatedAt.Round(time.Second).UTC()
+			rmsGet.UpdatedAt = rmsGet.UpdatedAt.Round(time.Second).UTC()
+			rmsGet.RetryAfter = rmsGet.RetryAfter.Round(time.Second).UTC()
+			assert.Equal(rms, rmsGet)
+
+			items, err := GetPendingRemoteMulticastSetups(ts.Tx(), 10)
+			assert.NoError(err)
+			assert.Len(items, 0)
+		})
+
+		t.Run("Multicast-group indices are exhausted", func(t *testing.T) {
+			assert := require.New(t)
+
+			for i := 1; i < 4; i++ {
+				rms := RemoteMulticastSetup{
+					DevEUI:           d.DevEUI,
+					MulticastGroupID: mgIDs[i],
+				}
+				assert.NoError(CreateRemoteMulticastSetup(ts.Tx(), &rms))
+				assert.Equal(i, rms.McGroupID)
+			}
+
+			rms := RemoteMulticastSetup{
+				DevEUI:           d.DevEUI,
+				MulticastGroupID: mgIDs[4],
+			}
+			assert.Equal(ErrRemoteMulticastSetupNoFreeMcGroupID, CreateRemoteMulticastSetup(ts.Tx(), &rms))
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteRemoteMulticastSetup(ts.Tx(), d.DevEUI, mgIDs[0]))
+			assert.Equal(ErrDoesNotExist, DeleteRemoteMulticastSetup(ts.Tx(), d.DevEUI, mgIDs[0]))
+
+			// the freed index is re-used
+			rms := RemoteMulticastSetup{
+				DevEUI:           d.DevEUI,
+				MulticastGroupID: mgIDs[4],
+			}
+			assert.NoError(CreateRemoteMulticastSetup(ts.Tx(), &rms))
+			assert.Equal(0, rms.McGroupID)
+		})
+	})
+}
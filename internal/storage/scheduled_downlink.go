@@ -0,0 +1,327 @@
+package storage
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/cron"
+	"github.com/brocaar/lorawan"
+)
+
+// minScheduledDownlinkInterval defines the minimum interval of a recurring
+// scheduled downlink.
+const minScheduledDownlinkInterval = time.Minute
+
+// ScheduledDownlink defines a downlink payload which will be enqueued for a
+// device or multicast-group at the NextRunAt timestamp. When Cron or
+// Interval is set, the downlink is recurring.
+type ScheduledDownlink struct {
+	ID               uuid.UUID      `db:"id"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+	DevEUI           *lorawan.EUI64 `db:"dev_eui"`
+	MulticastGroupID *uuid.UUID     `db:"multicast_group_id"`
+	FPort            uint8          `db:"f_port"`
+	Confirmed        bool           `db:"confirmed"`
+	Data             []byte         `db:"data"`
+	Cron             string         `db:"cron"`
+	Interval         time.Duration  `db:"interval"`
+
+	// NextRunAt holds the timestamp at which the downlink must be enqueued,
+	// it is set to nil once a non-recurring downlink has been enqueued.
+	NextRunAt *time.Time `db:"next_run_at"`
+	LastRunAt *time.Time `db:"last_run_at"`
+	LastError string     `db:"last_error"`
+}
+
+// ScheduledDownlinkFilters provides filters that can be used to filter on
+// scheduled downlinks. Note that empty values are not used as filter.
+type ScheduledDownlinkFilters struct {
+	DevEUI           lorawan.EUI64 `db:"dev_eui"`
+	MulticastGroupID uuid.UUID     `db:"multicast_group_id"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f ScheduledDownlinkFilters) SQL() string {
+	var filters []string
+
+	if f.DevEUI != (lorawan.EUI64{}) {
+		filters = append(filters, "dev_eui = :dev_eui")
+	}
+
+	if f.MulticastGroupID != uuid.Nil {
+		filters = append(filters, "multicast_group_id = :multicast_group_id")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// Validate validates the scheduled downlink data.
+func (d ScheduledDownlink) Validate() error {
+	if (d.DevEUI == nil) == (d.MulticastGroupID == nil) {
+		return ErrScheduledDownlinkInvalidTarget
+	}
+	if d.FPort < 1 || d.FPort > 223 {
+		return ErrScheduledDownlinkInvalidFPort
+	}
+	if d.MulticastGroupID != nil && d.Confirmed {
+		return ErrScheduledDownlinkConfirmedMulticast
+	}
+	if d.Cron != "" && d.Interval != 0 {
+		return ErrScheduledDownlinkInvalidRecurrence
+	}
+	if d.Interval < 0 || (d.Interval > 0 && d.Interval < minScheduledDownlinkInterval) {
+		return ErrScheduledDownlinkInvalidInterval
+	}
+	if d.Cron != "" {
+		s, err := cron.Parse(d.Cron)
+		if err != nil || s.Next(time.Now()).IsZero() {
+			return ErrScheduledDownlinkInvalidCron
+		}
+	}
+	return nil
+}
+
+// NextRunAfter returns the next run of a recurring scheduled downlink after
+// the given time, or nil when the downlink is not recurring. For an interval
+// the next run is aligned with the current NextRunAt, so that delays in
+// processing do not result in a drifting schedule.
+func (d ScheduledDownlink) NextRunAfter(t time.Time) *time.Time {
+	switch {
+	case d.Cron != "":
+		s, err := cron.Parse(d.Cron)
+		if err != nil {
+			return nil
+		}
+		next := s.Next(t)
+		if next.IsZero() {
+			return nil
+		}
+		return &next
+	case d.Interval > 0:
+		next := t.Add(d.Interval)
+		if d.NextRunAt != nil && !d.NextRunAt.After(t) {
+			n := t.Sub(*d.NextRunAt)/d.Interval + 1
+			next = d.NextRunAt.Add(n * d.Interval)
+		}
+		return &next
+	default:
+		return nil
+	}
+}
+
+// CreateScheduledDownlink creates the given scheduled downlink.
+func CreateScheduledDownlink(db sqlx.Execer, d *ScheduledDownlink) error {
+	if err := d.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid v4 error")
+	}
+
+	now := time.Now()
+	d.ID = id
+	d.CreatedAt = now
+	d.UpdatedAt = now
+
+	_, err = db.Exec(`
+		insert into scheduled_downlink (
+			id,
+			created_at,
+			updated_at,
+			dev_eui,
+			multicast_group_id,
+			f_port,
+			confirmed,
+			data,
+			cron,
+			interval,
+			next_run_at,
+			last_run_at,
+			last_error
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		d.ID,
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.DevEUI,
+		d.MulticastGroupID,
+		d.FPort,
+		d.Confirmed,
+		d.Data,
+		d.Cron,
+		d.Interval,
+		d.NextRunAt,
+		d.LastRunAt,
+		d.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithField("id", d.ID).Info("scheduled downlink created")
+
+	return nil
+}
+
+// GetScheduledDownlink returns the scheduled downlink for the given id.
+// When forUpdate is set to true, the row will be locked.
+func GetScheduledDownlink(db sqlx.Queryer, id uuid.UUID, forUpdate bool) (ScheduledDownlink, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var d ScheduledDownlink
+	err := sqlx.Get(db, &d, "select * from scheduled_downlink where id = $1"+fu, id)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+
+	return d, nil
+}
+
+// GetPendingScheduledDownlink returns a scheduled downlink which must be
+// enqueued, or nil when there is no such downlink. The returned downlink is
+// locked, downlinks locked by other transactions are skipped.
+func GetPendingScheduledDownlink(db sqlx.Queryer) (*ScheduledDownlink, error) {
+	var d ScheduledDownlink
+	err := sqlx.Get(db, &d, `
+		select
+			*
+		from scheduled_downlink
+		where
+			next_run_at <= $1
+		order by
+			next_run_at
+		limit 1
+		for update skip locked`,
+		time.Now(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return &d, nil
+}
+
+// GetScheduledDownlinkCount returns the number of scheduled downlinks
+// matching the given filters.
+func GetScheduledDownlinkCount(db sqlx.Queryer, filters ScheduledDownlinkFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from scheduled_downlink
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetScheduledDownlinks returns the scheduled downlinks matching the given
+// filters, ordered by their next run.
+func GetScheduledDownlinks(db sqlx.Queryer, filters ScheduledDownlinkFilters) ([]ScheduledDownlink, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from scheduled_downlink
+		`+filters.SQL()+`
+		order by
+			next_run_at nulls last,
+			created_at
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var items []ScheduledDownlink
+	err = sqlx.Select(db, &items, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// UpdateScheduledDownlink updates the run state (next run, last run and
+// last error) of the given scheduled downlink.
+func UpdateScheduledDownlink(db sqlx.Execer, d *ScheduledDownlink) error {
+	d.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update scheduled_downlink
+		set
+			updated_at = $2,
+			next_run_at = $3,
+			last_run_at = $4,
+			last_error = $5
+		where
+			id = $1`,
+		d.ID,
+		d.UpdatedAt,
+		d.NextRunAt,
+		d.LastRunAt,
+		d.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// DeleteScheduledDownlink deletes the scheduled downlink matching the given
+// id.
+func DeleteScheduledDownlink(db sqlx.Execer, id uuid.UUID) error {
+	res, err := db.Exec("delete from scheduled_downlink where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("scheduled downlink deleted")
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestScheduledDownlinkNextRunAfter(t *testing.T) {
+	now := time.Date(2019, 1, 17, 10, 12, 30, 0, time.UTC)
+	past := now.Add(-150 * time.Second)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		Name      string
+		Downlink  ScheduledDownlink
+		NextRunAt *time.Time
+	}{
+		{
+			Name:     "not recurring",
+			Downlink: ScheduledDownlink{NextRunAt: &past},
+		},
+		{
+			Name:      "cron",
+			Downlink:  ScheduledDownlink{Cron: "*/15 * * * *", NextRunAt: &past},
+			NextRunAt: timePtr(time.Date(2019, 1, 17, 10, 15, 0, 0, time.UTC)),
+		},
+		{
+			Name:      "interval is aligned with the previous run",
+			Downlink:  ScheduledDownlink{Interval: time.Minute, NextRunAt: &past},
+			NextRunAt: timePtr(past.Add(3 * time.Minute)),
+		},
+		{
+			Name:      "interval without previous run",
+			Downlink:  ScheduledDownlink{Interval: time.Minute},
+			NextRunAt: timePtr(now.Add(time.Minute)),
+		},
+		{
+			Name:      "interval with future run",
+			Downlink:  ScheduledDownlink{Interval: time.Minute, NextRunAt: &future},
+			NextRunAt: timePtr(now.Add(time.Minute)),
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(tst.NextRunAt, tst.Downlink.NextRunAfter(now))
+		})
+	}
+}
+
+func (ts *StorageTestSuite) TestScheduledDownlink() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	mg := MulticastGroup{
+		Name: "test-mg",
+	}
+	copy(mg.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateMulticastGroup(ts.Tx(), &mg))
+	var mgID uuid.UUID
+	copy(mgID[:], mg.MulticastGroup.Id)
+
+	ts.T().Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Downlink      ScheduledDownlink
+			ExpectedError error
+		}{
+			{
+				Name:          "no target",
+				Downlink:      ScheduledDownlink{FPort: 10},
+				ExpectedError: ErrScheduledDownlinkInvalidTarget,
+			},
+			{
+				Name:          "device and multicast-group",
+				Downlink:      ScheduledDownlink{DevEUI: &d.DevEUI, MulticastGroupID: &mgID, FPort: 10},
+				ExpectedError: ErrScheduledDownlinkInvalidTarget,
+			},
+			{
+				Name:          "invalid fPort",
+				Downlink:      ScheduledDownlink{DevEUI: &d.DevEUI},
+				ExpectedError: ErrScheduledDownlinkInvalidFPort,
+			},
+			{
+				Name:          "confirmed multicast",
+				Downlink:      ScheduledDownlink{MulticastGroupID: &mgID, FPort: 10, Confirmed: true},
+				ExpectedError: ErrScheduledDownlinkConfirmedMulticast,
+			},
+			{
+				Name:          "cron and interval",
+				Downlink:      ScheduledDownlink{DevEUI: &d.DevEUI, FPort: 10, Cron: "* * * * *", Interval: time.Hour},
+				ExpectedError: ErrScheduledDownlinkInvalidRecurrence,
+			},
+			{
+				Name:          "interval too short",
+				Downlink:      ScheduledDownlink{DevEUI: &d.DevEUI, FPort: 10, Interval: time.Second},
+				ExpectedError: ErrScheduledDownlinkInvalidInterval,
+			},
+			{
+				Name:          "invalid cron",
+				Downlink:      ScheduledDownlink{DevEUI: &d.DevEUI, FPort: 10, Cron: "* * *"},
+				ExpectedError: ErrScheduledDownlinkInvalidCron,
+			},
+			{
+				Name:     "valid",
+				Downlink: ScheduledDownlink{DevEUI: &d.DevEUI, FPort: 10, Cron: "0 6 * * *"},
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				assert := require.New(t)
+				assert.Equal(tst.ExpectedError, tst.Downlink.Validate())
+			})
+		}
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+
+		downlinks := []ScheduledDownlink{
+			{DevEUI: &d.DevEUI, FPort: 10, Confirmed: true, Data: []byte{1, 2, 3}, NextRunAt: &past},
+			{DevEUI: &d.DevEUI, FPort: 20, Data: []byte{4, 5, 6}, Interval: time.Hour, NextRunAt: &future},
+			{MulticastGroupID: &mgID, FPort: 30, Data: []byte{7, 8, 9}, Cron: "0 6 * * *", NextRunAt: &future},
+		}
+		for i := range downlinks {
+			assert.NoError(CreateScheduledDownlink(ts.Tx(), &downlinks[i]))
+			assert.NotEqual(uuid.Nil, downlinks[i].ID)
+		}
+
+		assert.Equal(ErrScheduledDownlinkInvalidTarget, errors.Cause(CreateScheduledDownlink(ts.Tx(), &ScheduledDownlink{FPort: 10})))
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			dl, err := GetScheduledDownlink(ts.Tx(), downlinks[1].ID, false)
+			assert.NoError(err)
+
+			assert.Equal(d.DevEUI, *dl.DevEUI)
+			assert.Nil(dl.MulticastGroupID)
+			assert.EqualValues(20, dl.FPort)
+			assert.Equal([]byte{4, 5, 6}, dl.Data)
+			assert.Equal(time.Hour, dl.Interval)
+			assert.True(future.Round(time.Millisecond).Equal(dl.NextRunAt.Round(time.Millisecond)))
+			assert.Nil(dl.LastRunAt)
+		})
+
+		t.Run("Get pending", func(t *testing.T) {
+			assert := require.New(t)
+
+			dl, err := GetPendingScheduledDownlink(ts.Tx())
+			assert.NoError(err)
+			assert.NotNil(dl)
+			assert.Equal(downlinks[0].ID, dl.ID)
+
+			t.Run("Update", func(t *testing.T) {
+				assert := require.New(t)
+
+				now := time.Now()
+				dl.LastRunAt = &now
+				dl.NextRunAt = nil
+				dl.LastError = "enqueue error"
+				assert.NoError(UpdateScheduledDownlink(ts.Tx(), dl))
+
+				dlGet, err := GetScheduledDownlink(ts.Tx(), dl.ID, false)
+				assert.NoError(err)
+				assert.Nil(dlGet.NextRunAt)
+				assert.True(now.Round(time.Millisecond).Equal(dlGet.LastRunAt.Round(time.Millisecond)))
+				assert.Equal("enqueue error", dlGet.LastError)
+
+				dl, err := GetPendingScheduledDownlink(ts.Tx())
+				assert.NoError(err)
+				assert.Nil(dl)
+			})
+		})
+
+		t.Run("List", func(t *testing.T) {
+			tests := []struct {
+				Name    string
+				Filters ScheduledDownlinkFilters
+				Count   int
+				IDs     []uuid.UUID
+			}{
+				{
+					Name:    "device",
+					Filters: ScheduledDownlinkFilters{DevEUI: d.DevEUI, Limit: 10},
+					Count:   2,
+					IDs:     []uuid.UUID{downlinks[1].ID, downlinks[0].ID},
+				},
+				{
+					Name:    "multicast-group",
+					Filters: ScheduledDownlinkFilters{MulticastGroupID: mgID, Limit: 10},
+					Count:   1,
+					IDs:     []uuid.UUID{downlinks[2].ID},
+				},
+				{
+					Name:    "limit and offset",
+					Filters: ScheduledDownlinkFilters{DevEUI: d.DevEUI, Limit: 1, Offset: 1},
+					Count:   2,
+					IDs:     []uuid.UUID{downlinks[0].ID},
+				},
+			}
+
+			for _, tst := range tests {
+				t.Run(tst.Name, func(t *testing.T) {
+					assert := require.New(t)
+
+					count, err := GetScheduledDownlinkCount(ts.Tx(), tst.Filters)
+					assert.NoError(err)
+					assert.Equal(tst.Count, count)
+
+					items, err := GetScheduledDownlinks(ts.Tx(), tst.Filters)
+					assert.NoError(err)
+
+					var ids []uuid.UUID
+					for _, item := range items {
+						ids = append(ids, item.ID)
+					}
+					assert.Equal(tst.IDs, ids)
+				})
+			}
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteScheduledDownlink(ts.Tx(), downlinks[0].ID))
+			assert.Equal(ErrDoesNotExist, DeleteScheduledDownlink(ts.Tx(), downlinks[0].ID))
+
+			_, err := GetScheduledDownlink(ts.Tx(), downlinks[0].ID, false)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+	})
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
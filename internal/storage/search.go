@@ -48,7 +48,7 @@ func GlobalSearch(db sqlx.Queryer, username string, globalAdmin bool, search str
 			on u.id = ou.user_id
 		where
 			($3 = true or u.username = $4)
-			and (d.name ilike $2 or encode(d.dev_eui, 'hex') ilike $2)
+			and (d.name ilike $2 or encode(d.dev_eui, 'hex') ilike $2 or exists (select 1 from each(d.tags) where key ilike $2 or value ilike $2))
 		union
 		select
 			'gateway' as kind,
@@ -1,9 +1,11 @@
 package storage
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
 
 	"github.com/brocaar/lorawan"
 
@@ -81,6 +83,11 @@ func TestSearch(t *testing.T) {
 			Name:            "test-device",
 			ApplicationID:   a.ID,
 			DeviceProfileID: dpID,
+			Tags: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"building": sql.NullString{String: "b-42", Valid: true},
+				},
+			},
 		}
 		So(CreateDevice(db, &d), ShouldBeNil)
 
@@ -92,6 +99,7 @@ func TestSearch(t *testing.T) {
 				"010203",
 				"020304",
 				"device",
+				"b-42",
 			}
 
 			for _, q := range queries {
@@ -103,14 +111,17 @@ func TestSearch(t *testing.T) {
 
 		Convey("When the user is global admin, this returns results", func() {
 			queries := map[string]int{
-				"test":   4,
-				"org":    1,
-				"app":    1,
-				"010203": 1,
-				"020304": 2,
-				"device": 1,
-				"dev":    1,
-				"gatew":  1,
+				"test":     4,
+				"org":      1,
+				"app":      1,
+				"010203":   1,
+				"020304":   2,
+				"device":   1,
+				"dev":      1,
+				"gatew":    1,
+				"b-42":     1,
+				"building": 1,
+				"=>":       0,
 			}
 
 			for q, c := range queries {
@@ -132,6 +143,7 @@ func TestSearch(t *testing.T) {
 				"device": 1,
 				"dev":    1,
 				"gatew":  1,
+				"b-42":   1,
 			}
 
 			for q, c := range queries {
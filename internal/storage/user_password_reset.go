@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// passwordResetTokenSize defines the size (in bytes) of the password reset
+// token.
+const passwordResetTokenSize = 32
+
+// CreatePasswordResetToken creates a password reset token for the given
+// user, valid for the given duration. Only the hash of the token is stored,
+// the (hex encoded) token itself is returned.
+func CreatePasswordResetToken(db sqlx.Execer, userID int64, ttl time.Duration) (string, error) {
+	b := make([]byte, passwordResetTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	token := hex.EncodeToString(b)
+
+	// cleanup the expired tokens
+	_, err := db.Exec("delete from user_password_reset where expires_at < now()")
+	if err != nil {
+		return "", handlePSQLError(Delete, err, "delete error")
+	}
+
+	now := time.Now()
+	_, err = db.Exec(`
+		insert into user_password_reset (
+			token_hash,
+			user_id,
+			created_at,
+			expires_at
+		) values ($1, $2, $3, $4)`,
+		passwordResetTokenHash(token),
+		userID,
+		now,
+		now.Add(ttl),
+	)
+	if err != nil {
+		return "", handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"user_id": userID,
+	}).Info("password reset token created")
+
+	return token, nil
+}
+
+// ResetPassword sets the password of the user to which the given password
+// reset token belongs. On success, all password reset tokens of the user
+// are deleted.
+func ResetPassword(db sqlx.Ext, token, password string) error {
+	var userID int64
+	err := sqlx.Get(db, &userID, `
+		select
+			user_id
+		from user_password_reset
+		where
+			token_hash = $1
+			and expires_at > now()
+		for update`,
+		passwordResetTokenHash(token),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrPasswordResetInvalidToken
+		}
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	if err := UpdatePassword(db, userID, password); err != nil {
+		return errors.Wrap(err, "update password error")
+	}
+
+	_, err = db.Exec("delete from user_password_reset where user_id = $1", userID)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+
+	log.WithFields(log.Fields{
+		"user_id": userID,
+	}).Info("user password reset")
+
+	return nil
+}
+
+func passwordResetTokenHash(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *StorageTestSuite) TestPasswordReset() {
+	assert := require.New(ts.T())
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "foo@example.com",
+	}
+	_, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+
+	ts.T().Run("Expired token", func(t *testing.T) {
+		assert := require.New(t)
+
+		token, err := CreatePasswordResetToken(ts.Tx(), user.ID, -time.Minute)
+		assert.NoError(err)
+		assert.Equal(ErrPasswordResetInvalidToken, ResetPassword(ts.Tx(), token, "newpassword"))
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		token, err := CreatePasswordResetToken(ts.Tx(), user.ID, time.Hour)
+		assert.NoError(err)
+		assert.Len(token, passwordResetTokenSize*2)
+
+		t.Run("Reset with invalid token", func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(ErrPasswordResetInvalidToken, ResetPassword(ts.Tx(), "foo", "newpassword"))
+		})
+
+		t.Run("Reset", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(ResetPassword(ts.Tx(), token, "newpassword"))
+
+			_, err := LoginUser(ts.Tx(), user.Username, "newpassword")
+			assert.NoError(err)
+
+			t.Run("Token can not be reused", func(t *testing.T) {
+				assert := require.New(t)
+				assert.Equal(ErrPasswordResetInvalidToken, ResetPassword(ts.Tx(), token, "otherpassword"))
+			})
+		})
+	})
+}
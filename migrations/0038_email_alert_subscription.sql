@@ -0,0 +1,16 @@
+-- +migrate Up
+create table email_alert_subscription (
+    user_id bigint not null references "user" on delete cascade,
+    organization_id bigint not null references organization on delete cascade,
+    alert_type varchar(50) not null,
+    created_at timestamp with time zone not null,
+
+    primary key(user_id, organization_id, alert_type)
+);
+
+create index idx_email_alert_subscription_organization_id on email_alert_subscription(organization_id);
+
+-- +migrate Down
+drop index idx_email_alert_subscription_organization_id;
+
+drop table email_alert_subscription;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table device_profile
+    add column payload_codec text not null default '',
+    add column payload_encoder_script text not null default '',
+    add column payload_decoder_script text not null default '';
+
+-- +migrate Down
+alter table device_profile
+    drop column payload_codec,
+    drop column payload_encoder_script,
+    drop column payload_decoder_script;
@@ -0,0 +1,36 @@
+-- +migrate Up
+create table application_payload_codec_revision (
+    application_id bigint not null references application on delete cascade,
+    revision integer not null,
+    created_at timestamp with time zone not null,
+    username varchar(100) not null,
+    payload_codec text not null,
+    payload_encoder_script text not null,
+    payload_decoder_script text not null,
+
+    primary key(application_id, revision)
+);
+
+-- store the current payload codec of each application as first revision
+insert into application_payload_codec_revision (
+    application_id,
+    revision,
+    created_at,
+    username,
+    payload_codec,
+    payload_encoder_script,
+    payload_decoder_script
+)
+select
+    id,
+    1,
+    now(),
+    '',
+    payload_codec,
+    payload_encoder_script,
+    payload_decoder_script
+from
+    application;
+
+-- +migrate Down
+drop table application_payload_codec_revision;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column variables hstore;
+
+-- +migrate Down
+alter table device
+    drop column variables;
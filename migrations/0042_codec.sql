@@ -0,0 +1,31 @@
+-- +migrate Up
+create table codec (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    organization_id bigint not null references organization on delete cascade,
+    name varchar(100) not null,
+    description text not null,
+    version bigint not null,
+    payload_codec text not null,
+    payload_encoder_script text not null,
+    payload_decoder_script text not null,
+
+    unique(organization_id, name)
+);
+
+create index idx_codec_organization_id on codec(organization_id);
+
+alter table application
+    add column codec_id bigint references codec on delete restrict;
+
+create index idx_application_codec_id on application(codec_id);
+
+-- +migrate Down
+drop index idx_application_codec_id;
+
+alter table application
+    drop column codec_id;
+
+drop index idx_codec_organization_id;
+drop table codec;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+    add column senml_output boolean not null default false;
+
+-- +migrate Down
+alter table application
+    drop column senml_output;
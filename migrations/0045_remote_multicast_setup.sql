@@ -0,0 +1,42 @@
+-- +migrate Up
+alter table device_keys
+    add column gen_app_key bytea not null default decode('00000000000000000000000000000000', 'hex');
+
+alter table device_keys
+    alter column gen_app_key drop default;
+
+alter table multicast_group
+    add column mc_key bytea not null default decode('00000000000000000000000000000000', 'hex');
+
+alter table multicast_group
+    alter column mc_key drop default;
+
+create table remote_multicast_setup (
+    dev_eui bytea not null references device on delete cascade,
+    multicast_group_id uuid not null references multicast_group on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    mc_group_id smallint not null,
+    state varchar(20) not null,
+    error_message text not null,
+    retry_count smallint not null,
+    retry_after timestamp with time zone not null,
+
+    primary key(dev_eui, multicast_group_id)
+);
+
+create unique index idx_remote_multicast_setup_dev_eui_mc_group_id on remote_multicast_setup(dev_eui, mc_group_id);
+create index idx_remote_multicast_setup_multicast_group_id on remote_multicast_setup(multicast_group_id);
+create index idx_remote_multicast_setup_state_retry_after on remote_multicast_setup(state, retry_after);
+
+-- +migrate Down
+drop index idx_remote_multicast_setup_state_retry_after;
+drop index idx_remote_multicast_setup_multicast_group_id;
+drop index idx_remote_multicast_setup_dev_eui_mc_group_id;
+drop table remote_multicast_setup;
+
+alter table multicast_group
+    drop column mc_key;
+
+alter table device_keys
+    drop column gen_app_key;
@@ -0,0 +1,14 @@
+-- +migrate Up
+create table device_clock_sync (
+    dev_eui bytea primary key references device on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    last_sync_at timestamp with time zone,
+    time_correction integer not null,
+    clock_drift double precision not null,
+    requested_periodicity smallint,
+    periodicity smallint
+);
+
+-- +migrate Down
+drop table device_clock_sync;
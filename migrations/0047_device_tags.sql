@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column tags hstore;
+
+-- +migrate Down
+alter table device
+    drop column tags;
@@ -0,0 +1,22 @@
+-- +migrate Up
+create table device_event (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    dev_eui bytea not null,
+    type varchar(20) not null,
+    payload jsonb not null
+);
+
+create index idx_device_event_dev_eui_created_at on device_event(dev_eui, created_at);
+create index idx_device_event_created_at on device_event(created_at);
+
+alter table organization
+    add column device_event_retention_days integer not null default 0;
+
+-- +migrate Down
+alter table organization
+    drop column device_event_retention_days;
+
+drop index idx_device_event_created_at;
+drop index idx_device_event_dev_eui_created_at;
+drop table device_event;
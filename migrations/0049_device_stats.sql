@@ -0,0 +1,20 @@
+-- +migrate Up
+create table device_stats (
+    dev_eui bytea not null references device on delete cascade,
+    interval varchar(10) not null,
+    timestamp timestamp with time zone not null,
+    rx_packets integer not null,
+    lost_packets integer not null,
+    f_cnt_last bigint not null,
+    rssi_max integer not null,
+    rssi_sum bigint not null,
+    snr_max double precision not null,
+    snr_sum double precision not null,
+    rx_packets_per_sf hstore,
+    rx_packets_per_gateway hstore,
+
+    primary key (dev_eui, interval, timestamp)
+);
+
+-- +migrate Down
+drop table device_stats;
@@ -0,0 +1,17 @@
+-- +migrate Up
+create table device_location (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    dev_eui bytea not null references device on delete cascade,
+    latitude double precision not null,
+    longitude double precision not null,
+    altitude double precision not null,
+    source varchar(20) not null,
+    accuracy integer not null
+);
+
+create index idx_device_location_dev_eui_created_at on device_location(dev_eui, created_at);
+
+-- +migrate Down
+drop index idx_device_location_dev_eui_created_at;
+drop table device_location;
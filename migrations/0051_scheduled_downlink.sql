@@ -0,0 +1,28 @@
+-- +migrate Up
+create table scheduled_downlink (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    dev_eui bytea references device on delete cascade,
+    multicast_group_id uuid references multicast_group on delete cascade,
+    f_port smallint not null,
+    confirmed boolean not null,
+    data bytea,
+    cron varchar(100) not null,
+    interval bigint not null,
+    next_run_at timestamp with time zone,
+    last_run_at timestamp with time zone,
+    last_error text not null,
+
+    check ((dev_eui is null) != (multicast_group_id is null))
+);
+
+create index idx_scheduled_downlink_dev_eui on scheduled_downlink(dev_eui);
+create index idx_scheduled_downlink_multicast_group_id on scheduled_downlink(multicast_group_id);
+create index idx_scheduled_downlink_next_run_at on scheduled_downlink(next_run_at);
+
+-- +migrate Down
+drop index idx_scheduled_downlink_next_run_at;
+drop index idx_scheduled_downlink_multicast_group_id;
+drop index idx_scheduled_downlink_dev_eui;
+drop table scheduled_downlink;
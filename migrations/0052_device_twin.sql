@@ -0,0 +1,15 @@
+-- +migrate Up
+create table device_twin (
+    dev_eui bytea primary key references device on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    f_port smallint not null,
+    desired jsonb not null,
+    reported jsonb not null,
+    reported_at timestamp with time zone,
+    sync_at timestamp with time zone,
+    uplinks_since_sync integer not null
+);
+
+-- +migrate Down
+drop table device_twin;
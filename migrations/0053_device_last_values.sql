@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column last_values jsonb not null default '{}';
+
+-- +migrate Down
+alter table device
+    drop column last_values;
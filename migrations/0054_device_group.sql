@@ -0,0 +1,56 @@
+-- +migrate Up
+create table device_group (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    application_id bigint not null references application on delete cascade,
+    name varchar(100) not null,
+    description text not null,
+    dynamic boolean not null,
+    filter_tags hstore,
+    filter_device_profile_id uuid references device_profile on delete cascade
+);
+
+create index idx_device_group_application_id on device_group(application_id);
+
+create table device_group_device (
+    device_group_id uuid not null references device_group on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    created_at timestamp with time zone not null,
+
+    primary key (device_group_id, dev_eui)
+);
+
+create index idx_device_group_device_dev_eui on device_group_device(dev_eui);
+
+create table device_group_job (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    device_group_id uuid not null references device_group on delete cascade,
+    action varchar(30) not null,
+    f_port smallint not null,
+    confirmed boolean not null,
+    data bytea,
+    device_profile_id uuid references device_profile on delete cascade,
+    multicast_group_id uuid references multicast_group on delete cascade,
+    status varchar(10) not null,
+    total_count integer not null,
+    processed_count integer not null,
+    error_count integer not null,
+    last_error text not null,
+    last_dev_eui bytea,
+    completed_at timestamp with time zone
+);
+
+create index idx_device_group_job_device_group_id on device_group_job(device_group_id);
+create index idx_device_group_job_status on device_group_job(status);
+
+-- +migrate Down
+drop index idx_device_group_job_status;
+drop index idx_device_group_job_device_group_id;
+drop table device_group_job;
+drop index idx_device_group_device_dev_eui;
+drop table device_group_device;
+drop index idx_device_group_application_id;
+drop table device_group;
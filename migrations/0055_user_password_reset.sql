@@ -0,0 +1,16 @@
+-- +migrate Up
+create table user_password_reset (
+    token_hash bytea primary key,
+    user_id bigint not null references "user" on delete cascade,
+    created_at timestamp with time zone not null,
+    expires_at timestamp with time zone not null
+);
+
+create index idx_user_password_reset_user_id on user_password_reset(user_id);
+create index idx_user_password_reset_expires_at on user_password_reset(expires_at);
+
+-- +migrate Down
+drop index idx_user_password_reset_expires_at;
+drop index idx_user_password_reset_user_id;
+
+drop table user_password_reset;
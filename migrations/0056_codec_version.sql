@@ -0,0 +1,41 @@
+-- +migrate Up
+create table codec_version (
+    codec_id bigint not null references codec on delete cascade,
+    version bigint not null,
+    created_at timestamp with time zone not null,
+    payload_codec text not null,
+    payload_encoder_script text not null,
+    payload_decoder_script text not null,
+
+    primary key(codec_id, version)
+);
+
+-- store the current version of each codec
+insert into codec_version (
+    codec_id,
+    version,
+    created_at,
+    payload_codec,
+    payload_encoder_script,
+    payload_decoder_script
+)
+select
+    id,
+    version,
+    updated_at,
+    payload_codec,
+    payload_encoder_script,
+    payload_decoder_script
+from
+    codec;
+
+alter table application
+    add column codec_version bigint,
+    add constraint fk_application_codec_version foreign key (codec_id, codec_version) references codec_version on delete restrict;
+
+-- +migrate Down
+alter table application
+    drop constraint fk_application_codec_version,
+    drop column codec_version;
+
+drop table codec_version;
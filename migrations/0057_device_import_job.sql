@@ -0,0 +1,31 @@
+-- +migrate Up
+create table device_import_job (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    application_id bigint not null references application on delete cascade,
+    records jsonb,
+    status varchar(10) not null,
+    total_count integer not null,
+    processed_count integer not null,
+    imported_count integer not null,
+    completed_at timestamp with time zone
+);
+
+create index idx_device_import_job_application_id on device_import_job(application_id);
+create index idx_device_import_job_status on device_import_job(status);
+
+create table device_import_job_error (
+    device_import_job_id uuid not null references device_import_job on delete cascade,
+    file_row integer not null,
+    dev_eui bytea not null,
+    error text not null,
+
+    primary key (device_import_job_id, file_row)
+);
+
+-- +migrate Down
+drop table device_import_job_error;
+drop index idx_device_import_job_status;
+drop index idx_device_import_job_application_id;
+drop table device_import_job;
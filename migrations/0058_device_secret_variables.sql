@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column secret_variables hstore;
+
+-- +migrate Down
+alter table device
+    drop column secret_variables;
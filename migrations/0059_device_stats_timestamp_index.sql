@@ -0,0 +1,5 @@
+-- +migrate Up
+create index idx_device_stats_interval_timestamp on device_stats(interval, timestamp);
+
+-- +migrate Down
+drop index idx_device_stats_interval_timestamp;
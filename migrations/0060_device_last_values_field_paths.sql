@@ -0,0 +1,18 @@
+-- +migrate Up
+-- last values are stored by field path, remove the values of nested objects
+-- which were stored by their top-level key
+update device
+set
+    last_values = (
+        select coalesce(jsonb_object_agg(key, value), '{}')
+        from jsonb_each(last_values)
+        where jsonb_typeof(value->'value') != 'object'
+    )
+where
+    exists (
+        select 1
+        from jsonb_each(last_values)
+        where jsonb_typeof(value->'value') = 'object'
+    );
+
+-- +migrate Down
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table gateway
+    add column last_seen_at timestamp with time zone;
+
+-- +migrate Down
+alter table gateway
+    drop column last_seen_at;
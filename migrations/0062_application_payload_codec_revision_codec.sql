@@ -0,0 +1,9 @@
+-- +migrate Up
+alter table application_payload_codec_revision
+    add column codec_id bigint,
+    add column codec_version bigint;
+
+-- +migrate Down
+alter table application_payload_codec_revision
+    drop column codec_version,
+    drop column codec_id;
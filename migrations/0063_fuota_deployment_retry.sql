@@ -0,0 +1,17 @@
+-- +migrate Up
+alter table fuota_deployment
+    add column retry_count integer not null default 0,
+    add column error_message text not null default '',
+    add column nb_frag_enqueued integer not null default 0;
+
+alter table fuota_deployment_device
+    add column enqueued_state varchar(20) not null default '';
+
+-- +migrate Down
+alter table fuota_deployment_device
+    drop column enqueued_state;
+
+alter table fuota_deployment
+    drop column nb_frag_enqueued,
+    drop column error_message,
+    drop column retry_count;
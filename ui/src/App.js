@@ -52,6 +52,11 @@ import ListDeviceProfiles from "./views/device-profiles/ListDeviceProfiles";
 import CreateDeviceProfile from "./views/device-profiles/CreateDeviceProfile";
 import DeviceProfileLayout from "./views/device-profiles/DeviceProfileLayout";
 
+// codecs
+import ListCodecs from "./views/codecs/ListCodecs";
+import CreateCodec from "./views/codecs/CreateCodec";
+import CodecLayout from "./views/codecs/CodecLayout";
+
 // gateways
 import ListGateways from "./views/gateways/ListGateways";
 import GatewayLayout from "./views/gateways/GatewayLayout";
@@ -183,6 +188,10 @@ class App extends Component {
                     <Route exact path="/organizations/:organizationID(\d+)/device-profiles/create" component={CreateDeviceProfile} />
                     <Route path="/organizations/:organizationID(\d+)/device-profiles/:deviceProfileID([\w-]{36})" component={DeviceProfileLayout} />
 
+                    <Route exact path="/organizations/:organizationID(\d+)/codecs" component={ListCodecs} />
+                    <Route exact path="/organizations/:organizationID(\d+)/codecs/create" component={CreateCodec} />
+                    <Route path="/organizations/:organizationID(\d+)/codecs/:codecID(\d+)" component={CodecLayout} />
+
                     <Route exact path="/organizations/:organizationID(\d+)/gateways" component={ListGateways} />
                     <Route exact path="/organizations/:organizationID(\d+)/gateways/create" component={CreateGateway} />
                     <Route path="/organizations/:organizationID(\d+)/gateways/:gatewayID([\w]{16})" component={GatewayLayout} />
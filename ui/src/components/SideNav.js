@@ -18,6 +18,7 @@ import Tune from "mdi-material-ui/Tune";
 import Settings from "mdi-material-ui/Settings";
 import Rss from "mdi-material-ui/Rss";
 import AccountDetails from "mdi-material-ui/AccountDetails";
+import CodeBraces from "mdi-material-ui/CodeBraces";
 
 import AutocompleteSelect from "./AutocompleteSelect";
 import SessionStore from "../stores/SessionStore";
@@ -223,6 +224,12 @@ class SideNav extends Component {
             </ListItemIcon>
             <ListItemText primary="Device-profiles" />
           </ListItem>
+          <ListItem button component={Link} to={`/organizations/${this.state.organization.id}/codecs`}>
+            <ListItemIcon>
+              <CodeBraces />
+            </ListItemIcon>
+            <ListItemText primary="Codecs" />
+          </ListItem>
           {this.state.organization.canHaveGateways && <ListItem button component={Link} to={`/organizations/${this.state.organization.id}/gateways`}>
             <ListItemIcon>
               <RadioTower />
@@ -0,0 +1,103 @@
+import { EventEmitter } from "events";
+
+import Swagger from "swagger-client";
+
+import sessionStore from "./SessionStore";
+import {checkStatus, errorHandler } from "./helpers";
+import dispatcher from "../dispatcher";
+
+
+class CodecStore extends EventEmitter {
+  constructor() {
+    super();
+    this.swagger = new Swagger("/swagger/codec.swagger.json", sessionStore.getClientOpts())
+  }
+
+  create(codec, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.CodecService.Create({
+        body: {
+          codec: codec,
+        },
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("created");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  get(id, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.CodecService.Get({
+        id: id,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  update(codec, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.CodecService.Update({
+        "codec.id": codec.id,
+        body: {
+          codec: codec,
+        },
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("updated");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  delete(id, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.CodecService.Delete({
+        id: id,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("deleted");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  list(organizationID, limit, offset, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.CodecService.List({
+        organizationID: organizationID,
+        limit: limit,
+        offset: offset,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  notify(action) {
+    dispatcher.dispatch({
+      type: "CREATE_NOTIFICATION",
+      notification: {
+        type: "success",
+        message: "codec has been " + action,
+      },
+    });
+  }
+}
+
+const codecStore = new CodecStore();
+export default codecStore;
@@ -5,6 +5,8 @@ import TextField from '@material-ui/core/TextField';
 import FormControl from "@material-ui/core/FormControl";
 import FormLabel from "@material-ui/core/FormLabel";
 import FormHelperText from "@material-ui/core/FormHelperText";
+import FormControlLabel from '@material-ui/core/FormControlLabel';
+import Checkbox from '@material-ui/core/Checkbox';
 
 import {Controlled as CodeMirror} from "react-codemirror2";
 import "codemirror/mode/javascript/javascript";
@@ -13,6 +15,7 @@ import FormComponent from "../../classes/FormComponent";
 import Form from "../../components/Form";
 import AutocompleteSelect from "../../components/AutocompleteSelect";
 import ServiceProfileStore from "../../stores/ServiceProfileStore";
+import CodecStore from "../../stores/CodecStore";
 
 
 const styles = {
@@ -30,6 +33,7 @@ class ApplicationForm extends FormComponent {
     super();
     this.getServiceProfileOption = this.getServiceProfileOption.bind(this);
     this.getServiceProfileOptions = this.getServiceProfileOptions.bind(this);
+    this.getCodecOptions = this.getCodecOptions.bind(this);
     this.getPayloadCodecOptions = this.getPayloadCodecOptions.bind(this);
     this.onCodeChange = this.onCodeChange.bind(this);
   }
@@ -47,11 +51,22 @@ class ApplicationForm extends FormComponent {
     });
   }
 
+  getCodecOptions(search, callbackFunc) {
+    CodecStore.list(this.props.match.params.organizationID, 999, 0, resp => {
+      let options = [{label: "None", value: "0"}];
+      options = options.concat(resp.result.map((c, i) => {return {label: `${c.name} (version ${c.version})`, value: c.id}}));
+      callbackFunc(options);
+    });
+  }
+
   getPayloadCodecOptions(search, callbackFunc) {
     const payloadCodecOptions = [
       {value: "", label: "None"},
       {value: "CAYENNE_LPP", label: "Cayenne LPP"},
+      {value: "CAYENNE_LPP_PACKED", label: "Cayenne LPP (packed)"},
       {value: "CUSTOM_JS", label: "Custom JavaScript codec functions"},
+      {value: "BINARY_SCHEMA", label: "Binary schema"},
+      {value: "PROTOBUF", label: "Protocol Buffers"},
     ];
 
     callbackFunc(payloadCodecOptions);
@@ -76,6 +91,7 @@ class ApplicationForm extends FormComponent {
       theme: "base16-light",
     };
     
+    const sharedCodec = this.state.object.codecID !== undefined && this.state.object.codecID !== "0";
     let payloadEncoderScript = this.state.object.payloadEncoderScript;
     let payloadDecoderScript = this.state.object.payloadDecoderScript;
 
@@ -93,8 +109,10 @@ function Encode(fPort, obj) {
       payloadDecoderScript = `// Decode decodes an array of bytes into an object.
 //  - fPort contains the LoRaWAN fPort number
 //  - bytes is an array of bytes, e.g. [225, 230, 255, 0]
+//  - variables contains the device variables e.g. {"calibration": "3.5"}
+//  - uplink contains the uplink metadata, e.g. {"devEUI": "0102030405060708", "fCnt": 10, ...}
 // The function must return an object, e.g. {"temperature": 22.5}
-function Decode(fPort, bytes) {
+function Decode(fPort, bytes, variables, uplink) {
   return {};
 }`;
     }
@@ -138,6 +156,19 @@ function Decode(fPort, bytes) {
           </FormHelperText>
         </FormControl>}
         <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>Shared codec</FormLabel>
+          <AutocompleteSelect
+            id="codecID"
+            label="Select shared codec"
+            value={this.state.object.codecID || "0"}
+            onChange={this.onChange}
+            getOptions={this.getCodecOptions}
+          />
+          <FormHelperText>
+            When selecting a codec of the organization codec library, the payload codec configured below is not used.
+          </FormHelperText>
+        </FormControl>
+        {!sharedCodec && <FormControl fullWidth margin="normal">
           <FormLabel className={this.props.classes.formLabel}>Payload codec</FormLabel>
           <AutocompleteSelect
             id="payloadCodec"
@@ -149,8 +180,32 @@ function Decode(fPort, bytes) {
           <FormHelperText>
             By defining a payload codec, LoRa App Server can encode and decode the binary device payload for you.
           </FormHelperText>
-        </FormControl>
-        {this.state.object.payloadCodec === "CUSTOM_JS" && <FormControl fullWidth margin="normal">
+        </FormControl>}
+        {!sharedCodec && this.state.object.payloadCodec === "BINARY_SCHEMA" && <FormControl fullWidth margin="normal">
+          <CodeMirror
+            value={this.state.object.payloadDecoderScript || ""}
+            options={{...codeMirrorOptions, mode: {name: "javascript", json: true}}}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            The JSON encoded schema describing the layout of the binary payload (per fPort).
+            LoRa App Server uses this schema both for decoding and encoding the payload.
+          </FormHelperText>
+        </FormControl>}
+        {!sharedCodec && this.state.object.payloadCodec === "PROTOBUF" && <FormControl fullWidth margin="normal">
+          <CodeMirror
+            value={this.state.object.payloadDecoderScript || ""}
+            options={{...codeMirrorOptions, mode: {name: "javascript", json: true}}}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            A JSON object containing the <strong>fileDescriptorSet</strong> (base64 encoded output of <strong>protoc --include_imports --descriptor_set_out</strong>)
+            and the <strong>messages</strong> object, mapping each fPort to a fully-qualified message name.
+          </FormHelperText>
+        </FormControl>}
+        {!sharedCodec && this.state.object.payloadCodec === "CUSTOM_JS" && <FormControl fullWidth margin="normal">
           <CodeMirror
             value={payloadDecoderScript}
             options={codeMirrorOptions}
@@ -158,11 +213,11 @@ function Decode(fPort, bytes) {
             className={this.props.classes.codeMirror}
           />
           <FormHelperText>
-            The function must have the signature <strong>function Decode(fPort, bytes)</strong> and must return an object.
+            The function must have the signature <strong>function Decode(fPort, bytes, variables, uplink)</strong> and must return an object.
             LoRa App Server will convert this object to JSON.
           </FormHelperText>
         </FormControl>}
-        {this.state.object.payloadCodec === "CUSTOM_JS" && <FormControl fullWidth margin="normal">
+        {!sharedCodec && this.state.object.payloadCodec === "CUSTOM_JS" && <FormControl fullWidth margin="normal">
           <CodeMirror
             value={payloadEncoderScript}
             options={codeMirrorOptions}
@@ -174,6 +229,22 @@ function Decode(fPort, bytes) {
             of bytes.
           </FormHelperText>
         </FormControl>}
+        <FormControl fullWidth margin="normal">
+          <FormControlLabel
+            label="Publish decoded object as SenML"
+            control={
+              <Checkbox
+                id="senMLOutput"
+                checked={!!this.state.object.senMLOutput}
+                onChange={this.onChange}
+                color="primary"
+              />
+            }
+          />
+          <FormHelperText>
+            When checked, the decoded object is published as a list of SenML (RFC 8428) records.
+          </FormHelperText>
+        </FormControl>
       </Form>
     );
   }
@@ -0,0 +1,182 @@
+import React from "react";
+
+import { withStyles } from "@material-ui/core/styles";
+import TextField from '@material-ui/core/TextField';
+import FormControl from "@material-ui/core/FormControl";
+import FormLabel from "@material-ui/core/FormLabel";
+import FormHelperText from "@material-ui/core/FormHelperText";
+
+import {Controlled as CodeMirror} from "react-codemirror2";
+import "codemirror/mode/javascript/javascript";
+
+import FormComponent from "../../classes/FormComponent";
+import Form from "../../components/Form";
+import AutocompleteSelect from "../../components/AutocompleteSelect";
+
+
+const styles = {
+  codeMirror: {
+    zIndex: 1,
+  },
+  formLabel: {
+    fontSize: 12,
+  },
+};
+
+
+class CodecForm extends FormComponent {
+  constructor() {
+    super();
+    this.getPayloadCodecOptions = this.getPayloadCodecOptions.bind(this);
+    this.onCodeChange = this.onCodeChange.bind(this);
+  }
+
+  getPayloadCodecOptions(search, callbackFunc) {
+    const payloadCodecOptions = [
+      {value: "", label: "None"},
+      {value: "CAYENNE_LPP", label: "Cayenne LPP"},
+      {value: "CAYENNE_LPP_PACKED", label: "Cayenne LPP (packed)"},
+      {value: "CUSTOM_JS", label: "Custom JavaScript codec functions"},
+      {value: "BINARY_SCHEMA", label: "Binary schema"},
+      {value: "PROTOBUF", label: "Protocol Buffers"},
+    ];
+
+    callbackFunc(payloadCodecOptions);
+  }
+
+  onCodeChange(field, editor, data, newCode) {
+    let object = this.state.object;
+    object[field] = newCode;
+    this.setState({
+      object: object,
+    });
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    const codeMirrorOptions = {
+      lineNumbers: true,
+      mode: "javascript",
+      theme: "base16-light",
+    };
+    
+    let payloadEncoderScript = this.state.object.payloadEncoderScript;
+    let payloadDecoderScript = this.state.object.payloadDecoderScript;
+
+    if (payloadEncoderScript === "" || payloadEncoderScript === undefined) {
+      payloadEncoderScript = `// Encode encodes the given object into an array of bytes.
+//  - fPort contains the LoRaWAN fPort number
+//  - obj is an object, e.g. {"temperature": 22.5}
+// The function must return an array of bytes, e.g. [225, 230, 255, 0]
+function Encode(fPort, obj) {
+  return [];
+}`;
+    }
+
+    if (payloadDecoderScript === "" || payloadDecoderScript === undefined) {
+      payloadDecoderScript = `// Decode decodes an array of bytes into an object.
+//  - fPort contains the LoRaWAN fPort number
+//  - bytes is an array of bytes, e.g. [225, 230, 255, 0]
+//  - variables contains the device variables e.g. {"calibration": "3.5"}
+//  - uplink contains the uplink metadata, e.g. {"devEUI": "0102030405060708", "fCnt": 10, ...}
+// The function must return an object, e.g. {"temperature": 22.5}
+function Decode(fPort, bytes, variables, uplink) {
+  return {};
+}`;
+    }
+
+    return(
+      <Form
+        submitLabel={this.props.submitLabel}
+        onSubmit={this.onSubmit}
+        disabled={this.props.disabled}
+      >
+        <TextField
+          id="name"
+          label="Codec name"
+          margin="normal"
+          value={this.state.object.name || ""}
+          onChange={this.onChange}
+          helperText="A name to identify the codec within the organization."
+          fullWidth
+          required
+        />
+        <TextField
+          id="description"
+          label="Codec description"
+          margin="normal"
+          value={this.state.object.description || ""}
+          onChange={this.onChange}
+          fullWidth
+        />
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>Payload codec</FormLabel>
+          <AutocompleteSelect
+            id="payloadCodec"
+            label="Select payload codec"
+            value={this.state.object.payloadCodec || ""}
+            onChange={this.onChange}
+            getOptions={this.getPayloadCodecOptions}
+          />
+          <FormHelperText>
+            The payload codec shared by all applications using this codec.
+            Every update of the codec increments its version.
+          </FormHelperText>
+        </FormControl>
+        {this.state.object.payloadCodec === "BINARY_SCHEMA" && <FormControl fullWidth margin="normal">
+          <CodeMirror
+            value={this.state.object.payloadDecoderScript || ""}
+            options={{...codeMirrorOptions, mode: {name: "javascript", json: true}}}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            The JSON encoded schema describing the layout of the binary payload (per fPort).
+            LoRa App Server uses this schema both for decoding and encoding the payload.
+          </FormHelperText>
+        </FormControl>}
+        {this.state.object.payloadCodec === "PROTOBUF" && <FormControl fullWidth margin="normal">
+          <CodeMirror
+            value={this.state.object.payloadDecoderScript || ""}
+            options={{...codeMirrorOptions, mode: {name: "javascript", json: true}}}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            A JSON object containing the <strong>fileDescriptorSet</strong> (base64 encoded output of <strong>protoc --include_imports --descriptor_set_out</strong>)
+            and the <strong>messages</strong> object, mapping each fPort to a fully-qualified message name.
+          </FormHelperText>
+        </FormControl>}
+        {this.state.object.payloadCodec === "CUSTOM_JS" && <FormControl fullWidth margin="normal">
+          <CodeMirror
+            value={payloadDecoderScript}
+            options={codeMirrorOptions}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            The function must have the signature <strong>function Decode(fPort, bytes, variables, uplink)</strong> and must return an object.
+            LoRa App Server will convert this object to JSON.
+          </FormHelperText>
+        </FormControl>}
+        {this.state.object.payloadCodec === "CUSTOM_JS" && <FormControl fullWidth margin="normal">
+          <CodeMirror
+            value={payloadEncoderScript}
+            options={codeMirrorOptions}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadEncoderScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            The function must have the signature <strong>function Encode(fPort, obj)</strong> and must return an array
+            of bytes.
+          </FormHelperText>
+        </FormControl>}
+      </Form>
+    );
+  }
+}
+
+export default withStyles(styles)(CodecForm);
@@ -0,0 +1,88 @@
+import React, { Component } from "react";
+import { withRouter } from "react-router-dom";
+
+import Grid from '@material-ui/core/Grid';
+
+import Delete from "mdi-material-ui/Delete";
+
+import TitleBar from "../../components/TitleBar";
+import TitleBarTitle from "../../components/TitleBarTitle";
+import TitleBarButton from "../../components/TitleBarButton";
+import Admin from "../../components/Admin";
+import CodecStore from "../../stores/CodecStore";
+import SessionStore from "../../stores/SessionStore";
+import UpdateCodec from "./UpdateCodec";
+
+
+class CodecLayout extends Component {
+  constructor() {
+    super();
+    this.state = {
+      admin: false,
+    };
+    this.deleteCodec = this.deleteCodec.bind(this);
+    this.setIsAdmin = this.setIsAdmin.bind(this);
+  }
+
+  componentDidMount() {
+    CodecStore.get(this.props.match.params.codecID, resp => {
+      this.setState({
+        codec: resp,
+      });
+    });
+
+    SessionStore.on("change", this.setIsAdmin);
+    this.setIsAdmin();
+  }
+
+  componentWillUpdate() {
+    SessionStore.removeListener("change", this.setIsAdmin);
+  }
+
+  setIsAdmin() {
+    this.setState({
+      admin: SessionStore.isAdmin() || SessionStore.isOrganizationAdmin(this.props.match.params.organizationID),
+    });
+  }
+
+  deleteCodec() {
+    if (window.confirm("Are you sure you want to delete this codec?")) {
+      CodecStore.delete(this.props.match.params.codecID, resp => {
+        this.props.history.push(`/organizations/${this.props.match.params.organizationID}/codecs`);
+      });
+    }
+  }
+
+  render() {
+    if (this.state.codec === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <Grid container spacing={24}>
+        <TitleBar
+          buttons={
+            <Admin organizationID={this.props.match.params.organizationID}>
+              <TitleBarButton
+                label="Delete"
+                icon={<Delete />}
+                color="secondary"
+                onClick={this.deleteCodec}
+              />
+            </Admin>
+          }
+        >
+          <TitleBarTitle to={`/organizations/${this.props.match.params.organizationID}/codecs`} title="Codecs" />
+          <TitleBarTitle title="/" />
+          <TitleBarTitle title={this.state.codec.codec.name} />
+        </TitleBar>
+
+        <Grid item xs={12}>
+          <UpdateCodec codec={this.state.codec.codec} admin={this.state.admin} />
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default withRouter(CodecLayout);
@@ -0,0 +1,63 @@
+import React, { Component } from "react";
+import { withRouter } from 'react-router-dom';
+
+import { withStyles } from "@material-ui/core/styles";
+import Grid from '@material-ui/core/Grid';
+import Card from '@material-ui/core/Card';
+import CardContent from "@material-ui/core/CardContent";
+
+import TitleBar from "../../components/TitleBar";
+import TitleBarTitle from "../../components/TitleBarTitle";
+
+import CodecForm from "./CodecForm";
+import CodecStore from "../../stores/CodecStore";
+
+
+const styles = {
+  card: {
+    overflow: "visible",
+  },
+};
+
+
+class CreateCodec extends Component {
+  constructor() {
+    super();
+    this.onSubmit = this.onSubmit.bind(this);
+  }
+
+  onSubmit(codec) {
+    let c = codec;
+    c.organizationID = this.props.match.params.organizationID;
+
+    CodecStore.create(c, resp => {
+      this.props.history.push(`/organizations/${this.props.match.params.organizationID}/codecs`);
+    });
+  }
+
+  render() {
+    return(
+      <Grid container spacing={24}>
+        <TitleBar>
+          <TitleBarTitle title="Codecs" to={`/organizations/${this.props.match.params.organizationID}/codecs`} />
+          <TitleBarTitle title="/" />
+          <TitleBarTitle title="Create" />
+        </TitleBar>
+
+        <Grid item xs={12}>
+          <Card className={this.props.classes.card}>
+            <CardContent>
+              <CodecForm
+                submitLabel="Create codec"
+                onSubmit={this.onSubmit}
+                match={this.props.match}
+              />
+            </CardContent>
+          </Card>
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default withStyles(styles)(withRouter(CreateCodec));
@@ -0,0 +1,76 @@
+import React, { Component } from "react";
+
+import Grid from '@material-ui/core/Grid';
+import TableCell from '@material-ui/core/TableCell';
+import TableRow from '@material-ui/core/TableRow';
+
+import Plus from "mdi-material-ui/Plus";
+
+import TitleBar from "../../components/TitleBar";
+import TitleBarTitle from "../../components/TitleBarTitle";
+import TableCellLink from "../../components/TableCellLink";
+import TitleBarButton from "../../components/TitleBarButton";
+import DataTable from "../../components/DataTable";
+import Admin from "../../components/Admin";
+import CodecStore from "../../stores/CodecStore";
+
+
+class ListCodecs extends Component {
+  constructor() {
+    super();
+
+    this.getPage = this.getPage.bind(this);
+    this.getRow = this.getRow.bind(this);
+  }
+
+  getPage(limit, offset, callbackFunc) {
+    CodecStore.list(this.props.match.params.organizationID, limit, offset, callbackFunc);
+  }
+
+  getRow(obj) {
+    return(
+      <TableRow key={obj.id}>
+        <TableCellLink to={`/organizations/${this.props.match.params.organizationID}/codecs/${obj.id}`}>{obj.name}</TableCellLink>
+        <TableCell>{obj.payloadCodec}</TableCell>
+        <TableCell>{obj.version}</TableCell>
+        <TableCell>{obj.applicationCount}</TableCell>
+      </TableRow>
+    );
+  }
+
+  render() {
+    return(
+      <Grid container spacing={24}>
+        <TitleBar
+          buttons={
+            <Admin organizationID={this.props.match.params.organizationID}>
+              <TitleBarButton
+                label="Create"
+                icon={<Plus />}
+                to={`/organizations/${this.props.match.params.organizationID}/codecs/create`}
+              />
+            </Admin>
+          }
+        >
+          <TitleBarTitle title="Codecs" />
+        </TitleBar>
+        <Grid item xs={12}>
+          <DataTable
+            header={
+              <TableRow>
+                <TableCell>Name</TableCell>
+                <TableCell>Payload codec</TableCell>
+                <TableCell>Version</TableCell>
+                <TableCell>Applications</TableCell>
+              </TableRow>
+            }
+            getPage={this.getPage}
+            getRow={this.getRow}
+          />
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default ListCodecs;
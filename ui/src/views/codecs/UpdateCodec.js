@@ -0,0 +1,46 @@
+import React, { Component } from "react";
+import { withRouter } from 'react-router-dom';
+
+import Grid from '@material-ui/core/Grid';
+import Card from '@material-ui/core/Card';
+import CardContent from "@material-ui/core/CardContent";
+
+import CodecStore from "../../stores/CodecStore";
+import CodecForm from "./CodecForm";
+
+
+class UpdateCodec extends Component {
+  constructor() {
+    super();
+    this.onSubmit = this.onSubmit.bind(this);
+  }
+
+  onSubmit(codec) {
+    CodecStore.update(codec, resp => {
+      this.props.history.push(`/organizations/${this.props.match.params.organizationID}/codecs`);
+    });
+  }
+
+  render() {
+    return(
+      <Grid container spacing={24}>
+        <Grid item xs={12}>
+          <Card>
+            <CardContent>
+              <CodecForm
+                submitLabel="Update codec"
+                object={this.props.codec}
+                onSubmit={this.onSubmit}
+                match={this.props.match}
+                disabled={!this.props.admin}
+                update={true}
+              />
+            </CardContent>
+          </Card>
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default withRouter(UpdateCodec);